@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	command_dto "fitness-mcp-server/internal/application/command/dto"
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// runCLI はCLIを実行し、終了コードと標準出力・標準エラー出力を返します
+func runCLI(t *testing.T, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestParseExerciseSpec(t *testing.T) {
+	t.Run("正常系:重量x回数xセット数@RPEをカンマ区切りで指定できる", func(t *testing.T) {
+		// Act
+		exercise, err := parseExerciseSpec("ベンチプレス:80x5x2@8,85×3@8.5, 60x10")
+
+		// Assert
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "ベンチプレス", exercise.Name)
+		if !assert.Len(t, exercise.Sets, 4) {
+			return
+		}
+		rpe8, rpe85 := 8.0, 8.5
+		assert.Equal(t, command_dto.SetDTO{WeightKg: 80, Reps: 5, RPE: &rpe8}, exercise.Sets[0])
+		assert.Equal(t, command_dto.SetDTO{WeightKg: 80, Reps: 5, RPE: &rpe8}, exercise.Sets[1])
+		assert.Equal(t, command_dto.SetDTO{WeightKg: 85, Reps: 3, RPE: &rpe85}, exercise.Sets[2])
+		assert.Equal(t, command_dto.SetDTO{WeightKg: 60, Reps: 10}, exercise.Sets[3])
+	})
+
+	t.Run("異常系:形式が不正な場合はエラーを返す", func(t *testing.T) {
+		for _, spec := range []string{"ベンチプレス", ":80x5", "ベンチプレス:80", "ベンチプレス:80x5x0", "ベンチプレス:abcx5", "ベンチプレス:80x5@hard"} {
+			// Act
+			_, err := parseExerciseSpec(spec)
+
+			// Assert
+			assert.Error(t, err, spec)
+		}
+	})
+}
+
+func TestParseDuration(t *testing.T) {
+	t.Run("正常系:MM:SS・H:MM:SS形式、または分数を秒数に変換する", func(t *testing.T) {
+		tests := map[string]float64{"25:30": 1530, "1:02:03": 3723, "45": 2700, "42.5": 2550}
+		for value, expected := range tests {
+			// Act
+			seconds, err := parseDuration(value)
+
+			// Assert
+			assert.NoError(t, err, value)
+			assert.Equal(t, expected, seconds, value)
+		}
+	})
+
+	t.Run("異常系:秒が60以上・形式が不正な場合はエラーを返す", func(t *testing.T) {
+		for _, value := range []string{"25:60", "1:2:3:4", "abc", ""} {
+			// Act
+			_, err := parseDuration(value)
+
+			// Assert
+			assert.Error(t, err, value)
+		}
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Run("正常系:記録した筋トレ・ランニングを自己ベスト・直近のランニングとして参照できる", func(t *testing.T) {
+		// Arrange
+		t.Setenv("MCP_DATA_DIR", t.TempDir())
+		code, _, stderr := runCLI(t, "log", "strength", "-date", "2025-06-01", "ベンチプレス:80x5x3,90x1@9")
+		assert.Equal(t, 0, code, stderr)
+		code, _, stderr = runCLI(t, "log", "run", "-date", "2025-06-02", "-distance", "10", "-duration", "50:00", "-hr", "150")
+		assert.Equal(t, 0, code, stderr)
+
+		// Act
+		prsCode, prsOut, _ := runCLI(t, "prs", "-exercise", "ベンチプレス", "-json")
+		runsCode, runsOut, _ := runCLI(t, "runs", "-days", "7", "-date", "2025-06-02", "-json")
+		tableCode, tableOut, _ := runCLI(t, "runs", "-days", "7", "-date", "2025-06-02")
+
+		// Assert
+		assert.Equal(t, 0, prsCode)
+		var records query_dto.GetPersonalRecordsResponse
+		if assert.NoError(t, json.Unmarshal([]byte(prsOut), &records)) && assert.Len(t, records.Records, 1) {
+			assert.Equal(t, 90.0, records.Records[0].MaxWeight.Value)
+			assert.Equal(t, 1, records.Records[0].TotalSessions)
+		}
+
+		assert.Equal(t, 0, runsCode)
+		var runs query_dto.GetRecentRunsResponse
+		if assert.NoError(t, json.Unmarshal([]byte(runsOut), &runs)) && assert.Len(t, runs.Runs, 1) {
+			assert.Equal(t, 10.0, runs.Runs[0].DistanceKm)
+			assert.Equal(t, "5:00/km", runs.Runs[0].Pace)
+		}
+
+		assert.Equal(t, 0, tableCode)
+		assert.Contains(t, tableOut, "DATE")
+		assert.Contains(t, tableOut, "2025-06-02  Easy")
+	})
+
+	t.Run("正常系:作成したスナップショットをファイル名で指定して検証・復元できる", func(t *testing.T) {
+		// Arrange
+		t.Setenv("MCP_DATA_DIR", t.TempDir())
+		code, _, stderr := runCLI(t, "log", "strength", "-date", "2025-06-01", "ベンチプレス:80x5")
+		assert.Equal(t, 0, code, stderr)
+		code, createOut, stderr := runCLI(t, "backup", "create", "-label", "test", "-json")
+		assert.Equal(t, 0, code, stderr)
+		var snapshot snapshotOutput
+		if !assert.NoError(t, json.Unmarshal([]byte(createOut), &snapshot)) {
+			return
+		}
+		code, _, stderr = runCLI(t, "log", "strength", "-date", "2025-06-02", "ベンチプレス:90x5")
+		assert.Equal(t, 0, code, stderr)
+
+		// Act
+		verifyCode, verifyOut, verifyErr := runCLI(t, "backup", "restore", "-verify-only", filepath.Base(snapshot.Path))
+		restoreCode, restoreOut, restoreErr := runCLI(t, "backup", "restore", filepath.Base(snapshot.Path))
+		prsCode, prsOut, _ := runCLI(t, "prs", "-exercise", "ベンチプレス", "-json")
+
+		// Assert
+		assert.Equal(t, 0, verifyCode, verifyErr)
+		assert.Contains(t, verifyOut, "復元できます")
+		assert.Equal(t, 0, restoreCode, restoreErr)
+		assert.Contains(t, restoreOut, "復元前のデータベースを保存しました")
+		assert.Equal(t, 0, prsCode)
+		var records query_dto.GetPersonalRecordsResponse
+		if assert.NoError(t, json.Unmarshal([]byte(prsOut), &records)) && assert.Len(t, records.Records, 1) {
+			assert.Equal(t, 80.0, records.Records[0].MaxWeight.Value)
+		}
+	})
+
+	t.Run("正常系:ユーザーのトークンを発行し、発行状況を一覧表示できる", func(t *testing.T) {
+		// Arrange
+		t.Setenv("MCP_DATA_DIR", t.TempDir())
+
+		// Act
+		tokenCode, tokenOut, tokenErr := runCLI(t, "user", "token", "alice")
+		listCode, listOut, _ := runCLI(t, "user", "list", "-json")
+
+		// Assert
+		assert.Equal(t, 0, tokenCode, tokenErr)
+		assert.NotEmpty(t, strings.TrimSpace(tokenOut))
+		assert.Equal(t, 0, listCode)
+		var users []userOutput
+		if !assert.NoError(t, json.Unmarshal([]byte(listOut), &users)) {
+			return
+		}
+		tokens := map[string]bool{}
+		for _, u := range users {
+			tokens[u.ID] = u.HasToken
+		}
+		assert.Equal(t, true, tokens["alice"])
+	})
+
+	t.Run("正常系:コマンドの定義から各シェルの補完スクリプトを出力する", func(t *testing.T) {
+		// Arrange
+		t.Setenv("MCP_DATA_DIR", t.TempDir())
+
+		for _, shell := range []string{"bash", "zsh", "fish"} {
+			// Act
+			code, stdout, stderr := runCLI(t, "completion", shell)
+
+			// Assert
+			assert.Equal(t, 0, code, stderr)
+			assert.Contains(t, stdout, "strength", shell)
+			assert.Contains(t, stdout, "distance", shell)
+		}
+	})
+
+	t.Run("異常系:不明なコマンド・サブコマンドや不正な引数の場合は0以外で終了する", func(t *testing.T) {
+		// Arrange
+		t.Setenv("MCP_DATA_DIR", t.TempDir())
+
+		for _, args := range [][]string{
+			{"unknown"},
+			{"log"},
+			{"log", "swim"},
+			{"log", "strength", "ベンチプレス:80"},
+			{"log", "run", "-distance", "5"},
+			{"runs", "-date", "2025/06/01"},
+			{"completion", "powershell"},
+			{"backup", "restore"},
+			{"backup", "restore", "missing.db"},
+			{"user", "token"},
+		} {
+			// Act
+			code, _, stderr := runCLI(t, args...)
+
+			// Assert
+			assert.NotEqual(t, 0, code, args)
+			assert.NotEmpty(t, stderr, args)
+		}
+	})
+}
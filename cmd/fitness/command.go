@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// runFunc はフラグを解釈した後の位置引数でサブコマンドを実行します
+type runFunc func(c *cli, args []string) error
+
+// command はサブコマンドの定義
+// 実行とシェル補完の両方で同じ定義を使用します
+type command struct {
+	name        string
+	summary     string
+	args        string                         // 位置引数の表記（使い方の表示用）
+	files       bool                           // 位置引数にファイルを補完するか
+	values      []string                       // 位置引数の候補（補完用）
+	subcommands []*command                     // 指定した場合は1つ目の引数でサブコマンドを選択
+	setup       func(fs *flag.FlagSet) runFunc // フラグを登録し、実行する関数を返す
+}
+
+// commands はすべてのコマンドを返します
+func commands() []*command {
+	return []*command{
+		logCommand(),
+		runsCommand(),
+		prsCommand(),
+		exportCommand(),
+		importCommand(),
+		backupCommand(),
+		migrateCommand(),
+		userCommand(),
+		completionCommand(),
+	}
+}
+
+// findCommand は名前が一致するコマンドを返します（見つからない場合はnil）
+func findCommand(cmds []*command, name string) *command {
+	for _, cmd := range cmds {
+		if cmd.name == name {
+			return cmd
+		}
+	}
+	return nil
+}
+
+// execute はフラグを解釈してコマンドを実行します（サブコマンドがある場合はそちらに委譲）
+func (cmd *command) execute(c *cli, parent string, args []string) error {
+	path := parent + " " + cmd.name
+	if len(cmd.subcommands) > 0 {
+		if len(args) == 0 {
+			return fmt.Errorf("%s requires a subcommand (%s)", path, strings.Join(cmd.subcommandNames(), ", "))
+		}
+		if args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+			cmd.printSubcommands(c.errOut, path)
+			return flag.ErrHelp
+		}
+		sub := findCommand(cmd.subcommands, args[0])
+		if sub == nil {
+			return fmt.Errorf("unknown %s subcommand: %s (%s)", path, args[0], strings.Join(cmd.subcommandNames(), ", "))
+		}
+		return sub.execute(c, path, args[1:])
+	}
+
+	fs := cmd.flagSet(path)
+	fs.SetOutput(c.errOut)
+	run := cmd.setup(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return run(c, fs.Args())
+}
+
+// flagSet は使い方の表示を設定したフラグセットを作成します
+func (cmd *command) flagSet(path string) *flag.FlagSet {
+	fs := flag.NewFlagSet(path, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "使い方: %s\n  %s\n", strings.TrimSpace(path+" [フラグ] "+cmd.args), cmd.summary)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// printSubcommands はサブコマンドの一覧を表示します
+func (cmd *command) printSubcommands(w io.Writer, path string) {
+	fmt.Fprintf(w, "使い方: %s <サブコマンド> [フラグ]\n  %s\n\nサブコマンド:\n", path, cmd.summary)
+	for _, sub := range cmd.subcommands {
+		fmt.Fprintf(w, "  %-10s %s\n", sub.name, sub.summary)
+	}
+}
+
+// subcommandNames はサブコマンドの名前を返します
+func (cmd *command) subcommandNames() []string {
+	names := make([]string, 0, len(cmd.subcommands))
+	for _, sub := range cmd.subcommands {
+		names = append(names, sub.name)
+	}
+	return names
+}
+
+// flagNames はコマンドのフラグ名を返します（サブコマンドがある場合は空）
+func (cmd *command) flagNames() []string {
+	if cmd.setup == nil {
+		return nil
+	}
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	cmd.setup(fs)
+	var names []string
+	fs.VisitAll(func(f *flag.Flag) {
+		names = append(names, "-"+f.Name)
+	})
+	return names
+}
+
+// candidates はフラグと位置引数の候補を補完の候補として返します
+func (cmd *command) candidates() []string {
+	return append(cmd.flagNames(), cmd.values...)
+}
+
+// jsonFlag は出力をJSONに切り替えるフラグを登録します
+func jsonFlag(fs *flag.FlagSet) *bool {
+	return fs.Bool("json", false, "表の代わりにJSONで出力します")
+}
+
+// render は結果をJSON、または表として出力します
+func (c *cli) render(asJSON bool, data any, table func(w io.Writer)) error {
+	if asJSON {
+		encoder := json.NewEncoder(c.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(data)
+	}
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	table(w)
+	return w.Flush()
+}
+
+// optionalString は空でない場合のみ値へのポインタを返します
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
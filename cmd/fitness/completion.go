@@ -0,0 +1,201 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// =============================================================================
+// シェル補完 - コマンドの定義からbash・zsh・fishの補完スクリプトを生成します
+// =============================================================================
+
+// completionCommand は補完スクリプトを出力するコマンドを返します
+func completionCommand() *command {
+	return &command{
+		name:    "completion",
+		summary: "シェル補完のスクリプトを出力します（例: source <(fitness completion bash)）",
+		args:    "bash|zsh|fish",
+		values:  []string{"bash", "zsh", "fish"},
+		setup: func(fs *flag.FlagSet) runFunc {
+			return func(c *cli, args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("completion requires a shell (bash, zsh or fish)")
+				}
+				switch args[0] {
+				case "bash":
+					writeBashCompletion(c.out, commands())
+				case "zsh":
+					writeZshCompletion(c.out, commands())
+				case "fish":
+					writeFishCompletion(c.out, commands())
+				default:
+					return fmt.Errorf("unsupported shell: %s (bash, zsh or fish)", args[0])
+				}
+				return nil
+			}
+		},
+	}
+}
+
+// completionEntry は補完候補を決めるコマンドの位置（ルートはpathが空）
+type completionEntry struct {
+	path []string
+	cmd  *command // ルートの場合はnil
+}
+
+// completionEntries はルートとすべてのコマンド・サブコマンドを深さ優先で返します
+func completionEntries(cmds []*command) []completionEntry {
+	entries := []completionEntry{{}}
+	var walk func(path []string, cmds []*command)
+	walk = func(path []string, cmds []*command) {
+		for _, cmd := range cmds {
+			cmdPath := append(append([]string{}, path...), cmd.name)
+			entries = append(entries, completionEntry{path: cmdPath, cmd: cmd})
+			walk(cmdPath, cmd.subcommands)
+		}
+	}
+	walk(nil, cmds)
+	return entries
+}
+
+// children はエントリの直下のコマンドを返します
+func (e completionEntry) children(root []*command) []*command {
+	if e.cmd == nil {
+		return root
+	}
+	return e.cmd.subcommands
+}
+
+// commandNames はコマンドの名前を返します
+func commandNames(cmds []*command) []string {
+	names := make([]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		names = append(names, cmd.name)
+	}
+	return names
+}
+
+// writeBashCompletion はbashの補完スクリプトを出力します
+// -config の値を除いたフラグ以外の単語をコマンドのパスとして候補を決めます
+func writeBashCompletion(w io.Writer, cmds []*command) {
+	fmt.Fprint(w, `# fitness のbash補完（source <(fitness completion bash)）
+_fitness() {
+    local cur="${COMP_WORDS[COMP_CWORD]}"
+    local i cmdpath=""
+    for ((i = 1; i < COMP_CWORD; i++)); do
+        case "${COMP_WORDS[i]}" in
+            -config) ((i++)) ;;
+            -*) ;;
+            *) cmdpath="${cmdpath:+$cmdpath }${COMP_WORDS[i]}" ;;
+        esac
+    done
+    case "$cmdpath" in
+`)
+	for _, entry := range completionEntries(cmds) {
+		path := strings.Join(entry.path, " ")
+		if entry.cmd == nil || len(entry.cmd.subcommands) > 0 {
+			// コマンドのグループはサブコマンドを補完する
+			fmt.Fprintf(w, "        %q) COMPREPLY=($(compgen -W %q -- \"$cur\")) ;;\n", path, strings.Join(commandNames(entry.children(cmds)), " "))
+			continue
+		}
+		// 実行するコマンドはフラグ（引数にファイルを取る場合はファイルも）を補完する
+		flags := fmt.Sprintf("COMPREPLY=($(compgen -W %q -- \"$cur\"))", strings.Join(entry.cmd.candidates(), " "))
+		if entry.cmd.files {
+			flags = fmt.Sprintf("if [[ $cur == -* ]]; then %s; else COMPREPLY=($(compgen -f -- \"$cur\")); fi", flags)
+		}
+		fmt.Fprintf(w, "        %q*) %s ;;\n", path, flags)
+	}
+	fmt.Fprint(w, `    esac
+}
+complete -o filenames -F _fitness fitness
+`)
+}
+
+// writeZshCompletion はzshの補完スクリプトを出力します
+func writeZshCompletion(w io.Writer, cmds []*command) {
+	fmt.Fprint(w, `#compdef fitness
+# fitness のzsh補完（source <(fitness completion zsh)、または$fpathの_fitnessに保存）
+_fitness() {
+    local i cmdpath=""
+    local -a items
+    for ((i = 2; i < CURRENT; i++)); do
+        case "${words[i]}" in
+            -config) ((i++)) ;;
+            -*) ;;
+            *) cmdpath="${cmdpath:+$cmdpath }${words[i]}" ;;
+        esac
+    done
+    case "$cmdpath" in
+`)
+	for _, entry := range completionEntries(cmds) {
+		path := strings.Join(entry.path, " ")
+		if entry.cmd == nil || len(entry.cmd.subcommands) > 0 {
+			items := make([]string, 0, len(entry.children(cmds)))
+			for _, child := range entry.children(cmds) {
+				items = append(items, shellQuote(child.name+":"+child.summary))
+			}
+			fmt.Fprintf(w, "        %q) items=(%s); _describe 'command' items ;;\n", path, strings.Join(items, " "))
+			continue
+		}
+		action := fmt.Sprintf("compadd -- %s", strings.Join(entry.cmd.candidates(), " "))
+		if entry.cmd.files {
+			action += "; _files"
+		}
+		fmt.Fprintf(w, "        %q*) %s ;;\n", path, action)
+	}
+	fmt.Fprint(w, `    esac
+}
+if [[ "${funcstack[1]}" == "_fitness" ]]; then
+    _fitness "$@"
+else
+    compdef _fitness fitness
+fi
+`)
+}
+
+// writeFishCompletion はfishの補完スクリプトを出力します
+// サブコマンドを持つコマンドは、いずれのサブコマンドも入力していない場合にサブコマンドを補完します
+func writeFishCompletion(w io.Writer, cmds []*command) {
+	fmt.Fprintln(w, "# fitness のfish補完（fitness completion fish > ~/.config/fish/completions/fitness.fish）")
+	fmt.Fprintln(w, "complete -c fitness -f")
+	fmt.Fprintln(w, "complete -c fitness -n __fish_use_subcommand -o config -r -F -d '設定ファイル（YAML）のパス'")
+	for _, entry := range completionEntries(cmds) {
+		var condition string
+		if entry.cmd == nil {
+			condition = "__fish_use_subcommand"
+		} else {
+			conditions := make([]string, 0, len(entry.path))
+			for _, name := range entry.path {
+				conditions = append(conditions, "__fish_seen_subcommand_from "+name)
+			}
+			condition = strings.Join(conditions, "; and ")
+		}
+
+		children := entry.children(cmds)
+		if len(children) > 0 {
+			if entry.cmd != nil {
+				condition += "; and not __fish_seen_subcommand_from " + strings.Join(commandNames(children), " ")
+			}
+			for _, child := range children {
+				fmt.Fprintf(w, "complete -c fitness -n %s -a %s -d %s\n", shellQuote(condition), child.name, shellQuote(child.summary))
+			}
+			continue
+		}
+		for _, name := range entry.cmd.flagNames() {
+			fmt.Fprintf(w, "complete -c fitness -n %s -o %s\n", shellQuote(condition), strings.TrimPrefix(name, "-"))
+		}
+		if len(entry.cmd.values) > 0 {
+			fmt.Fprintf(w, "complete -c fitness -n %s -a %s\n", shellQuote(condition), shellQuote(strings.Join(entry.cmd.values, " ")))
+		}
+		if entry.cmd.files {
+			fmt.Fprintf(w, "complete -c fitness -n %s -F\n", shellQuote(condition))
+		}
+	}
+}
+
+// shellQuote は文字列をシングルクォートで囲みます
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
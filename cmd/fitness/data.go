@@ -0,0 +1,363 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"fitness-mcp-server/internal/app"
+	command_dto "fitness-mcp-server/internal/application/command/dto"
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/infrastructure/backup"
+	"fitness-mcp-server/internal/infrastructure/database"
+	"fitness-mcp-server/internal/infrastructure/migrations"
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
+)
+
+// =============================================================================
+// データ管理のコマンド - export / import / backup / migrate
+// バックアップ・復元・マイグレーションはデータベースを開く（マイグレーションを適用する）前に実行します
+// =============================================================================
+
+// exportCommand はデータをエクスポートするコマンドを返します
+func exportCommand() *command {
+	return &command{
+		name:    "export",
+		summary: "データをエクスポートします（-o を省略した場合は標準出力）",
+		setup: func(fs *flag.FlagSet) runFunc {
+			format := fs.String("format", "json", "エクスポート形式（json / csv / markdown）")
+			start := fs.String("start", "", "開始日（YYYY-MM-DD）")
+			end := fs.String("end", "", "終了日（YYYY-MM-DD）")
+			output := fs.String("o", "", "書き出し先のパス（csvの場合はディレクトリ）")
+			return func(c *cli, args []string) error {
+				deps, err := c.dependencies()
+				if err != nil {
+					return err
+				}
+				query := query_dto.ExportDataQuery{Format: *format, OutputPath: *output}
+				if *start != "" {
+					date, err := deps.Calendar.ParseDate(*start)
+					if err != nil {
+						return fmt.Errorf("invalid -start: %w", err)
+					}
+					query.StartDate = &date
+				}
+				if *end != "" {
+					date, err := deps.Calendar.ParseDate(*end)
+					if err != nil {
+						return fmt.Errorf("invalid -end: %w", err)
+					}
+					query.EndDate = &date
+				}
+
+				response, err := deps.DataExportHandler.ExportData(deps.DefaultUserContext(), query)
+				if err != nil {
+					return fmt.Errorf("failed to export data: %w", err)
+				}
+				if *output == "" && len(response.Files) == 1 {
+					_, err := c.out.Write(response.Files[0].Content)
+					return err
+				}
+				// 書き出した場合は結果の概要、CSVを標準出力に出力する場合はファイル名の見出し付きで出力する
+				out := c.out
+				if *output != "" {
+					out = c.errOut
+				}
+				fmt.Fprint(out, converter.FormatExportDataResponse(response))
+				return nil
+			}
+		},
+	}
+}
+
+// importCommand はファイルを取り込むコマンドを返します
+func importCommand() *command {
+	return &command{
+		name:    "import",
+		summary: "エクスポートしたJSON、または他アプリの筋トレCSV（Strong・Hevy・FitNotes）を取り込みます",
+		args:    "FILE",
+		files:   true,
+		setup: func(fs *flag.FlagSet) runFunc {
+			dryRun := fs.Bool("dry-run", false, "保存せずに取り込み結果のみ表示します")
+			format := fs.String("format", "", "CSVのアプリ（Strong / Hevy / FitNotes、省略時はヘッダーから判定）")
+			unit := fs.String("unit", "", "CSVに単位がない場合の重量の単位（kg / lbs、省略時は設定の単位）")
+			asJSON := jsonFlag(fs)
+			return func(c *cli, args []string) error {
+				if len(args) != 1 {
+					return fmt.Errorf("import requires exactly one file")
+				}
+				deps, err := c.dependencies()
+				if err != nil {
+					return err
+				}
+
+				path := args[0]
+				if strings.EqualFold(filepath.Ext(path), ".csv") {
+					result, err := deps.StrengthImportHandler.ImportStrengthCSV(deps.DefaultUserContext(), command_dto.ImportStrengthCSVCommand{
+						FilePath:   path,
+						Format:     *format,
+						WeightUnit: *unit,
+						DryRun:     *dryRun,
+					})
+					if err != nil {
+						return fmt.Errorf("failed to import strength csv: %w", err)
+					}
+					return c.render(*asJSON, result, func(w io.Writer) {
+						fmt.Fprint(w, converter.FormatImportStrengthCSVResult(result))
+					})
+				}
+
+				result, err := deps.DataImportHandler.ImportData(deps.DefaultUserContext(), command_dto.ImportDataCommand{FilePath: path, DryRun: *dryRun})
+				if err != nil {
+					return fmt.Errorf("failed to import data: %w", err)
+				}
+				return c.render(*asJSON, result, func(w io.Writer) {
+					fmt.Fprint(w, converter.FormatImportDataResult(result))
+				})
+			}
+		},
+	}
+}
+
+// snapshotOutput はスナップショットのJSON出力
+type snapshotOutput struct {
+	Path      string    `json:"path"`
+	Label     string    `json:"label"`
+	CreatedAt time.Time `json:"created_at"`
+	Size      int64     `json:"size"`
+}
+
+// snapshotOutputs はスナップショットをJSON出力に変換します
+func snapshotOutputs(snapshots []backup.Snapshot) []snapshotOutput {
+	outputs := make([]snapshotOutput, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		outputs = append(outputs, snapshotOutput{Path: snapshot.Path, Label: snapshot.Label, CreatedAt: snapshot.CreatedAt, Size: snapshot.Size})
+	}
+	return outputs
+}
+
+// backupCommand はスナップショットを管理するコマンドを返します
+func backupCommand() *command {
+	return &command{
+		name:    "backup",
+		summary: "データベースのスナップショットを作成・一覧表示・削除・復元します",
+		subcommands: []*command{
+			{
+				name:    "create",
+				summary: "スナップショットを作成します",
+				setup: func(fs *flag.FlagSet) runFunc {
+					label := fs.String("label", "manual", "スナップショットのラベル")
+					asJSON := jsonFlag(fs)
+					return func(c *cli, args []string) error {
+						snapshot, err := app.NewBackupService(c.cfg).CreateFromFile(c.cfg.Database.SQLitePath, *label)
+						if err != nil {
+							return fmt.Errorf("failed to create snapshot: %w", err)
+						}
+						return c.render(*asJSON, snapshotOutputs([]backup.Snapshot{*snapshot})[0], func(w io.Writer) {
+							fmt.Fprintf(w, "✅ スナップショットを作成しました: %s（%s）\n", snapshot.Path, formatSize(snapshot.Size))
+						})
+					}
+				},
+			},
+			{
+				name:    "list",
+				summary: "スナップショットを新しい順に一覧表示します",
+				setup: func(fs *flag.FlagSet) runFunc {
+					asJSON := jsonFlag(fs)
+					return func(c *cli, args []string) error {
+						snapshots, err := app.NewBackupService(c.cfg).List()
+						if err != nil {
+							return err
+						}
+						return c.render(*asJSON, snapshotOutputs(snapshots), func(w io.Writer) {
+							fmt.Fprintln(w, "CREATED\tLABEL\tSIZE\tFILE")
+							for _, snapshot := range snapshots {
+								fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", snapshot.CreatedAt.Local().Format("2006-01-02 15:04:05"), snapshot.Label, formatSize(snapshot.Size), filepath.Base(snapshot.Path))
+							}
+						})
+					}
+				},
+			},
+			{
+				name:    "prune",
+				summary: "保持ルールに従って古いスナップショットを削除します",
+				setup: func(fs *flag.FlagSet) runFunc {
+					asJSON := jsonFlag(fs)
+					return func(c *cli, args []string) error {
+						pruned, err := app.NewBackupService(c.cfg).Prune()
+						if err != nil {
+							return err
+						}
+						return c.render(*asJSON, snapshotOutputs(pruned), func(w io.Writer) {
+							fmt.Fprintf(w, "🧹 %d件のスナップショットを削除しました（保持: 最新%d件・直近%d日は各日1件）\n", len(pruned), c.cfg.Backup.KeepLast, c.cfg.Backup.KeepDays)
+						})
+					}
+				},
+			},
+			{
+				name:    "restore",
+				summary: "スナップショットを検証してからデータベースを置き換えます（パス、またはバックアップディレクトリ内のファイル名）",
+				args:    "SNAPSHOT",
+				files:   true,
+				setup: func(fs *flag.FlagSet) runFunc {
+					verifyOnly := fs.Bool("verify-only", false, "検証のみ行い、データベースを置き換えません")
+					return func(c *cli, args []string) error {
+						if len(args) != 1 {
+							return fmt.Errorf("restore requires exactly one snapshot")
+						}
+						service := app.NewBackupService(c.cfg)
+						path := args[0]
+						if _, err := os.Stat(path); os.IsNotExist(err) {
+							path = filepath.Join(service.Dir(), path)
+						}
+
+						if *verifyOnly {
+							verification, err := backup.Verify(path, migrations.LatestVersion())
+							if err != nil {
+								return fmt.Errorf("verification failed: %w", err)
+							}
+							fmt.Fprintf(c.out, "✅ %s は復元できます（スキーマバージョン %s、整合性チェック: %s）\n", path, verification.SchemaVersion, verification.Integrity)
+							return nil
+						}
+
+						result, err := service.Restore(path, c.cfg.Database.SQLitePath, migrations.LatestVersion())
+						if err != nil {
+							return fmt.Errorf("failed to restore: %w", err)
+						}
+						if result.SafetyBackup != nil {
+							fmt.Fprintf(c.out, "📦 復元前のデータベースを保存しました: %s\n", result.SafetyBackup.Path)
+						}
+						fmt.Fprintf(c.out, "✅ %s を復元しました（スキーマバージョン %s）\n", result.Restored.Path, result.Verification.SchemaVersion)
+						if result.Verification.SchemaVersion < migrations.LatestVersion() {
+							fmt.Fprintf(c.out, "ℹ️ fitness・MCPサーバを次に実行するとスキーマバージョン %s までマイグレーションを適用します\n", migrations.LatestVersion())
+						}
+						return nil
+					}
+				},
+			},
+		},
+	}
+}
+
+// migrationOutput はマイグレーションの適用状況のJSON出力
+type migrationOutput struct {
+	Version   string     `json:"version"`
+	Name      string     `json:"name"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+	Modified  bool       `json:"modified"`
+	HasDown   bool       `json:"has_down"`
+}
+
+// migrateCommand はマイグレーションを管理するコマンドを返します
+// 適用・ロールバックの前に既存のデータベースのスナップショットを作成します
+func migrateCommand() *command {
+	return &command{
+		name:    "migrate",
+		summary: "マイグレーションの適用状況の表示・適用・ロールバックを行います",
+		subcommands: []*command{
+			{
+				name:    "status",
+				summary: "マイグレーションの適用状況を表示します",
+				setup: func(fs *flag.FlagSet) runFunc {
+					asJSON := jsonFlag(fs)
+					return func(c *cli, args []string) error {
+						return c.withMigrationRunner(func(runner *migrations.Runner) error {
+							statuses, err := runner.Status()
+							if err != nil {
+								return err
+							}
+							outputs := make([]migrationOutput, 0, len(statuses))
+							for _, status := range statuses {
+								outputs = append(outputs, migrationOutput(status))
+							}
+							return c.render(*asJSON, outputs, func(w io.Writer) {
+								fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED\tNOTE")
+								for _, status := range statuses {
+									applied := "-"
+									if status.AppliedAt != nil {
+										applied = status.AppliedAt.Local().Format("2006-01-02 15:04")
+									} else if status.Applied {
+										applied = "yes"
+									}
+									var notes []string
+									if status.Modified {
+										notes = append(notes, "適用後にファイルが変更されています")
+									}
+									if !status.HasDown {
+										notes = append(notes, "ロールバック不可")
+									}
+									fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", status.Version, status.Name, applied, strings.Join(notes, "・"))
+								}
+								fmt.Fprintf(w, "\n%s（最新バージョン %s）\n", c.cfg.Database.SQLitePath, runner.Latest())
+							})
+						})
+					}
+				},
+			},
+			{
+				name:    "up",
+				summary: "未適用のマイグレーションを適用します",
+				setup: func(fs *flag.FlagSet) runFunc {
+					return func(c *cli, args []string) error {
+						return c.withMigrationRunner(func(runner *migrations.Runner) error {
+							applied, err := runner.Up()
+							if err != nil {
+								return err
+							}
+							fmt.Fprintf(c.out, "✅ %d件のマイグレーションを適用しました（バージョン %s）\n", len(applied), runner.Latest())
+							return nil
+						})
+					}
+				},
+			},
+			{
+				name:    "down",
+				summary: "適用済みのマイグレーションをロールバックします",
+				setup: func(fs *flag.FlagSet) runFunc {
+					steps := fs.Int("steps", 1, "ロールバックするマイグレーション数")
+					return func(c *cli, args []string) error {
+						return c.withMigrationRunner(func(runner *migrations.Runner) error {
+							reverted, err := runner.Down(*steps)
+							if err != nil {
+								return err
+							}
+							for _, migration := range reverted {
+								fmt.Fprintf(c.out, "↩️ %s_%s をロールバックしました\n", migration.Version, migration.Name)
+							}
+							fmt.Fprintln(c.out, "ℹ️ fitness・MCPサーバを次に実行すると未適用のマイグレーションを再び適用します")
+							return nil
+						})
+					}
+				},
+			},
+		},
+	}
+}
+
+// withMigrationRunner はデータベースを開いてマイグレーションのランナーを作成し、関数を実行します
+func (c *cli) withMigrationRunner(fn func(runner *migrations.Runner) error) error {
+	db, err := database.Open(c.cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	runner, err := app.NewMigrationRunner(db, app.NewBackupService(c.cfg))
+	if err != nil {
+		return err
+	}
+	return fn(runner)
+}
+
+// formatSize はファイルサイズを読みやすい形式にフォーマットします
+func formatSize(size int64) string {
+	if size < 1024*1024 {
+		return fmt.Sprintf("%.1fKB", float64(size)/1024)
+	}
+	return fmt.Sprintf("%.1fMB", float64(size)/1024/1024)
+}
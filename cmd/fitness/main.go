@@ -0,0 +1,134 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	_ "time/tzdata" // タイムゾーンのデータベースがない環境でもMCP_TIMEZONEを解決する
+
+	"fitness-mcp-server/internal/app"
+	"fitness-mcp-server/internal/config"
+	"fitness-mcp-server/internal/logging"
+
+	_ "modernc.org/sqlite"
+)
+
+// =============================================================================
+// fitness - ターミナルから記録・参照するためのCLI
+// MCPサーバを介さずにアプリケーション層のハンドラーを直接呼び出し、MCPサーバと同じデータベースを扱います
+// =============================================================================
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run はグローバルフラグを解釈してサブコマンドを実行し、終了コードを返します
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("fitness", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	configPath := flags.String("config", "", "設定ファイル（YAML）のパス（省略時はMCP_CONFIG）")
+	verbose := flags.Bool("v", false, "設定のログレベルのログを表示します（省略時は警告以上のみ）")
+	flags.Usage = func() { printUsage(stderr, flags) }
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if flags.NArg() == 0 {
+		printUsage(stderr, flags)
+		return 2
+	}
+
+	// 設定の初期化（MCPサーバと同じく既定値・設定ファイル・環境変数の順に重ねる）
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to load configuration: %v\n", err)
+		return 1
+	}
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	// 結果の表示を妨げないよう、マイグレーションの適用などの情報ログは -v を指定した場合のみ表示する
+	if !*verbose && logging.ParseLevel(cfg.Server.LogLevel) < slog.LevelWarn {
+		cfg.Server.LogLevel = "warn"
+	}
+	logFile, err := logging.Setup(cfg.Server)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to set up logging: %v\n", err)
+		return 1
+	}
+	defer logFile.Close()
+	if err := cfg.EnsureDatabaseDir(); err != nil {
+		fmt.Fprintf(stderr, "failed to create database directory: %v\n", err)
+		return 1
+	}
+
+	c := &cli{cfg: cfg, out: stdout, errOut: stderr}
+	defer c.close()
+	if err := c.execute(flags.Args()); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		fmt.Fprintf(stderr, "fitness: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// printUsage はコマンドの一覧を表示します
+func printUsage(w io.Writer, flags *flag.FlagSet) {
+	fmt.Fprintln(w, "使い方: fitness [-config PATH] [-v] <コマンド> [フラグ] [引数]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "コマンド:")
+	for _, cmd := range commands() {
+		fmt.Fprintf(w, "  %-12s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "グローバルフラグ:")
+	flags.PrintDefaults()
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "各コマンドのフラグは fitness <コマンド> -h で表示します")
+}
+
+// cli はサブコマンドの実行に必要な設定・出力先・依存関係を保持します
+type cli struct {
+	cfg    *config.Config
+	out    io.Writer
+	errOut io.Writer
+	deps   *app.Dependencies
+}
+
+// dependencies は依存関係を初めて必要になったときに初期化します
+// バックアップ・マイグレーションはデータベースを開く（マイグレーションを適用する）前に実行するため、依存関係を使用しません
+func (c *cli) dependencies() (*app.Dependencies, error) {
+	if c.deps != nil {
+		return c.deps, nil
+	}
+	deps, err := app.InitializeDependencies(c.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize dependencies: %w", err)
+	}
+	c.deps = deps
+	return deps, nil
+}
+
+// close は初期化したデータベース接続を閉じます
+func (c *cli) close() {
+	if c.deps != nil {
+		c.deps.DB.Close()
+	}
+}
+
+// execute はコマンド名に対応するサブコマンドを実行します
+func (c *cli) execute(args []string) error {
+	cmd := findCommand(commands(), args[0])
+	if cmd == nil {
+		return fmt.Errorf("unknown command: %s（fitness -h でコマンドの一覧を表示します）", args[0])
+	}
+	return cmd.execute(c, "fitness", args[1:])
+}
@@ -0,0 +1,324 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	command_dto "fitness-mcp-server/internal/application/command/dto"
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/domain/shared"
+)
+
+// =============================================================================
+// 記録・参照のコマンド - log / runs / prs
+// 既定のユーザー（MCP_USER）のデータを対象にします
+// =============================================================================
+
+// logCommand は筋トレ・ランニングを記録するコマンドを返します
+func logCommand() *command {
+	return &command{
+		name:    "log",
+		summary: "筋トレ・ランニングを記録します",
+		subcommands: []*command{
+			{
+				name:    "strength",
+				summary: `筋トレを記録します（例: "ベンチプレス:80x5x3@8,85x3@9"。重量(kg)x回数[xセット数][@RPE]をカンマ区切り）`,
+				args:    "EXERCISE:SETS...",
+				setup:   setupLogStrength,
+			},
+			{
+				name:    "run",
+				summary: "ランニングを記録します",
+				setup:   setupLogRun,
+			},
+		},
+	}
+}
+
+// setupLogStrength は筋トレの記録のフラグを登録します
+func setupLogStrength(fs *flag.FlagSet) runFunc {
+	date := fs.String("date", "", "記録日（YYYY-MM-DD、省略時は今日）")
+	notes := fs.String("notes", "", "メモ")
+	asJSON := jsonFlag(fs)
+	return func(c *cli, args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("log strength requires at least one exercise (e.g. \"ベンチプレス:80x5x3\")")
+		}
+		exercises := make([]command_dto.ExerciseDTO, 0, len(args))
+		for _, spec := range args {
+			exercise, err := parseExerciseSpec(spec)
+			if err != nil {
+				return err
+			}
+			exercises = append(exercises, exercise)
+		}
+
+		deps, err := c.dependencies()
+		if err != nil {
+			return err
+		}
+		recordDate, err := dateOrToday(deps.Calendar, *date)
+		if err != nil {
+			return err
+		}
+		result, err := deps.CommandHandler.RecordTraining(deps.DefaultUserContext(), command_dto.RecordTrainingCommand{
+			Date:      recordDate,
+			Exercises: exercises,
+			Notes:     *notes,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record training: %w", err)
+		}
+
+		return c.render(*asJSON, result, func(w io.Writer) {
+			fmt.Fprintf(w, "✅ %s（%s）\n", result.Message, result.Date.Format(shared.DateLayout))
+			if len(result.NewRecords) == 0 {
+				return
+			}
+			fmt.Fprintln(w, "\nEXERCISE\tRECORD\tVALUE\tPREVIOUS")
+			for _, record := range result.NewRecords {
+				previous := "-"
+				if record.PreviousValue != nil {
+					previous = formatNumber(*record.PreviousValue)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", record.ExerciseName, record.Label, formatNumber(record.Value), previous)
+			}
+		})
+	}
+}
+
+// setupLogRun はランニングの記録のフラグを登録します
+func setupLogRun(fs *flag.FlagSet) runFunc {
+	date := fs.String("date", "", "記録日（YYYY-MM-DD、省略時は今日）")
+	distance := fs.Float64("distance", 0, "距離（km）")
+	duration := fs.String("duration", "", `時間（"MM:SS"・"H:MM:SS"形式、または分数）`)
+	runType := fs.String("type", "Easy", "ランニングタイプ（Easy / Tempo / Interval / Long / Race）")
+	heartRate := fs.Int("hr", 0, "平均心拍数（bpm）")
+	notes := fs.String("notes", "", "メモ")
+	asJSON := jsonFlag(fs)
+	return func(c *cli, args []string) error {
+		if len(args) > 0 {
+			return fmt.Errorf("log run takes no arguments: %s", strings.Join(args, " "))
+		}
+		if *distance <= 0 || *duration == "" {
+			return fmt.Errorf("log run requires -distance and -duration")
+		}
+		seconds, err := parseDuration(*duration)
+		if err != nil {
+			return err
+		}
+
+		deps, err := c.dependencies()
+		if err != nil {
+			return err
+		}
+		recordDate, err := dateOrToday(deps.Calendar, *date)
+		if err != nil {
+			return err
+		}
+		cmd := command_dto.RecordRunningCommand{
+			Date:            recordDate,
+			DistanceKm:      *distance,
+			DurationSeconds: seconds,
+			RunType:         *runType,
+			Notes:           *notes,
+		}
+		if *heartRate > 0 {
+			cmd.HeartRateBPM = heartRate
+		}
+		result, err := deps.RunningCommandHandler.RecordRunning(deps.DefaultUserContext(), cmd)
+		if err != nil {
+			return fmt.Errorf("failed to record running: %w", err)
+		}
+
+		return c.render(*asJSON, result, func(w io.Writer) {
+			fmt.Fprintf(w, "✅ %s\n", result.Message)
+			fmt.Fprintln(w, "\nDATE\tTYPE\tDISTANCE\tDURATION\tPACE\tZONE")
+			zone := result.PaceZone
+			if zone == "" {
+				zone = "-"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%.2fkm\t%s\t%s\t%s\n", result.Date.Format(shared.DateLayout), result.RunType, result.DistanceKm, result.Duration, result.Pace, zone)
+			for _, warning := range result.Warnings {
+				fmt.Fprintf(w, "⚠️ %s\n", warning)
+			}
+		})
+	}
+}
+
+// runsCommand は直近のランニングを一覧表示するコマンドを返します
+func runsCommand() *command {
+	return &command{
+		name:    "runs",
+		summary: "直近のランニングを新しい順に一覧表示します",
+		setup: func(fs *flag.FlagSet) runFunc {
+			days := fs.Int("days", 30, "対象期間（日数）")
+			date := fs.String("date", "", "期間の終了日（YYYY-MM-DD、省略時は今日）")
+			asJSON := jsonFlag(fs)
+			return func(c *cli, args []string) error {
+				deps, err := c.dependencies()
+				if err != nil {
+					return err
+				}
+				reference, err := dateOrToday(deps.Calendar, *date)
+				if err != nil {
+					return err
+				}
+				response, err := deps.RunningQueryHandler.GetRecentRuns(deps.DefaultUserContext(), query_dto.GetRecentRunsQuery{Days: *days, ReferenceDate: reference})
+				if err != nil {
+					return fmt.Errorf("failed to get recent runs: %w", err)
+				}
+
+				return c.render(*asJSON, response, func(w io.Writer) {
+					fmt.Fprintln(w, "DATE\tTYPE\tDISTANCE\tDURATION\tPACE\tHR\tNOTES")
+					for _, run := range response.Runs {
+						heartRate := "-"
+						if run.HeartRateBPM != nil {
+							heartRate = strconv.Itoa(*run.HeartRateBPM)
+						}
+						fmt.Fprintf(w, "%s\t%s\t%.2fkm\t%s\t%s\t%s\t%s\n", run.Date.Format(shared.DateLayout), run.RunType, run.DistanceKm, run.Duration, run.Pace, heartRate, run.Notes)
+					}
+					fmt.Fprintf(w, "\n%s: %d件 合計%.2fkm\n", response.Period, response.Count, response.TotalDistanceKm)
+				})
+			}
+		},
+	}
+}
+
+// prsCommand は個人記録を一覧表示するコマンドを返します
+func prsCommand() *command {
+	return &command{
+		name:    "prs",
+		summary: "エクササイズごとの個人記録を一覧表示します",
+		setup: func(fs *flag.FlagSet) runFunc {
+			exercise := fs.String("exercise", "", "エクササイズ名（省略時はすべて）")
+			asJSON := jsonFlag(fs)
+			return func(c *cli, args []string) error {
+				deps, err := c.dependencies()
+				if err != nil {
+					return err
+				}
+				response, err := deps.QueryHandler.GetPersonalRecords(deps.DefaultUserContext(), query_dto.GetPersonalRecordsQuery{ExerciseName: optionalString(*exercise)})
+				if err != nil {
+					return fmt.Errorf("failed to get personal records: %w", err)
+				}
+
+				return c.render(*asJSON, response, func(w io.Writer) {
+					fmt.Fprintln(w, "EXERCISE\tMAX WEIGHT\tMAX REPS\tMAX VOLUME\tSESSIONS\tLAST")
+					for _, record := range response.Records {
+						fmt.Fprintf(w, "%s\t%skg\t%s\t%skg\t%d\t%s\n",
+							record.ExerciseName,
+							formatNumber(record.MaxWeight.Value),
+							formatNumber(record.MaxReps.Value),
+							formatNumber(record.MaxVolume.Value),
+							record.TotalSessions,
+							deps.Calendar.In(record.LastPerformed).Format(shared.DateLayout))
+					}
+				})
+			}
+		},
+	}
+}
+
+// parseExerciseSpec は "エクササイズ名:重量x回数[xセット数][@RPE],..." 形式の指定をエクササイズに変換します
+func parseExerciseSpec(spec string) (command_dto.ExerciseDTO, error) {
+	index := strings.LastIndex(spec, ":")
+	if index < 0 {
+		return command_dto.ExerciseDTO{}, fmt.Errorf("invalid exercise %q: expected NAME:WEIGHTxREPS[xSETS][@RPE]", spec)
+	}
+	name := strings.TrimSpace(spec[:index])
+	if name == "" {
+		return command_dto.ExerciseDTO{}, fmt.Errorf("invalid exercise %q: name is required", spec)
+	}
+
+	exercise := command_dto.ExerciseDTO{Name: name}
+	for _, item := range strings.Split(spec[index+1:], ",") {
+		sets, err := parseSetSpec(strings.TrimSpace(item))
+		if err != nil {
+			return command_dto.ExerciseDTO{}, fmt.Errorf("invalid sets of %s: %w", name, err)
+		}
+		exercise.Sets = append(exercise.Sets, sets...)
+	}
+	return exercise, nil
+}
+
+// parseSetSpec は "重量x回数[xセット数][@RPE]" 形式の指定をセット数分のセットに変換します
+func parseSetSpec(item string) ([]command_dto.SetDTO, error) {
+	body, rpeText, hasRPE := strings.Cut(item, "@")
+	parts := strings.Split(strings.ReplaceAll(strings.ToLower(body), "×", "x"), "x")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("%q: expected WEIGHTxREPS[xSETS][@RPE]", item)
+	}
+
+	weight, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return nil, fmt.Errorf("%q: invalid weight", item)
+	}
+	reps, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return nil, fmt.Errorf("%q: invalid reps", item)
+	}
+	count := 1
+	if len(parts) == 3 {
+		count, err = strconv.Atoi(strings.TrimSpace(parts[2]))
+		if err != nil || count < 1 {
+			return nil, fmt.Errorf("%q: invalid number of sets", item)
+		}
+	}
+	var rpe *float64
+	if hasRPE {
+		value, err := strconv.ParseFloat(strings.TrimSpace(rpeText), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q: invalid RPE", item)
+		}
+		rpe = &value
+	}
+
+	sets := make([]command_dto.SetDTO, count)
+	for i := range sets {
+		sets[i] = command_dto.SetDTO{WeightKg: weight, Reps: reps, RPE: rpe}
+	}
+	return sets, nil
+}
+
+// parseDuration は走行時間（"MM:SS"・"H:MM:SS"形式、または分数）を秒数に変換します
+func parseDuration(value string) (float64, error) {
+	if minutes, err := strconv.ParseFloat(value, 64); err == nil {
+		return minutes * 60, nil
+	}
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf(`invalid duration %q: expected "MM:SS", "H:MM:SS" or minutes`, value)
+	}
+	total := 0.0
+	for i, part := range parts {
+		number, err := strconv.ParseFloat(part, 64)
+		// 先頭以外の分・秒は60未満
+		if err != nil || number < 0 || (i > 0 && number >= 60) {
+			return 0, fmt.Errorf(`invalid duration %q: expected "MM:SS", "H:MM:SS" or minutes`, value)
+		}
+		total = total*60 + number
+	}
+	return total, nil
+}
+
+// dateOrToday は日付を解釈します（空の場合は今日）
+func dateOrToday(calendar shared.Calendar, value string) (time.Time, error) {
+	if value == "" {
+		return calendar.Today(), nil
+	}
+	date, err := calendar.ParseDate(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid -date: %w", err)
+	}
+	return date, nil
+}
+
+// formatNumber は数値を末尾の0を除いて表示します
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
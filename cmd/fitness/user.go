@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"fitness-mcp-server/internal/domain/user"
+)
+
+// =============================================================================
+// ユーザー管理のコマンド - user token / user list
+// トークンは発行時にのみ表示します（再発行すると以前のトークンは使用できなくなります）
+// =============================================================================
+
+// userOutput はユーザーのJSON出力
+type userOutput struct {
+	ID        string    `json:"id"`
+	HasToken  bool      `json:"has_token"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// userCommand はユーザーのトークンの発行・一覧表示を行うコマンドを返します
+func userCommand() *command {
+	return &command{
+		name:    "user",
+		summary: "ユーザーのトークンを発行・一覧表示します",
+		subcommands: []*command{
+			{
+				name:    "token",
+				summary: "ユーザーを作成（既存の場合はトークンを再発行）し、トークンを表示します",
+				args:    "NAME",
+				setup: func(fs *flag.FlagSet) runFunc {
+					return func(c *cli, args []string) error {
+						if len(args) != 1 {
+							return fmt.Errorf("user token requires exactly one user name")
+						}
+						id, err := user.NewID(args[0])
+						if err != nil {
+							return err
+						}
+						deps, err := c.dependencies()
+						if err != nil {
+							return err
+						}
+						token, err := deps.Authenticator.IssueToken(context.Background(), id)
+						if err != nil {
+							return fmt.Errorf("failed to issue token: %w", err)
+						}
+						fmt.Fprintf(c.errOut, "ユーザー %s のトークンを発行しました（再表示できないため控えてください）\n", id)
+						fmt.Fprintln(c.out, token)
+						return nil
+					}
+				},
+			},
+			{
+				name:    "list",
+				summary: "ユーザーとトークンの発行状況を一覧表示します",
+				setup: func(fs *flag.FlagSet) runFunc {
+					asJSON := jsonFlag(fs)
+					return func(c *cli, args []string) error {
+						deps, err := c.dependencies()
+						if err != nil {
+							return err
+						}
+						users, err := deps.Authenticator.Users(context.Background())
+						if err != nil {
+							return fmt.Errorf("failed to list users: %w", err)
+						}
+						outputs := make([]userOutput, 0, len(users))
+						for _, u := range users {
+							outputs = append(outputs, userOutput{ID: u.ID().String(), HasToken: u.HasToken(), CreatedAt: u.CreatedAt()})
+						}
+						return c.render(*asJSON, outputs, func(w io.Writer) {
+							fmt.Fprintln(w, "USER\tTOKEN\tCREATED")
+							for _, u := range users {
+								token := "未発行"
+								if u.HasToken() {
+									token = "発行済み"
+								}
+								fmt.Fprintf(w, "%s\t%s\t%s\n", u.ID(), token, u.CreatedAt().Format("2006-01-02 15:04"))
+							}
+						})
+					}
+				},
+			},
+		},
+	}
+}
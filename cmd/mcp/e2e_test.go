@@ -0,0 +1,586 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/app"
+	"fitness-mcp-server/internal/application/auth"
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/config"
+	"fitness-mcp-server/internal/domain/user"
+	"fitness-mcp-server/internal/interface/mcp-resource/resource"
+	"fitness-mcp-server/internal/interface/transport"
+
+	"github.com/mark3labs/mcp-go/client"
+	mcp_transport "github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+)
+
+// httpTestServer はSSEまたはStreamable HTTPで待ち受けるテスト用のMCPサーバ
+type httpTestServer struct {
+	deps          *app.Dependencies
+	transportType string
+	url           string
+	stop          context.CancelFunc
+	served        chan error
+}
+
+// startHTTPTestServer は一時的なデータディレクトリでMCPサーバを起動します
+func startHTTPTestServer(t *testing.T) *httpTestServer {
+	return startHTTPTestServerWith(t, config.TransportStreamableHTTP)
+}
+
+// startHTTPTestServerWith は指定したトランスポートでMCPサーバを起動します
+func startHTTPTestServerWith(t *testing.T, transportType string) *httpTestServer {
+	t.Helper()
+	t.Setenv("MCP_DATA_DIR", t.TempDir())
+	cfg := config.NewConfig()
+	deps, err := app.InitializeDependencies(cfg)
+	if err != nil {
+		t.Fatalf("failed to initialize dependencies: %v", err)
+	}
+	t.Cleanup(func() { deps.DB.Close() })
+	mcpServer, err := newMCPServer(cfg, deps)
+	if err != nil {
+		t.Fatalf("failed to create MCP server: %v", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	transportConfig := config.TransportConfig{
+		Type:            transportType,
+		Addr:            listener.Addr().String(),
+		ShutdownTimeout: 5,
+	}
+	serverCtx, stop := context.WithCancel(context.Background())
+	t.Cleanup(stop)
+	served := make(chan error, 1)
+	go func() {
+		served <- transport.ServeListener(serverCtx, mcpServer, transportConfig, deps.Authenticator, listener)
+	}()
+
+	path := transport.StreamableHTTPPath
+	if transportType == config.TransportSSE {
+		path = "/sse"
+	}
+	return &httpTestServer{
+		deps:          deps,
+		transportType: transportType,
+		url:           "http://" + listener.Addr().String() + path,
+		stop:          stop,
+		served:        served,
+	}
+}
+
+// issueToken はユーザーのトークンを発行します
+func (s *httpTestServer) issueToken(t *testing.T, name string) (user.ID, string) {
+	t.Helper()
+	id, err := user.NewID(name)
+	if err != nil {
+		t.Fatalf("invalid user id: %v", err)
+	}
+	token, err := s.deps.Authenticator.IssueToken(context.Background(), id)
+	if err != nil {
+		t.Fatalf("failed to issue token: %v", err)
+	}
+	return id, token
+}
+
+// connect はヘッダを付けて接続し、初期化したクライアントを返します
+func (s *httpTestServer) connect(ctx context.Context, t *testing.T, headers map[string]string) *client.Client {
+	t.Helper()
+	var mcpClient *client.Client
+	var err error
+	if s.transportType == config.TransportSSE {
+		mcpClient, err = client.NewSSEMCPClient(s.url, client.WithHeaders(headers))
+	} else {
+		mcpClient, err = client.NewStreamableHttpClient(s.url, mcp_transport.WithHTTPHeaders(headers))
+	}
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	t.Cleanup(func() { mcpClient.Close() })
+	if err := mcpClient.Start(ctx); err != nil {
+		t.Fatalf("failed to start client: %v", err)
+	}
+
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{Name: "e2e-test", Version: "1.0.0"}
+	if _, err := mcpClient.Initialize(ctx, initRequest); err != nil {
+		t.Fatalf("failed to initialize: %v", err)
+	}
+	return mcpClient
+}
+
+// recordTrainingRequest はベンチプレス2セットのrecord_trainingの呼び出しを作成します
+func recordTrainingRequest(notes string) mcp.CallToolRequest {
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "record_training"
+	request.Params.Arguments = map[string]any{
+		"date": "2025-06-14",
+		"exercises": []any{
+			map[string]any{
+				"name": "ベンチプレス",
+				"sets": []any{
+					map[string]any{"weight_kg": 95, "reps": 8, "rpe": 8},
+					map[string]any{"weight_kg": 95, "reps": 7, "rpe": 9},
+				},
+			},
+		},
+		"notes": notes,
+	}
+	return request
+}
+
+// getTrainingsRequest は2025-06-14のget_trainings_by_date_rangeの呼び出しを作成します
+func getTrainingsRequest() mcp.CallToolRequest {
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "get_trainings_by_date_range"
+	request.Params.Arguments = map[string]any{"start_date": "2025-06-14", "end_date": "2025-06-14"}
+	return request
+}
+
+// resultText はツールの結果のテキストを返します
+func resultText(t *testing.T, result *mcp.CallToolResult) string {
+	t.Helper()
+	if !assert.NotEmpty(t, result.Content) {
+		return ""
+	}
+	assert.False(t, result.IsError, "%v", result.Content)
+	text, ok := result.Content[0].(mcp.TextContent)
+	assert.True(t, ok)
+	return text.Text
+}
+
+func TestStreamableHTTPTransport(t *testing.T) {
+	t.Run("正常系:HTTPで受け付けたrecord_trainingを保存し、終了時に処理を待って停止する", func(t *testing.T) {
+		// Arrange
+		server := startHTTPTestServer(t)
+		alice, token := server.issueToken(t, "alice")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		mcpClient := server.connect(ctx, t, map[string]string{"Authorization": "Bearer " + token})
+
+		// Act
+		result, err := mcpClient.CallTool(ctx, recordTrainingRequest("HTTP経由"))
+
+		// Assert
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Contains(t, resultText(t, result), "ベンチプレス")
+
+		day := time.Date(2025, 6, 14, 0, 0, 0, 0, time.UTC)
+		response, err := server.deps.QueryHandler.GetTrainingsByDateRange(auth.WithUser(ctx, alice), query_dto.GetTrainingsByDateRangeQuery{StartDate: day, EndDate: day})
+		assert.NoError(t, err)
+		if !assert.Len(t, response.Trainings, 1) {
+			return
+		}
+		assert.Equal(t, "HTTP経由", response.Trainings[0].Notes)
+		assert.Len(t, response.Trainings[0].Exercises[0].Sets, 2)
+
+		server.stop()
+		select {
+		case err := <-server.served:
+			assert.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("server did not shut down")
+		}
+	})
+}
+
+func TestHTTPAuthentication(t *testing.T) {
+	t.Run("異常系:トークンがない・不正な場合は401を返す", func(t *testing.T) {
+		// Arrange
+		server := startHTTPTestServer(t)
+		tests := []struct {
+			name   string
+			header string
+			value  string
+		}{
+			{name: "トークンなし"},
+			{name: "未発行のトークン", header: "Authorization", value: "Bearer fmcp_unknown"},
+			{name: "Bearer以外の方式", header: "Authorization", value: "Basic YWxpY2U6cGFzcw=="},
+			{name: "未発行のAPIキー", header: transport.APIKeyHeader, value: "fmcp_unknown"},
+		}
+
+		for _, tt := range tests {
+			request, err := http.NewRequest(http.MethodPost, server.url, strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"ping"}`))
+			assert.NoError(t, err)
+			request.Header.Set("Content-Type", "application/json")
+			if tt.header != "" {
+				request.Header.Set(tt.header, tt.value)
+			}
+
+			// Act
+			response, err := http.DefaultClient.Do(request)
+
+			// Assert
+			if !assert.NoError(t, err, tt.name) {
+				continue
+			}
+			response.Body.Close()
+			assert.Equal(t, http.StatusUnauthorized, response.StatusCode, tt.name)
+			assert.Contains(t, response.Header.Get("WWW-Authenticate"), "Bearer", tt.name)
+		}
+	})
+
+	t.Run("正常系:ユーザーごとに記録が分かれ、他のユーザーの記録は参照できない", func(t *testing.T) {
+		// Arrange
+		server := startHTTPTestServer(t)
+		_, aliceToken := server.issueToken(t, "alice")
+		_, bobToken := server.issueToken(t, "bob")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		aliceClient := server.connect(ctx, t, map[string]string{"Authorization": "Bearer " + aliceToken})
+		bobClient := server.connect(ctx, t, map[string]string{transport.APIKeyHeader: bobToken})
+		_, err := aliceClient.CallTool(ctx, recordTrainingRequest("aliceの記録"))
+		assert.NoError(t, err)
+
+		// Act
+		bobResult, bobErr := bobClient.CallTool(ctx, getTrainingsRequest())
+		aliceResult, aliceErr := aliceClient.CallTool(ctx, getTrainingsRequest())
+
+		// Assert
+		if assert.NoError(t, bobErr) {
+			assert.NotContains(t, resultText(t, bobResult), "aliceの記録")
+		}
+		if assert.NoError(t, aliceErr) {
+			assert.Contains(t, resultText(t, aliceResult), "aliceの記録")
+		}
+	})
+}
+
+func TestResources(t *testing.T) {
+	t.Run("正常系:URIで筋トレセッション・自己ベスト・直近のランニング・目標を参照できる", func(t *testing.T) {
+		// Arrange
+		server := startHTTPTestServer(t)
+		_, token := server.issueToken(t, "alice")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		mcpClient := server.connect(ctx, t, map[string]string{"Authorization": "Bearer " + token})
+		_, err := mcpClient.CallTool(ctx, recordTrainingRequest("リソース確認"))
+		assert.NoError(t, err)
+		goalRequest := mcp.CallToolRequest{}
+		goalRequest.Params.Name = "set_running_goal"
+		goalRequest.Params.Arguments = map[string]any{"event_type": "Half", "target_time": "1:39:59", "description": "サブ100"}
+		_, err = mcpClient.CallTool(ctx, goalRequest)
+		assert.NoError(t, err)
+
+		// Act
+		resources, listErr := mcpClient.ListResources(ctx, mcp.ListResourcesRequest{})
+		templates, templatesErr := mcpClient.ListResourceTemplates(ctx, mcp.ListResourceTemplatesRequest{})
+		trainings := readResourceText(ctx, t, mcpClient, resource.TrainingsURI("2025-06-14"))
+		records := readResourceText(ctx, t, mcpClient, resource.ExerciseRecordsURI("ベンチプレス"))
+		runs := readResourceText(ctx, t, mcpClient, resource.RecentRunsURI)
+		goals := readResourceText(ctx, t, mcpClient, resource.GoalsURI)
+
+		// Assert
+		if assert.NoError(t, listErr) {
+			var uris []string
+			for _, r := range resources.Resources {
+				uris = append(uris, r.URI)
+			}
+			assert.ElementsMatch(t, []string{resource.RecordsURI, resource.RecentRunsURI, resource.GoalsURI}, uris)
+		}
+		if assert.NoError(t, templatesErr) {
+			assert.Len(t, templates.ResourceTemplates, 2)
+		}
+		assert.Contains(t, trainings, `"notes": "リソース確認"`)
+		assert.Contains(t, records, `"exercise_name": "ベンチプレス"`)
+		assert.Contains(t, runs, `"runs": []`)
+		assert.Contains(t, goals, `"description": "サブ100"`)
+		assert.Contains(t, goals, `"active_count": 1`)
+	})
+
+	t.Run("異常系:日付の形式が不正な場合はエラーを返す", func(t *testing.T) {
+		// Arrange
+		server := startHTTPTestServer(t)
+		_, token := server.issueToken(t, "alice")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		mcpClient := server.connect(ctx, t, map[string]string{"Authorization": "Bearer " + token})
+		request := mcp.ReadResourceRequest{}
+		request.Params.URI = resource.TrainingsURI("2025-6-14")
+
+		// Act
+		_, err := mcpClient.ReadResource(ctx, request)
+
+		// Assert
+		assert.ErrorContains(t, err, "invalid date")
+	})
+
+	t.Run("正常系:記録後に変更されたリソースを書き込んだユーザーのセッションにのみ通知する", func(t *testing.T) {
+		// Arrange
+		server := startHTTPTestServerWith(t, config.TransportSSE)
+		_, aliceToken := server.issueToken(t, "alice")
+		_, bobToken := server.issueToken(t, "bob")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		aliceClient := server.connect(ctx, t, map[string]string{"Authorization": "Bearer " + aliceToken})
+		bobClient := server.connect(ctx, t, map[string]string{"Authorization": "Bearer " + bobToken})
+		updated := make(chan string, 10)
+		aliceClient.OnNotification(func(notification mcp.JSONRPCNotification) {
+			if notification.Method == mcp.MethodNotificationResourceUpdated {
+				updated <- notification.Params.AdditionalFields["uri"].(string)
+			}
+		})
+		bobUpdated := make(chan string, 10)
+		bobClient.OnNotification(func(notification mcp.JSONRPCNotification) {
+			bobUpdated <- notification.Method
+		})
+
+		// Act
+		_, err := aliceClient.CallTool(ctx, recordTrainingRequest("通知確認"))
+
+		// Assert
+		assert.NoError(t, err)
+		var uris []string
+		for len(uris) < 3 {
+			select {
+			case uri := <-updated:
+				uris = append(uris, uri)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("resource update notifications not received: %v", uris)
+			}
+		}
+		assert.ElementsMatch(t, []string{resource.RecordsURI, resource.TrainingsURI("2025-06-14"), resource.ExerciseRecordsURI("ベンチプレス")}, uris)
+		assert.Empty(t, bobUpdated)
+	})
+}
+
+// readResourceText はリソースを読み込み、最初の内容のテキストを返します
+func readResourceText(ctx context.Context, t *testing.T, mcpClient *client.Client, uri string) string {
+	t.Helper()
+	request := mcp.ReadResourceRequest{}
+	request.Params.URI = uri
+	result, err := mcpClient.ReadResource(ctx, request)
+	if !assert.NoError(t, err, uri) || !assert.NotEmpty(t, result.Contents, uri) {
+		return ""
+	}
+	text, ok := result.Contents[0].(mcp.TextResourceContents)
+	assert.True(t, ok, uri)
+	assert.Equal(t, "application/json", text.MIMEType, uri)
+	return text.Text
+}
+
+func TestPrompts(t *testing.T) {
+	today := time.Now().UTC().Format("2006-01-02")
+
+	t.Run("正常系:記録を埋め込んだプロンプトを返す", func(t *testing.T) {
+		// Arrange
+		server := startHTTPTestServer(t)
+		_, token := server.issueToken(t, "alice")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		mcpClient := server.connect(ctx, t, map[string]string{"Authorization": "Bearer " + token})
+		_, err := mcpClient.CallTool(ctx, recordTrainingRequest("週次レビュー確認"))
+		assert.NoError(t, err)
+		todayTraining := recordTrainingRequest("今日の記録")
+		todayTraining.Params.Arguments.(map[string]any)["date"] = today
+		_, err = mcpClient.CallTool(ctx, todayTraining)
+		assert.NoError(t, err)
+
+		tests := []struct {
+			name      string
+			arguments map[string]string
+			contains  []string
+		}{
+			{
+				name:      "weekly_review",
+				arguments: map[string]string{"week": "2025-06-12"},
+				contains:  []string{"2025-06-09〜2025-06-15", "週次レビュー確認", "ベンチプレス", "[初記録]", "ランニングの記録は見つかりませんでした"},
+			},
+			{
+				name:      "plan_next_session",
+				arguments: map[string]string{"exercise": "ベンチプレス", "weeks": "2"},
+				contains:  []string{"「ベンチプレス」の直近2週間", "今日の記録", "95.0kg × 8回", "🏆", "バー: 20kg"},
+			},
+			{
+				name:      "race_week_briefing",
+				arguments: map[string]string{"race_date": time.Now().UTC().AddDate(0, 0, 5).Format("2006-01-02"), "event": "10K"},
+				contains:  []string{"10K、あと5日", "レースタイム予測", "トレーニングゾーン", "直近14日間"},
+			},
+		}
+
+		for _, tt := range tests {
+			request := mcp.GetPromptRequest{}
+			request.Params.Name = tt.name
+			request.Params.Arguments = tt.arguments
+
+			// Act
+			result, err := mcpClient.GetPrompt(ctx, request)
+
+			// Assert
+			if !assert.NoError(t, err, tt.name) || !assert.Len(t, result.Messages, 1, tt.name) {
+				continue
+			}
+			assert.Equal(t, mcp.RoleUser, result.Messages[0].Role, tt.name)
+			text, ok := result.Messages[0].Content.(mcp.TextContent)
+			if !assert.True(t, ok, tt.name) {
+				continue
+			}
+			for _, want := range tt.contains {
+				assert.Contains(t, text.Text, want, tt.name)
+			}
+		}
+	})
+
+	t.Run("異常系:引数が不足・不正な場合はエラーを返す", func(t *testing.T) {
+		// Arrange
+		server := startHTTPTestServer(t)
+		_, token := server.issueToken(t, "alice")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		mcpClient := server.connect(ctx, t, map[string]string{"Authorization": "Bearer " + token})
+		tests := []struct {
+			name      string
+			prompt    string
+			arguments map[string]string
+			wantErr   string
+		}{
+			{name: "エクササイズなし", prompt: "plan_next_session", arguments: map[string]string{}, wantErr: "exercise is required"},
+			{name: "期間が範囲外", prompt: "plan_next_session", arguments: map[string]string{"exercise": "ベンチプレス", "weeks": "53"}, wantErr: "invalid weeks"},
+			{name: "週の日付が不正", prompt: "weekly_review", arguments: map[string]string{"week": "2025/06/12"}, wantErr: "invalid week"},
+			{name: "レース日なし", prompt: "race_week_briefing", arguments: map[string]string{}, wantErr: "race_date is required"},
+			{name: "過去のレース日", prompt: "race_week_briefing", arguments: map[string]string{"race_date": "2025-01-01"}, wantErr: "in the past"},
+			{name: "不明な種目", prompt: "race_week_briefing", arguments: map[string]string{"race_date": today, "event": "Custom"}, wantErr: "invalid event"},
+		}
+
+		for _, tt := range tests {
+			request := mcp.GetPromptRequest{}
+			request.Params.Name = tt.prompt
+			request.Params.Arguments = tt.arguments
+
+			// Act
+			_, err := mcpClient.GetPrompt(ctx, request)
+
+			// Assert
+			assert.ErrorContains(t, err, tt.wantErr, tt.name)
+		}
+	})
+}
+
+func TestOutputFormat(t *testing.T) {
+	t.Run("正常系:output_formatに応じてMarkdown・JSONを返す", func(t *testing.T) {
+		// Arrange
+		server := startHTTPTestServer(t)
+		_, token := server.issueToken(t, "alice")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		mcpClient := server.connect(ctx, t, map[string]string{"Authorization": "Bearer " + token})
+		_, err := mcpClient.CallTool(ctx, recordTrainingRequest("出力形式確認"))
+		assert.NoError(t, err)
+		tests := []struct {
+			format    string
+			wantTexts int
+			jsonIndex int // JSONのテキストの位置（-1はJSONなし）
+		}{
+			{format: "", wantTexts: 1, jsonIndex: -1},
+			{format: "markdown", wantTexts: 1, jsonIndex: -1},
+			{format: "json", wantTexts: 1, jsonIndex: 0},
+			{format: "both", wantTexts: 2, jsonIndex: 1},
+		}
+
+		for _, tt := range tests {
+			request := getTrainingsRequest()
+			if tt.format != "" {
+				request.Params.Arguments.(map[string]any)["output_format"] = tt.format
+			}
+
+			// Act
+			result, err := mcpClient.CallTool(ctx, request)
+
+			// Assert
+			if !assert.NoError(t, err, tt.format) || !assert.Len(t, result.Content, tt.wantTexts, tt.format) {
+				continue
+			}
+			if tt.jsonIndex != 0 {
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "📊 **期間: 2025-06-14", tt.format)
+			}
+			if tt.jsonIndex < 0 {
+				continue
+			}
+			var output struct {
+				Schema        string                                    `json:"schema"`
+				SchemaVersion int                                       `json:"schema_version"`
+				Data          query_dto.GetTrainingsByDateRangeResponse `json:"data"`
+			}
+			text := result.Content[tt.jsonIndex].(mcp.TextContent).Text
+			if assert.NoError(t, json.Unmarshal([]byte(text), &output), tt.format) {
+				assert.Equal(t, "fitness-mcp-server/get_trainings_by_date_range", output.Schema)
+				assert.Equal(t, 1, output.SchemaVersion)
+				if assert.Len(t, output.Data.Trainings, 1) {
+					assert.Equal(t, "出力形式確認", output.Data.Trainings[0].Notes)
+				}
+			}
+		}
+	})
+
+	t.Run("異常系:未対応の出力形式の場合は記録せずにエラーを返す", func(t *testing.T) {
+		// Arrange
+		server := startHTTPTestServer(t)
+		_, token := server.issueToken(t, "alice")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		mcpClient := server.connect(ctx, t, map[string]string{"Authorization": "Bearer " + token})
+		request := recordTrainingRequest("記録されない")
+		request.Params.Arguments.(map[string]any)["output_format"] = "yaml"
+
+		// Act
+		result, err := mcpClient.CallTool(ctx, request)
+
+		// Assert
+		if assert.NoError(t, err) {
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "output_format must be markdown, json or both")
+		}
+		trainings, err := mcpClient.CallTool(ctx, getTrainingsRequest())
+		if assert.NoError(t, err) {
+			assert.Contains(t, resultText(t, trainings), "見つかりませんでした")
+		}
+	})
+}
+
+func TestSearchTrainings(t *testing.T) {
+	t.Run("正常系:セットの条件に一致したセッションを一致しないセットも含めて返す", func(t *testing.T) {
+		// Arrange
+		server := startHTTPTestServer(t)
+		_, token := server.issueToken(t, "alice")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		mcpClient := server.connect(ctx, t, map[string]string{"Authorization": "Bearer " + token})
+		_, err := mcpClient.CallTool(ctx, recordTrainingRequest("検索確認"))
+		assert.NoError(t, err)
+		request := mcp.CallToolRequest{}
+		request.Params.Name = "search_trainings"
+		request.Params.Arguments = map[string]any{"min_rpe": 9, "output_format": "json"}
+
+		// Act
+		result, err := mcpClient.CallTool(ctx, request)
+
+		// Assert
+		if !assert.NoError(t, err) {
+			return
+		}
+		var output struct {
+			Data query_dto.SearchTrainingsResponse `json:"data"`
+		}
+		if !assert.NoError(t, json.Unmarshal([]byte(resultText(t, result)), &output)) {
+			return
+		}
+		if assert.Len(t, output.Data.Trainings, 1) && assert.Len(t, output.Data.Trainings[0].Exercises, 1) {
+			assert.Len(t, output.Data.Trainings[0].Exercises[0].Sets, 2)
+		}
+	})
+}
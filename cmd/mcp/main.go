@@ -1,104 +1,142 @@
 package main
 
 import (
-	"database/sql"
-	"fitness-mcp-server/internal/application/command/handler"
-	command_usecase "fitness-mcp-server/internal/application/command/usecase"
-	query_handler "fitness-mcp-server/internal/application/query/handler"
-	query_usecase "fitness-mcp-server/internal/application/query/usecase"
+	"context"
+	"fitness-mcp-server/internal/app"
 	"fitness-mcp-server/internal/config"
-	sqlite_query "fitness-mcp-server/internal/infrastructure/query/sqlite"
-	"fitness-mcp-server/internal/infrastructure/repository/sqlite"
+	"fitness-mcp-server/internal/interface/mcp-prompt/prompt"
+	"fitness-mcp-server/internal/interface/mcp-resource/resource"
+	"fitness-mcp-server/internal/interface/mcp-tool/middleware"
 	"fitness-mcp-server/internal/interface/mcp-tool/tool"
-	"fitness-mcp-server/internal/interface/repository"
+	"fitness-mcp-server/internal/interface/transport"
+	"fitness-mcp-server/internal/logging"
+	"flag"
 	"fmt"
-	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
+	_ "time/tzdata" // タイムゾーンのデータベースがない環境でもMCP_TIMEZONEを解決する
 
 	"github.com/mark3labs/mcp-go/server"
 	_ "modernc.org/sqlite"
 )
 
 func main() {
-	// 設定の初期化
-	cfg := config.NewConfig()
-	log.Printf("Database path: %s", cfg.Database.SQLitePath)
+	// 設定の初期化（既定値・設定ファイル・環境変数の順に重ね、不正な設定は全ての問題を表示して終了）
+	flags := flag.NewFlagSet("mcp", flag.ContinueOnError)
+	configPath := flags.String("config", "", "設定ファイル（YAML）のパス（省略時はMCP_CONFIG）")
+	if err := flags.Parse(os.Args[1:]); err != nil {
+		os.Exit(2)
+	}
+	// mcp はMCPサーバの起動のみを行い、エクスポート・取り込み・ユーザー管理・バックアップ・マイグレーションは fitness で行う
+	if flags.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "unknown command: %s（データの管理は fitness %s を使用してください）\n使い方: mcp [-config PATH]\n", flags.Arg(0), flags.Arg(0))
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		fatal("failed to load configuration", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// ログの設定（標準入出力のJSON-RPCを壊さないよう、ログは常に標準エラー出力に書き込む）
+	logFile, err := logging.Setup(cfg.Server)
+	if err != nil {
+		fatal("failed to set up logging", err)
+	}
+	defer logFile.Close()
+	slog.Debug("database path", "path", cfg.Database.SQLitePath)
 
 	// データベースディレクトリを作成
 	if err := cfg.EnsureDatabaseDir(); err != nil {
-		log.Fatalf("Failed to create database directory: %v", err)
+		fatal("failed to create database directory", err)
 	}
-	log.Printf("Database directory created successfully")
 
 	// 依存関係の初期化
-	dependencies, err := initializeDependencies(cfg)
+	dependencies, err := app.InitializeDependencies(cfg)
 	if err != nil {
-		log.Fatalf("Failed to initialize dependencies: %v", err)
+		fatal("failed to initialize dependencies", err)
 	}
 
 	// MCPサーバの作成
-	s := server.NewMCPServer(
-		cfg.MCP.Name,
-		cfg.MCP.Version,
-		server.WithToolCapabilities(false),
-	)
-
-	// ツールの登録
-	if err := registerAllTools(s, dependencies); err != nil {
-		log.Fatalf("Failed to register tools: %v", err)
+	s, err := newMCPServer(cfg, dependencies)
+	if err != nil {
+		fatal("failed to create MCP server", err)
 	}
 
-	// サーバの起動
-	if err := server.ServeStdio(s); err != nil {
-		fmt.Printf("Server error: %v\n", err)
+	// サーバの起動（SIGINT・SIGTERMで処理中のリクエストの完了を待って終了）
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := transport.Serve(ctx, s, cfg.Transport, dependencies.Authenticator); err != nil {
+		slog.Error("server error", "error", err)
+	}
+	for _, metrics := range dependencies.ToolMetrics.Snapshot() {
+		slog.Info("tool metrics", "tool", metrics.Tool, "calls", metrics.Calls, "errors", metrics.Errors, "total_duration", metrics.TotalDuration)
+	}
+	if err := dependencies.DB.Close(); err != nil {
+		slog.Error("failed to close database", "error", err)
 	}
 }
 
-// Dependencies はアプリケーションの依存関係を表します
-type Dependencies struct {
-	CommandHandler *handler.StrengthCommandHandler
-	QueryHandler   *query_handler.StrengthQueryHandler
+// fatal はエラーをログに出力して終了します
+func fatal(message string, err error) {
+	slog.Error(message, "error", err)
+	os.Exit(1)
 }
 
-// initializeDependencies は依存関係を初期化します
-func initializeDependencies(cfg *config.Config) (*Dependencies, error) {
-	// リポジトリを初期化
-	repo, err := initializeStrengthRepository(cfg.Database.SQLitePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize strength repository: %w", err)
-	}
+// newMCPServer はツール・リソース・プロンプトを登録したMCPサーバを作成します
+func newMCPServer(cfg *config.Config, deps *app.Dependencies) (*server.MCPServer, error) {
+	// 書き込み系のツールの実行後に、変更されたリソースをユーザーのセッションに通知する
+	notifier := resource.NewChangeNotifier()
+	s := server.NewMCPServer(
+		cfg.MCP.Name,
+		cfg.MCP.Version,
+		server.WithToolCapabilities(false),
+		server.WithResourceCapabilities(false, false),
+		server.WithPromptCapabilities(false),
+		server.WithHooks(notifier.Hooks()),
+		// 全てのツールにログ・集計・タイムアウト・パニックの回復を適用する（Recoverはgoroutine内で回復するため最も内側）
+		server.WithToolHandlerMiddleware(middleware.Chain(
+			middleware.Logging(),
+			deps.ToolMetrics.Middleware(),
+			middleware.Timeout(time.Duration(cfg.Server.RequestTimeout)*time.Second),
+			middleware.Recover(),
+		)),
+	)
+	notifier.Attach(s)
 
-	// クエリサービスを初期化
-	queryService, err := initializeStrengthQueryService(cfg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize strength query service: %w", err)
+	if err := registerAllTools(s, deps); err != nil {
+		return nil, err
 	}
-
-	// Command系の初期化
-	commandUsecase := command_usecase.NewStrengthTrainingUsecase(repo)
-	commandHandler := handler.NewStrengthCommandHandler(commandUsecase)
-
-	// Query系の初期化
-	queryUsecase := query_usecase.NewStrengthQueryUsecase(queryService)
-	personalRecordsUsecase := query_usecase.NewPersonalRecordsUsecase(queryService)
-	queryHandler := query_handler.NewStrengthQueryHandler(queryUsecase, personalRecordsUsecase)
-
-	return &Dependencies{
-		CommandHandler: commandHandler,
-		QueryHandler:   queryHandler,
-	}, nil
+	if cfg.Features.Resources {
+		if err := registerAllResources(s, deps); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Features.Prompts {
+		if err := registerAllPrompts(s, cfg, deps); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
 }
 
 // registerAllTools はすべてのツールを登録します
-func registerAllTools(s *server.MCPServer, deps *Dependencies) error {
+func registerAllTools(s *server.MCPServer, deps *app.Dependencies) error {
 	// トレーニング記録ツール
-	trainingTool := tool.NewTrainingToolHandler(deps.CommandHandler)
+	trainingTool := tool.NewTrainingToolHandler(deps.CommandHandler, deps.Calendar)
 	if err := trainingTool.Register(s); err != nil {
 		return fmt.Errorf("failed to register training tool: %w", err)
 	}
 
 	// 期間指定クエリツール
-	queryTool := tool.NewQueryToolHandler(deps.QueryHandler)
+	queryTool := tool.NewQueryToolHandler(deps.QueryHandler, deps.Calendar)
 	if err := queryTool.Register(s); err != nil {
 		return fmt.Errorf("failed to register query tool: %w", err)
 	}
@@ -109,55 +147,90 @@ func registerAllTools(s *server.MCPServer, deps *Dependencies) error {
 		return fmt.Errorf("failed to register record tool: %w", err)
 	}
 
-	return nil
-}
+	// レースタイム予測ツール
+	predictionTool := tool.NewPredictionToolHandler(deps.RunningQueryHandler, deps.Calendar)
+	if err := predictionTool.Register(s); err != nil {
+		return fmt.Errorf("failed to register prediction tool: %w", err)
+	}
 
-// initializeStrengthRepository はStrengthRepositoryを初期化します
-func initializeStrengthRepository(dbPath string) (repository.StrengthTrainingRepository, error) {
-	log.Printf("Initializing SQLite repository at: %s", dbPath)
+	// ランニング記録ツール
+	runningTool := tool.NewRunningToolHandler(deps.RunningCommandHandler, deps.Calendar)
+	if err := runningTool.Register(s); err != nil {
+		return fmt.Errorf("failed to register running tool: %w", err)
+	}
 
-	// SQLiteリポジトリを作成
-	repo, err := sqlite.NewStrengthRepository(dbPath)
-	if err != nil {
-		log.Printf("Failed to create SQLite repository: %v", err)
-		return nil, err
+	// 身体測定ツール
+	bodyTool := tool.NewBodyToolHandler(deps.BodyCommandHandler, deps.BodyQueryHandler, deps.Calendar)
+	if err := bodyTool.Register(s); err != nil {
+		return fmt.Errorf("failed to register body tool: %w", err)
 	}
 
-	// データベースの初期化（テーブル作成）
-	if err := repo.Initialize(); err != nil {
-		log.Printf("Failed to initialize database: %v", err)
-		return nil, err
+	// トレーニングゾーンツール
+	zoneTool := tool.NewZoneToolHandler(deps.RunningQueryHandler)
+	if err := zoneTool.Register(s); err != nil {
+		return fmt.Errorf("failed to register zone tool: %w", err)
+	}
+
+	// FITファイル取り込みツール
+	fitTool := tool.NewFitToolHandler(deps.ActivityImportHandler)
+	if err := fitTool.Register(s); err != nil {
+		return fmt.Errorf("failed to register fit tool: %w", err)
 	}
 
-	log.Printf("Successfully initialized SQLite repository at: %s", dbPath)
-	return repo, nil
+	// 筋トレCSV取り込みツール
+	strengthCSVTool := tool.NewStrengthCSVToolHandler(deps.StrengthImportHandler)
+	if err := strengthCSVTool.Register(s); err != nil {
+		return fmt.Errorf("failed to register strength csv tool: %w", err)
+	}
+
+	// データのエクスポート・取り込みツール
+	dataTool := tool.NewDataToolHandler(deps.DataExportHandler, deps.DataImportHandler, deps.Calendar)
+	if err := dataTool.Register(s); err != nil {
+		return fmt.Errorf("failed to register data tool: %w", err)
+	}
+
+	return nil
 }
 
-// initializeStrengthQueryService はStrengthQueryServiceを初期化します
-func initializeStrengthQueryService(cfg *config.Config) (*sqlite_query.StrengthQueryService, error) {
-	log.Printf("Initializing SQLite query service at: %s", cfg.Database.SQLitePath)
+// registerAllResources はすべてのリソースを登録します
+func registerAllResources(s *server.MCPServer, deps *app.Dependencies) error {
+	// 筋トレセッション・自己ベストのリソース
+	strengthResource := resource.NewStrengthResourceHandler(deps.QueryHandler, deps.Calendar)
+	if err := strengthResource.Register(s); err != nil {
+		return fmt.Errorf("failed to register strength resources: %w", err)
+	}
 
-	// データベース接続を開く
-	db, err := sql.Open("sqlite", cfg.Database.SQLitePath)
-	if err != nil {
-		log.Printf("Failed to open database: %v", err)
-		return nil, fmt.Errorf("failed to open database: %w", err)
+	// ランニングのリソース
+	runningResource := resource.NewRunningResourceHandler(deps.RunningQueryHandler)
+	if err := runningResource.Register(s); err != nil {
+		return fmt.Errorf("failed to register running resources: %w", err)
 	}
 
-	// 接続テスト
-	if err := db.Ping(); err != nil {
-		log.Printf("Failed to ping database: %v", err)
-		return nil, fmt.Errorf("failed to ping database: %w", err)
+	return nil
+}
+
+// registerAllPrompts はすべてのプロンプトを登録します
+func registerAllPrompts(s *server.MCPServer, cfg *config.Config, deps *app.Dependencies) error {
+	// 週次レビューのプロンプト
+	reviewPrompt := prompt.NewReviewPromptHandler(deps.QueryHandler, deps.RunningQueryHandler, deps.Calendar)
+	if err := reviewPrompt.Register(s); err != nil {
+		return fmt.Errorf("failed to register review prompts: %w", err)
 	}
 
-	// SQLiteの設定
-	db.SetMaxOpenConns(cfg.Database.MaxOpenConns)
-	db.SetMaxIdleConns(cfg.Database.MaxIdleConns)
-	db.SetConnMaxLifetime(time.Duration(cfg.Database.ConnMaxLifetime) * time.Hour)
+	// 筋トレのプロンプト
+	strengthPrompt := prompt.NewStrengthPromptHandler(deps.QueryHandler, prompt.PlateInventory{
+		BarKg:    cfg.Plates.BarKg,
+		PlatesKg: cfg.Plates.AvailableKg,
+	}, deps.Calendar)
+	if err := strengthPrompt.Register(s); err != nil {
+		return fmt.Errorf("failed to register strength prompts: %w", err)
+	}
 
-	// SQLiteクエリサービスを作成
-	queryService := sqlite_query.NewStrengthQueryService(db)
+	// ランニングのプロンプト
+	runningPrompt := prompt.NewRunningPromptHandler(deps.RunningQueryHandler, deps.Calendar)
+	if err := runningPrompt.Register(s); err != nil {
+		return fmt.Errorf("failed to register running prompts: %w", err)
+	}
 
-	log.Printf("Successfully initialized SQLite query service at: %s", cfg.Database.SQLitePath)
-	return queryService, nil
+	return nil
 }
@@ -0,0 +1,253 @@
+// Package app はMCPサーバとCLIで共有するアプリケーションの組み立て（依存関係の初期化）を提供します
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"fitness-mcp-server/internal/application/auth"
+	"fitness-mcp-server/internal/application/command/handler"
+	command_usecase "fitness-mcp-server/internal/application/command/usecase"
+	query_handler "fitness-mcp-server/internal/application/query/handler"
+	query_usecase "fitness-mcp-server/internal/application/query/usecase"
+	"fitness-mcp-server/internal/config"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/user"
+	"fitness-mcp-server/internal/infrastructure/backup"
+	"fitness-mcp-server/internal/infrastructure/database"
+	"fitness-mcp-server/internal/infrastructure/importer/fit"
+	"fitness-mcp-server/internal/infrastructure/importer/strengthcsv"
+	"fitness-mcp-server/internal/infrastructure/importer/trackfile"
+	"fitness-mcp-server/internal/infrastructure/migrations"
+	sqlite_query "fitness-mcp-server/internal/infrastructure/query/sqlite"
+	"fitness-mcp-server/internal/infrastructure/repository/sqlite"
+	"fitness-mcp-server/internal/interface/mcp-tool/middleware"
+
+	_ "modernc.org/sqlite"
+)
+
+// Dependencies はアプリケーションの依存関係を表します
+type Dependencies struct {
+	DB                    *sql.DB
+	Calendar              shared.Calendar
+	Authenticator         *auth.Authenticator
+	CommandHandler        *handler.StrengthCommandHandler
+	QueryHandler          *query_handler.StrengthQueryHandler
+	RunningCommandHandler *handler.RunningCommandHandler
+	RunningQueryHandler   *query_handler.RunningQueryHandler
+	BodyCommandHandler    *handler.BodyMetricCommandHandler
+	BodyQueryHandler      *query_handler.BodyMetricQueryHandler
+	ActivityImportHandler *handler.ActivityImportCommandHandler
+	StrengthImportHandler *handler.StrengthImportCommandHandler
+	DataExportHandler     *query_handler.DataExportQueryHandler
+	DataImportHandler     *handler.DataImportCommandHandler
+	ToolMetrics           *middleware.Metrics
+}
+
+// InitializeDependencies はデータベースを開いてマイグレーションを適用し、依存関係を初期化します
+func InitializeDependencies(cfg *config.Config) (*Dependencies, error) {
+	// リポジトリ・クエリサービスで共有するデータベース接続を開く
+	db, err := database.Open(cfg.Database)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// スキーマを最新にする（既存のデータベースは適用前にスナップショットを作成）
+	if err := MigrateDatabase(db, NewBackupService(cfg)); err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	// ユーザーの認証を初期化（既定のユーザーはトークンなしで作成）
+	defaultUser, err := user.NewID(cfg.Auth.DefaultUser)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default user: %w", err)
+	}
+	authenticator := auth.NewAuthenticator(sqlite.NewUserRepository(db), defaultUser, cfg.Auth.RequiresToken())
+	if err := authenticator.EnsureDefaultUser(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to create default user: %w", err)
+	}
+
+	// 日付はユーザーのタイムゾーンで扱う（日時はUTCで保存し、ローカル日付を併せて保存する）
+	location, err := cfg.Location()
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+	calendar := shared.NewCalendar(location)
+
+	// リポジトリを初期化
+	repo := sqlite.NewStrengthTrainingRepository(db, calendar)
+
+	// クエリサービスを初期化
+	queryService := initializeStrengthQueryService(db, calendar)
+
+	// PRイベントログのリポジトリを初期化
+	recordRepo := sqlite.NewPersonalRecordRepository(db, calendar)
+
+	// Command系の初期化
+	commandUsecase := command_usecase.NewStrengthTrainingUsecase(repo, recordRepo, queryService)
+	commandHandler := handler.NewStrengthCommandHandler(commandUsecase)
+
+	// 既存のトレーニング履歴からユーザーごとにPR履歴を構築（未構築の場合のみ）
+	users, err := authenticator.Users(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load users: %w", err)
+	}
+	for _, u := range users {
+		if err := commandHandler.InitializePersonalRecords(auth.WithUser(context.Background(), u.ID())); err != nil {
+			return nil, fmt.Errorf("failed to initialize personal record history of %s: %w", u.ID(), err)
+		}
+	}
+
+	// Query系の初期化
+	queryUsecase := query_usecase.NewStrengthQueryUsecase(queryService)
+	personalRecordsUsecase := query_usecase.NewPersonalRecordsUsecase(queryService)
+	queryHandler := query_handler.NewStrengthQueryHandler(queryUsecase, personalRecordsUsecase)
+
+	// アスリートプロファイルが未登録の場合に使用するトレーニングゾーンの基準値
+	defaultProfile, err := newDefaultAthleteProfile(cfg.Zones)
+	if err != nil {
+		return nil, fmt.Errorf("invalid training zones: %w", err)
+	}
+
+	// ランニングQuery系の初期化
+	runningQueryService := sqlite_query.NewRunningQueryService(db, calendar)
+	racePredictionUsecase := query_usecase.NewRacePredictionUsecase(runningQueryService, calendar)
+	trainingZonesUsecase := query_usecase.NewTrainingZonesUsecase(runningQueryService, defaultProfile, calendar)
+	runningHistoryUsecase := query_usecase.NewRunningHistoryUsecase(runningQueryService, calendar)
+	runningGoalsUsecase := query_usecase.NewRunningGoalsUsecase(runningQueryService)
+	runningQueryHandler := query_handler.NewRunningQueryHandler(racePredictionUsecase, trainingZonesUsecase, runningHistoryUsecase, runningGoalsUsecase)
+
+	// ランニングCommand系の初期化
+	runningRepo := sqlite.NewRunningRepository(db, calendar)
+	profileRepo := sqlite.NewAthleteProfileRepository(db)
+	goalRepo := sqlite.NewRunningGoalRepository(db, calendar)
+	runningUsecase := command_usecase.NewRunningUsecase(runningRepo, profileRepo, goalRepo, runningQueryService, trackfile.NewImporter(), defaultProfile)
+	runningCommandHandler := handler.NewRunningCommandHandler(runningUsecase)
+
+	// 身体測定値（体重・体脂肪率）の初期化
+	bodyRepo := sqlite.NewBodyMetricRepository(db, calendar)
+	bodyQueryService := sqlite_query.NewBodyMetricQueryService(db, calendar)
+	bodyCommandHandler := handler.NewBodyMetricCommandHandler(command_usecase.NewBodyMetricUsecase(bodyRepo))
+	bodyQueryHandler := query_handler.NewBodyMetricQueryHandler(query_usecase.NewBodyMetricsUsecase(bodyQueryService, calendar))
+
+	// FITファイル取り込みの初期化（ラン・筋トレそれぞれの記録ユースケースに委譲）
+	activityImportUsecase := command_usecase.NewActivityImportUsecase(fit.NewImporter(), runningUsecase, commandUsecase)
+	activityImportHandler := handler.NewActivityImportCommandHandler(activityImportUsecase)
+
+	// 他アプリの筋トレCSV取り込みの初期化（PR履歴の再構築は筋トレ記録ユースケースに委譲）
+	strengthImportUsecase := command_usecase.NewStrengthImportUsecase(strengthcsv.NewImporter(), repo, queryService, commandUsecase, cfg.Units.Weight, calendar)
+	strengthImportHandler := handler.NewStrengthImportCommandHandler(strengthImportUsecase)
+
+	// データのエクスポート・取り込みの初期化
+	dataExportHandler := query_handler.NewDataExportQueryHandler(query_usecase.NewDataExportUsecase(queryService, runningQueryService, bodyQueryService))
+	dataImportUsecase := command_usecase.NewDataImportUsecase(
+		repo, runningRepo, profileRepo, goalRepo, bodyRepo, queryService, runningQueryService, bodyQueryService, commandUsecase)
+	dataImportHandler := handler.NewDataImportCommandHandler(dataImportUsecase)
+
+	return &Dependencies{
+		DB:                    db,
+		Calendar:              calendar,
+		Authenticator:         authenticator,
+		CommandHandler:        commandHandler,
+		QueryHandler:          queryHandler,
+		RunningCommandHandler: runningCommandHandler,
+		RunningQueryHandler:   runningQueryHandler,
+		BodyCommandHandler:    bodyCommandHandler,
+		BodyQueryHandler:      bodyQueryHandler,
+		ActivityImportHandler: activityImportHandler,
+		StrengthImportHandler: strengthImportHandler,
+		DataExportHandler:     dataExportHandler,
+		DataImportHandler:     dataImportHandler,
+		ToolMetrics:           middleware.NewMetrics(),
+	}, nil
+}
+
+// DefaultUserContext は既定のユーザーとしてデータを扱うコンテキストを返します
+func (d *Dependencies) DefaultUserContext() context.Context {
+	return auth.WithUser(context.Background(), d.Authenticator.DefaultUser())
+}
+
+// newDefaultAthleteProfile は設定のトレーニングゾーンの基準値からプロファイルを作成します（未設定の場合はnil）
+func newDefaultAthleteProfile(zones config.ZonesConfig) (*running.AthleteProfile, error) {
+	thresholdPace, err := zones.ThresholdPaceSecondsPerKm()
+	if err != nil {
+		return nil, err
+	}
+	if zones.MaxHeartRate == 0 && zones.RestingHeartRate == 0 && zones.ThresholdHeartRate == 0 && thresholdPace == 0 {
+		return nil, nil
+	}
+
+	toHeartRate := func(bpm int) (*running.HeartRate, error) {
+		if bpm == 0 {
+			return nil, nil
+		}
+		heartRate, err := running.NewHeartRate(bpm)
+		if err != nil {
+			return nil, err
+		}
+		return &heartRate, nil
+	}
+	maxHR, err := toHeartRate(zones.MaxHeartRate)
+	if err != nil {
+		return nil, err
+	}
+	restingHR, err := toHeartRate(zones.RestingHeartRate)
+	if err != nil {
+		return nil, err
+	}
+	lthr, err := toHeartRate(zones.ThresholdHeartRate)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := running.NewAthleteProfile()
+	if err := profile.SetHeartRates(maxHR, restingHR, lthr); err != nil {
+		return nil, err
+	}
+	if thresholdPace > 0 {
+		pace, err := running.NewPace(thresholdPace / 60)
+		if err != nil {
+			return nil, err
+		}
+		profile.SetThresholdPace(pace)
+	}
+	return profile, nil
+}
+
+// NewBackupService は設定からスナップショットのサービスを作成します
+func NewBackupService(cfg *config.Config) *backup.Service {
+	return backup.NewService(cfg.Backup.Dir, backup.RetentionPolicy{
+		KeepLast: cfg.Backup.KeepLast,
+		KeepDays: cfg.Backup.KeepDays,
+	})
+}
+
+// NewMigrationRunner はマイグレーションを適用・ロールバックする前にスナップショットを作成するRunnerを作成します
+func NewMigrationRunner(db *sql.DB, backupService *backup.Service) (*migrations.Runner, error) {
+	return migrations.NewRunner(db, func(db *sql.DB, direction migrations.Direction, versions []string) error {
+		label := "pre-migrate-" + versions[0]
+		if direction == migrations.Down {
+			label = "pre-rollback-" + versions[0]
+		}
+		_, err := backupService.Create(db, label)
+		return err
+	})
+}
+
+// MigrateDatabase は未適用のマイグレーションを適用します
+func MigrateDatabase(db *sql.DB, backupService *backup.Service) error {
+	runner, err := NewMigrationRunner(db, backupService)
+	if err != nil {
+		return err
+	}
+	_, err = runner.Up()
+	return err
+}
+
+// initializeStrengthQueryService はStrengthQueryServiceを初期化します
+func initializeStrengthQueryService(db *sql.DB, calendar shared.Calendar) *sqlite_query.StrengthQueryService {
+	// SQLiteクエリサービスを作成
+	return sqlite_query.NewStrengthQueryService(db, calendar)
+}
@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"fitness-mcp-server/internal/domain/user"
+	"fitness-mcp-server/internal/interface/repository"
+)
+
+// tokenPrefix はトークンの接頭辞（設定ファイル等に含まれている場合に判別しやすくする）
+const tokenPrefix = "fmcp_"
+
+// ErrInvalidToken はトークンが不正な場合のエラー
+var ErrInvalidToken = errors.New("invalid token")
+
+// Authenticator はトークンの発行と、リクエストのユーザーの特定を行います
+type Authenticator struct {
+	users        repository.UserRepository
+	defaultUser  user.ID
+	requireToken bool
+	now          func() time.Time
+}
+
+// NewAuthenticator は新しいAuthenticatorを作成します
+// requireTokenがfalseの場合、HTTPのリクエストもdefaultUserとして扱います
+func NewAuthenticator(users repository.UserRepository, defaultUser user.ID, requireToken bool) *Authenticator {
+	return &Authenticator{users: users, defaultUser: defaultUser, requireToken: requireToken, now: time.Now}
+}
+
+// DefaultUser は標準入出力・CLI・認証なしの場合に使用するユーザーを返します
+func (a *Authenticator) DefaultUser() user.ID {
+	return a.defaultUser
+}
+
+// RequiresToken はHTTPのリクエストにトークンが必要かどうかを判定します
+func (a *Authenticator) RequiresToken() bool {
+	return a.requireToken
+}
+
+// EnsureDefaultUser は既定のユーザーが登録されていない場合に作成します（トークンは発行しません）
+func (a *Authenticator) EnsureDefaultUser(ctx context.Context) error {
+	existing, err := a.users.FindByID(ctx, a.defaultUser)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return nil
+	}
+	return a.users.Save(ctx, user.NewUser(a.defaultUser, "", a.now()))
+}
+
+// Authenticate はトークンのユーザーを返します
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (user.ID, error) {
+	token = strings.TrimSpace(token)
+	if !strings.HasPrefix(token, tokenPrefix) {
+		return user.ID{}, ErrInvalidToken
+	}
+
+	found, err := a.users.FindByTokenHash(ctx, HashToken(token))
+	if err != nil {
+		return user.ID{}, fmt.Errorf("failed to look up token: %w", err)
+	}
+	if found == nil {
+		return user.ID{}, ErrInvalidToken
+	}
+	return found.ID(), nil
+}
+
+// IssueToken はユーザーに新しいトークンを発行します
+// ユーザーが存在しない場合は作成し、存在する場合は以前のトークンを無効にします
+func (a *Authenticator) IssueToken(ctx context.Context, id user.ID) (string, error) {
+	token, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+
+	target, err := a.users.FindByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if target == nil {
+		target = user.NewUser(id, "", a.now())
+	}
+	target.ReplaceToken(HashToken(token))
+
+	if err := a.users.Save(ctx, target); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Users は登録されているユーザーを返します
+func (a *Authenticator) Users(ctx context.Context) ([]*user.User, error) {
+	return a.users.FindAll(ctx)
+}
+
+// HashToken はトークンを保存用のハッシュに変換します
+// トークンは十分な長さの乱数のため、ソルトなしのSHA-256で照合します
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateToken は32バイトの乱数からトークンを生成します
+func generateToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return tokenPrefix + hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"fitness-mcp-server/internal/domain/user"
+)
+
+// ErrUnauthenticated はコンテキストにユーザーが設定されていない場合のエラー
+var ErrUnauthenticated = errors.New("unauthenticated: no user in request context")
+
+// userKey はコンテキストにユーザーIDを格納するキー
+type userKey struct{}
+
+// WithUser はユーザーIDを設定したコンテキストを返します
+func WithUser(ctx context.Context, id user.ID) context.Context {
+	return context.WithValue(ctx, userKey{}, id)
+}
+
+// UserFromContext はコンテキストのユーザーIDを返します
+// ユーザーが設定されていない場合は、他のユーザーのデータを扱わないようエラーにします
+func UserFromContext(ctx context.Context) (user.ID, error) {
+	id, ok := ctx.Value(userKey{}).(user.ID)
+	if !ok || id.IsEmpty() {
+		return user.ID{}, ErrUnauthenticated
+	}
+	return id, nil
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"fmt"
+
+	"fitness-mcp-server/internal/domain/running"
+)
+
+// =============================================================================
+// アクティビティ取り込みコマンドDTO - デバイスのFITファイルの取り込み
+// =============================================================================
+
+// ImportFitFileCommand はFITファイル取り込みコマンドDTO
+// ファイルパスまたはファイルの内容のどちらか一方を指定します
+type ImportFitFileCommand struct {
+	FilePath string `json:"file_path,omitempty"` // ローカルのファイルパス
+	Content  []byte `json:"content,omitempty"`   // ファイルの内容（base64デコード済み）
+	RunType  string `json:"run_type"`            // ランの場合のランニングタイプ（省略時はEasy）
+	Notes    string `json:"notes"`               // 省略時はファイル名
+	Preview  bool   `json:"preview"`             // trueの場合は保存せずに解析結果のみ返す
+}
+
+// Validate はImportFitFileCommandの妥当性検証を行います
+func (cmd *ImportFitFileCommand) Validate() error {
+	if cmd.FilePath == "" && len(cmd.Content) == 0 {
+		return fmt.Errorf("file path or content is required")
+	}
+	if cmd.FilePath != "" && len(cmd.Content) > 0 {
+		return fmt.Errorf("specify either file path or content, not both")
+	}
+	if cmd.RunType != "" {
+		if _, err := running.NewRunType(cmd.RunType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParsedRunType はランニングタイプを返します（省略時はEasy）
+func (cmd *ImportFitFileCommand) ParsedRunType() (running.RunType, error) {
+	if cmd.RunType == "" {
+		return running.Easy, nil
+	}
+	return running.NewRunType(cmd.RunType)
+}
@@ -0,0 +1,23 @@
+package dto
+
+// =============================================================================
+// アクティビティ取り込みレスポンスDTO - FITファイルの取り込み結果
+// =============================================================================
+
+// ImportFitFileResult はFITファイル取り込み結果DTO（RunとStrengthのいずれか一方）
+type ImportFitFileResult struct {
+	Sport    string                `json:"sport"`   // デバイスが記録した種目（running / training 等）
+	Preview  bool                  `json:"preview"` // trueの場合は保存していない
+	Run      *ImportRunFileResult  `json:"run,omitempty"`
+	Strength *ImportStrengthResult `json:"strength,omitempty"`
+	Warnings []string              `json:"warnings,omitempty"` // 取り込めなかったラップ・セット等
+}
+
+// ImportStrengthResult はFITファイルから取り込んだ筋トレの結果DTO
+type ImportStrengthResult struct {
+	ElapsedTime string                `json:"elapsed_time"` // 開始から終了までの経過時間
+	RestSets    int                   `json:"rest_sets"`    // 取り込まなかった休息セットの数
+	TotalVolume float64               `json:"total_volume"` // 総負荷量（kg）
+	Session     *TrainingSessionDTO   `json:"session"`
+	Result      *RecordTrainingResult `json:"result"`
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"fitness-mcp-server/internal/domain/body"
+	"fitness-mcp-server/internal/domain/shared"
+)
+
+// =============================================================================
+// 身体測定コマンドDTO - 体重・体脂肪率の記録
+// =============================================================================
+
+// RecordBodyMetricCommand は身体測定値記録コマンドDTO
+type RecordBodyMetricCommand struct {
+	Date           time.Time `json:"date"`
+	WeightKg       float64   `json:"weight_kg"`
+	BodyFatPercent *float64  `json:"body_fat_percent,omitempty"` // オプション: 体脂肪率（%）
+	Notes          string    `json:"notes"`
+}
+
+// Validate はRecordBodyMetricCommandの妥当性検証を行います
+func (cmd *RecordBodyMetricCommand) Validate() error {
+	if cmd.Date.IsZero() {
+		return fmt.Errorf("date is required")
+	}
+	if _, err := body.NewWeight(cmd.WeightKg); err != nil {
+		return err
+	}
+	if cmd.BodyFatPercent != nil {
+		if _, err := body.NewBodyFat(*cmd.BodyFatPercent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToMetric はコマンドから身体測定値を作成します
+func (cmd *RecordBodyMetricCommand) ToMetric() (*body.Metric, error) {
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
+	weight, err := body.NewWeight(cmd.WeightKg)
+	if err != nil {
+		return nil, err
+	}
+	metric, err := body.NewMetric(shared.NewBodyMetricID(), cmd.Date, weight, cmd.Notes)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.BodyFatPercent != nil {
+		bodyFat, err := body.NewBodyFat(*cmd.BodyFatPercent)
+		if err != nil {
+			return nil, err
+		}
+		metric.SetBodyFat(bodyFat)
+	}
+	return metric, nil
+}
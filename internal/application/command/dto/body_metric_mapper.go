@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"math"
+
+	"fitness-mcp-server/internal/domain/body"
+)
+
+// =============================================================================
+// 身体測定マッパー - ドメインモデルとDTOの変換
+// =============================================================================
+
+// FromBodyMetric は身体測定値を記録結果DTOに変換します
+func FromBodyMetric(metric *body.Metric) *RecordBodyMetricResult {
+	result := &RecordBodyMetricResult{
+		MetricID: metric.ID().String(),
+		Date:     metric.Date(),
+		WeightKg: metric.Weight().Kg(),
+	}
+	if metric.BodyFat() != nil {
+		percent := metric.BodyFat().Percent()
+		result.BodyFatPercent = &percent
+	}
+	if lean := metric.LeanMassKg(); lean != nil {
+		rounded := math.Round(*lean*10) / 10
+		result.LeanMassKg = &rounded
+	}
+	return result
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"time"
+)
+
+// =============================================================================
+// 身体測定レスポンスDTO - ユースケース実行結果のデータ構造
+// =============================================================================
+
+// RecordBodyMetricResult は身体測定値記録結果DTO
+type RecordBodyMetricResult struct {
+	MetricID       string    `json:"metric_id"`
+	Date           time.Time `json:"date"`
+	WeightKg       float64   `json:"weight_kg"`
+	BodyFatPercent *float64  `json:"body_fat_percent,omitempty"`
+	LeanMassKg     *float64  `json:"lean_mass_kg,omitempty"` // 体脂肪率がある場合のみ
+	Message        string    `json:"message"`
+}
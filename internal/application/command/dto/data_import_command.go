@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"fmt"
+)
+
+// =============================================================================
+// データ取り込みコマンドDTO - export_dataでエクスポートしたJSONの取り込み
+// =============================================================================
+
+// ImportDataCommand はデータ取り込みコマンドDTO
+// ファイルパスまたはファイルの内容のどちらか一方を指定します
+type ImportDataCommand struct {
+	FilePath string `json:"file_path,omitempty"` // ローカルのファイルパス
+	Content  []byte `json:"content,omitempty"`   // ファイルの内容
+	DryRun   bool   `json:"dry_run"`             // trueの場合は保存せずに取り込み結果のみ返す
+}
+
+// Validate はImportDataCommandの妥当性検証を行います
+func (cmd *ImportDataCommand) Validate() error {
+	if cmd.FilePath == "" && len(cmd.Content) == 0 {
+		return fmt.Errorf("file path or content is required")
+	}
+	if cmd.FilePath != "" && len(cmd.Content) > 0 {
+		return fmt.Errorf("specify either file path or content, not both")
+	}
+	return nil
+}
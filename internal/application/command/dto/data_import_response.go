@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+)
+
+// =============================================================================
+// データ取り込みレスポンスDTO - エクスポートしたJSONの取り込み結果
+// =============================================================================
+
+// ImportDataResult はデータ取り込み結果DTO
+// 同じIDのセッション・目標・身体測定値が記録済みの場合は取り込まないため、同じファイルを何度取り込んでも結果は変わりません
+type ImportDataResult struct {
+	SchemaVersion       int                           `json:"schema_version"`
+	ExportedAt          time.Time                     `json:"exported_at"`
+	DryRun              bool                          `json:"dry_run"`               // trueの場合は保存していない
+	StrengthCreated     int                           `json:"strength_created"`      // 作成した（ドライランの場合は作成する）筋トレセッション数
+	StrengthExisting    int                           `json:"strength_existing"`     // 同じIDが記録済みのため取り込まなかった筋トレセッション数
+	RunsCreated         int                           `json:"runs_created"`          // 作成した（ドライランの場合は作成する）ランニングセッション数
+	RunsExisting        int                           `json:"runs_existing"`         // 同じIDが記録済みのため取り込まなかったランニングセッション数
+	GoalsCreated        int                           `json:"goals_created"`         // 作成した（ドライランの場合は作成する）目標数
+	GoalsExisting       int                           `json:"goals_existing"`        // 同じIDが記録済みのため取り込まなかった目標数
+	BodyMetricsCreated  int                           `json:"body_metrics_created"`  // 作成した（ドライランの場合は作成する）身体測定値の数
+	BodyMetricsExisting int                           `json:"body_metrics_existing"` // 同じIDが記録済みのため取り込まなかった身体測定値の数
+	ProfileImported     bool                          `json:"profile_imported"`      // アスリートプロファイルを取り込んだか
+	ProfileSkipReason   string                        `json:"profile_skip_reason,omitempty"`
+	Records             *RebuildPersonalRecordsResult `json:"records,omitempty"` // 取り込み後のPR履歴の再構築結果
+	Message             string                        `json:"message"`
+}
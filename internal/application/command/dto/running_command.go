@@ -0,0 +1,401 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+)
+
+// =============================================================================
+// ランニングコマンドDTO - 外部インターフェースとの入出力データ構造
+// =============================================================================
+
+// RecordRunningCommand はランニングセッション記録コマンドDTO
+type RecordRunningCommand struct {
+	Date            time.Time `json:"date"`
+	DistanceKm      float64   `json:"distance_km"`              // 省略時（0）はラップの合計
+	DurationSeconds float64   `json:"duration_seconds"`         // 省略時（0）はラップの合計
+	RunType         string    `json:"run_type"`                 // Easy / Tempo / Interval / Long / Race
+	HeartRateBPM    *int      `json:"heart_rate_bpm,omitempty"` // オプション: 平均心拍数
+	Notes           string    `json:"notes"`
+	Laps            []LapDTO  `json:"laps,omitempty"` // オプション: ラップ（記録順）
+}
+
+// LapDTO はラップDTO
+type LapDTO struct {
+	DistanceKm      float64 `json:"distance_km"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	HeartRateBPM    *int    `json:"heart_rate_bpm,omitempty"` // オプション: 平均心拍数
+	Type            string  `json:"type,omitempty"`           // Work / Rest（省略時はWork）
+}
+
+// ImportRunFileCommand はGPX・TCXファイル取り込みコマンドDTO
+// ファイルパスまたはファイルの内容のどちらか一方を指定します
+type ImportRunFileCommand struct {
+	FilePath string `json:"file_path,omitempty"` // ローカルのファイルパス
+	Content  []byte `json:"content,omitempty"`   // ファイルの内容（base64デコード済み）
+	RunType  string `json:"run_type"`            // 省略時はEasy
+	Notes    string `json:"notes"`               // 省略時はトラック名
+}
+
+// UpdateAthleteProfileCommand はアスリートプロファイル更新コマンドDTO
+// 指定した項目のみ更新し、省略した項目は現在の値を維持します
+type UpdateAthleteProfileCommand struct {
+	MaxHeartRate              *int     `json:"max_hr,omitempty"`
+	RestingHeartRate          *int     `json:"resting_hr,omitempty"`
+	ThresholdHeartRate        *int     `json:"lthr,omitempty"`
+	VDOT                      *float64 `json:"vdot,omitempty"`
+	ThresholdPaceSecondsPerKm *float64 `json:"threshold_pace_seconds_per_km,omitempty"`
+}
+
+// SetRunningGoalCommand はランニング目標設定コマンドDTO
+type SetRunningGoalCommand struct {
+	EventType         string     `json:"event_type"`            // 5K / 10K / Half / Marathon / Custom
+	DistanceKm        float64    `json:"distance_km,omitempty"` // Customの場合のみ指定（その他は標準距離）
+	TargetTimeSeconds float64    `json:"target_time_seconds"`
+	EventDate         *time.Time `json:"event_date,omitempty"` // オプション: イベント日
+	Description       string     `json:"description"`
+}
+
+// UpdateRunningGoalCommand はランニング目標更新コマンドDTO
+// 指定した項目のみ更新し、省略した項目は現在の値を維持します
+type UpdateRunningGoalCommand struct {
+	GoalID      string     `json:"goal_id"`
+	Status      *string    `json:"status,omitempty"` // Active / Achieved / Paused / Cancelled
+	EventDate   *time.Time `json:"event_date,omitempty"`
+	Description *string    `json:"description,omitempty"`
+}
+
+// Validate はRecordRunningCommandの妥当性検証を行います
+func (cmd *RecordRunningCommand) Validate() error {
+	if cmd.Date.IsZero() {
+		return fmt.Errorf("date is required")
+	}
+	for i, lap := range cmd.Laps {
+		if err := lap.Validate(); err != nil {
+			return fmt.Errorf("lap[%d]: %w", i, err)
+		}
+	}
+	distanceKm, durationSeconds := cmd.Totals()
+	if distanceKm <= 0 {
+		return fmt.Errorf("distance must be positive")
+	}
+	if durationSeconds <= 0 {
+		return fmt.Errorf("duration must be positive")
+	}
+	if _, err := running.NewRunType(cmd.RunType); err != nil {
+		return err
+	}
+	if cmd.HeartRateBPM != nil {
+		if _, err := running.NewHeartRate(*cmd.HeartRateBPM); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToRunningSession はコマンドからランニングセッションを作成します
+func (cmd *RecordRunningCommand) ToRunningSession() (*running.RunningSession, error) {
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
+	distanceKm, durationSeconds := cmd.Totals()
+	distance, err := running.NewDistance(distanceKm)
+	if err != nil {
+		return nil, err
+	}
+	duration, err := running.NewDuration(time.Duration(durationSeconds * float64(time.Second)))
+	if err != nil {
+		return nil, err
+	}
+	runType, err := running.NewRunType(cmd.RunType)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := running.NewRunningSession(shared.NewSessionID(), cmd.Date, distance, duration, runType, cmd.Notes)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.HeartRateBPM != nil {
+		heartRate, err := running.NewHeartRate(*cmd.HeartRateBPM)
+		if err != nil {
+			return nil, err
+		}
+		session.SetHeartRate(heartRate)
+	}
+
+	for i, lapDTO := range cmd.Laps {
+		if err := lapDTO.addTo(session); err != nil {
+			return nil, fmt.Errorf("lap[%d]: %w", i, err)
+		}
+	}
+
+	return session, nil
+}
+
+// Totals はセッション全体の距離（km）と時間（秒）を返します
+// 距離・時間が省略された場合はラップの合計で補完します
+func (cmd *RecordRunningCommand) Totals() (distanceKm, durationSeconds float64) {
+	distanceKm, durationSeconds = cmd.DistanceKm, cmd.DurationSeconds
+	var lapDistanceKm, lapDurationSeconds float64
+	for _, lap := range cmd.Laps {
+		lapDistanceKm += lap.DistanceKm
+		lapDurationSeconds += lap.DurationSeconds
+	}
+	if distanceKm == 0 {
+		distanceKm = lapDistanceKm
+	}
+	if durationSeconds == 0 {
+		durationSeconds = lapDurationSeconds
+	}
+	return distanceKm, durationSeconds
+}
+
+// Validate はLapDTOの妥当性検証を行います
+func (dto *LapDTO) Validate() error {
+	if dto.DistanceKm <= 0 {
+		return fmt.Errorf("lap distance must be positive")
+	}
+	if dto.DurationSeconds <= 0 {
+		return fmt.Errorf("lap duration must be positive")
+	}
+	if dto.Type != "" {
+		if _, err := running.NewLapType(dto.Type); err != nil {
+			return err
+		}
+	}
+	if dto.HeartRateBPM != nil {
+		if _, err := running.NewHeartRate(*dto.HeartRateBPM); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addTo はラップをランニングセッションに追加します
+func (dto *LapDTO) addTo(session *running.RunningSession) error {
+	distance, err := running.NewDistance(dto.DistanceKm)
+	if err != nil {
+		return err
+	}
+	duration, err := running.NewDuration(time.Duration(dto.DurationSeconds * float64(time.Second)))
+	if err != nil {
+		return err
+	}
+	lapType := running.WorkLap
+	if dto.Type != "" {
+		if lapType, err = running.NewLapType(dto.Type); err != nil {
+			return err
+		}
+	}
+
+	lap, err := session.AddLap(distance, duration, lapType)
+	if err != nil {
+		return err
+	}
+	if dto.HeartRateBPM != nil {
+		heartRate, err := running.NewHeartRate(*dto.HeartRateBPM)
+		if err != nil {
+			return err
+		}
+		lap.SetHeartRate(heartRate)
+	}
+	return nil
+}
+
+// Validate はImportRunFileCommandの妥当性検証を行います
+func (cmd *ImportRunFileCommand) Validate() error {
+	if cmd.FilePath == "" && len(cmd.Content) == 0 {
+		return fmt.Errorf("file path or content is required")
+	}
+	if cmd.FilePath != "" && len(cmd.Content) > 0 {
+		return fmt.Errorf("specify either file path or content, not both")
+	}
+	if cmd.RunType != "" {
+		if _, err := running.NewRunType(cmd.RunType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParsedRunType はランニングタイプを返します（省略時はEasy）
+func (cmd *ImportRunFileCommand) ParsedRunType() (running.RunType, error) {
+	if cmd.RunType == "" {
+		return running.Easy, nil
+	}
+	return running.NewRunType(cmd.RunType)
+}
+
+// Validate はUpdateAthleteProfileCommandの妥当性検証を行います
+func (cmd *UpdateAthleteProfileCommand) Validate() error {
+	if cmd.MaxHeartRate == nil && cmd.RestingHeartRate == nil && cmd.ThresholdHeartRate == nil &&
+		cmd.VDOT == nil && cmd.ThresholdPaceSecondsPerKm == nil {
+		return fmt.Errorf("at least one profile field is required")
+	}
+	return nil
+}
+
+// ApplyTo はコマンドで指定された項目をアスリートプロファイルに反映します
+func (cmd *UpdateAthleteProfileCommand) ApplyTo(profile *running.AthleteProfile) error {
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	toHeartRate := func(bpm *int) (*running.HeartRate, error) {
+		if bpm == nil {
+			return nil, nil
+		}
+		heartRate, err := running.NewHeartRate(*bpm)
+		if err != nil {
+			return nil, err
+		}
+		return &heartRate, nil
+	}
+
+	maxHR, err := toHeartRate(cmd.MaxHeartRate)
+	if err != nil {
+		return fmt.Errorf("max_hr: %w", err)
+	}
+	restingHR, err := toHeartRate(cmd.RestingHeartRate)
+	if err != nil {
+		return fmt.Errorf("resting_hr: %w", err)
+	}
+	lthr, err := toHeartRate(cmd.ThresholdHeartRate)
+	if err != nil {
+		return fmt.Errorf("lthr: %w", err)
+	}
+	if err := profile.SetHeartRates(maxHR, restingHR, lthr); err != nil {
+		return err
+	}
+
+	if cmd.VDOT != nil {
+		if err := profile.SetVDOT(*cmd.VDOT); err != nil {
+			return err
+		}
+	}
+
+	if cmd.ThresholdPaceSecondsPerKm != nil {
+		pace, err := running.NewPace(*cmd.ThresholdPaceSecondsPerKm / 60)
+		if err != nil {
+			return fmt.Errorf("threshold pace: %w", err)
+		}
+		profile.SetThresholdPace(pace)
+	}
+
+	return nil
+}
+
+// Validate はSetRunningGoalCommandの妥当性検証を行います
+func (cmd *SetRunningGoalCommand) Validate() error {
+	eventType, err := running.NewEventType(cmd.EventType)
+	if err != nil {
+		return err
+	}
+	if eventType.Equals(running.Custom) {
+		if cmd.DistanceKm <= 0 {
+			return fmt.Errorf("distance is required for a custom goal")
+		}
+	} else if cmd.DistanceKm != 0 {
+		return fmt.Errorf("distance can only be specified for a custom goal")
+	}
+	if cmd.TargetTimeSeconds <= 0 {
+		return fmt.Errorf("target time must be positive")
+	}
+	return nil
+}
+
+// ToRunningGoal はコマンドからランニング目標を作成します
+func (cmd *SetRunningGoalCommand) ToRunningGoal() (*running.RunningGoal, error) {
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
+	eventType, err := running.NewEventType(cmd.EventType)
+	if err != nil {
+		return nil, err
+	}
+	targetTime, err := running.NewDuration(time.Duration(cmd.TargetTimeSeconds * float64(time.Second)))
+	if err != nil {
+		return nil, err
+	}
+
+	var goal *running.RunningGoal
+	if eventType.Equals(running.Custom) {
+		distance, err := running.NewDistance(cmd.DistanceKm)
+		if err != nil {
+			return nil, err
+		}
+		goal, err = running.NewCustomRunningGoal(shared.NewGoalID(), distance, targetTime, cmd.Description)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		goal, err = running.NewRunningGoal(shared.NewGoalID(), eventType, targetTime, cmd.Description)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cmd.EventDate != nil {
+		goal.SetEventDate(*cmd.EventDate)
+	}
+	return goal, nil
+}
+
+// Validate はUpdateRunningGoalCommandの妥当性検証を行います
+func (cmd *UpdateRunningGoalCommand) Validate() error {
+	if _, err := shared.NewGoalIDFromString(cmd.GoalID); err != nil {
+		return fmt.Errorf("invalid goal ID: %w", err)
+	}
+	if cmd.Status == nil && cmd.EventDate == nil && cmd.Description == nil {
+		return fmt.Errorf("at least one goal field is required")
+	}
+	if cmd.Status != nil {
+		if _, err := running.NewGoalStatus(*cmd.Status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyTo はコマンドで指定された項目をランニング目標に反映します
+// 再開（Active）できるのは一時停止中の目標のみです
+func (cmd *UpdateRunningGoalCommand) ApplyTo(goal *running.RunningGoal) error {
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+
+	if cmd.Status != nil {
+		status, err := running.NewGoalStatus(*cmd.Status)
+		if err != nil {
+			return err
+		}
+		switch {
+		case status.Equals(goal.Status()):
+		case status.Equals(running.Active):
+			if !goal.Status().Equals(running.Paused) {
+				return fmt.Errorf("only paused goals can be resumed: %s", goal.Status())
+			}
+			goal.Resume()
+		case status.Equals(running.Achieved):
+			goal.MarkAsAchieved()
+		case status.Equals(running.Paused):
+			goal.MarkAsPaused()
+		case status.Equals(running.Cancelled):
+			goal.MarkAsCancelled()
+		}
+	}
+	if cmd.EventDate != nil {
+		goal.SetEventDate(*cmd.EventDate)
+	}
+	if cmd.Description != nil {
+		goal.UpdateDescription(*cmd.Description)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"fitness-mcp-server/internal/domain/running"
+)
+
+// =============================================================================
+// ランニングマッパー - ドメインモデルとDTOの変換
+// =============================================================================
+
+// FromAthleteProfile はアスリートプロファイルを更新結果DTOに変換します
+func FromAthleteProfile(profile *running.AthleteProfile) *UpdateAthleteProfileResult {
+	result := &UpdateAthleteProfileResult{
+		VDOT: profile.VDOT(),
+	}
+	if profile.MaxHeartRate() != nil {
+		bpm := profile.MaxHeartRate().BPM()
+		result.MaxHeartRate = &bpm
+	}
+	if profile.RestingHeartRate() != nil {
+		bpm := profile.RestingHeartRate().BPM()
+		result.RestingHeartRate = &bpm
+	}
+	if profile.ThresholdHeartRate() != nil {
+		bpm := profile.ThresholdHeartRate().BPM()
+		result.ThresholdHeartRate = &bpm
+	}
+	if profile.ThresholdPace() != nil {
+		result.ThresholdPace = profile.ThresholdPace().String()
+	}
+	return result
+}
+
+// FromLaps はラップをDTOに変換します
+func FromLaps(laps []*running.Lap) []LapResultDTO {
+	result := make([]LapResultDTO, 0, len(laps))
+	for _, lap := range laps {
+		lapDTO := LapResultDTO{
+			Number:     lap.Number(),
+			Type:       lap.Type().String(),
+			DistanceKm: lap.Distance().Km(),
+			Duration:   lap.Duration().Clock(),
+			Pace:       lap.Pace().String(),
+		}
+		if lap.HeartRate() != nil {
+			bpm := lap.HeartRate().BPM()
+			lapDTO.HeartRateBPM = &bpm
+		}
+		result = append(result, lapDTO)
+	}
+	return result
+}
+
+// FromLapAnalysis はラップ分析結果をDTOに変換します（疾走区間が2本未満の場合はnil）
+func FromLapAnalysis(laps []*running.Lap) *LapAnalysisDTO {
+	consistency := running.AnalyzeIntervalConsistency(laps)
+	splits := running.CompareSplits(laps)
+	if consistency == nil || splits == nil {
+		return nil
+	}
+
+	return &LapAnalysisDTO{
+		WorkLaps:           consistency.WorkLaps(),
+		AveragePace:        consistency.AveragePace().String(),
+		FastestLap:         consistency.Fastest().Number(),
+		SlowestLap:         consistency.Slowest().Number(),
+		SpreadSecondsPerKm: consistency.SpreadSecondsPerKm(),
+		CVPercent:          consistency.CVPercent(),
+		Consistent:         consistency.IsConsistent(),
+		FirstHalfPace:      splits.FirstHalf().String(),
+		SecondHalfPace:     splits.SecondHalf().String(),
+		SplitDiffSeconds:   splits.DifferenceSecondsPerKm(),
+		NegativeSplit:      splits.IsNegativeSplit(),
+	}
+}
+
+// FromRunningGoal はランニング目標を設定・更新結果DTOに変換します
+func FromRunningGoal(goal *running.RunningGoal) *RunningGoalResult {
+	return &RunningGoalResult{
+		GoalID:         goal.ID().String(),
+		EventType:      goal.EventType().String(),
+		DistanceKm:     goal.Distance().Km(),
+		TargetTime:     goal.TargetTime().Clock(),
+		TargetPace:     goal.TargetPace().String(),
+		EventDate:      goal.EventDate(),
+		DaysUntilEvent: goal.DaysUntilEvent(),
+		Status:         goal.Status().String(),
+		Description:    goal.Description(),
+		AchievedAt:     goal.AchievedAt(),
+	}
+}
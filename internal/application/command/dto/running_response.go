@@ -0,0 +1,85 @@
+package dto
+
+import (
+	"time"
+)
+
+// =============================================================================
+// ランニングレスポンスDTO - ユースケース実行結果のデータ構造
+// =============================================================================
+
+// RecordRunningResult はランニングセッション記録結果DTO
+type RecordRunningResult struct {
+	SessionID     string          `json:"session_id,omitempty"` // プレビュー（未保存）の場合は空
+	Date          time.Time       `json:"date"`
+	DistanceKm    float64         `json:"distance_km"`
+	Duration      string          `json:"duration"`
+	Pace          string          `json:"pace"`
+	RunType       string          `json:"run_type"`
+	PaceZone      string          `json:"pace_zone,omitempty"`       // トレーニングペースが求められない場合は省略
+	HeartRateZone *int            `json:"heart_rate_zone,omitempty"` // 心拍数・心拍ゾーンがない場合は省略
+	Warnings      []string        `json:"warnings,omitempty"`        // ランニングタイプと強度が合わない場合の警告
+	Laps          []LapResultDTO  `json:"laps,omitempty"`
+	LapAnalysis   *LapAnalysisDTO `json:"lap_analysis,omitempty"` // 疾走区間が2本以上の場合のみ
+	Message       string          `json:"message"`
+}
+
+// ImportRunFileResult はGPX・TCXファイル取り込み結果DTO
+type ImportRunFileResult struct {
+	Format         string               `json:"format"` // GPX / TCX
+	PointCount     int                  `json:"point_count"`
+	ElapsedTime    string               `json:"elapsed_time"` // 開始から終了までの経過時間
+	MovingTime     string               `json:"moving_time"`  // 停止中を除いた移動時間（セッションの時間）
+	ElevationGainM float64              `json:"elevation_gain_m"`
+	Run            *RecordRunningResult `json:"run"`
+}
+
+// LapResultDTO はラップ表示用DTO
+type LapResultDTO struct {
+	Number       int     `json:"number"`
+	Type         string  `json:"type"` // Work / Rest
+	DistanceKm   float64 `json:"distance_km"`
+	Duration     string  `json:"duration"`
+	Pace         string  `json:"pace"`
+	HeartRateBPM *int    `json:"heart_rate_bpm,omitempty"`
+}
+
+// LapAnalysisDTO はラップ分析結果DTO
+type LapAnalysisDTO struct {
+	WorkLaps           int     `json:"work_laps"`
+	AveragePace        string  `json:"average_pace"`          // 疾走区間の平均ペース
+	FastestLap         int     `json:"fastest_lap"`           // 最も速い本のラップ番号
+	SlowestLap         int     `json:"slowest_lap"`           // 最も遅い本のラップ番号
+	SpreadSecondsPerKm float64 `json:"spread_seconds_per_km"` // 最速と最遅のペース差
+	CVPercent          float64 `json:"cv_percent"`            // ペースの変動係数（%）
+	Consistent         bool    `json:"consistent"`            // 変動係数が基準以下か
+	FirstHalfPace      string  `json:"first_half_pace"`
+	SecondHalfPace     string  `json:"second_half_pace"`
+	SplitDiffSeconds   float64 `json:"split_diff_seconds_per_km"` // 後半 - 前半（負の値はネガティブスプリット）
+	NegativeSplit      bool    `json:"negative_split"`
+}
+
+// UpdateAthleteProfileResult はアスリートプロファイル更新結果DTO
+type UpdateAthleteProfileResult struct {
+	MaxHeartRate       *int     `json:"max_hr,omitempty"`
+	RestingHeartRate   *int     `json:"resting_hr,omitempty"`
+	ThresholdHeartRate *int     `json:"lthr,omitempty"`
+	VDOT               *float64 `json:"vdot,omitempty"`
+	ThresholdPace      string   `json:"threshold_pace,omitempty"`
+	Message            string   `json:"message"`
+}
+
+// RunningGoalResult はランニング目標の設定・更新結果DTO
+type RunningGoalResult struct {
+	GoalID         string     `json:"goal_id"`
+	EventType      string     `json:"event_type"`
+	DistanceKm     float64    `json:"distance_km"`
+	TargetTime     string     `json:"target_time"` // H:MM:SS
+	TargetPace     string     `json:"target_pace"` // M:SS/km
+	EventDate      *time.Time `json:"event_date,omitempty"`
+	DaysUntilEvent *int       `json:"days_until_event,omitempty"`
+	Status         string     `json:"status"`
+	Description    string     `json:"description,omitempty"`
+	AchievedAt     *time.Time `json:"achieved_at,omitempty"`
+	Message        string     `json:"message"`
+}
@@ -3,6 +3,8 @@ package dto
 import (
 	"fmt"
 	"time"
+
+	"fitness-mcp-server/internal/domain/strength"
 )
 
 // =============================================================================
@@ -36,10 +38,17 @@ type ExerciseDTO struct {
 }
 
 // SetDTO はセットDTO
+// 回数・時間・距離のいずれか1つ以上を指定します
 type SetDTO struct {
-	WeightKg float64 `json:"weight_kg"`
-	Reps     int     `json:"reps"`
-	RPE      *int    `json:"rpe,omitempty"` // オプション
+	WeightKg        float64  `json:"weight_kg"`
+	Reps            int      `json:"reps,omitempty"`             // 回数ベースのセット
+	DurationSeconds *int     `json:"duration_seconds,omitempty"` // 時間ベースのセット（プランク等）
+	DistanceMeters  *float64 `json:"distance_m,omitempty"`       // 距離ベースのセット（キャリー等）
+	RPE             *float64 `json:"rpe,omitempty"`              // オプション: 0.5刻み（例: 8.5）
+	RIR             *float64 `json:"rir,omitempty"`              // オプション: 余力レップ数（RPE = 10 - RIR に換算）
+	Tempo           *string  `json:"tempo,omitempty"`            // オプション: テンポ（例: 3-1-1-0）
+	RangeOfMotion   *string  `json:"range_of_motion,omitempty"`  // オプション: 可動域（Full/Partial）
+	Variations      []string `json:"variations,omitempty"`       // オプション: バリエーションタグ（例: paused, pin）
 }
 
 // Validate はRecordTrainingCommandの妥当性検証を行います
@@ -103,14 +112,59 @@ func (dto *ExerciseDTO) Validate() error {
 
 // Validate はSetDTOの妥当性検証を行います
 func (dto *SetDTO) Validate() error {
-	if dto.WeightKg <= 0 {
-		return fmt.Errorf("weight must be positive")
+	if !dto.HasTimeOrDistance() {
+		// 回数のみのセットは従来通り重量と回数が必須
+		if dto.WeightKg <= 0 {
+			return fmt.Errorf("weight must be positive")
+		}
+		if dto.Reps <= 0 {
+			return fmt.Errorf("reps must be positive")
+		}
+	}
+	if dto.WeightKg < 0 {
+		return fmt.Errorf("weight cannot be negative")
+	}
+	if dto.Reps < 0 {
+		return fmt.Errorf("reps cannot be negative")
+	}
+	if dto.DurationSeconds != nil && *dto.DurationSeconds <= 0 {
+		return fmt.Errorf("duration must be positive")
+	}
+	if dto.DistanceMeters != nil && *dto.DistanceMeters <= 0 {
+		return fmt.Errorf("distance must be positive")
+	}
+	if dto.Tempo != nil {
+		if _, err := strength.ParseTempo(*dto.Tempo); err != nil {
+			return err
+		}
 	}
-	if dto.Reps <= 0 {
-		return fmt.Errorf("reps must be positive")
+	if dto.RangeOfMotion != nil {
+		if _, err := strength.NewRangeOfMotion(*dto.RangeOfMotion); err != nil {
+			return err
+		}
+	}
+	for _, tag := range dto.Variations {
+		if _, err := strength.NewVariation(tag); err != nil {
+			return err
+		}
 	}
-	if dto.RPE != nil && (*dto.RPE < 1 || *dto.RPE > 10) {
-		return fmt.Errorf("RPE must be between 1 and 10")
+	if dto.RPE != nil && dto.RIR != nil {
+		return fmt.Errorf("specify either RPE or RIR, not both")
+	}
+	if dto.RPE != nil {
+		if _, err := strength.NewRPEFromValue(*dto.RPE); err != nil {
+			return err
+		}
+	}
+	if dto.RIR != nil {
+		if _, err := strength.NewRPEFromRIR(*dto.RIR); err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+// HasTimeOrDistance は時間または距離が指定されたセットかを判定します
+func (dto *SetDTO) HasTimeOrDistance() bool {
+	return dto.DurationSeconds != nil || dto.DistanceMeters != nil
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+)
+
+// =============================================================================
+// 筋トレCSV取り込みコマンドDTO - 他アプリ（Strong / Hevy / FitNotes）のCSVエクスポートの取り込み
+// =============================================================================
+
+// ImportStrengthCSVCommand は筋トレCSV取り込みコマンドDTO
+// ファイルパスまたはファイルの内容のどちらか一方を指定します
+type ImportStrengthCSVCommand struct {
+	FilePath        string            `json:"file_path,omitempty"`        // ローカルのファイルパス
+	Content         []byte            `json:"content,omitempty"`          // ファイルの内容
+	Format          string            `json:"format,omitempty"`           // Strong / Hevy / FitNotes（省略時はヘッダーから判定）
+	WeightUnit      string            `json:"weight_unit,omitempty"`      // ファイルに単位がない場合の単位（kg / lbs、省略時は設定の単位）
+	ExerciseMapping map[string]string `json:"exercise_mapping,omitempty"` // アプリのエクササイズ名→このアプリのエクササイズ名
+	DryRun          bool              `json:"dry_run"`                    // trueの場合は保存せずに取り込み結果のみ返す
+}
+
+// Validate はImportStrengthCSVCommandの妥当性検証を行います
+func (cmd *ImportStrengthCSVCommand) Validate() error {
+	if cmd.FilePath == "" && len(cmd.Content) == 0 {
+		return fmt.Errorf("file path or content is required")
+	}
+	if cmd.FilePath != "" && len(cmd.Content) > 0 {
+		return fmt.Errorf("specify either file path or content, not both")
+	}
+	switch strings.ToLower(cmd.WeightUnit) {
+	case "", "kg", "lbs":
+	default:
+		return fmt.Errorf("weight unit must be kg or lbs: %s", cmd.WeightUnit)
+	}
+	return nil
+}
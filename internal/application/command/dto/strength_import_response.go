@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"time"
+)
+
+// =============================================================================
+// 筋トレCSV取り込みレスポンスDTO - 他アプリのCSVエクスポートの取り込み結果
+// =============================================================================
+
+// ImportStrengthCSVResult は筋トレCSV取り込み結果DTO
+type ImportStrengthCSVResult struct {
+	Format          string                        `json:"format"`      // Strong / Hevy / FitNotes
+	WeightUnit      string                        `json:"weight_unit"` // ファイルの重量の単位（kg / lbs）
+	UnitSource      string                        `json:"unit_source"` // 単位の判定方法
+	Rows            int                           `json:"rows"`        // データ行数
+	DryRun          bool                          `json:"dry_run"`     // trueの場合は保存していない
+	Created         []ImportedSessionDTO          `json:"created"`     // 作成した（ドライランの場合は作成する）セッション
+	Duplicates      []ImportedSessionDTO          `json:"duplicates"`  // 既存のセッションと同じ内容のため取り込まなかったセッション
+	Skipped         []SkippedSessionDTO           `json:"skipped"`     // 有効なセットがなく取り込まなかったセッション
+	SkippedRows     []SkippedRowDTO               `json:"skipped_rows,omitempty"`
+	ExerciseMapping map[string]string             `json:"exercise_mapping,omitempty"` // 名前を対応付けたエクササイズ
+	Records         *RebuildPersonalRecordsResult `json:"records,omitempty"`          // 取り込み後のPR履歴の再構築結果
+	Message         string                        `json:"message"`
+}
+
+// ImportedSessionDTO はCSVから取り込んだセッションの概要DTO
+type ImportedSessionDTO struct {
+	TrainingID  string    `json:"training_id,omitempty"`  // ドライランの場合は空
+	DuplicateOf string    `json:"duplicate_of,omitempty"` // 重複の場合は既存のセッションのID
+	Date        time.Time `json:"date"`
+	Notes       string    `json:"notes"`
+	Exercises   int       `json:"exercises"`
+	Sets        int       `json:"sets"`
+	TotalVolume float64   `json:"total_volume"` // 総負荷量（kg）
+}
+
+// SkippedSessionDTO は取り込まなかったセッションDTO
+type SkippedSessionDTO struct {
+	Date    time.Time `json:"date"`
+	Workout string    `json:"workout"`
+	Reason  string    `json:"reason"`
+}
+
+// SkippedRowDTO は取り込まなかったCSVの行DTO
+type SkippedRowDTO struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
@@ -97,23 +97,89 @@ func (dto *SetDTO) ToSet() (strength.Set, error) {
 		return strength.Set{}, fmt.Errorf("invalid weight: %w", err)
 	}
 
-	// レップ数を作成
-	reps, err := strength.NewReps(dto.Reps)
-	if err != nil {
-		return strength.Set{}, fmt.Errorf("invalid reps: %w", err)
+	// レップ数を作成（時間・距離ベースのセットではオプション）
+	var reps *strength.Reps
+	if dto.Reps > 0 {
+		repsValue, err := strength.NewReps(dto.Reps)
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("invalid reps: %w", err)
+		}
+		reps = &repsValue
+	}
+
+	// 実施時間を作成（オプション）
+	var duration *strength.Duration
+	if dto.DurationSeconds != nil {
+		durationValue, err := strength.NewDuration(*dto.DurationSeconds)
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("invalid duration: %w", err)
+		}
+		duration = &durationValue
+	}
+
+	// 移動距離を作成（オプション）
+	var distance *strength.Distance
+	if dto.DistanceMeters != nil {
+		distanceValue, err := strength.NewDistance(*dto.DistanceMeters)
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("invalid distance: %w", err)
+		}
+		distance = &distanceValue
 	}
 
-	// RPEを作成（オプション）
+	// RPEを作成（オプション、RIR指定時はRPEに換算）
 	var rpe *strength.RPE
 	if dto.RPE != nil {
-		rpeValue, err := strength.NewRPE(*dto.RPE)
+		rpeValue, err := strength.NewRPEFromValue(*dto.RPE)
 		if err != nil {
 			return strength.Set{}, fmt.Errorf("invalid RPE: %w", err)
 		}
 		rpe = &rpeValue
+	} else if dto.RIR != nil {
+		rpeValue, err := strength.NewRPEFromRIR(*dto.RIR)
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("invalid RIR: %w", err)
+		}
+		rpe = &rpeValue
+	}
+
+	set, err := strength.NewMeasuredSet(weight, reps, duration, distance, rpe)
+	if err != nil {
+		return strength.Set{}, err
+	}
+
+	// テンポを設定（オプション）
+	if dto.Tempo != nil {
+		tempo, err := strength.ParseTempo(*dto.Tempo)
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("invalid tempo: %w", err)
+		}
+		set = set.WithTempo(tempo)
 	}
 
-	return strength.NewSet(weight, reps, rpe), nil
+	// 可動域を設定（オプション）
+	if dto.RangeOfMotion != nil {
+		rom, err := strength.NewRangeOfMotion(*dto.RangeOfMotion)
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("invalid range of motion: %w", err)
+		}
+		set = set.WithRangeOfMotion(rom)
+	}
+
+	// バリエーションタグを設定（オプション）
+	if len(dto.Variations) > 0 {
+		variations := make([]strength.Variation, 0, len(dto.Variations))
+		for _, tag := range dto.Variations {
+			variation, err := strength.NewVariation(tag)
+			if err != nil {
+				return strength.Set{}, fmt.Errorf("invalid variation: %w", err)
+			}
+			variations = append(variations, variation)
+		}
+		set = set.WithVariations(variations...)
+	}
+
+	return set, nil
 }
 
 // FromStrengthTraining はStrengthTrainingエンティティからTrainingSessionDTOを生成します
@@ -152,15 +218,68 @@ func FromExercise(exercise *strength.Exercise) *ExerciseDTO {
 
 // FromSet はSetからSetDTOを生成します
 func FromSet(set strength.Set) *SetDTO {
-	var rpe *int
+	var rpe *float64
 	if set.RPE() != nil {
-		rpeValue := set.RPE().Rating()
+		rpeValue := set.RPE().Value()
 		rpe = &rpeValue
 	}
 
+	var durationSeconds *int
+	if set.Duration() != nil {
+		seconds := set.Duration().Seconds()
+		durationSeconds = &seconds
+	}
+
+	var distanceMeters *float64
+	if set.Distance() != nil {
+		meters := set.Distance().Meters()
+		distanceMeters = &meters
+	}
+
+	var tempo *string
+	if set.Tempo() != nil {
+		tempoValue := set.Tempo().String()
+		tempo = &tempoValue
+	}
+
+	var rangeOfMotion *string
+	if set.RangeOfMotion() != nil {
+		rom := set.RangeOfMotion().Value()
+		rangeOfMotion = &rom
+	}
+
+	var variations []string
+	for _, variation := range set.Variations() {
+		variations = append(variations, variation.Tag())
+	}
+
 	return &SetDTO{
-		WeightKg: set.Weight().Kg(),
-		Reps:     set.Reps().Count(),
-		RPE:      rpe,
+		WeightKg:        set.Weight().Kg(),
+		Reps:            set.Reps().Count(),
+		DurationSeconds: durationSeconds,
+		DistanceMeters:  distanceMeters,
+		RPE:             rpe,
+		Tempo:           tempo,
+		RangeOfMotion:   rangeOfMotion,
+		Variations:      variations,
+	}
+}
+
+// FromPersonalRecordEvent はドメインのPRイベントをDTOに変換します
+func FromPersonalRecordEvent(event strength.PersonalRecordEvent) PersonalRecordEventDTO {
+	result := PersonalRecordEventDTO{
+		ExerciseName:  event.ExerciseName().String(),
+		RecordType:    event.RecordType().String(),
+		Label:         event.Label(),
+		Reps:          event.Reps(),
+		Value:         event.Value(),
+		PreviousValue: event.PreviousValue(),
+	}
+	if set := event.Set(); set != nil {
+		weight := set.Weight().Kg()
+		reps := set.Reps().Count()
+		result.SetWeightKg = &weight
+		result.SetReps = &reps
 	}
+	return result
 }
@@ -10,9 +10,28 @@ import (
 
 // RecordTrainingResult は筋トレセッション記録結果DTO
 type RecordTrainingResult struct {
-	TrainingID string    `json:"training_id"`
-	Date       time.Time `json:"date"`
-	Message    string    `json:"message"`
+	TrainingID string                   `json:"training_id,omitempty"` // プレビュー（未保存）の場合は空
+	Date       time.Time                `json:"date"`
+	Message    string                   `json:"message"`
+	NewRecords []PersonalRecordEventDTO `json:"new_records,omitempty"` // このセッションで更新したPR
+}
+
+// PersonalRecordEventDTO はPR更新イベントDTO
+type PersonalRecordEventDTO struct {
+	ExerciseName  string   `json:"exercise_name"`
+	RecordType    string   `json:"record_type"` // RepMax / E1RM / SessionVolume
+	Label         string   `json:"label"`       // 例: 5RM、推定1RM
+	Reps          int      `json:"reps,omitempty"`
+	Value         float64  `json:"value"`
+	PreviousValue *float64 `json:"previous_value,omitempty"` // 初記録の場合は省略
+	SetWeightKg   *float64 `json:"set_weight_kg,omitempty"`
+	SetReps       *int     `json:"set_reps,omitempty"`
+}
+
+// RebuildPersonalRecordsResult はPR履歴再構築結果DTO
+type RebuildPersonalRecordsResult struct {
+	EventCount int    `json:"event_count"`
+	Message    string `json:"message"`
 }
 
 // UpdateTrainingResult は筋トレセッション更新結果DTO
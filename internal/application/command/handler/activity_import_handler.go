@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/application/command/usecase"
+)
+
+// =============================================================================
+// アクティビティ取り込みコマンドハンドラー - ビジネスロジックの実行
+// =============================================================================
+
+// ActivityImportCommandHandler はデバイスのアクティビティファイル取り込みに関するコマンドを処理するハンドラー
+type ActivityImportCommandHandler struct {
+	usecase usecase.ActivityImportUsecase
+}
+
+// NewActivityImportCommandHandler は新しいActivityImportCommandHandlerを作成します
+func NewActivityImportCommandHandler(usecase usecase.ActivityImportUsecase) *ActivityImportCommandHandler {
+	return &ActivityImportCommandHandler{
+		usecase: usecase,
+	}
+}
+
+// ImportFitFile はFITファイルからランまたは筋トレを取り込みます
+func (h *ActivityImportCommandHandler) ImportFitFile(ctx context.Context, cmd dto.ImportFitFileCommand) (*dto.ImportFitFileResult, error) {
+	return h.usecase.ImportFitFile(ctx, cmd)
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/application/command/usecase"
+)
+
+// =============================================================================
+// 身体測定コマンドハンドラー - ビジネスロジックの実行
+// =============================================================================
+
+// BodyMetricCommandHandler は身体測定値に関するコマンドを処理するハンドラー
+type BodyMetricCommandHandler struct {
+	usecase usecase.BodyMetricUsecase
+}
+
+// NewBodyMetricCommandHandler は新しいBodyMetricCommandHandlerを作成します
+func NewBodyMetricCommandHandler(usecase usecase.BodyMetricUsecase) *BodyMetricCommandHandler {
+	return &BodyMetricCommandHandler{
+		usecase: usecase,
+	}
+}
+
+// RecordBodyMetric は体重・体脂肪率を記録します
+func (h *BodyMetricCommandHandler) RecordBodyMetric(ctx context.Context, cmd dto.RecordBodyMetricCommand) (*dto.RecordBodyMetricResult, error) {
+	return h.usecase.RecordBodyMetric(ctx, cmd)
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/application/command/usecase"
+)
+
+// =============================================================================
+// データ取り込みコマンドハンドラー - ビジネスロジックの実行
+// =============================================================================
+
+// DataImportCommandHandler はエクスポートしたデータの取り込みに関するコマンドを処理するハンドラー
+type DataImportCommandHandler struct {
+	usecase usecase.DataImportUsecase
+}
+
+// NewDataImportCommandHandler は新しいDataImportCommandHandlerを作成します
+func NewDataImportCommandHandler(usecase usecase.DataImportUsecase) *DataImportCommandHandler {
+	return &DataImportCommandHandler{
+		usecase: usecase,
+	}
+}
+
+// ImportData はexport_dataでエクスポートしたJSONから筋トレ・ラン・アスリートプロファイルを取り込みます
+func (h *DataImportCommandHandler) ImportData(ctx context.Context, cmd dto.ImportDataCommand) (*dto.ImportDataResult, error) {
+	return h.usecase.ImportData(ctx, cmd)
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/application/command/usecase"
+)
+
+// =============================================================================
+// ランニングコマンドハンドラー - ビジネスロジックの実行
+// =============================================================================
+
+// RunningCommandHandler はランニングに関するコマンドを処理するハンドラー
+type RunningCommandHandler struct {
+	usecase usecase.RunningUsecase
+}
+
+// NewRunningCommandHandler は新しいRunningCommandHandlerを作成します
+func NewRunningCommandHandler(usecase usecase.RunningUsecase) *RunningCommandHandler {
+	return &RunningCommandHandler{
+		usecase: usecase,
+	}
+}
+
+// RecordRunning はランニングセッションを記録します
+func (h *RunningCommandHandler) RecordRunning(ctx context.Context, cmd dto.RecordRunningCommand) (*dto.RecordRunningResult, error) {
+	return h.usecase.RecordRunning(ctx, cmd)
+}
+
+// ImportRunFile はGPX・TCXファイルからランニングセッションを取り込みます
+func (h *RunningCommandHandler) ImportRunFile(ctx context.Context, cmd dto.ImportRunFileCommand) (*dto.ImportRunFileResult, error) {
+	return h.usecase.ImportRunFile(ctx, cmd)
+}
+
+// UpdateAthleteProfile はアスリートプロファイルを更新します
+func (h *RunningCommandHandler) UpdateAthleteProfile(ctx context.Context, cmd dto.UpdateAthleteProfileCommand) (*dto.UpdateAthleteProfileResult, error) {
+	return h.usecase.UpdateAthleteProfile(ctx, cmd)
+}
+
+// SetRunningGoal はランニング目標を設定します
+func (h *RunningCommandHandler) SetRunningGoal(ctx context.Context, cmd dto.SetRunningGoalCommand) (*dto.RunningGoalResult, error) {
+	return h.usecase.SetRunningGoal(ctx, cmd)
+}
+
+// UpdateRunningGoal はランニング目標の状態・イベント日・説明を更新します
+func (h *RunningCommandHandler) UpdateRunningGoal(ctx context.Context, cmd dto.UpdateRunningGoalCommand) (*dto.RunningGoalResult, error) {
+	return h.usecase.UpdateRunningGoal(ctx, cmd)
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"context"
+
 	"fitness-mcp-server/internal/application/command/dto"
 	"fitness-mcp-server/internal/application/command/usecase"
 )
@@ -22,16 +24,26 @@ func NewStrengthCommandHandler(usecase usecase.StrengthTrainingUsecase) *Strengt
 }
 
 // RecordTraining は筋トレセッションを記録します
-func (h *StrengthCommandHandler) RecordTraining(cmd dto.RecordTrainingCommand) (*dto.RecordTrainingResult, error) {
-	return h.usecase.RecordTraining(cmd)
+func (h *StrengthCommandHandler) RecordTraining(ctx context.Context, cmd dto.RecordTrainingCommand) (*dto.RecordTrainingResult, error) {
+	return h.usecase.RecordTraining(ctx, cmd)
 }
 
 // UpdateTraining は筋トレセッションを更新します
-func (h *StrengthCommandHandler) UpdateTraining(cmd dto.UpdateTrainingCommand) (*dto.UpdateTrainingResult, error) {
-	return h.usecase.UpdateTraining(cmd)
+func (h *StrengthCommandHandler) UpdateTraining(ctx context.Context, cmd dto.UpdateTrainingCommand) (*dto.UpdateTrainingResult, error) {
+	return h.usecase.UpdateTraining(ctx, cmd)
 }
 
 // DeleteTraining は筋トレセッションを削除します
-func (h *StrengthCommandHandler) DeleteTraining(cmd dto.DeleteTrainingCommand) (*dto.DeleteTrainingResult, error) {
-	return h.usecase.DeleteTraining(cmd)
+func (h *StrengthCommandHandler) DeleteTraining(ctx context.Context, cmd dto.DeleteTrainingCommand) (*dto.DeleteTrainingResult, error) {
+	return h.usecase.DeleteTraining(ctx, cmd)
+}
+
+// RebuildPersonalRecords はトレーニング履歴からPR履歴を再構築します
+func (h *StrengthCommandHandler) RebuildPersonalRecords(ctx context.Context) (*dto.RebuildPersonalRecordsResult, error) {
+	return h.usecase.RebuildPersonalRecords(ctx)
+}
+
+// InitializePersonalRecords はPR履歴が未構築の場合に構築します
+func (h *StrengthCommandHandler) InitializePersonalRecords(ctx context.Context) error {
+	return h.usecase.InitializePersonalRecords(ctx)
 }
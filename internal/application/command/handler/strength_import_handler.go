@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/application/command/usecase"
+)
+
+// =============================================================================
+// 筋トレ取り込みコマンドハンドラー - ビジネスロジックの実行
+// =============================================================================
+
+// StrengthImportCommandHandler は他アプリの筋トレ記録の取り込みに関するコマンドを処理するハンドラー
+type StrengthImportCommandHandler struct {
+	usecase usecase.StrengthImportUsecase
+}
+
+// NewStrengthImportCommandHandler は新しいStrengthImportCommandHandlerを作成します
+func NewStrengthImportCommandHandler(usecase usecase.StrengthImportUsecase) *StrengthImportCommandHandler {
+	return &StrengthImportCommandHandler{
+		usecase: usecase,
+	}
+}
+
+// ImportStrengthCSV はStrong・Hevy・FitNotesのCSVエクスポートから筋トレを取り込みます
+func (h *StrengthImportCommandHandler) ImportStrengthCSV(ctx context.Context, cmd dto.ImportStrengthCSVCommand) (*dto.ImportStrengthCSVResult, error) {
+	return h.usecase.ImportStrengthCSV(ctx, cmd)
+}
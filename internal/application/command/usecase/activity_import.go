@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/command/dto"
+)
+
+// ActivityImportUsecase はデバイスのアクティビティファイル取り込みのユースケースインターフェース
+type ActivityImportUsecase interface {
+	ImportFitFile(ctx context.Context, cmd dto.ImportFitFileCommand) (*dto.ImportFitFileResult, error)
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/interface/importer"
+)
+
+type ActivityImportUsecaseImpl struct {
+	fileParser importer.ActivityFileImporter // FITファイルの解析に使用
+	runs       RunningUsecase                // ランの記録（強度判定・ラップ分析を含む）に使用
+	trainings  StrengthTrainingUsecase       // 筋トレの記録（PR検出を含む）に使用
+}
+
+func NewActivityImportUsecase(
+	fileParser importer.ActivityFileImporter,
+	runs RunningUsecase,
+	trainings StrengthTrainingUsecase,
+) *ActivityImportUsecaseImpl {
+	return &ActivityImportUsecaseImpl{
+		fileParser: fileParser,
+		runs:       runs,
+		trainings:  trainings,
+	}
+}
+
+func (u *ActivityImportUsecaseImpl) ImportFitFile(ctx context.Context, cmd dto.ImportFitFileCommand) (*dto.ImportFitFileResult, error) {
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	runType, err := cmd.ParsedRunType()
+	if err != nil {
+		return nil, err
+	}
+
+	var activity *importer.ImportedActivity
+	if cmd.FilePath != "" {
+		slog.InfoContext(ctx, "importing FIT file", "path", cmd.FilePath, "preview", cmd.Preview)
+		activity, err = u.fileParser.ParseFile(cmd.FilePath, runType, cmd.Notes)
+	} else {
+		slog.InfoContext(ctx, "importing FIT file content", "bytes", len(cmd.Content), "preview", cmd.Preview)
+		activity, err = u.fileParser.Parse(cmd.Content, runType, cmd.Notes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse FIT file: %w", err)
+	}
+
+	result := &dto.ImportFitFileResult{
+		Sport:    activity.Sport,
+		Preview:  cmd.Preview,
+		Warnings: activity.Warnings,
+	}
+
+	switch {
+	case activity.Run != nil:
+		imported := activity.Run
+		run, err := u.runs.RecordImportedRun(ctx, imported.Session, cmd.Preview)
+		if err != nil {
+			return nil, err
+		}
+		result.Run = &dto.ImportRunFileResult{
+			Format:         imported.Format,
+			PointCount:     imported.PointCount,
+			ElapsedTime:    formatClock(imported.ElapsedTime),
+			MovingTime:     formatClock(imported.MovingTime),
+			ElevationGainM: imported.ElevationGainM,
+			Run:            run,
+		}
+	case activity.Strength != nil:
+		imported := activity.Strength
+		training, err := u.trainings.RecordImportedTraining(ctx, imported.Training, cmd.Preview)
+		if err != nil {
+			return nil, err
+		}
+		result.Strength = &dto.ImportStrengthResult{
+			ElapsedTime: formatClock(imported.ElapsedTime),
+			RestSets:    imported.RestSets,
+			TotalVolume: imported.Training.TotalVolume(),
+			Session:     dto.FromStrengthTraining(imported.Training),
+			Result:      training,
+		}
+	default:
+		return nil, fmt.Errorf("FIT file contains neither a run nor a strength training")
+	}
+
+	return result, nil
+}
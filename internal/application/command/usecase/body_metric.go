@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/command/dto"
+)
+
+// BodyMetricUsecase は身体測定値の記録のユースケースインターフェース
+type BodyMetricUsecase interface {
+	RecordBodyMetric(ctx context.Context, cmd dto.RecordBodyMetricCommand) (*dto.RecordBodyMetricResult, error)
+}
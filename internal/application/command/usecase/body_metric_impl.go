@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/interface/repository"
+)
+
+type BodyMetricUsecaseImpl struct {
+	repo repository.BodyMetricRepository
+}
+
+func NewBodyMetricUsecase(repo repository.BodyMetricRepository) *BodyMetricUsecaseImpl {
+	return &BodyMetricUsecaseImpl{repo: repo}
+}
+
+func (u *BodyMetricUsecaseImpl) RecordBodyMetric(ctx context.Context, cmd dto.RecordBodyMetricCommand) (*dto.RecordBodyMetricResult, error) {
+	slog.DebugContext(ctx, "recording body metric", "date", cmd.Date.Format("2006-01-02"))
+
+	metric, err := cmd.ToMetric()
+	if err != nil {
+		return nil, fmt.Errorf("invalid body metric: %w", err)
+	}
+
+	if err := u.repo.Save(ctx, metric); err != nil {
+		return nil, fmt.Errorf("failed to save body metric: %w", err)
+	}
+
+	result := dto.FromBodyMetric(metric)
+	result.Message = "身体測定値を記録しました"
+	return result, nil
+}
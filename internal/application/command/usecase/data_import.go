@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/command/dto"
+)
+
+// DataImportUsecase はエクスポートしたデータの取り込みのユースケースインターフェース
+type DataImportUsecase interface {
+	ImportData(ctx context.Context, cmd dto.ImportDataCommand) (*dto.ImportDataResult, error)
+}
@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/application/exchange"
+	"fitness-mcp-server/internal/domain/body"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/strength"
+	"fitness-mcp-server/internal/interface/query"
+	"fitness-mcp-server/internal/interface/repository"
+)
+
+type DataImportUsecaseImpl struct {
+	strengthRepo    repository.StrengthTrainingRepository
+	runningRepo     repository.RunningRepository
+	profileRepo     repository.AthleteProfileRepository
+	goalRepo        repository.RunningGoalRepository
+	bodyRepo        repository.BodyMetricRepository
+	strengthHistory query.StrengthQueryService   // 記録済みのセッションの判定に使用
+	runningHistory  query.RunningQueryService    // 記録済みのセッション・プロファイル・目標の判定に使用
+	bodyHistory     query.BodyMetricQueryService // 記録済みの身体測定値の判定に使用
+	trainings       StrengthTrainingUsecase      // 取り込み後のPR履歴の再構築に使用
+}
+
+func NewDataImportUsecase(
+	strengthRepo repository.StrengthTrainingRepository,
+	runningRepo repository.RunningRepository,
+	profileRepo repository.AthleteProfileRepository,
+	goalRepo repository.RunningGoalRepository,
+	bodyRepo repository.BodyMetricRepository,
+	strengthHistory query.StrengthQueryService,
+	runningHistory query.RunningQueryService,
+	bodyHistory query.BodyMetricQueryService,
+	trainings StrengthTrainingUsecase,
+) *DataImportUsecaseImpl {
+	return &DataImportUsecaseImpl{
+		strengthRepo:    strengthRepo,
+		runningRepo:     runningRepo,
+		profileRepo:     profileRepo,
+		goalRepo:        goalRepo,
+		bodyRepo:        bodyRepo,
+		strengthHistory: strengthHistory,
+		runningHistory:  runningHistory,
+		bodyHistory:     bodyHistory,
+		trainings:       trainings,
+	}
+}
+
+func (u *DataImportUsecaseImpl) ImportData(ctx context.Context, cmd dto.ImportDataCommand) (*dto.ImportDataResult, error) {
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
+	data := cmd.Content
+	if cmd.FilePath != "" {
+		slog.InfoContext(ctx, "importing export file", "path", cmd.FilePath, "dry_run", cmd.DryRun)
+		content, err := os.ReadFile(cmd.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read export file: %w", err)
+		}
+		data = content
+	} else {
+		slog.InfoContext(ctx, "importing export content", "bytes", len(data), "dry_run", cmd.DryRun)
+	}
+
+	document, err := exchange.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1件でも不正なデータがあれば何も保存しないよう、先にすべて変換する
+	trainings := make([]*strength.StrengthTraining, 0, len(document.StrengthTrainings))
+	for i, t := range document.StrengthTrainings {
+		training, err := t.ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("strength_trainings[%d]: %w", i, err)
+		}
+		trainings = append(trainings, training)
+	}
+	sessions := make([]*running.RunningSession, 0, len(document.Runs))
+	for i, r := range document.Runs {
+		session, err := r.ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("runs[%d]: %w", i, err)
+		}
+		sessions = append(sessions, session)
+	}
+	goals := make([]*running.RunningGoal, 0, len(document.Goals))
+	for i, g := range document.Goals {
+		goal, err := g.ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("goals[%d]: %w", i, err)
+		}
+		goals = append(goals, goal)
+	}
+	metrics := make([]*body.Metric, 0, len(document.BodyMetrics))
+	for i, m := range document.BodyMetrics {
+		metric, err := m.ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("body_metrics[%d]: %w", i, err)
+		}
+		metrics = append(metrics, metric)
+	}
+	var profile *running.AthleteProfile
+	if document.AthleteProfile != nil {
+		profile, err = document.AthleteProfile.ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("athlete_profile: %w", err)
+		}
+	}
+
+	result := &dto.ImportDataResult{
+		SchemaVersion: document.SchemaVersion,
+		ExportedAt:    document.ExportedAt,
+		DryRun:        cmd.DryRun,
+	}
+
+	// 同じIDのセッション・目標・身体測定値は記録済みとして取り込まない（同じファイルを再度取り込んでも重複しない）
+	newTrainings := make([]*strength.StrengthTraining, 0, len(trainings))
+	for _, training := range trainings {
+		exists, err := u.strengthHistory.ExistsById(ctx, training.ID())
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			result.StrengthExisting++
+			continue
+		}
+		newTrainings = append(newTrainings, training)
+	}
+	newSessions := make([]*running.RunningSession, 0, len(sessions))
+	for _, session := range sessions {
+		exists, err := u.runningHistory.ExistsByID(ctx, session.ID())
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			result.RunsExisting++
+			continue
+		}
+		newSessions = append(newSessions, session)
+	}
+	newGoals := make([]*running.RunningGoal, 0, len(goals))
+	for _, goal := range goals {
+		current, err := u.runningHistory.FindGoalByID(ctx, goal.ID())
+		if err != nil {
+			return nil, err
+		}
+		if current != nil {
+			result.GoalsExisting++
+			continue
+		}
+		newGoals = append(newGoals, goal)
+	}
+	newMetrics := make([]*body.Metric, 0, len(metrics))
+	for _, metric := range metrics {
+		exists, err := u.bodyHistory.ExistsByID(ctx, metric.ID())
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			result.BodyMetricsExisting++
+			continue
+		}
+		newMetrics = append(newMetrics, metric)
+	}
+	result.StrengthCreated = len(newTrainings)
+	result.RunsCreated = len(newSessions)
+	result.GoalsCreated = len(newGoals)
+	result.BodyMetricsCreated = len(newMetrics)
+
+	// 登録済みのプロファイルは上書きしない
+	if profile != nil {
+		current, err := u.runningHistory.FindAthleteProfile(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get athlete profile: %w", err)
+		}
+		if current != nil {
+			profile = nil
+			result.ProfileSkipReason = "アスリートプロファイルは登録済みのため上書きしません"
+		}
+	}
+	result.ProfileImported = profile != nil
+
+	if cmd.DryRun {
+		result.Message = fmt.Sprintf("筋トレ%d件・ラン%d件・目標%d件・身体測定%d件を取り込めます（未保存）",
+			result.StrengthCreated, result.RunsCreated, result.GoalsCreated, result.BodyMetricsCreated)
+		return result, nil
+	}
+
+	if len(newTrainings) > 0 {
+		if err := u.strengthRepo.SaveAll(ctx, newTrainings); err != nil {
+			return nil, fmt.Errorf("failed to save imported trainings: %w", err)
+		}
+	}
+	if len(newSessions) > 0 {
+		if err := u.runningRepo.SaveAll(ctx, newSessions); err != nil {
+			return nil, fmt.Errorf("failed to save imported running sessions: %w", err)
+		}
+	}
+	if len(newGoals) > 0 {
+		if err := u.goalRepo.SaveAll(ctx, newGoals); err != nil {
+			return nil, fmt.Errorf("failed to save imported running goals: %w", err)
+		}
+	}
+	if len(newMetrics) > 0 {
+		if err := u.bodyRepo.SaveAll(ctx, newMetrics); err != nil {
+			return nil, fmt.Errorf("failed to save imported body metrics: %w", err)
+		}
+	}
+	if profile != nil {
+		if err := u.profileRepo.Save(ctx, profile); err != nil {
+			return nil, fmt.Errorf("failed to save imported athlete profile: %w", err)
+		}
+	}
+	slog.InfoContext(ctx, "imported export data",
+		"trainings", len(newTrainings), "runs", len(newSessions), "goals", len(newGoals), "body_metrics", len(newMetrics))
+
+	if len(newTrainings) > 0 {
+		// 過去の日付のセッションを取り込むため、PR履歴は全履歴から再構築する
+		records, err := u.trainings.RebuildPersonalRecords(ctx)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to rebuild personal records", "error", err)
+		}
+		result.Records = records
+	}
+
+	result.Message = fmt.Sprintf("筋トレ%d件・ラン%d件・目標%d件・身体測定%d件を取り込みました",
+		result.StrengthCreated, result.RunsCreated, result.GoalsCreated, result.BodyMetricsCreated)
+	return result, nil
+}
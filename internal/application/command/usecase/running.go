@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/domain/running"
+)
+
+// RunningUsecase はランニング記録のユースケースインターフェース
+type RunningUsecase interface {
+	RecordRunning(ctx context.Context, cmd dto.RecordRunningCommand) (*dto.RecordRunningResult, error)
+	ImportRunFile(ctx context.Context, cmd dto.ImportRunFileCommand) (*dto.ImportRunFileResult, error)
+	RecordImportedRun(ctx context.Context, session *running.RunningSession, preview bool) (*dto.RecordRunningResult, error)
+	UpdateAthleteProfile(ctx context.Context, cmd dto.UpdateAthleteProfileCommand) (*dto.UpdateAthleteProfileResult, error)
+	SetRunningGoal(ctx context.Context, cmd dto.SetRunningGoalCommand) (*dto.RunningGoalResult, error)
+	UpdateRunningGoal(ctx context.Context, cmd dto.UpdateRunningGoalCommand) (*dto.RunningGoalResult, error)
+}
@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/importer"
+	"fitness-mcp-server/internal/interface/query"
+	"fitness-mcp-server/internal/interface/repository"
+)
+
+// zoneBasisDays はプロファイルにVDOT・閾値ペースがない場合に参照する直近の期間（日数）です
+const zoneBasisDays = 90
+
+type RunningUsecaseImpl struct {
+	runningRepo    repository.RunningRepository
+	profileRepo    repository.AthleteProfileRepository
+	goalRepo       repository.RunningGoalRepository
+	history        query.RunningQueryService // プロファイル・直近のセッション・目標の参照に使用
+	fileParser     importer.RunFileImporter  // GPX・TCXファイルの解析に使用
+	defaultProfile *running.AthleteProfile   // プロファイルが未登録の場合に強度判定に使用する設定の基準値（nilの場合はなし）
+}
+
+func NewRunningUsecase(
+	runningRepo repository.RunningRepository,
+	profileRepo repository.AthleteProfileRepository,
+	goalRepo repository.RunningGoalRepository,
+	history query.RunningQueryService,
+	fileParser importer.RunFileImporter,
+	defaultProfile *running.AthleteProfile,
+) *RunningUsecaseImpl {
+	return &RunningUsecaseImpl{
+		runningRepo:    runningRepo,
+		profileRepo:    profileRepo,
+		goalRepo:       goalRepo,
+		history:        history,
+		fileParser:     fileParser,
+		defaultProfile: defaultProfile,
+	}
+}
+
+func (u *RunningUsecaseImpl) RecordRunning(ctx context.Context, cmd dto.RecordRunningCommand) (*dto.RecordRunningResult, error) {
+	slog.DebugContext(ctx, "recording running session", "date", cmd.Date.Format("2006-01-02"))
+
+	session, err := cmd.ToRunningSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create running session entity: %w", err)
+	}
+
+	return u.saveAndClassify(ctx, session)
+}
+
+func (u *RunningUsecaseImpl) ImportRunFile(ctx context.Context, cmd dto.ImportRunFileCommand) (*dto.ImportRunFileResult, error) {
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	runType, err := cmd.ParsedRunType()
+	if err != nil {
+		return nil, err
+	}
+
+	var imported *importer.ImportedRun
+	if cmd.FilePath != "" {
+		slog.InfoContext(ctx, "importing run file", "path", cmd.FilePath)
+		imported, err = u.fileParser.ParseFile(cmd.FilePath, runType, cmd.Notes)
+	} else {
+		slog.InfoContext(ctx, "importing run file content", "bytes", len(cmd.Content))
+		imported, err = u.fileParser.Parse(cmd.Content, runType, cmd.Notes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse run file: %w", err)
+	}
+
+	result, err := u.saveAndClassify(ctx, imported.Session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ImportRunFileResult{
+		Format:         imported.Format,
+		PointCount:     imported.PointCount,
+		ElapsedTime:    formatClock(imported.ElapsedTime),
+		MovingTime:     formatClock(imported.MovingTime),
+		ElevationGainM: imported.ElevationGainM,
+		Run:            result,
+	}, nil
+}
+
+// formatClock は時間を時計表記で返します
+func formatClock(d time.Duration) string {
+	duration, err := running.NewDuration(d)
+	if err != nil {
+		return d.String()
+	}
+	return duration.Clock()
+}
+
+// RecordImportedRun はファイルから取り込んだセッションを記録します（previewの場合は保存せずに結果のみ返します）
+func (u *RunningUsecaseImpl) RecordImportedRun(ctx context.Context, session *running.RunningSession, preview bool) (*dto.RecordRunningResult, error) {
+	if !preview {
+		return u.saveAndClassify(ctx, session)
+	}
+
+	result := u.classifiedResult(ctx, session)
+	result.Message = fmt.Sprintf("ランニングセッション（%s、%s）のプレビューです（未保存）", session.Distance().String(), session.Duration().Clock())
+	return result, nil
+}
+
+// saveAndClassify はセッションを保存し、ゾーンによる強度判定とラップ分析の結果を返します
+func (u *RunningUsecaseImpl) saveAndClassify(ctx context.Context, session *running.RunningSession) (*dto.RecordRunningResult, error) {
+	if err := u.runningRepo.Save(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to save running session: %w", err)
+	}
+
+	slog.InfoContext(ctx, "recorded running session", "session_id", session.ID().String())
+
+	result := u.classifiedResult(ctx, session)
+	result.SessionID = session.ID().String()
+	result.Message = fmt.Sprintf("ランニングセッション（%s、%s）を記録しました", session.Distance().String(), session.Duration().Clock())
+	return result, nil
+}
+
+// classifiedResult はセッションのゾーンによる強度判定とラップ分析の結果を返します
+func (u *RunningUsecaseImpl) classifiedResult(ctx context.Context, session *running.RunningSession) *dto.RecordRunningResult {
+	result := &dto.RecordRunningResult{
+		Date:        session.Date(),
+		DistanceKm:  session.Distance().Km(),
+		Duration:    session.Duration().Clock(),
+		Pace:        session.Pace().String(),
+		RunType:     session.RunType().String(),
+		Warnings:    make([]string, 0),
+		Laps:        dto.FromLaps(session.Laps()),
+		LapAnalysis: dto.FromLapAnalysis(session.Laps()),
+	}
+
+	// 強度判定の失敗で記録自体は失敗させない
+	classification, err := u.classify(ctx, session)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to classify running session", "error", err)
+		return result
+	}
+
+	if classification.PaceZone() != nil {
+		result.PaceZone = classification.PaceZone().String()
+	}
+	result.HeartRateZone = classification.HeartRateZone()
+	result.Warnings = classification.Warnings()
+
+	return result
+}
+
+func (u *RunningUsecaseImpl) UpdateAthleteProfile(ctx context.Context, cmd dto.UpdateAthleteProfileCommand) (*dto.UpdateAthleteProfileResult, error) {
+	slog.DebugContext(ctx, "updating athlete profile")
+
+	profile, err := u.history.FindAthleteProfile(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get athlete profile: %w", err)
+	}
+	if profile == nil {
+		profile = running.NewAthleteProfile()
+	}
+
+	if err := cmd.ApplyTo(profile); err != nil {
+		return nil, fmt.Errorf("invalid athlete profile: %w", err)
+	}
+
+	if err := u.profileRepo.Save(ctx, profile); err != nil {
+		return nil, fmt.Errorf("failed to save athlete profile: %w", err)
+	}
+
+	result := dto.FromAthleteProfile(profile)
+	result.Message = "アスリートプロファイルを更新しました"
+	return result, nil
+}
+
+func (u *RunningUsecaseImpl) SetRunningGoal(ctx context.Context, cmd dto.SetRunningGoalCommand) (*dto.RunningGoalResult, error) {
+	slog.DebugContext(ctx, "setting running goal", "event_type", cmd.EventType)
+
+	goal, err := cmd.ToRunningGoal()
+	if err != nil {
+		return nil, fmt.Errorf("invalid running goal: %w", err)
+	}
+
+	if err := u.goalRepo.Save(ctx, goal); err != nil {
+		return nil, fmt.Errorf("failed to save running goal: %w", err)
+	}
+
+	result := dto.FromRunningGoal(goal)
+	result.Message = "ランニング目標を設定しました"
+	return result, nil
+}
+
+func (u *RunningUsecaseImpl) UpdateRunningGoal(ctx context.Context, cmd dto.UpdateRunningGoalCommand) (*dto.RunningGoalResult, error) {
+	slog.DebugContext(ctx, "updating running goal", "goal_id", cmd.GoalID)
+
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+	id, err := shared.NewGoalIDFromString(cmd.GoalID)
+	if err != nil {
+		return nil, err
+	}
+	goal, err := u.history.FindGoalByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get running goal: %w", err)
+	}
+	if goal == nil {
+		return nil, fmt.Errorf("running goal not found: %s", cmd.GoalID)
+	}
+
+	if err := cmd.ApplyTo(goal); err != nil {
+		return nil, fmt.Errorf("invalid running goal: %w", err)
+	}
+
+	if err := u.goalRepo.Update(ctx, goal); err != nil {
+		return nil, fmt.Errorf("failed to update running goal: %w", err)
+	}
+
+	result := dto.FromRunningGoal(goal)
+	result.Message = "ランニング目標を更新しました"
+	return result, nil
+}
+
+// classify はプロファイルと直近のセッションから求めたゾーンでセッションの強度を判定します
+func (u *RunningUsecaseImpl) classify(ctx context.Context, session *running.RunningSession) (running.RunClassification, error) {
+	profile, err := u.history.FindAthleteProfile(ctx)
+	if err != nil {
+		return running.RunClassification{}, fmt.Errorf("failed to get athlete profile: %w", err)
+	}
+	if profile == nil {
+		profile = u.defaultProfile
+	}
+
+	recent, err := u.history.FindByDateRange(ctx, session.Date().AddDate(0, 0, -zoneBasisDays), session.Date())
+	if err != nil {
+		return running.RunClassification{}, fmt.Errorf("failed to get recent running sessions: %w", err)
+	}
+
+	// 判定対象のセッション自体はゾーンの算出に含めない
+	others := make([]*running.RunningSession, 0, len(recent))
+	for _, other := range recent {
+		if !other.ID().Equals(session.ID()) {
+			others = append(others, other)
+		}
+	}
+
+	paces, _, err := running.ResolveTrainingPaces(profile, others)
+	if err != nil {
+		slog.DebugContext(ctx, "training paces are not available", "error", err)
+		paces = nil
+	}
+
+	var heartRateZones *running.HeartRateZones
+	if profile != nil {
+		if zones, err := profile.HeartRateZones(); err == nil {
+			heartRateZones = zones
+		}
+	}
+
+	return running.ClassifyRun(session, paces, heartRateZones), nil
+}
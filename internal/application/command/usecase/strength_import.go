@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/command/dto"
+)
+
+// StrengthImportUsecase は他アプリの筋トレ記録の取り込みのユースケースインターフェース
+type StrengthImportUsecase interface {
+	ImportStrengthCSV(ctx context.Context, cmd dto.ImportStrengthCSVCommand) (*dto.ImportStrengthCSVResult, error)
+}
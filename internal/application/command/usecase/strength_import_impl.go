@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/strength"
+	"fitness-mcp-server/internal/interface/importer"
+	"fitness-mcp-server/internal/interface/query"
+	"fitness-mcp-server/internal/interface/repository"
+)
+
+type StrengthImportUsecaseImpl struct {
+	csvParser    importer.StrengthCSVImporter // CSVの解析に使用
+	strengthRepo repository.StrengthTrainingRepository
+	history      query.StrengthQueryService // 既存セッションとの重複の判定に使用
+	trainings    StrengthTrainingUsecase    // 取り込み後のPR履歴の再構築に使用
+	weightUnit   string                     // ファイルにもコマンドにも重量の単位がない場合の単位
+	calendar     shared.Calendar            // ファイルの日時にタイムゾーンがない場合のタイムゾーン
+}
+
+func NewStrengthImportUsecase(
+	csvParser importer.StrengthCSVImporter,
+	strengthRepo repository.StrengthTrainingRepository,
+	history query.StrengthQueryService,
+	trainings StrengthTrainingUsecase,
+	weightUnit string,
+	calendar shared.Calendar,
+) *StrengthImportUsecaseImpl {
+	return &StrengthImportUsecaseImpl{
+		csvParser:    csvParser,
+		strengthRepo: strengthRepo,
+		history:      history,
+		trainings:    trainings,
+		weightUnit:   weightUnit,
+		calendar:     calendar,
+	}
+}
+
+func (u *StrengthImportUsecaseImpl) ImportStrengthCSV(ctx context.Context, cmd dto.ImportStrengthCSVCommand) (*dto.ImportStrengthCSVResult, error) {
+	if err := cmd.Validate(); err != nil {
+		return nil, err
+	}
+
+	aliases, err := strength.NewExerciseAliasResolver(cmd.ExerciseMapping)
+	if err != nil {
+		return nil, err
+	}
+	weightUnit := cmd.WeightUnit
+	if weightUnit == "" {
+		weightUnit = u.weightUnit
+	}
+	options := importer.StrengthCSVOptions{Format: cmd.Format, WeightUnit: weightUnit, Aliases: aliases, Location: u.calendar.Location()}
+
+	var imported *importer.ImportedStrengthCSV
+	if cmd.FilePath != "" {
+		slog.InfoContext(ctx, "importing strength CSV file", "path", cmd.FilePath, "dry_run", cmd.DryRun)
+		imported, err = u.csvParser.ParseFile(cmd.FilePath, options)
+	} else {
+		slog.InfoContext(ctx, "importing strength CSV content", "bytes", len(cmd.Content), "dry_run", cmd.DryRun)
+		imported, err = u.csvParser.Parse(cmd.Content, options)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV file: %w", err)
+	}
+
+	result := &dto.ImportStrengthCSVResult{
+		Format:          imported.Format,
+		WeightUnit:      imported.WeightUnit,
+		UnitSource:      imported.UnitSource,
+		Rows:            imported.RowCount,
+		DryRun:          cmd.DryRun,
+		Created:         make([]dto.ImportedSessionDTO, 0),
+		Duplicates:      make([]dto.ImportedSessionDTO, 0),
+		Skipped:         make([]dto.SkippedSessionDTO, 0, len(imported.SkippedSessions)),
+		SkippedRows:     make([]dto.SkippedRowDTO, 0, len(imported.SkippedRows)),
+		ExerciseMapping: imported.ExerciseMapping,
+	}
+	for _, skipped := range imported.SkippedSessions {
+		result.Skipped = append(result.Skipped, dto.SkippedSessionDTO{Date: skipped.Date, Workout: skipped.Workout, Reason: skipped.Reason})
+	}
+	for _, skipped := range imported.SkippedRows {
+		result.SkippedRows = append(result.SkippedRows, dto.SkippedRowDTO{Line: skipped.Line, Reason: skipped.Reason})
+	}
+
+	// 既存のセッション（同じファイル内で先に取り込むセッションを含む）と同じ内容のセッションは取り込まない
+	known, err := u.findExistingTrainings(ctx, imported.Trainings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load existing trainings: %w", err)
+	}
+	newTrainings := make([]*strength.StrengthTraining, 0, len(imported.Trainings))
+	for _, training := range imported.Trainings {
+		summary := toImportedSessionDTO(training)
+		if duplicate := findSameContent(known, training); duplicate != nil {
+			summary.DuplicateOf = duplicate.ID().String()
+			result.Duplicates = append(result.Duplicates, summary)
+			continue
+		}
+		if !cmd.DryRun {
+			summary.TrainingID = training.ID().String()
+		}
+		result.Created = append(result.Created, summary)
+		newTrainings = append(newTrainings, training)
+		known = append(known, training)
+	}
+
+	if cmd.DryRun {
+		result.Message = fmt.Sprintf("%d件のセッションを取り込めます（未保存）", len(newTrainings))
+		return result, nil
+	}
+
+	if len(newTrainings) > 0 {
+		if err := u.strengthRepo.SaveAll(ctx, newTrainings); err != nil {
+			return nil, fmt.Errorf("failed to save imported trainings: %w", err)
+		}
+		slog.InfoContext(ctx, "imported strength CSV", "trainings", len(newTrainings), "format", imported.Format)
+
+		// 過去の日付のセッションを取り込むため、PR履歴は全履歴から再構築する
+		records, err := u.trainings.RebuildPersonalRecords(ctx)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to rebuild personal records", "error", err)
+		}
+		result.Records = records
+	}
+
+	result.Message = fmt.Sprintf("%d件のセッションを取り込みました", len(newTrainings))
+	return result, nil
+}
+
+// findExistingTrainings は取り込むセッションの期間に記録済みのセッションを取得します
+func (u *StrengthImportUsecaseImpl) findExistingTrainings(ctx context.Context, trainings []*strength.StrengthTraining) ([]*strength.StrengthTraining, error) {
+	if len(trainings) == 0 {
+		return nil, nil
+	}
+
+	// 取り込むセッションは日時順（期間は最初と最後のセッションのローカル日付を含む）
+	return u.history.FindByDateRange(ctx, trainings[0].Date(), trainings[len(trainings)-1].Date())
+}
+
+// findSameContent は同じ日に同じ内容を記録したセッションを返します（ない場合はnil）
+func findSameContent(known []*strength.StrengthTraining, training *strength.StrengthTraining) *strength.StrengthTraining {
+	for _, candidate := range known {
+		if candidate.HasSameContent(training) {
+			return candidate
+		}
+	}
+	return nil
+}
+
+// toImportedSessionDTO はセッションの概要DTOを作成します
+func toImportedSessionDTO(training *strength.StrengthTraining) dto.ImportedSessionDTO {
+	return dto.ImportedSessionDTO{
+		Date:        training.Date(),
+		Notes:       training.Notes(),
+		Exercises:   training.ExerciseCount(),
+		Sets:        training.TotalSets(),
+		TotalVolume: training.TotalVolume(),
+	}
+}
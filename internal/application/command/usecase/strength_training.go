@@ -1,12 +1,18 @@
 package usecase
 
 import (
+	"context"
+
 	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/domain/strength"
 )
 
 // StrengthTrainingUsecase は筋トレ記録のユースケースインターフェース
 type StrengthTrainingUsecase interface {
-	RecordTraining(cmd dto.RecordTrainingCommand) (*dto.RecordTrainingResult, error)
-	UpdateTraining(cmd dto.UpdateTrainingCommand) (*dto.UpdateTrainingResult, error)
-	DeleteTraining(cmd dto.DeleteTrainingCommand) (*dto.DeleteTrainingResult, error)
+	RecordTraining(ctx context.Context, cmd dto.RecordTrainingCommand) (*dto.RecordTrainingResult, error)
+	RecordImportedTraining(ctx context.Context, training *strength.StrengthTraining, preview bool) (*dto.RecordTrainingResult, error)
+	UpdateTraining(ctx context.Context, cmd dto.UpdateTrainingCommand) (*dto.UpdateTrainingResult, error)
+	DeleteTraining(ctx context.Context, cmd dto.DeleteTrainingCommand) (*dto.DeleteTrainingResult, error)
+	RebuildPersonalRecords(ctx context.Context) (*dto.RebuildPersonalRecordsResult, error)
+	InitializePersonalRecords(ctx context.Context) error
 }
@@ -1,56 +1,105 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"fitness-mcp-server/internal/application/command/dto"
 	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/strength"
+	"fitness-mcp-server/internal/interface/query"
 	"fitness-mcp-server/internal/interface/repository"
 )
 
 type StrengthTrainingUsecaseImpl struct {
 	strengthRepo repository.StrengthTrainingRepository
+	recordRepo   repository.PersonalRecordRepository
+	history      query.StrengthQueryService // PR履歴の再構築に使用
 }
 
-func NewStrengthTrainingUsecase(strengthRepo repository.StrengthTrainingRepository) *StrengthTrainingUsecaseImpl {
-	return &StrengthTrainingUsecaseImpl{strengthRepo: strengthRepo}
+func NewStrengthTrainingUsecase(
+	strengthRepo repository.StrengthTrainingRepository,
+	recordRepo repository.PersonalRecordRepository,
+	history query.StrengthQueryService,
+) *StrengthTrainingUsecaseImpl {
+	return &StrengthTrainingUsecaseImpl{
+		strengthRepo: strengthRepo,
+		recordRepo:   recordRepo,
+		history:      history,
+	}
 }
 
-func (u *StrengthTrainingUsecaseImpl) RecordTraining(cmd dto.RecordTrainingCommand) (*dto.RecordTrainingResult, error) {
-	log.Printf("Recording training session for date: %s", cmd.Date.Format("2006-01-02"))
+func (u *StrengthTrainingUsecaseImpl) RecordTraining(ctx context.Context, cmd dto.RecordTrainingCommand) (*dto.RecordTrainingResult, error) {
+	slog.DebugContext(ctx, "recording training session", "date", cmd.Date.Format("2006-01-02"))
 
 	training, err := cmd.ToStrengthTraining()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create training entity: %w", err)
 	}
 
-	if err := u.strengthRepo.Save(training); err != nil {
-		return nil, fmt.Errorf("failed to save training: %w", err)
+	return u.record(ctx, training, false)
+}
+
+// RecordImportedTraining はファイルから取り込んだトレーニングを記録します
+// previewの場合は保存せず、更新されるPRのみを判定して返します
+func (u *StrengthTrainingUsecaseImpl) RecordImportedTraining(ctx context.Context, training *strength.StrengthTraining, preview bool) (*dto.RecordTrainingResult, error) {
+	return u.record(ctx, training, preview)
+}
+
+// record はトレーニングを保存し、更新されたPRを検出します
+func (u *StrengthTrainingUsecaseImpl) record(ctx context.Context, training *strength.StrengthTraining, preview bool) (*dto.RecordTrainingResult, error) {
+	if !preview {
+		if err := u.strengthRepo.Save(ctx, training); err != nil {
+			return nil, fmt.Errorf("failed to save training: %w", err)
+		}
+		slog.InfoContext(ctx, "recorded training", "training_id", training.ID().String())
 	}
 
-	log.Printf("Successfully recorded training with ID: %s", training.ID().String())
+	// PR検出の失敗で記録自体は失敗させない
+	events, err := u.detectPersonalRecords(ctx, training, preview)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to detect personal records", "error", err)
+	}
+
+	newRecords := make([]dto.PersonalRecordEventDTO, 0, len(events))
+	for _, event := range events {
+		newRecords = append(newRecords, dto.FromPersonalRecordEvent(event))
+	}
+
+	if preview {
+		return &dto.RecordTrainingResult{
+			Date:       training.Date(),
+			Message:    fmt.Sprintf("筋トレセッション（%d種目、%dセット）のプレビューです（未保存）", training.ExerciseCount(), training.TotalSets()),
+			NewRecords: newRecords,
+		}, nil
+	}
 
 	return &dto.RecordTrainingResult{
 		TrainingID: training.ID().String(),
 		Date:       training.Date(),
 		Message:    fmt.Sprintf("筋トレセッション（%d種目、%dセット）を記録しました", training.ExerciseCount(), training.TotalSets()),
+		NewRecords: newRecords,
 	}, nil
 }
 
-func (u *StrengthTrainingUsecaseImpl) UpdateTraining(cmd dto.UpdateTrainingCommand) (*dto.UpdateTrainingResult, error) {
-	log.Printf("Updating training session with ID: %s", cmd.ID)
+func (u *StrengthTrainingUsecaseImpl) UpdateTraining(ctx context.Context, cmd dto.UpdateTrainingCommand) (*dto.UpdateTrainingResult, error) {
+	slog.DebugContext(ctx, "updating training session", "training_id", cmd.ID)
 
 	training, err := cmd.ToStrengthTraining()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create training entity: %w", err)
 	}
 
-	if err := u.strengthRepo.Update(training); err != nil {
+	if err := u.strengthRepo.Update(ctx, training); err != nil {
 		return nil, fmt.Errorf("failed to update training: %w", err)
 	}
 
-	log.Printf("Successfully updated training with ID: %s", training.ID().String())
+	slog.InfoContext(ctx, "updated training", "training_id", training.ID().String())
+
+	if _, err := u.RebuildPersonalRecords(ctx); err != nil {
+		slog.WarnContext(ctx, "failed to rebuild personal records", "error", err)
+	}
 
 	return &dto.UpdateTrainingResult{
 		TrainingID: training.ID().String(),
@@ -59,8 +108,8 @@ func (u *StrengthTrainingUsecaseImpl) UpdateTraining(cmd dto.UpdateTrainingComma
 	}, nil
 }
 
-func (u *StrengthTrainingUsecaseImpl) DeleteTraining(cmd dto.DeleteTrainingCommand) (*dto.DeleteTrainingResult, error) {
-	log.Printf("Deleting training session with ID: %s", cmd.ID)
+func (u *StrengthTrainingUsecaseImpl) DeleteTraining(ctx context.Context, cmd dto.DeleteTrainingCommand) (*dto.DeleteTrainingResult, error) {
+	slog.DebugContext(ctx, "deleting training session", "training_id", cmd.ID)
 
 	if err := cmd.Validate(); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
@@ -72,14 +121,93 @@ func (u *StrengthTrainingUsecaseImpl) DeleteTraining(cmd dto.DeleteTrainingComma
 		return nil, fmt.Errorf("invalid training ID: %w", err)
 	}
 
-	if err := u.strengthRepo.Delete(trainingID); err != nil {
+	if err := u.strengthRepo.Delete(ctx, trainingID); err != nil {
 		return nil, fmt.Errorf("failed to delete training: %w", err)
 	}
 
-	log.Printf("Successfully deleted training with ID: %s", cmd.ID)
+	slog.InfoContext(ctx, "deleted training", "training_id", cmd.ID)
+
+	if _, err := u.RebuildPersonalRecords(ctx); err != nil {
+		slog.WarnContext(ctx, "failed to rebuild personal records", "error", err)
+	}
 
 	return &dto.DeleteTrainingResult{
 		TrainingID: cmd.ID,
 		Message:    "筋トレセッションを削除しました",
 	}, nil
 }
+
+func (u *StrengthTrainingUsecaseImpl) RebuildPersonalRecords(ctx context.Context) (*dto.RebuildPersonalRecordsResult, error) {
+	trainings, err := u.history.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load training history: %w", err)
+	}
+
+	events := strength.BuildRecordHistory(trainings)
+	if err := u.recordRepo.ReplaceAll(ctx, events); err != nil {
+		return nil, fmt.Errorf("failed to save personal record history: %w", err)
+	}
+
+	return &dto.RebuildPersonalRecordsResult{
+		EventCount: len(events),
+		Message:    fmt.Sprintf("%d件のトレーニングからPR履歴（%d件）を再構築しました", len(trainings), len(events)),
+	}, nil
+}
+
+// InitializePersonalRecords はPR履歴が空の場合に既存のトレーニング履歴から構築します
+func (u *StrengthTrainingUsecaseImpl) InitializePersonalRecords(ctx context.Context) error {
+	count, err := u.recordRepo.CountEvents(ctx)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	_, err = u.RebuildPersonalRecords(ctx)
+	return err
+}
+
+// detectPersonalRecords は記録したトレーニングで更新されたPRを検出し、イベントログに追記します
+// 過去日付のトレーニングを追加した場合は、以降の記録に影響するため履歴全体を再構築します
+// previewの場合は未保存のトレーニングを履歴に加えて判定し、イベントログは変更しません
+func (u *StrengthTrainingUsecaseImpl) detectPersonalRecords(ctx context.Context, training *strength.StrengthTraining, preview bool) ([]strength.PersonalRecordEvent, error) {
+	book, err := u.recordRepo.LoadRecordBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if book.CanApply(training) {
+		events := book.Apply(training)
+		if preview {
+			return events, nil
+		}
+		if err := u.recordRepo.SaveEvents(ctx, events); err != nil {
+			return nil, err
+		}
+		return events, nil
+	}
+
+	trainings, err := u.history.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load training history: %w", err)
+	}
+	if preview {
+		trainings = append(trainings, training)
+	}
+
+	allEvents := strength.BuildRecordHistory(trainings)
+	if !preview {
+		if err := u.recordRepo.ReplaceAll(ctx, allEvents); err != nil {
+			return nil, err
+		}
+	}
+
+	events := make([]strength.PersonalRecordEvent, 0)
+	for _, event := range allEvents {
+		if event.TrainingID().Equals(training.ID()) {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,266 @@
+package exchange
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// データ交換スキーマのテスト（ゴールデンファイル・往復変換）
+// =============================================================================
+
+var update = flag.Bool("update", false, "update golden files")
+
+// newTestDocument は自重セット・注釈付きセット・ラップ・プロファイル・目標・身体測定値を含むドキュメントを作成します
+func newTestDocument() *Document {
+	intPtr := func(v int) *int { return &v }
+	floatPtr := func(v float64) *float64 { return &v }
+	stringPtr := func(v string) *string { return &v }
+	timePtr := func(v time.Time) *time.Time { return &v }
+
+	return &Document{
+		SchemaVersion: SchemaVersion,
+		ExportedAt:    time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+		StrengthTrainings: []StrengthTraining{
+			{
+				ID:    "22222222-2222-4222-8222-222222222222",
+				Date:  time.Date(2024, 2, 20, 19, 0, 0, 0, time.UTC),
+				Notes: "脚の日",
+				Exercises: []Exercise{
+					{Name: "スクワット", Sets: []Set{
+						{WeightKg: 100, Reps: 5, RPE: floatPtr(8), Tempo: stringPtr("3-1-1-0"), RangeOfMotion: stringPtr("Full")},
+						{WeightKg: 100, Reps: 5, RPE: floatPtr(8.5), Variations: []string{"pause"}},
+					}},
+					{Name: "ファーマーズウォーク", Sets: []Set{
+						{WeightKg: 40, DistanceMeters: floatPtr(30)},
+					}},
+				},
+			},
+			{
+				ID:    "11111111-1111-4111-8111-111111111111",
+				Date:  time.Date(2024, 2, 18, 10, 0, 0, 0, time.UTC),
+				Notes: "",
+				Exercises: []Exercise{
+					{Name: "懸垂", Sets: []Set{
+						{WeightKg: 0, Reps: 10},
+					}},
+					{Name: "プランク", Sets: []Set{
+						{WeightKg: 0, DurationSeconds: intPtr(60)},
+					}},
+				},
+			},
+		},
+		Runs: []Run{
+			{
+				ID:              "33333333-3333-4333-8333-333333333333",
+				Date:            time.Date(2024, 2, 19, 7, 0, 0, 0, time.UTC),
+				DistanceKm:      5,
+				DurationSeconds: 1500,
+				HeartRateBPM:    intPtr(150),
+				RunType:         "Easy",
+				Notes:           "朝ラン",
+			},
+			{
+				ID:              "44444444-4444-4444-8444-444444444444",
+				Date:            time.Date(2024, 2, 21, 7, 0, 0, 0, time.UTC),
+				DistanceKm:      2.4,
+				DurationSeconds: 660,
+				RunType:         "Interval",
+				Notes:           "",
+				Laps: []Lap{
+					{Number: 1, Type: "Work", DistanceKm: 1, DurationSeconds: 225.5, HeartRateBPM: intPtr(172)},
+					{Number: 2, Type: "Rest", DistanceKm: 0.4, DurationSeconds: 180},
+					{Number: 3, Type: "Work", DistanceKm: 1, DurationSeconds: 254.5},
+				},
+			},
+		},
+		AthleteProfile: &AthleteProfile{
+			MaxHeartRate:              intPtr(190),
+			RestingHeartRate:          intPtr(50),
+			ThresholdHeartRate:        intPtr(170),
+			VDOT:                      floatPtr(50),
+			ThresholdPaceSecondsPerKm: floatPtr(255),
+			UpdatedAt:                 time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+		},
+		Goals: []Goal{
+			{
+				ID:                "66666666-6666-4666-8666-666666666666",
+				EventType:         "Custom",
+				DistanceKm:        30,
+				TargetTimeSeconds: 9000,
+				Status:            "Achieved",
+				Description:       "",
+				CreatedAt:         time.Date(2024, 1, 20, 8, 0, 0, 0, time.UTC),
+				AchievedAt:        timePtr(time.Date(2024, 2, 25, 9, 0, 0, 0, time.UTC)),
+			},
+			{
+				ID:                "55555555-5555-4555-8555-555555555555",
+				EventType:         "Marathon",
+				DistanceKm:        42.195,
+				TargetTimeSeconds: 12600,
+				EventDate:         timePtr(time.Date(2024, 4, 21, 0, 0, 0, 0, time.UTC)),
+				Status:            "Active",
+				Description:       "サブ3.5",
+				CreatedAt:         time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		BodyMetrics: []BodyMetric{
+			{
+				ID:             "77777777-7777-4777-8777-777777777777",
+				Date:           time.Date(2024, 2, 20, 7, 0, 0, 0, time.UTC),
+				WeightKg:       68.4,
+				BodyFatPercent: floatPtr(15.2),
+				Notes:          "起床時",
+			},
+			{
+				ID:       "88888888-8888-4888-8888-888888888888",
+				Date:     time.Date(2024, 2, 18, 7, 0, 0, 0, time.UTC),
+				WeightKg: 68.9,
+				Notes:    "",
+			},
+		},
+	}
+}
+
+func TestRender_Golden(t *testing.T) {
+	for _, format := range []string{FormatJSON, FormatCSV, FormatMarkdown} {
+		t.Run("正常系:"+format, func(t *testing.T) {
+			// Act
+			files, err := Render(newTestDocument(), format)
+			assert.NoError(t, err)
+
+			// Assert
+			for _, file := range files {
+				golden := filepath.Join("testdata", file.Name+".golden")
+				if *update {
+					assert.NoError(t, os.WriteFile(golden, file.Content, 0o644))
+				}
+				want, err := os.ReadFile(golden)
+				assert.NoError(t, err)
+				assert.Equal(t, string(want), string(file.Content), file.Name)
+			}
+		})
+	}
+}
+
+func TestDocument_RoundTrip(t *testing.T) {
+	// Arrange
+	data, err := Encode(newTestDocument())
+	assert.NoError(t, err)
+
+	// Act: JSON → ドメインオブジェクト → JSON
+	decoded, err := Decode(data)
+	assert.NoError(t, err)
+
+	restored := &Document{SchemaVersion: decoded.SchemaVersion, ExportedAt: decoded.ExportedAt}
+	for _, training := range decoded.StrengthTrainings {
+		domain, err := training.ToDomain()
+		assert.NoError(t, err)
+		restored.StrengthTrainings = append(restored.StrengthTrainings, FromStrengthTraining(domain))
+	}
+	for _, run := range decoded.Runs {
+		domain, err := run.ToDomain()
+		assert.NoError(t, err)
+		restored.Runs = append(restored.Runs, FromRunningSession(domain))
+	}
+	profile, err := decoded.AthleteProfile.ToDomain()
+	assert.NoError(t, err)
+	restored.AthleteProfile = FromAthleteProfile(profile)
+	for _, goal := range decoded.Goals {
+		domain, err := goal.ToDomain()
+		assert.NoError(t, err)
+		restored.Goals = append(restored.Goals, FromRunningGoal(domain))
+	}
+	for _, metric := range decoded.BodyMetrics {
+		domain, err := metric.ToDomain()
+		assert.NoError(t, err)
+		restored.BodyMetrics = append(restored.BodyMetrics, FromBodyMetric(domain))
+	}
+
+	// Assert
+	again, err := Encode(restored)
+	assert.NoError(t, err)
+	assert.Equal(t, string(data), string(again))
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("正常系:目標・身体測定値のないバージョン1のドキュメントを読み込める", func(t *testing.T) {
+		// Act
+		d, err := Decode([]byte(`{"schema_version": 1, "strength_trainings": [], "runs": []}`))
+
+		// Assert
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 1, d.SchemaVersion)
+		assert.Empty(t, d.Goals)
+		assert.Empty(t, d.BodyMetrics)
+	})
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:    "異常系:新しいスキーマバージョンはエラー",
+			input:   `{"schema_version": 3, "strength_trainings": [], "runs": []}`,
+			wantErr: "unsupported schema version: 3",
+		},
+		{
+			name:    "異常系:スキーマバージョンがない場合はエラー",
+			input:   `{"strength_trainings": [], "runs": []}`,
+			wantErr: "unsupported schema version: 0",
+		},
+		{
+			name:    "異常系:未知のフィールドはエラー",
+			input:   `{"schema_version": 2, "injuries": []}`,
+			wantErr: "unknown field",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := Decode([]byte(tt.input))
+
+			// Assert
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRun_ToDomain(t *testing.T) {
+	t.Run("異常系:ラップ番号が連続していない場合はエラー", func(t *testing.T) {
+		// Arrange
+		run := newTestDocument().Runs[1]
+		run.Laps[1].Number = 5
+
+		// Act
+		_, err := run.ToDomain()
+
+		// Assert
+		assert.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), "lap numbers must be consecutive"))
+	})
+}
+
+func TestGoal_ToDomain(t *testing.T) {
+	t.Run("異常系:標準距離の種目で距離が一致しない場合はエラー", func(t *testing.T) {
+		// Arrange
+		goal := newTestDocument().Goals[1]
+		goal.DistanceKm = 40
+
+		// Act
+		_, err := goal.ToDomain()
+
+		// Assert
+		assert.ErrorContains(t, err, "does not match")
+	})
+}
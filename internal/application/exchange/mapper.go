@@ -0,0 +1,416 @@
+package exchange
+
+import (
+	"fmt"
+	"time"
+
+	"fitness-mcp-server/internal/domain/body"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/strength"
+)
+
+// =============================================================================
+// スキーマとドメインオブジェクトの変換
+// =============================================================================
+
+// FromStrengthTraining は筋トレセッションをスキーマに変換します
+func FromStrengthTraining(training *strength.StrengthTraining) StrengthTraining {
+	exercises := make([]Exercise, 0, training.ExerciseCount())
+	for _, exercise := range training.Exercises() {
+		sets := make([]Set, 0, exercise.SetCount())
+		for _, set := range exercise.Sets() {
+			sets = append(sets, fromSet(set))
+		}
+		exercises = append(exercises, Exercise{Name: exercise.Name().String(), Sets: sets})
+	}
+
+	return StrengthTraining{
+		ID:        training.ID().String(),
+		Date:      training.Date(),
+		Notes:     training.Notes(),
+		Exercises: exercises,
+	}
+}
+
+// fromSet はセットをスキーマに変換します
+func fromSet(set strength.Set) Set {
+	result := Set{WeightKg: set.Weight().Kg(), Reps: set.Reps().Count()}
+	if set.Duration() != nil {
+		seconds := set.Duration().Seconds()
+		result.DurationSeconds = &seconds
+	}
+	if set.Distance() != nil {
+		meters := set.Distance().Meters()
+		result.DistanceMeters = &meters
+	}
+	if set.RPE() != nil {
+		rpe := set.RPE().Value()
+		result.RPE = &rpe
+	}
+	if set.Tempo() != nil {
+		tempo := set.Tempo().String()
+		result.Tempo = &tempo
+	}
+	if set.RangeOfMotion() != nil {
+		rom := set.RangeOfMotion().Value()
+		result.RangeOfMotion = &rom
+	}
+	for _, variation := range set.Variations() {
+		result.Variations = append(result.Variations, variation.Tag())
+	}
+	return result
+}
+
+// ToDomain は筋トレセッションをドメインオブジェクトに変換します（IDはエクスポート元のIDを引き継ぎます）
+func (t StrengthTraining) ToDomain() (*strength.StrengthTraining, error) {
+	id, err := shared.NewTrainingIDFromString(t.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid training ID: %w", err)
+	}
+	if len(t.Exercises) == 0 {
+		return nil, fmt.Errorf("training %s has no exercises", t.ID)
+	}
+
+	training := strength.NewStrengthTraining(id, t.Date, t.Notes)
+	for i, e := range t.Exercises {
+		name, err := strength.NewExerciseName(e.Name)
+		if err != nil {
+			return nil, fmt.Errorf("exercise[%d]: %w", i, err)
+		}
+		if len(e.Sets) == 0 {
+			return nil, fmt.Errorf("exercise[%d]: at least one set is required", i)
+		}
+
+		exercise := strength.NewExercise(name)
+		for j, s := range e.Sets {
+			set, err := s.toDomain()
+			if err != nil {
+				return nil, fmt.Errorf("exercise[%d].set[%d]: %w", i, j, err)
+			}
+			exercise.AddSet(set)
+		}
+		training.AddExercise(exercise)
+	}
+	return training, nil
+}
+
+// toDomain はセットをドメインオブジェクトに変換します
+func (s Set) toDomain() (strength.Set, error) {
+	weight, err := strength.NewWeight(s.WeightKg)
+	if err != nil {
+		return strength.Set{}, err
+	}
+
+	var reps *strength.Reps
+	if s.Reps != 0 {
+		value, err := strength.NewReps(s.Reps)
+		if err != nil {
+			return strength.Set{}, err
+		}
+		reps = &value
+	}
+	var duration *strength.Duration
+	if s.DurationSeconds != nil {
+		value, err := strength.NewDuration(*s.DurationSeconds)
+		if err != nil {
+			return strength.Set{}, err
+		}
+		duration = &value
+	}
+	var distance *strength.Distance
+	if s.DistanceMeters != nil {
+		value, err := strength.NewDistance(*s.DistanceMeters)
+		if err != nil {
+			return strength.Set{}, err
+		}
+		distance = &value
+	}
+	var rpe *strength.RPE
+	if s.RPE != nil {
+		value, err := strength.NewRPEFromValue(*s.RPE)
+		if err != nil {
+			return strength.Set{}, err
+		}
+		rpe = &value
+	}
+
+	set, err := strength.NewMeasuredSet(weight, reps, duration, distance, rpe)
+	if err != nil {
+		return strength.Set{}, err
+	}
+
+	if s.Tempo != nil {
+		tempo, err := strength.ParseTempo(*s.Tempo)
+		if err != nil {
+			return strength.Set{}, err
+		}
+		set = set.WithTempo(tempo)
+	}
+	if s.RangeOfMotion != nil {
+		rom, err := strength.NewRangeOfMotion(*s.RangeOfMotion)
+		if err != nil {
+			return strength.Set{}, err
+		}
+		set = set.WithRangeOfMotion(rom)
+	}
+	if len(s.Variations) > 0 {
+		variations := make([]strength.Variation, 0, len(s.Variations))
+		for _, tag := range s.Variations {
+			variation, err := strength.NewVariation(tag)
+			if err != nil {
+				return strength.Set{}, err
+			}
+			variations = append(variations, variation)
+		}
+		set = set.WithVariations(variations...)
+	}
+	return set, nil
+}
+
+// FromRunningSession はランニングセッションをスキーマに変換します
+func FromRunningSession(session *running.RunningSession) Run {
+	run := Run{
+		ID:              session.ID().String(),
+		Date:            session.Date(),
+		DistanceKm:      session.Distance().Km(),
+		DurationSeconds: int(session.Duration().Seconds()),
+		HeartRateBPM:    heartRateBPM(session.HeartRate()),
+		RunType:         session.RunType().String(),
+		Notes:           session.Notes(),
+	}
+	for _, lap := range session.Laps() {
+		run.Laps = append(run.Laps, Lap{
+			Number:          lap.Number(),
+			Type:            lap.Type().String(),
+			DistanceKm:      lap.Distance().Km(),
+			DurationSeconds: lap.Duration().Seconds(),
+			HeartRateBPM:    heartRateBPM(lap.HeartRate()),
+		})
+	}
+	return run
+}
+
+// ToDomain はランニングセッションをドメインオブジェクトに変換します（IDはエクスポート元のIDを引き継ぎます）
+func (r Run) ToDomain() (*running.RunningSession, error) {
+	id, err := shared.NewSessionIDFromString(r.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session ID: %w", err)
+	}
+	distance, err := running.NewDistance(r.DistanceKm)
+	if err != nil {
+		return nil, err
+	}
+	duration, err := running.NewDuration(time.Duration(r.DurationSeconds) * time.Second)
+	if err != nil {
+		return nil, err
+	}
+	runType, err := running.NewRunType(r.RunType)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := running.NewRunningSession(id, r.Date, distance, duration, runType, r.Notes)
+	if err != nil {
+		return nil, err
+	}
+	if r.HeartRateBPM != nil {
+		heartRate, err := running.NewHeartRate(*r.HeartRateBPM)
+		if err != nil {
+			return nil, err
+		}
+		session.SetHeartRate(heartRate)
+	}
+
+	for i, l := range r.Laps {
+		if l.Number != i+1 {
+			return nil, fmt.Errorf("lap numbers must be consecutive from 1: got %d at position %d", l.Number, i+1)
+		}
+		lapDistance, err := running.NewDistance(l.DistanceKm)
+		if err != nil {
+			return nil, fmt.Errorf("lap %d: %w", l.Number, err)
+		}
+		lapDuration, err := running.NewDuration(time.Duration(l.DurationSeconds * float64(time.Second)))
+		if err != nil {
+			return nil, fmt.Errorf("lap %d: %w", l.Number, err)
+		}
+		lapType, err := running.NewLapType(l.Type)
+		if err != nil {
+			return nil, fmt.Errorf("lap %d: %w", l.Number, err)
+		}
+		lap, err := session.AddLap(lapDistance, lapDuration, lapType)
+		if err != nil {
+			return nil, fmt.Errorf("lap %d: %w", l.Number, err)
+		}
+		if l.HeartRateBPM != nil {
+			heartRate, err := running.NewHeartRate(*l.HeartRateBPM)
+			if err != nil {
+				return nil, fmt.Errorf("lap %d: %w", l.Number, err)
+			}
+			lap.SetHeartRate(heartRate)
+		}
+	}
+	return session, nil
+}
+
+// FromAthleteProfile はアスリートプロファイルをスキーマに変換します（未登録の場合はnil）
+func FromAthleteProfile(profile *running.AthleteProfile) *AthleteProfile {
+	if profile == nil {
+		return nil
+	}
+
+	result := &AthleteProfile{
+		MaxHeartRate:       heartRateBPM(profile.MaxHeartRate()),
+		RestingHeartRate:   heartRateBPM(profile.RestingHeartRate()),
+		ThresholdHeartRate: heartRateBPM(profile.ThresholdHeartRate()),
+		VDOT:               profile.VDOT(),
+		UpdatedAt:          profile.UpdatedAt(),
+	}
+	if profile.ThresholdPace() != nil {
+		seconds := profile.ThresholdPace().SecondsPerKm()
+		result.ThresholdPaceSecondsPerKm = &seconds
+	}
+	return result
+}
+
+// ToDomain はアスリートプロファイルをドメインオブジェクトに変換します
+func (p AthleteProfile) ToDomain() (*running.AthleteProfile, error) {
+	profile := running.NewAthleteProfile()
+
+	heartRates := make([]*running.HeartRate, 3)
+	for i, bpm := range []*int{p.MaxHeartRate, p.RestingHeartRate, p.ThresholdHeartRate} {
+		if bpm == nil {
+			continue
+		}
+		heartRate, err := running.NewHeartRate(*bpm)
+		if err != nil {
+			return nil, err
+		}
+		heartRates[i] = &heartRate
+	}
+	if err := profile.SetHeartRates(heartRates[0], heartRates[1], heartRates[2]); err != nil {
+		return nil, err
+	}
+	if p.VDOT != nil {
+		if err := profile.SetVDOT(*p.VDOT); err != nil {
+			return nil, err
+		}
+	}
+	if p.ThresholdPaceSecondsPerKm != nil {
+		pace, err := running.NewPace(*p.ThresholdPaceSecondsPerKm / 60)
+		if err != nil {
+			return nil, err
+		}
+		profile.SetThresholdPace(pace)
+	}
+
+	profile.Restore(p.UpdatedAt)
+	return profile, nil
+}
+
+// FromRunningGoal はランニング目標をスキーマに変換します
+func FromRunningGoal(goal *running.RunningGoal) Goal {
+	return Goal{
+		ID:                goal.ID().String(),
+		EventType:         goal.EventType().String(),
+		DistanceKm:        goal.Distance().Km(),
+		TargetTimeSeconds: int(goal.TargetTime().Seconds()),
+		EventDate:         goal.EventDate(),
+		Status:            goal.Status().String(),
+		Description:       goal.Description(),
+		CreatedAt:         goal.CreatedAt(),
+		AchievedAt:        goal.AchievedAt(),
+	}
+}
+
+// ToDomain はランニング目標をドメインオブジェクトに変換します（IDはエクスポート元のIDを引き継ぎます）
+func (g Goal) ToDomain() (*running.RunningGoal, error) {
+	id, err := shared.NewGoalIDFromString(g.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid goal ID: %w", err)
+	}
+	eventType, err := running.NewEventType(g.EventType)
+	if err != nil {
+		return nil, err
+	}
+	distance, err := running.NewDistance(g.DistanceKm)
+	if err != nil {
+		return nil, err
+	}
+	targetTime, err := running.NewDuration(time.Duration(g.TargetTimeSeconds) * time.Second)
+	if err != nil {
+		return nil, err
+	}
+	status, err := running.NewGoalStatus(g.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	var goal *running.RunningGoal
+	if eventType.Equals(running.Custom) {
+		goal, err = running.NewCustomRunningGoal(id, distance, targetTime, g.Description)
+	} else {
+		goal, err = running.NewRunningGoal(id, eventType, targetTime, g.Description)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !goal.Distance().Equals(distance) {
+		return nil, fmt.Errorf("goal %s: distance %.4gkm does not match %s", g.ID, g.DistanceKm, g.EventType)
+	}
+
+	if g.EventDate != nil {
+		goal.SetEventDate(*g.EventDate)
+	}
+	goal.Restore(status, g.CreatedAt, g.AchievedAt)
+	return goal, nil
+}
+
+// FromBodyMetric は身体測定値をスキーマに変換します
+func FromBodyMetric(metric *body.Metric) BodyMetric {
+	result := BodyMetric{
+		ID:       metric.ID().String(),
+		Date:     metric.Date(),
+		WeightKg: metric.Weight().Kg(),
+		Notes:    metric.Notes(),
+	}
+	if metric.BodyFat() != nil {
+		percent := metric.BodyFat().Percent()
+		result.BodyFatPercent = &percent
+	}
+	return result
+}
+
+// ToDomain は身体測定値をドメインオブジェクトに変換します（IDはエクスポート元のIDを引き継ぎます）
+func (m BodyMetric) ToDomain() (*body.Metric, error) {
+	id, err := shared.NewBodyMetricIDFromString(m.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid body metric ID: %w", err)
+	}
+	weight, err := body.NewWeight(m.WeightKg)
+	if err != nil {
+		return nil, err
+	}
+
+	metric, err := body.NewMetric(id, m.Date, weight, m.Notes)
+	if err != nil {
+		return nil, err
+	}
+	if m.BodyFatPercent != nil {
+		bodyFat, err := body.NewBodyFat(*m.BodyFatPercent)
+		if err != nil {
+			return nil, err
+		}
+		metric.SetBodyFat(bodyFat)
+	}
+	return metric, nil
+}
+
+// heartRateBPM は心拍数をbpmに変換します（未記録の場合はnil）
+func heartRateBPM(heartRate *running.HeartRate) *int {
+	if heartRate == nil {
+		return nil
+	}
+	bpm := heartRate.BPM()
+	return &bpm
+}
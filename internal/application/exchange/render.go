@@ -0,0 +1,394 @@
+package exchange
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// エクスポート形式 - 正規のJSON・エンティティごとのCSV・Markdownのトレーニングログ
+// =============================================================================
+
+// エクスポート形式
+const (
+	FormatJSON     = "json"
+	FormatCSV      = "csv"
+	FormatMarkdown = "markdown"
+)
+
+// File はエクスポートしたファイル
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Render はドキュメントを指定した形式のファイルに変換します（CSVはエンティティごとに複数のファイル）
+func Render(d *Document, format string) ([]File, error) {
+	d.Sort()
+	switch format {
+	case FormatJSON:
+		data, err := Encode(d)
+		if err != nil {
+			return nil, err
+		}
+		return []File{{Name: "fitness_export.json", Content: data}}, nil
+	case FormatCSV:
+		return renderCSV(d)
+	case FormatMarkdown:
+		return []File{{Name: "training_log.md", Content: renderMarkdown(d)}}, nil
+	}
+	return nil, fmt.Errorf("unsupported export format: %s", format)
+}
+
+// WriteFiles はファイルを書き出し、書き出したパスを返します
+// ファイルが1つの場合はpathをファイルパス、複数の場合はディレクトリとして扱います
+func WriteFiles(path string, files []File) ([]string, error) {
+	if len(files) == 1 {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.WriteFile(path, files[0].Content, 0o644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		return []string{path}, nil
+	}
+
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+	written := make([]string, 0, len(files))
+	for _, file := range files {
+		target := filepath.Join(path, file.Name)
+		if err := os.WriteFile(target, file.Content, 0o644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", target, err)
+		}
+		written = append(written, target)
+	}
+	return written, nil
+}
+
+// -----------------------------------------------------------------------------
+// CSV
+// -----------------------------------------------------------------------------
+
+// renderCSV はエンティティごとのCSVファイルに変換します
+func renderCSV(d *Document) ([]File, error) {
+	trainings := [][]string{{"training_id", "date", "notes", "exercises", "sets", "total_volume_kg"}}
+	sets := [][]string{{"training_id", "date", "exercise_order", "exercise", "set_order", "weight_kg", "reps",
+		"duration_seconds", "distance_m", "rpe", "tempo", "range_of_motion", "variations"}}
+	for _, t := range d.StrengthTrainings {
+		setCount, volume := 0, 0.0
+		for exerciseOrder, e := range t.Exercises {
+			for setOrder, s := range e.Sets {
+				setCount++
+				volume += s.WeightKg * float64(s.Reps)
+				sets = append(sets, []string{
+					t.ID, formatTime(t.Date), strconv.Itoa(exerciseOrder + 1), e.Name, strconv.Itoa(setOrder + 1),
+					formatFloat(s.WeightKg), optionalInt(&s.Reps), optionalInt(s.DurationSeconds), optionalFloat(s.DistanceMeters),
+					optionalFloat(s.RPE), optionalString(s.Tempo), optionalString(s.RangeOfMotion), strings.Join(s.Variations, "|"),
+				})
+			}
+		}
+		trainings = append(trainings, []string{
+			t.ID, formatTime(t.Date), t.Notes, strconv.Itoa(len(t.Exercises)), strconv.Itoa(setCount), formatFloat(volume),
+		})
+	}
+
+	runs := [][]string{{"run_id", "date", "distance_km", "duration_seconds", "heart_rate_bpm", "run_type", "notes"}}
+	laps := [][]string{{"run_id", "lap_number", "type", "distance_km", "duration_seconds", "heart_rate_bpm"}}
+	for _, r := range d.Runs {
+		runs = append(runs, []string{
+			r.ID, formatTime(r.Date), formatFloat(r.DistanceKm), strconv.Itoa(r.DurationSeconds), optionalInt(r.HeartRateBPM), r.RunType, r.Notes,
+		})
+		for _, l := range r.Laps {
+			laps = append(laps, []string{
+				r.ID, strconv.Itoa(l.Number), l.Type, formatFloat(l.DistanceKm), formatFloat(l.DurationSeconds), optionalInt(l.HeartRateBPM),
+			})
+		}
+	}
+
+	goals := [][]string{{"goal_id", "event_type", "distance_km", "target_time_seconds", "event_date", "status", "description", "created_at", "achieved_at"}}
+	for _, g := range d.Goals {
+		goals = append(goals, []string{
+			g.ID, g.EventType, formatFloat(g.DistanceKm), strconv.Itoa(g.TargetTimeSeconds), optionalTime(g.EventDate),
+			g.Status, g.Description, formatTime(g.CreatedAt), optionalTime(g.AchievedAt),
+		})
+	}
+
+	metrics := [][]string{{"metric_id", "date", "weight_kg", "body_fat_percent", "notes"}}
+	for _, m := range d.BodyMetrics {
+		metrics = append(metrics, []string{
+			m.ID, formatTime(m.Date), formatFloat(m.WeightKg), optionalFloat(m.BodyFatPercent), m.Notes,
+		})
+	}
+
+	tables := []struct {
+		name string
+		rows [][]string
+	}{
+		{name: "strength_trainings.csv", rows: trainings},
+		{name: "strength_sets.csv", rows: sets},
+		{name: "runs.csv", rows: runs},
+		{name: "run_laps.csv", rows: laps},
+		{name: "running_goals.csv", rows: goals},
+		{name: "body_metrics.csv", rows: metrics},
+	}
+	if p := d.AthleteProfile; p != nil {
+		tables = append(tables, struct {
+			name string
+			rows [][]string
+		}{name: "athlete_profile.csv", rows: [][]string{
+			{"max_hr", "resting_hr", "lthr", "vdot", "threshold_pace_seconds_per_km", "updated_at"},
+			{optionalInt(p.MaxHeartRate), optionalInt(p.RestingHeartRate), optionalInt(p.ThresholdHeartRate),
+				optionalFloat(p.VDOT), optionalFloat(p.ThresholdPaceSecondsPerKm), formatTime(p.UpdatedAt)},
+		}})
+	}
+
+	files := make([]File, 0, len(tables))
+	for _, table := range tables {
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		if err := w.WriteAll(table.rows); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", table.name, err)
+		}
+		files = append(files, File{Name: table.name, Content: buf.Bytes()})
+	}
+	return files, nil
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func optionalInt(v *int) string {
+	if v == nil || *v == 0 {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
+
+func optionalFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return formatFloat(*v)
+}
+
+func optionalTime(v *time.Time) string {
+	if v == nil {
+		return ""
+	}
+	return formatTime(*v)
+}
+
+func optionalString(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+// -----------------------------------------------------------------------------
+// Markdown
+// -----------------------------------------------------------------------------
+
+var weekdays = []string{"日", "月", "火", "水", "木", "金", "土"}
+
+// logEntry は日付ごとにまとめるためのトレーニングログの1件（筋トレ・ラン・身体測定のいずれか）
+type logEntry struct {
+	date     time.Time
+	training *StrengthTraining
+	run      *Run
+	metric   *BodyMetric
+}
+
+// renderMarkdown は日付ごとのトレーニングログに変換します
+func renderMarkdown(d *Document) []byte {
+	var b strings.Builder
+	b.WriteString("# トレーニングログ\n\n")
+	fmt.Fprintf(&b, "- エクスポート日時: %s\n", d.ExportedAt.Format("2006-01-02 15:04"))
+	period := "全期間"
+	if d.StartDate != nil && d.EndDate != nil {
+		period = fmt.Sprintf("%s 〜 %s", d.StartDate.Format("2006-01-02"), d.EndDate.Format("2006-01-02"))
+	}
+	fmt.Fprintf(&b, "- 期間: %s\n", period)
+	fmt.Fprintf(&b, "- 筋トレ %d件 / ラン %d件 / 身体測定 %d件\n", len(d.StrengthTrainings), len(d.Runs), len(d.BodyMetrics))
+
+	if p := d.AthleteProfile; p != nil {
+		b.WriteString("\n## アスリートプロファイル\n\n")
+		if p.MaxHeartRate != nil {
+			fmt.Fprintf(&b, "- 最大心拍数: %dbpm\n", *p.MaxHeartRate)
+		}
+		if p.RestingHeartRate != nil {
+			fmt.Fprintf(&b, "- 安静時心拍数: %dbpm\n", *p.RestingHeartRate)
+		}
+		if p.ThresholdHeartRate != nil {
+			fmt.Fprintf(&b, "- 乳酸閾値心拍数: %dbpm\n", *p.ThresholdHeartRate)
+		}
+		if p.VDOT != nil {
+			fmt.Fprintf(&b, "- VDOT: %.1f\n", *p.VDOT)
+		}
+		if p.ThresholdPaceSecondsPerKm != nil {
+			fmt.Fprintf(&b, "- 閾値ペース: %s/km\n", formatClock(*p.ThresholdPaceSecondsPerKm))
+		}
+	}
+
+	if len(d.Goals) > 0 {
+		b.WriteString("\n## 目標\n\n")
+		for _, g := range d.Goals {
+			writeGoal(&b, g)
+		}
+	}
+
+	entries := make([]logEntry, 0, len(d.StrengthTrainings)+len(d.Runs)+len(d.BodyMetrics))
+	for i := range d.StrengthTrainings {
+		entries = append(entries, logEntry{date: d.StrengthTrainings[i].Date, training: &d.StrengthTrainings[i]})
+	}
+	for i := range d.Runs {
+		entries = append(entries, logEntry{date: d.Runs[i].Date, run: &d.Runs[i]})
+	}
+	for i := range d.BodyMetrics {
+		entries = append(entries, logEntry{date: d.BodyMetrics[i].Date, metric: &d.BodyMetrics[i]})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].date.Before(entries[j].date)
+	})
+
+	currentDay := ""
+	for _, entry := range entries {
+		day := entry.date.Format("2006-01-02")
+		if day != currentDay {
+			fmt.Fprintf(&b, "\n## %s（%s）\n", day, weekdays[entry.date.Weekday()])
+			currentDay = day
+		}
+		switch {
+		case entry.training != nil:
+			writeTraining(&b, entry.training)
+		case entry.run != nil:
+			writeRun(&b, entry.run)
+		default:
+			writeBodyMetric(&b, entry.metric)
+		}
+	}
+
+	return []byte(b.String())
+}
+
+// writeTraining は筋トレセッションを書き出します
+func writeTraining(b *strings.Builder, t *StrengthTraining) {
+	title := "🏋️ 筋トレ"
+	if t.Notes != "" {
+		title += ": " + t.Notes
+	}
+	fmt.Fprintf(b, "\n### %s\n\n", title)
+
+	volume := 0.0
+	for _, e := range t.Exercises {
+		sets := make([]string, 0, len(e.Sets))
+		for _, s := range e.Sets {
+			volume += s.WeightKg * float64(s.Reps)
+			sets = append(sets, formatSet(s))
+		}
+		fmt.Fprintf(b, "- **%s**: %s\n", e.Name, strings.Join(sets, ", "))
+	}
+	fmt.Fprintf(b, "- 総負荷量: %skg\n", formatFloat(volume))
+}
+
+// formatSet はセットを1行に書き出します（例: 100kg×5 @RPE8 [paused]）
+func formatSet(s Set) string {
+	text := formatFloat(s.WeightKg) + "kg"
+	if s.Reps > 0 {
+		text += fmt.Sprintf("×%d", s.Reps)
+	}
+	if s.DurationSeconds != nil {
+		text += fmt.Sprintf(" %d秒", *s.DurationSeconds)
+	}
+	if s.DistanceMeters != nil {
+		text += fmt.Sprintf(" %sm", formatFloat(*s.DistanceMeters))
+	}
+	if s.RPE != nil {
+		text += " @RPE" + formatFloat(*s.RPE)
+	}
+	if s.Tempo != nil {
+		text += " テンポ" + *s.Tempo
+	}
+	if s.RangeOfMotion != nil {
+		text += " " + *s.RangeOfMotion
+	}
+	if len(s.Variations) > 0 {
+		text += " [" + strings.Join(s.Variations, ", ") + "]"
+	}
+	return text
+}
+
+// writeRun はランニングセッションを書き出します
+func writeRun(b *strings.Builder, r *Run) {
+	fmt.Fprintf(b, "\n### 🏃 ラン: %s %.2fkm %s（%s/km", r.RunType, r.DistanceKm,
+		formatClock(float64(r.DurationSeconds)), formatClock(float64(r.DurationSeconds)/r.DistanceKm))
+	if r.HeartRateBPM != nil {
+		fmt.Fprintf(b, "、心拍 %dbpm", *r.HeartRateBPM)
+	}
+	b.WriteString("）\n")
+	if r.Notes != "" {
+		fmt.Fprintf(b, "\n%s\n", r.Notes)
+	}
+
+	if len(r.Laps) == 0 {
+		return
+	}
+	b.WriteString("\n| # | 種別 | 距離 | タイム | ペース | 心拍 |\n|---|---|---|---|---|---|\n")
+	for _, l := range r.Laps {
+		heartRate := "-"
+		if l.HeartRateBPM != nil {
+			heartRate = fmt.Sprintf("%dbpm", *l.HeartRateBPM)
+		}
+		fmt.Fprintf(b, "| %d | %s | %.2fkm | %s | %s/km | %s |\n",
+			l.Number, l.Type, l.DistanceKm, formatClock(l.DurationSeconds), formatClock(l.DurationSeconds/l.DistanceKm), heartRate)
+	}
+}
+
+// writeGoal は目標を1行に書き出します（例: Marathon 42.195km 3:30:00（2024-04-21） Active: サブ3.5）
+func writeGoal(b *strings.Builder, g Goal) {
+	fmt.Fprintf(b, "- %s %skm %s", g.EventType, formatFloat(g.DistanceKm), formatClock(float64(g.TargetTimeSeconds)))
+	if g.EventDate != nil {
+		fmt.Fprintf(b, "（%s）", g.EventDate.Format("2006-01-02"))
+	}
+	fmt.Fprintf(b, " %s", g.Status)
+	if g.Description != "" {
+		fmt.Fprintf(b, ": %s", g.Description)
+	}
+	b.WriteString("\n")
+}
+
+// writeBodyMetric は身体測定値を書き出します
+func writeBodyMetric(b *strings.Builder, m *BodyMetric) {
+	fmt.Fprintf(b, "\n### ⚖️ 身体測定: %skg", formatFloat(m.WeightKg))
+	if m.BodyFatPercent != nil {
+		fmt.Fprintf(b, "、体脂肪率 %s%%", formatFloat(*m.BodyFatPercent))
+	}
+	b.WriteString("\n")
+	if m.Notes != "" {
+		fmt.Fprintf(b, "\n%s\n", m.Notes)
+	}
+}
+
+// formatClock は秒数を"h:mm:ss"・"m:ss"形式に変換します
+func formatClock(seconds float64) string {
+	total := int(seconds + 0.5)
+	h, m, s := total/3600, total%3600/60, total%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%d:%02d", m, s)
+}
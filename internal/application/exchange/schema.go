@@ -0,0 +1,167 @@
+package exchange
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// =============================================================================
+// データ交換スキーマ - エクスポート・インポートで共有する正規のJSON形式
+// =============================================================================
+
+// SchemaVersion は現在のスキーマバージョンです
+// フィールドの意味を変える変更や必須フィールドの追加を行う場合は値を上げ、Decodeで旧バージョンを変換します
+// バージョン2で目標・身体測定値を追加しました（バージョン1のドキュメントはそのまま読み込めます）
+const SchemaVersion = 2
+
+// Document はエクスポートしたデータ全体
+type Document struct {
+	SchemaVersion     int                `json:"schema_version"`
+	ExportedAt        time.Time          `json:"exported_at"`
+	StartDate         *time.Time         `json:"start_date,omitempty"` // 期間指定でエクスポートした場合の開始日
+	EndDate           *time.Time         `json:"end_date,omitempty"`   // 期間指定でエクスポートした場合の終了日
+	StrengthTrainings []StrengthTraining `json:"strength_trainings"`
+	Runs              []Run              `json:"runs"`
+	AthleteProfile    *AthleteProfile    `json:"athlete_profile,omitempty"`
+	Goals             []Goal             `json:"goals"`        // 期間指定に関わらずすべての目標
+	BodyMetrics       []BodyMetric       `json:"body_metrics"` // 期間内の身体測定値
+}
+
+// StrengthTraining は筋トレセッション
+type StrengthTraining struct {
+	ID        string     `json:"id"`
+	Date      time.Time  `json:"date"`
+	Notes     string     `json:"notes"`
+	Exercises []Exercise `json:"exercises"`
+}
+
+// Exercise はエクササイズ（セットは実施順）
+type Exercise struct {
+	Name string `json:"name"`
+	Sets []Set  `json:"sets"`
+}
+
+// Set はセット（回数・時間・距離のいずれか1つ以上）
+type Set struct {
+	WeightKg        float64  `json:"weight_kg"`
+	Reps            int      `json:"reps,omitempty"`
+	DurationSeconds *int     `json:"duration_seconds,omitempty"`
+	DistanceMeters  *float64 `json:"distance_m,omitempty"`
+	RPE             *float64 `json:"rpe,omitempty"`
+	Tempo           *string  `json:"tempo,omitempty"`
+	RangeOfMotion   *string  `json:"range_of_motion,omitempty"`
+	Variations      []string `json:"variations,omitempty"`
+}
+
+// Run はランニングセッション
+type Run struct {
+	ID              string    `json:"id"`
+	Date            time.Time `json:"date"`
+	DistanceKm      float64   `json:"distance_km"`
+	DurationSeconds int       `json:"duration_seconds"`
+	HeartRateBPM    *int      `json:"heart_rate_bpm,omitempty"`
+	RunType         string    `json:"run_type"`
+	Notes           string    `json:"notes"`
+	Laps            []Lap     `json:"laps,omitempty"`
+}
+
+// Lap はランのラップ（番号順）
+type Lap struct {
+	Number          int     `json:"number"`
+	Type            string  `json:"type"` // Work / Rest
+	DistanceKm      float64 `json:"distance_km"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	HeartRateBPM    *int    `json:"heart_rate_bpm,omitempty"`
+}
+
+// AthleteProfile はアスリートプロファイル（心拍数・VDOT・閾値ペース）
+type AthleteProfile struct {
+	MaxHeartRate              *int      `json:"max_hr,omitempty"`
+	RestingHeartRate          *int      `json:"resting_hr,omitempty"`
+	ThresholdHeartRate        *int      `json:"lthr,omitempty"`
+	VDOT                      *float64  `json:"vdot,omitempty"`
+	ThresholdPaceSecondsPerKm *float64  `json:"threshold_pace_seconds_per_km,omitempty"`
+	UpdatedAt                 time.Time `json:"updated_at"`
+}
+
+// Goal はランニング目標
+type Goal struct {
+	ID                string     `json:"id"`
+	EventType         string     `json:"event_type"`  // 5K / 10K / Half / Marathon / Custom
+	DistanceKm        float64    `json:"distance_km"` // Custom以外はイベントタイプの標準距離
+	TargetTimeSeconds int        `json:"target_time_seconds"`
+	EventDate         *time.Time `json:"event_date,omitempty"`
+	Status            string     `json:"status"` // Active / Achieved / Paused / Cancelled
+	Description       string     `json:"description"`
+	CreatedAt         time.Time  `json:"created_at"`
+	AchievedAt        *time.Time `json:"achieved_at,omitempty"`
+}
+
+// BodyMetric は身体測定値（体重・体脂肪率）
+type BodyMetric struct {
+	ID             string    `json:"id"`
+	Date           time.Time `json:"date"`
+	WeightKg       float64   `json:"weight_kg"`
+	BodyFatPercent *float64  `json:"body_fat_percent,omitempty"`
+	Notes          string    `json:"notes"`
+}
+
+// Sort はセッション・身体測定値を日時順、目標を作成日時順（同じ日時はID順）に並べ替えます
+func (d *Document) Sort() {
+	sort.SliceStable(d.StrengthTrainings, func(i, j int) bool {
+		a, b := d.StrengthTrainings[i], d.StrengthTrainings[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		return a.ID < b.ID
+	})
+	sort.SliceStable(d.Runs, func(i, j int) bool {
+		a, b := d.Runs[i], d.Runs[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		return a.ID < b.ID
+	})
+	sort.SliceStable(d.Goals, func(i, j int) bool {
+		a, b := d.Goals[i], d.Goals[j]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.ID < b.ID
+	})
+	sort.SliceStable(d.BodyMetrics, func(i, j int) bool {
+		a, b := d.BodyMetrics[i], d.BodyMetrics[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		return a.ID < b.ID
+	})
+}
+
+// Encode は正規のJSON（日時順、インデント付き）に変換します
+func Encode(d *Document) ([]byte, error) {
+	d.Sort()
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode export document: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// Decode は正規のJSONを読み込みます（未知のフィールド・対応していないスキーマバージョンはエラー）
+func Decode(data []byte) (*Document, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var d Document
+	if err := decoder.Decode(&d); err != nil {
+		return nil, fmt.Errorf("failed to decode export document: %w", err)
+	}
+	if d.SchemaVersion < 1 || d.SchemaVersion > SchemaVersion {
+		return nil, fmt.Errorf("unsupported schema version: %d (supported: 1-%d)", d.SchemaVersion, SchemaVersion)
+	}
+	return &d, nil
+}
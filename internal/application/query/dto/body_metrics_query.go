@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+// =============================================================================
+// 身体測定値のDTO定義
+// =============================================================================
+
+type (
+	// GetBodyMetricsQuery は身体測定値取得のクエリ
+	GetBodyMetricsQuery struct {
+		Days          int       `json:"days"`           // 対象期間（日数）
+		ReferenceDate time.Time `json:"reference_date"` // 期間の終了日（通常は今日）
+	}
+
+	// GetBodyMetricsResponse は身体測定値取得のレスポンス
+	GetBodyMetricsResponse struct {
+		Period         string          `json:"period"`                     // 対象期間
+		Count          int             `json:"count"`                      // 測定回数
+		LatestWeightKg *float64        `json:"latest_weight_kg,omitempty"` // 期間内の最新の体重
+		WeightChangeKg *float64        `json:"weight_change_kg,omitempty"` // 期間内の最初の測定からの体重の増減（2回以上測定した場合のみ）
+		Metrics        []BodyMetricDTO `json:"metrics"`                    // 測定日時順の測定値
+	}
+
+	// BodyMetricDTO は身体測定値のDTO
+	BodyMetricDTO struct {
+		ID             string    `json:"id"`
+		Date           time.Time `json:"date"`
+		WeightKg       float64   `json:"weight_kg"`
+		BodyFatPercent *float64  `json:"body_fat_percent,omitempty"`
+		Notes          string    `json:"notes,omitempty"`
+	}
+)
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+)
+
+// =============================================================================
+// データエクスポートのDTO定義
+// =============================================================================
+
+type (
+	// ExportDataQuery はデータエクスポートのクエリ
+	// 期間を省略した場合は全期間をエクスポートします
+	ExportDataQuery struct {
+		Format    string     `json:"format"` // json / csv / markdown
+		StartDate *time.Time `json:"start_date,omitempty"`
+		EndDate   *time.Time `json:"end_date,omitempty"`
+		// OutputPath を指定した場合はファイルに書き出します
+		// 1ファイルの形式（JSON・Markdown）はそのパスに、CSVはそのディレクトリにエンティティごとのファイルを書き出します
+		OutputPath string `json:"output_path,omitempty"`
+	}
+
+	// ExportDataResponse はデータエクスポートのレスポンス
+	ExportDataResponse struct {
+		Format            string         `json:"format"`
+		SchemaVersion     int            `json:"schema_version"`
+		StrengthTrainings int            `json:"strength_trainings"`
+		Runs              int            `json:"runs"`
+		AthleteProfile    bool           `json:"athlete_profile"`
+		Goals             int            `json:"goals"`
+		BodyMetrics       int            `json:"body_metrics"`
+		Files             []ExportedFile `json:"files"`         // CSVはエンティティごとに複数のファイル
+		WrittenPaths      []string       `json:"written_paths"` // 書き出したファイルのパス（OutputPath未指定の場合は空）
+	}
+
+	// ExportedFile はエクスポートしたファイル
+	ExportedFile struct {
+		Name    string `json:"name"`
+		Content []byte `json:"content"`
+	}
+)
+
+// Validate はExportDataQueryの妥当性検証を行います
+func (q *ExportDataQuery) Validate() error {
+	switch q.Format {
+	case "json", "csv", "markdown":
+	default:
+		return fmt.Errorf("format must be json, csv or markdown: %s", q.Format)
+	}
+	if (q.StartDate == nil) != (q.EndDate == nil) {
+		return fmt.Errorf("start date and end date must be specified together")
+	}
+	if q.StartDate != nil && q.StartDate.After(*q.EndDate) {
+		return fmt.Errorf("start date must be before end date")
+	}
+	return nil
+}
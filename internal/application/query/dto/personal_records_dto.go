@@ -8,6 +8,8 @@ type PersonalRecordQueryResult struct {
 	MaxWeight     PersonalRecordQueryDetail
 	MaxReps       PersonalRecordQueryDetail
 	MaxVolume     PersonalRecordQueryDetail
+	LongestHold   *PersonalRecordQueryDetail // 時間ベースのセットがある場合のみ
+	HeaviestCarry *PersonalRecordQueryDetail // 距離ベースのセットがある場合のみ
 	TotalSessions int
 	LastPerformed time.Time
 }
@@ -22,7 +24,21 @@ type PersonalRecordQueryDetail struct {
 
 // SetQueryDetails はセット詳細（Query層専用）
 type SetQueryDetails struct {
-	WeightKg float64
-	Reps     int
-	RPE      *int
+	WeightKg        float64
+	Reps            int
+	DurationSeconds *int
+	DistanceMeters  *float64
+	RPE             *float64
+}
+
+// PersonalRecordEventQueryResult はPR更新イベント（Query層専用）
+type PersonalRecordEventQueryResult struct {
+	ExerciseName  string
+	RecordType    string
+	Reps          int
+	Value         float64
+	PreviousValue *float64
+	Date          time.Time
+	TrainingID    string
+	SetDetails    *SetQueryDetails // SessionVolumeの場合はnil
 }
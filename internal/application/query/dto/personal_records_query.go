@@ -4,7 +4,8 @@ import "time"
 
 type (
 	GetPersonalRecordsQuery struct {
-		ExerciseName *string `json:"exercise_name,omitempty"` // オプション: 特定のエクササイズ名でフィルタリング
+		ExerciseName        *string  `json:"exercise_name,omitempty"`    // オプション: 特定のエクササイズ名でフィルタリング
+		CarryDistanceMeters *float64 `json:"carry_distance_m,omitempty"` // オプション: 最大重量キャリーの対象とする最低距離（m）
 	}
 
 	GetPersonalRecordsResponse struct {
@@ -14,12 +15,14 @@ type (
 
 	// PersonalRecord は個人記録のデータ転送オブジェクト
 	PersonalRecord struct {
-		ExerciseName  string               `json:"exercise_name"`  // エクササイズ名
-		MaxWeight     PersonalRecordDetail `json:"max_weight"`     // 最大重量
-		MaxReps       PersonalRecordDetail `json:"max_reps"`       // 最大レップ数
-		MaxVolume     PersonalRecordDetail `json:"max_volume"`     // 最大ボリューム
-		TotalSessions int                  `json:"total_sessions"` // セッション数
-		LastPerformed time.Time            `json:"last_performed"` // 最終実施日時
+		ExerciseName  string                `json:"exercise_name"`            // エクササイズ名
+		MaxWeight     PersonalRecordDetail  `json:"max_weight"`               // 最大重量
+		MaxReps       PersonalRecordDetail  `json:"max_reps"`                 // 最大レップ数
+		MaxVolume     PersonalRecordDetail  `json:"max_volume"`               // 最大ボリューム
+		LongestHold   *PersonalRecordDetail `json:"longest_hold,omitempty"`   // 最長保持時間（時間ベースのセットのみ）
+		HeaviestCarry *PersonalRecordDetail `json:"heaviest_carry,omitempty"` // 最大重量キャリー（距離ベースのセットのみ）
+		TotalSessions int                   `json:"total_sessions"`           // セッション数
+		LastPerformed time.Time             `json:"last_performed"`           // 最終実施日時
 	}
 
 	// PersonalRecordDetail は個人記録の詳細情報
@@ -32,8 +35,38 @@ type (
 
 	// SetInfo はセットの詳細情報
 	SetInfo struct {
-		WeightKg float64 `json:"weight_kg"`     // 重量（kg）
-		Reps     int     `json:"reps"`          // レップ数
-		RPE      *int    `json:"rpe,omitempty"` // オプション: RPE（Rate of Perceived Exertion）
+		WeightKg        float64  `json:"weight_kg"`                  // 重量（kg）
+		Reps            int      `json:"reps"`                       // レップ数
+		DurationSeconds *int     `json:"duration_seconds,omitempty"` // オプション: 実施時間（秒）
+		DistanceMeters  *float64 `json:"distance_m,omitempty"`       // オプション: 移動距離（m）
+		RPE             *float64 `json:"rpe,omitempty"`              // オプション: RPE（Rate of Perceived Exertion）
+	}
+
+	GetPRHistoryQuery struct {
+		ExerciseName *string `json:"exercise_name,omitempty"` // オプション: 特定のエクササイズ名でフィルタリング
+		RecordType   *string `json:"record_type,omitempty"`   // オプション: RepMax / E1RM / SessionVolume
+	}
+
+	GetPRHistoryResponse struct {
+		Timelines []PRTimeline `json:"timelines"` // エクササイズごとのPR履歴
+		Count     int          `json:"count"`     // PRイベントの総数
+	}
+
+	// PRTimeline はエクササイズごとの時系列PR履歴
+	PRTimeline struct {
+		ExerciseName string    `json:"exercise_name"` // エクササイズ名
+		Events       []PREvent `json:"events"`        // 日付順のPRイベント
+	}
+
+	// PREvent はPR更新イベントの情報
+	PREvent struct {
+		Date          time.Time `json:"date"`                     // 記録日
+		RecordType    string    `json:"record_type"`              // RepMax / E1RM / SessionVolume
+		Label         string    `json:"label"`                    // 表示名（例: 5RM、推定1RM）
+		Reps          int       `json:"reps,omitempty"`           // nRMの回数
+		Value         float64   `json:"value"`                    // 新しい記録値（kg）
+		PreviousValue *float64  `json:"previous_value,omitempty"` // 更新前の記録値（初記録の場合は省略）
+		TrainingID    string    `json:"training_id"`              // 関連するトレーニングセッションのID
+		SetDetails    *SetInfo  `json:"set_details,omitempty"`    // 記録を更新したセット
 	}
 )
@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// =============================================================================
+// レースタイム予測のDTO定義
+// =============================================================================
+
+type (
+	// PredictRaceTimesQuery はレースタイム予測のクエリ
+	PredictRaceTimesQuery struct {
+		Days          int       `json:"days"`           // 基準にするセッションの対象期間（日数）
+		ReferenceDate time.Time `json:"reference_date"` // 期間の終了日（通常は今日）
+	}
+
+	// PredictRaceTimesResponse はレースタイム予測のレスポンス
+	PredictRaceTimesResponse struct {
+		Period      string            `json:"period"`      // 対象期間
+		BasisCount  int               `json:"basis_count"` // 予測の基準になり得るRace・Tempoセッション数
+		Predictions []EventPrediction `json:"predictions"` // 種目ごとの予測
+	}
+
+	// EventPrediction は種目ごとの予測結果
+	EventPrediction struct {
+		EventType  string            `json:"event_type"`  // 種目（5K/10K/Half/Marathon）
+		DistanceKm float64           `json:"distance_km"` // 距離（km）
+		Models     []ModelPrediction `json:"models"`      // モデルごとの予測
+	}
+
+	// ModelPrediction はモデルごとの予測タイム
+	ModelPrediction struct {
+		Model            string       `json:"model"`             // Riegel / VDOT / Cameron
+		PredictedSeconds int          `json:"predicted_seconds"` // 予測タイム（秒）
+		PredictedTime    string       `json:"predicted_time"`    // 予測タイム（H:MM:SS）
+		Pace             string       `json:"pace"`              // 予測ペース（M:SS/km）
+		Basis            BasisSession `json:"basis"`             // 予測の基準にしたセッション
+	}
+
+	// BasisSession は予測の基準にしたセッションの情報
+	BasisSession struct {
+		SessionID  string    `json:"session_id"`
+		Date       time.Time `json:"date"`
+		RunType    string    `json:"run_type"`
+		DistanceKm float64   `json:"distance_km"`
+		Duration   string    `json:"duration"`
+		Pace       string    `json:"pace"`
+	}
+)
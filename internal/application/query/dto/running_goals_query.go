@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// =============================================================================
+// ランニング目標のDTO定義
+// =============================================================================
+
+type (
+	// GetRunningGoalsResponse はランニング目標取得のレスポンス
+	GetRunningGoalsResponse struct {
+		Count       int              `json:"count"`        // 目標の数
+		ActiveCount int              `json:"active_count"` // 進行中の目標の数
+		Goals       []RunningGoalDTO `json:"goals"`        // 作成日時順の目標
+	}
+
+	// RunningGoalDTO はランニング目標のDTO
+	RunningGoalDTO struct {
+		ID             string     `json:"id"`
+		EventType      string     `json:"event_type"`  // 5K / 10K / Half / Marathon / Custom
+		DistanceKm     float64    `json:"distance_km"` // 距離（km）
+		TargetTime     string     `json:"target_time"` // 目標タイム（H:MM:SS）
+		TargetPace     string     `json:"target_pace"` // 目標ペース（M:SS/km）
+		EventDate      *time.Time `json:"event_date,omitempty"`
+		DaysUntilEvent *int       `json:"days_until_event,omitempty"` // イベント日までの日数
+		Status         string     `json:"status"`                     // Active / Achieved / Paused / Cancelled
+		Description    string     `json:"description,omitempty"`
+		CreatedAt      time.Time  `json:"created_at"`
+		AchievedAt     *time.Time `json:"achieved_at,omitempty"`
+	}
+)
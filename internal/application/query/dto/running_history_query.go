@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+// =============================================================================
+// ランニング履歴のDTO定義
+// =============================================================================
+
+type (
+	// GetRecentRunsQuery は直近のランニング取得のクエリ
+	GetRecentRunsQuery struct {
+		Days          int       `json:"days"`           // 対象期間（日数）
+		ReferenceDate time.Time `json:"reference_date"` // 期間の終了日（通常は今日）
+	}
+
+	// GetRecentRunsResponse は直近のランニング取得のレスポンス
+	GetRecentRunsResponse struct {
+		Period          string   `json:"period"`            // 対象期間
+		Count           int      `json:"count"`             // セッション数
+		TotalDistanceKm float64  `json:"total_distance_km"` // 合計距離（km）
+		Runs            []RunDTO `json:"runs"`              // 新しい順のセッション
+	}
+
+	// RunDTO はランニングセッションのDTO
+	RunDTO struct {
+		ID              string    `json:"id"`
+		Date            time.Time `json:"date"`
+		RunType         string    `json:"run_type"`                 // Easy / Tempo / Interval / Long / Race
+		DistanceKm      float64   `json:"distance_km"`              // 距離（km）
+		DurationSeconds int       `json:"duration_seconds"`         // 時間（秒）
+		Duration        string    `json:"duration"`                 // 時間（H:MM:SS）
+		Pace            string    `json:"pace"`                     // ペース（M:SS/km）
+		HeartRateBPM    *int      `json:"heart_rate_bpm,omitempty"` // 平均心拍数
+		Laps            int       `json:"laps"`                     // ラップ数
+		Notes           string    `json:"notes,omitempty"`
+	}
+)
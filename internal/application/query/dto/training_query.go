@@ -14,6 +14,43 @@ import (
 type GetTrainingsByDateRangeQuery struct {
 	StartDate time.Time `json:"start_date"`
 	EndDate   time.Time `json:"end_date"`
+
+	// セットの絞り込み条件（オプション）
+	ExerciseName  *string `json:"exercise_name,omitempty"`   // エクササイズ名
+	Variation     *string `json:"variation,omitempty"`       // バリエーションタグ（例: paused）
+	RangeOfMotion *string `json:"range_of_motion,omitempty"` // 可動域（Full/Partial）
+	TempoOnly     bool    `json:"tempo_only,omitempty"`      // テンポが記録されたセットのみ
+}
+
+// SetCriteria はクエリの絞り込み条件をドメインの条件に変換します
+func (q GetTrainingsByDateRangeQuery) SetCriteria() (strength.SetCriteria, error) {
+	criteria := strength.SetCriteria{TempoOnly: q.TempoOnly}
+
+	if q.ExerciseName != nil {
+		name, err := strength.NewExerciseName(*q.ExerciseName)
+		if err != nil {
+			return strength.SetCriteria{}, err
+		}
+		criteria.ExerciseName = &name
+	}
+
+	if q.Variation != nil {
+		variation, err := strength.NewVariation(*q.Variation)
+		if err != nil {
+			return strength.SetCriteria{}, err
+		}
+		criteria.Variation = &variation
+	}
+
+	if q.RangeOfMotion != nil {
+		rom, err := strength.NewRangeOfMotion(*q.RangeOfMotion)
+		if err != nil {
+			return strength.SetCriteria{}, err
+		}
+		criteria.RangeOfMotion = &rom
+	}
+
+	return criteria, nil
 }
 
 // GetTrainingsByDateRangeResponse は期間指定トレーニング取得のレスポンス
@@ -21,6 +58,7 @@ type GetTrainingsByDateRangeResponse struct {
 	Trainings []*TrainingDTO `json:"trainings"`
 	Count     int            `json:"count"`
 	Period    string         `json:"period"`
+	Filter    string         `json:"filter,omitempty"` // 適用した絞り込み条件の説明
 }
 
 // TrainingDTO はトレーニングセッションのDTO
@@ -40,9 +78,16 @@ type ExerciseDTO struct {
 
 // SetDTO はセットのDTO
 type SetDTO struct {
-	WeightKg float64 `json:"weight_kg"`
-	Reps     int     `json:"reps"`
-	RPE      *int    `json:"rpe,omitempty"`
+	WeightKg        float64  `json:"weight_kg"`
+	Reps            int      `json:"reps,omitempty"`
+	DurationSeconds *int     `json:"duration_seconds,omitempty"`
+	DistanceMeters  *float64 `json:"distance_m,omitempty"`
+	RPE             *float64 `json:"rpe,omitempty"`
+
+	Tempo                   *string  `json:"tempo,omitempty"`
+	RangeOfMotion           *string  `json:"range_of_motion,omitempty"`
+	Variations              []string `json:"variations,omitempty"`
+	TimeUnderTensionSeconds *int     `json:"time_under_tension_seconds,omitempty"`
 }
 
 // SummaryDTO はトレーニングセッションの概要DTO
@@ -51,6 +96,8 @@ type SummaryDTO struct {
 	TotalSets      int     `json:"total_sets"`
 	TotalVolume    float64 `json:"total_volume"`
 	Duration       string  `json:"duration"`
+
+	TimeUnderTensionSeconds int `json:"time_under_tension_seconds,omitempty"` // テンポ記録がある場合のみ
 }
 
 // =============================================================================
@@ -74,6 +121,8 @@ func TrainingToDTO(training *strength.StrengthTraining) *TrainingDTO {
 			TotalSets:      training.TotalSets(),
 			TotalVolume:    training.TotalVolume(),
 			Duration:       "", // 実装時に追加
+
+			TimeUnderTensionSeconds: int(training.TimeUnderTension().Seconds()),
 		},
 	}
 }
@@ -98,10 +147,39 @@ func SetToDTO(set strength.Set) *SetDTO {
 		Reps:     set.Reps().Count(),
 	}
 
+	if duration := set.Duration(); duration != nil {
+		seconds := duration.Seconds()
+		dto.DurationSeconds = &seconds
+	}
+
+	if distance := set.Distance(); distance != nil {
+		meters := distance.Meters()
+		dto.DistanceMeters = &meters
+	}
+
 	if rpe := set.RPE(); rpe != nil {
-		value := rpe.Rating()
+		value := rpe.Value()
 		dto.RPE = &value
 	}
 
+	if tempo := set.Tempo(); tempo != nil {
+		value := tempo.String()
+		dto.Tempo = &value
+
+		tut := int(set.TimeUnderTension().Seconds())
+		if tut > 0 {
+			dto.TimeUnderTensionSeconds = &tut
+		}
+	}
+
+	if rom := set.RangeOfMotion(); rom != nil {
+		value := rom.Value()
+		dto.RangeOfMotion = &value
+	}
+
+	for _, variation := range set.Variations() {
+		dto.Variations = append(dto.Variations, variation.Tag())
+	}
+
 	return dto
 }
@@ -0,0 +1,199 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"fitness-mcp-server/internal/domain/strength"
+)
+
+// =============================================================================
+// 筋トレセッション検索のDTO定義
+// =============================================================================
+
+// 検索結果の並び順
+const (
+	SortDateDesc      = "date_desc"       // 新しい順（既定）
+	SortDateAsc       = "date_asc"        // 古い順
+	SortMaxWeightDesc = "max_weight_desc" // 一致したセットの最大重量の重い順
+	SortVolumeDesc    = "volume_desc"     // 一致したセットの合計ボリュームの多い順
+)
+
+// 1ページの件数
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
+type (
+	// SearchTrainingsQuery は筋トレセッション検索のクエリ
+	// セットの条件（エクササイズ・重量・回数・RPE）は、返すセッションの絞り込み（同じセットで全て満たすセットを含むもの）と
+	// max_weight_desc・volume_descの並び順の計算（一致したセットの最大重量・合計ボリューム）に使います
+	// セッションは一致しないセットも含めて全てのセットを返します
+	SearchTrainingsQuery struct {
+		ExerciseName  *string    `json:"exercise_name,omitempty"`  // エクササイズ名または別名（例: bench press）
+		MinWeightKg   *float64   `json:"min_weight_kg,omitempty"`  // 最小重量（kg）
+		MaxWeightKg   *float64   `json:"max_weight_kg,omitempty"`  // 最大重量（kg）
+		MinReps       *int       `json:"min_reps,omitempty"`       // 最小回数
+		MaxReps       *int       `json:"max_reps,omitempty"`       // 最大回数
+		MinRPE        *float64   `json:"min_rpe,omitempty"`        // 最小RPE
+		MaxRPE        *float64   `json:"max_rpe,omitempty"`        // 最大RPE
+		NotesContains *string    `json:"notes_contains,omitempty"` // メモに含まれる文字列
+		Weekdays      []string   `json:"weekdays,omitempty"`       // 曜日（Mon〜Sun、月〜日）
+		StartDate     *time.Time `json:"start_date,omitempty"`     // 開始日（ローカル日付）
+		EndDate       *time.Time `json:"end_date,omitempty"`       // 終了日（ローカル日付）
+		Sort          string     `json:"sort,omitempty"`           // 並び順（省略時はdate_desc）
+		Cursor        string     `json:"cursor,omitempty"`         // 前のページのnext_cursor
+		Limit         int        `json:"limit,omitempty"`          // 1ページの件数（省略時は20、最大100）
+	}
+
+	// SearchTrainingsResponse は筋トレセッション検索のレスポンス
+	SearchTrainingsResponse struct {
+		Trainings  []*TrainingDTO `json:"trainings"`
+		Count      int            `json:"count"`                 // このページのセッション数
+		Sort       string         `json:"sort"`                  // 適用した並び順
+		Filter     string         `json:"filter,omitempty"`      // 適用した絞り込み条件の説明
+		NextCursor string         `json:"next_cursor,omitempty"` // 次のページのカーソル（最後のページの場合は省略）
+	}
+
+	// TrainingSearchCriteria はクエリサービスに渡す検索条件（Query層専用）
+	// セットの条件は同じセットで全て満たす必要があります
+	TrainingSearchCriteria struct {
+		ExerciseName  *string
+		MinWeightKg   *float64
+		MaxWeightKg   *float64
+		MinReps       *int
+		MaxReps       *int
+		MinRPE        *float64
+		MaxRPE        *float64
+		NotesContains *string
+		Weekdays      []time.Weekday // ローカル日付の曜日
+		StartDate     *time.Time
+		EndDate       *time.Time
+		Sort          string
+		Cursor        string
+		Limit         int
+	}
+
+	// TrainingSearchQueryResult は筋トレセッション検索の結果（Query層専用）
+	TrainingSearchQueryResult struct {
+		Trainings  []*strength.StrengthTraining // 並び順のセッション（全てのセットを含む）
+		NextCursor string                       // 続きがない場合は空
+	}
+)
+
+// SearchCriteria はクエリを検証し、別名を解決した検索条件に変換します
+func (q SearchTrainingsQuery) SearchCriteria() (TrainingSearchCriteria, error) {
+	criteria := TrainingSearchCriteria{
+		MinWeightKg: q.MinWeightKg,
+		MaxWeightKg: q.MaxWeightKg,
+		MinReps:     q.MinReps,
+		MaxReps:     q.MaxReps,
+		MinRPE:      q.MinRPE,
+		MaxRPE:      q.MaxRPE,
+		StartDate:   q.StartDate,
+		EndDate:     q.EndDate,
+		Sort:        q.Sort,
+		Cursor:      q.Cursor,
+		Limit:       q.Limit,
+	}
+
+	if q.ExerciseName != nil {
+		resolver, err := strength.NewExerciseAliasResolver(nil)
+		if err != nil {
+			return TrainingSearchCriteria{}, err
+		}
+		name, err := resolver.Resolve(*q.ExerciseName)
+		if err != nil {
+			return TrainingSearchCriteria{}, err
+		}
+		resolved := name.String()
+		criteria.ExerciseName = &resolved
+	}
+
+	if q.MinWeightKg != nil && q.MaxWeightKg != nil && *q.MinWeightKg > *q.MaxWeightKg {
+		return TrainingSearchCriteria{}, fmt.Errorf("min_weight_kg must be less than or equal to max_weight_kg")
+	}
+	if q.MinReps != nil && q.MaxReps != nil && *q.MinReps > *q.MaxReps {
+		return TrainingSearchCriteria{}, fmt.Errorf("min_reps must be less than or equal to max_reps")
+	}
+	if q.MinRPE != nil && q.MaxRPE != nil && *q.MinRPE > *q.MaxRPE {
+		return TrainingSearchCriteria{}, fmt.Errorf("min_rpe must be less than or equal to max_rpe")
+	}
+	if q.StartDate != nil && q.EndDate != nil && q.StartDate.After(*q.EndDate) {
+		return TrainingSearchCriteria{}, fmt.Errorf("start date must be before or equal to end date")
+	}
+
+	if q.NotesContains != nil {
+		if notes := strings.TrimSpace(*q.NotesContains); notes != "" {
+			criteria.NotesContains = &notes
+		}
+	}
+
+	for _, value := range q.Weekdays {
+		weekday, err := ParseWeekday(value)
+		if err != nil {
+			return TrainingSearchCriteria{}, err
+		}
+		criteria.Weekdays = append(criteria.Weekdays, weekday)
+	}
+
+	switch criteria.Sort {
+	case "":
+		criteria.Sort = SortDateDesc
+	case SortDateDesc, SortDateAsc, SortMaxWeightDesc, SortVolumeDesc:
+	default:
+		return TrainingSearchCriteria{}, fmt.Errorf("invalid sort: %s (date_desc, date_asc, max_weight_desc or volume_desc)", criteria.Sort)
+	}
+
+	switch {
+	case criteria.Limit == 0:
+		criteria.Limit = DefaultSearchLimit
+	case criteria.Limit < 0 || criteria.Limit > MaxSearchLimit:
+		return TrainingSearchCriteria{}, fmt.Errorf("limit must be between 1 and %d", MaxSearchLimit)
+	}
+
+	return criteria, nil
+}
+
+// SetCriteria は検索条件のうちセットの条件をドメインの条件に変換します
+func (c TrainingSearchCriteria) SetCriteria() (strength.SetCriteria, error) {
+	criteria := strength.SetCriteria{
+		MinWeightKg: c.MinWeightKg,
+		MaxWeightKg: c.MaxWeightKg,
+		MinReps:     c.MinReps,
+		MaxReps:     c.MaxReps,
+		MinRPE:      c.MinRPE,
+		MaxRPE:      c.MaxRPE,
+	}
+	if c.ExerciseName != nil {
+		name, err := strength.NewExerciseName(*c.ExerciseName)
+		if err != nil {
+			return strength.SetCriteria{}, err
+		}
+		criteria.ExerciseName = &name
+	}
+	return criteria, nil
+}
+
+// weekdayNames は曜日の表記（英語の略称・英語・日本語）と曜日の対応です
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday, "日": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday, "月": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday, "火": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday, "水": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday, "木": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday, "金": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday, "土": time.Saturday,
+}
+
+// ParseWeekday は曜日の表記（Mon・Monday・月・月曜日など）を曜日に変換します
+func ParseWeekday(value string) (time.Weekday, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	normalized = strings.TrimSuffix(strings.TrimSuffix(normalized, "曜日"), "曜")
+	if weekday, exists := weekdayNames[normalized]; exists {
+		return weekday, nil
+	}
+	return 0, fmt.Errorf("invalid weekday: %q (Mon〜Sun or 月〜日)", value)
+}
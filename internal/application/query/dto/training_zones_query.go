@@ -0,0 +1,43 @@
+package dto
+
+// =============================================================================
+// トレーニングゾーンのDTO定義
+// =============================================================================
+
+type (
+	// GetTrainingZonesQuery はトレーニングゾーン取得のクエリ
+	// 指定した項目は保存済みのアスリートプロファイルより優先されます
+	GetTrainingZonesQuery struct {
+		VDOT                      *float64 `json:"vdot,omitempty"`
+		ThresholdPaceSecondsPerKm *float64 `json:"threshold_pace_seconds_per_km,omitempty"`
+		MaxHeartRate              *int     `json:"max_hr,omitempty"`
+		RestingHeartRate          *int     `json:"resting_hr,omitempty"`
+		ThresholdHeartRate        *int     `json:"lthr,omitempty"`
+	}
+
+	// GetTrainingZonesResponse はトレーニングゾーン取得のレスポンス
+	GetTrainingZonesResponse struct {
+		PaceSource      string             `json:"pace_source,omitempty"`       // VDOT / ThresholdPace / RecentRuns
+		VDOT            float64            `json:"vdot,omitempty"`              // ペースゾーンの算出に使ったVDOT
+		PaceZones       []PaceZoneDTO      `json:"pace_zones"`                  // ペースゾーン（遅い順）
+		HeartRateMethod string             `json:"heart_rate_method,omitempty"` // Karvonen / LTHR
+		HeartRateZones  []HeartRateZoneDTO `json:"heart_rate_zones"`            // 心拍ゾーン1〜5
+		Notes           []string           `json:"notes,omitempty"`             // ゾーンを算出できなかった理由など
+	}
+
+	// PaceZoneDTO はペースゾーンのペース範囲
+	PaceZoneDTO struct {
+		Zone                string  `json:"zone"`
+		Fastest             string  `json:"fastest"` // M:SS/km
+		Slowest             string  `json:"slowest"` // M:SS/km
+		FastestSecondsPerKm float64 `json:"fastest_seconds_per_km"`
+		SlowestSecondsPerKm float64 `json:"slowest_seconds_per_km"`
+	}
+
+	// HeartRateZoneDTO は心拍ゾーンの範囲
+	HeartRateZoneDTO struct {
+		Zone     int `json:"zone"`
+		LowerBPM int `json:"lower_bpm"` // 0の場合は下限なし
+		UpperBPM int `json:"upper_bpm"`
+	}
+)
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/application/query/usecase"
+)
+
+// BodyMetricQueryHandler は身体測定値の読み取り系ハンドラー
+type BodyMetricQueryHandler struct {
+	metricsUC usecase.BodyMetricsUsecase
+}
+
+// NewBodyMetricQueryHandler は新しいBodyMetricQueryHandlerを作成します
+func NewBodyMetricQueryHandler(metricsUC usecase.BodyMetricsUsecase) *BodyMetricQueryHandler {
+	return &BodyMetricQueryHandler{
+		metricsUC: metricsUC,
+	}
+}
+
+// GetBodyMetrics は身体測定値を取得します
+func (h *BodyMetricQueryHandler) GetBodyMetrics(ctx context.Context, query dto.GetBodyMetricsQuery) (*dto.GetBodyMetricsResponse, error) {
+	return h.metricsUC.GetBodyMetrics(ctx, query)
+}
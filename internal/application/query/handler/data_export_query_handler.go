@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/application/query/usecase"
+)
+
+// DataExportQueryHandler はデータエクスポートの読み取り系ハンドラー
+type DataExportQueryHandler struct {
+	exportUC usecase.DataExportUsecase
+}
+
+// NewDataExportQueryHandler は新しいDataExportQueryHandlerを作成します
+func NewDataExportQueryHandler(exportUC usecase.DataExportUsecase) *DataExportQueryHandler {
+	return &DataExportQueryHandler{
+		exportUC: exportUC,
+	}
+}
+
+// ExportData は筋トレ・ラン・アスリートプロファイルをエクスポートします
+func (h *DataExportQueryHandler) ExportData(ctx context.Context, query dto.ExportDataQuery) (*dto.ExportDataResponse, error) {
+	return h.exportUC.ExportData(ctx, query)
+}
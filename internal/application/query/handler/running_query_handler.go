@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/application/query/usecase"
+)
+
+// RunningQueryHandler はランニングデータの読み取り系ハンドラー
+type RunningQueryHandler struct {
+	predictionUC usecase.RacePredictionUsecase
+	zonesUC      usecase.TrainingZonesUsecase
+	historyUC    usecase.RunningHistoryUsecase
+	goalsUC      usecase.RunningGoalsUsecase
+}
+
+// NewRunningQueryHandler は新しいRunningQueryHandlerを作成します
+func NewRunningQueryHandler(
+	predictionUC usecase.RacePredictionUsecase,
+	zonesUC usecase.TrainingZonesUsecase,
+	historyUC usecase.RunningHistoryUsecase,
+	goalsUC usecase.RunningGoalsUsecase,
+) *RunningQueryHandler {
+	return &RunningQueryHandler{
+		predictionUC: predictionUC,
+		zonesUC:      zonesUC,
+		historyUC:    historyUC,
+		goalsUC:      goalsUC,
+	}
+}
+
+// PredictRaceTimes はレースタイムを予測します
+func (h *RunningQueryHandler) PredictRaceTimes(ctx context.Context, query dto.PredictRaceTimesQuery) (*dto.PredictRaceTimesResponse, error) {
+	return h.predictionUC.PredictRaceTimes(ctx, query)
+}
+
+// GetTrainingZones はトレーニングゾーンを取得します
+func (h *RunningQueryHandler) GetTrainingZones(ctx context.Context, query dto.GetTrainingZonesQuery) (*dto.GetTrainingZonesResponse, error) {
+	return h.zonesUC.GetTrainingZones(ctx, query)
+}
+
+// GetRecentRuns は直近のランニングセッションを取得します
+func (h *RunningQueryHandler) GetRecentRuns(ctx context.Context, query dto.GetRecentRunsQuery) (*dto.GetRecentRunsResponse, error) {
+	return h.historyUC.GetRecentRuns(ctx, query)
+}
+
+// GetRunningGoals はランニング目標を取得します
+func (h *RunningQueryHandler) GetRunningGoals(ctx context.Context) (*dto.GetRunningGoalsResponse, error) {
+	return h.goalsUC.GetRunningGoals(ctx)
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"context"
+
 	"fitness-mcp-server/internal/application/query/dto"
 	"fitness-mcp-server/internal/application/query/usecase"
 )
@@ -23,11 +25,21 @@ func NewStrengthQueryHandler(
 }
 
 // GetTrainingsByDateRange は指定した期間のトレーニングセッションを取得します
-func (h *StrengthQueryHandler) GetTrainingsByDateRange(query dto.GetTrainingsByDateRangeQuery) (*dto.GetTrainingsByDateRangeResponse, error) {
-	return h.usecase.GetTrainingsByDateRange(query)
+func (h *StrengthQueryHandler) GetTrainingsByDateRange(ctx context.Context, query dto.GetTrainingsByDateRangeQuery) (*dto.GetTrainingsByDateRangeResponse, error) {
+	return h.usecase.GetTrainingsByDateRange(ctx, query)
+}
+
+// SearchTrainings は条件に一致する筋トレセッションを1ページ分検索します
+func (h *StrengthQueryHandler) SearchTrainings(ctx context.Context, query dto.SearchTrainingsQuery) (*dto.SearchTrainingsResponse, error) {
+	return h.usecase.SearchTrainings(ctx, query)
 }
 
 // GetPersonalRecords は個人記録を取得します
-func (h *StrengthQueryHandler) GetPersonalRecords(query dto.GetPersonalRecordsQuery) (*dto.GetPersonalRecordsResponse, error) {
-	return h.personalRecordsUC.GetPersonalRecords(query)
+func (h *StrengthQueryHandler) GetPersonalRecords(ctx context.Context, query dto.GetPersonalRecordsQuery) (*dto.GetPersonalRecordsResponse, error) {
+	return h.personalRecordsUC.GetPersonalRecords(ctx, query)
+}
+
+// GetPRHistory はエクササイズごとの時系列PR履歴を取得します
+func (h *StrengthQueryHandler) GetPRHistory(ctx context.Context, query dto.GetPRHistoryQuery) (*dto.GetPRHistoryResponse, error) {
+	return h.personalRecordsUC.GetPRHistory(ctx, query)
 }
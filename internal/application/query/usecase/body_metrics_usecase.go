@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/domain/body"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/query"
+)
+
+// DefaultBodyMetricDays は身体測定値のデフォルト対象期間（日数）です
+const DefaultBodyMetricDays = 90
+
+// BodyMetricsUsecase は身体測定値取得のユースケースインターフェース
+type BodyMetricsUsecase interface {
+	GetBodyMetrics(ctx context.Context, query dto.GetBodyMetricsQuery) (*dto.GetBodyMetricsResponse, error)
+}
+
+// bodyMetricsUsecaseImpl はBodyMetricsUsecaseの実装
+type bodyMetricsUsecaseImpl struct {
+	queryService query.BodyMetricQueryService
+	calendar     shared.Calendar
+}
+
+// NewBodyMetricsUsecase は新しいBodyMetricsUsecaseを作成します
+func NewBodyMetricsUsecase(queryService query.BodyMetricQueryService, calendar shared.Calendar) BodyMetricsUsecase {
+	return &bodyMetricsUsecaseImpl{
+		queryService: queryService,
+		calendar:     calendar,
+	}
+}
+
+// GetBodyMetrics は基準日までの指定日数の身体測定値を測定日時順に取得します
+func (u *bodyMetricsUsecaseImpl) GetBodyMetrics(ctx context.Context, query dto.GetBodyMetricsQuery) (*dto.GetBodyMetricsResponse, error) {
+	days := query.Days
+	if days <= 0 {
+		days = DefaultBodyMetricDays
+	}
+	if days > 3650 {
+		return nil, fmt.Errorf("period too long: maximum 3650 days allowed")
+	}
+
+	start, end := recentPeriod(u.calendar, query.ReferenceDate, days)
+
+	metrics, err := u.queryService.FindByDateRange(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get body metrics: %w", err)
+	}
+
+	response := &dto.GetBodyMetricsResponse{
+		Period:  fmt.Sprintf("%s 〜 %s", start.Format("2006-01-02"), end.Format("2006-01-02")),
+		Count:   len(metrics),
+		Metrics: make([]dto.BodyMetricDTO, 0, len(metrics)),
+	}
+	for _, metric := range metrics {
+		response.Metrics = append(response.Metrics, toBodyMetricDTO(metric))
+	}
+	if len(metrics) > 0 {
+		latest := metrics[len(metrics)-1].Weight().Kg()
+		response.LatestWeightKg = &latest
+	}
+	if len(metrics) > 1 {
+		change := math.Round((metrics[len(metrics)-1].Weight().Kg()-metrics[0].Weight().Kg())*10) / 10
+		response.WeightChangeKg = &change
+	}
+
+	return response, nil
+}
+
+// toBodyMetricDTO は身体測定値をDTOに変換します
+func toBodyMetricDTO(metric *body.Metric) dto.BodyMetricDTO {
+	result := dto.BodyMetricDTO{
+		ID:       metric.ID().String(),
+		Date:     metric.Date(),
+		WeightKg: metric.Weight().Kg(),
+		Notes:    metric.Notes(),
+	}
+	if metric.BodyFat() != nil {
+		percent := metric.BodyFat().Percent()
+		result.BodyFatPercent = &percent
+	}
+	return result
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fitness-mcp-server/internal/application/exchange"
+	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/interface/query"
+)
+
+// DataExportUsecase はデータエクスポートのユースケースインターフェース
+type DataExportUsecase interface {
+	ExportData(ctx context.Context, query dto.ExportDataQuery) (*dto.ExportDataResponse, error)
+}
+
+// dataExportUsecaseImpl はDataExportUsecaseの実装
+type dataExportUsecaseImpl struct {
+	strengthQueryService query.StrengthQueryService
+	runningQueryService  query.RunningQueryService
+	bodyQueryService     query.BodyMetricQueryService
+}
+
+// NewDataExportUsecase は新しいDataExportUsecaseを作成します
+func NewDataExportUsecase(
+	strengthQueryService query.StrengthQueryService,
+	runningQueryService query.RunningQueryService,
+	bodyQueryService query.BodyMetricQueryService,
+) DataExportUsecase {
+	return &dataExportUsecaseImpl{
+		strengthQueryService: strengthQueryService,
+		runningQueryService:  runningQueryService,
+		bodyQueryService:     bodyQueryService,
+	}
+}
+
+// ExportData は筋トレ・ラン・身体測定値・アスリートプロファイル・目標を指定した形式でエクスポートします
+// プロファイルと目標は期間の指定に関わらずすべてエクスポートします
+func (u *dataExportUsecaseImpl) ExportData(ctx context.Context, query dto.ExportDataQuery) (*dto.ExportDataResponse, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 期間の指定がなければ全期間（期間は開始日・終了日を含む）
+	start, end := time.Time{}, time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	if query.StartDate != nil {
+		start, end = *query.StartDate, *query.EndDate
+	}
+
+	trainings, err := u.strengthQueryService.FindByDateRange(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get strength trainings: %w", err)
+	}
+	sessions, err := u.runningQueryService.FindByDateRange(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get running sessions: %w", err)
+	}
+	profile, err := u.runningQueryService.FindAthleteProfile(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get athlete profile: %w", err)
+	}
+	goals, err := u.runningQueryService.FindGoals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get running goals: %w", err)
+	}
+	metrics, err := u.bodyQueryService.FindByDateRange(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get body metrics: %w", err)
+	}
+
+	document := &exchange.Document{
+		SchemaVersion:     exchange.SchemaVersion,
+		ExportedAt:        time.Now(),
+		StartDate:         query.StartDate,
+		EndDate:           query.EndDate,
+		StrengthTrainings: make([]exchange.StrengthTraining, 0, len(trainings)),
+		Runs:              make([]exchange.Run, 0, len(sessions)),
+		AthleteProfile:    exchange.FromAthleteProfile(profile),
+		Goals:             make([]exchange.Goal, 0, len(goals)),
+		BodyMetrics:       make([]exchange.BodyMetric, 0, len(metrics)),
+	}
+	for _, training := range trainings {
+		document.StrengthTrainings = append(document.StrengthTrainings, exchange.FromStrengthTraining(training))
+	}
+	for _, session := range sessions {
+		document.Runs = append(document.Runs, exchange.FromRunningSession(session))
+	}
+	for _, goal := range goals {
+		document.Goals = append(document.Goals, exchange.FromRunningGoal(goal))
+	}
+	for _, metric := range metrics {
+		document.BodyMetrics = append(document.BodyMetrics, exchange.FromBodyMetric(metric))
+	}
+
+	files, err := exchange.Render(document, query.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	var written []string
+	if query.OutputPath != "" {
+		written, err = exchange.WriteFiles(query.OutputPath, files)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := &dto.ExportDataResponse{
+		Format:            query.Format,
+		SchemaVersion:     exchange.SchemaVersion,
+		StrengthTrainings: len(document.StrengthTrainings),
+		Runs:              len(document.Runs),
+		AthleteProfile:    document.AthleteProfile != nil,
+		Goals:             len(document.Goals),
+		BodyMetrics:       len(document.BodyMetrics),
+		Files:             make([]dto.ExportedFile, 0, len(files)),
+		WrittenPaths:      written,
+	}
+	for _, file := range files {
+		response.Files = append(response.Files, dto.ExportedFile{Name: file.Name, Content: file.Content})
+	}
+	return response, nil
+}
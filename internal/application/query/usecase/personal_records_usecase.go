@@ -1,16 +1,19 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
 
 	query_dto "fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/domain/strength"
 	"fitness-mcp-server/internal/interface/query"
 )
 
 // personalRecordsUsecaseImpl は個人記録に関するクエリユースケース
 type (
 	PersonalRecordsUsecase interface {
-		GetPersonalRecords(query query_dto.GetPersonalRecordsQuery) (*query_dto.GetPersonalRecordsResponse, error)
+		GetPersonalRecords(ctx context.Context, query query_dto.GetPersonalRecordsQuery) (*query_dto.GetPersonalRecordsResponse, error)
+		GetPRHistory(ctx context.Context, query query_dto.GetPRHistoryQuery) (*query_dto.GetPRHistoryResponse, error)
 	}
 	personalRecordsUsecaseImpl struct {
 		queryService query.StrengthQueryService
@@ -25,9 +28,9 @@ func NewPersonalRecordsUsecase(queryService query.StrengthQueryService) Personal
 }
 
 // GetPersonalRecords は個人記録を取得します
-func (u *personalRecordsUsecaseImpl) GetPersonalRecords(query query_dto.GetPersonalRecordsQuery) (*query_dto.GetPersonalRecordsResponse, error) {
+func (u *personalRecordsUsecaseImpl) GetPersonalRecords(ctx context.Context, query query_dto.GetPersonalRecordsQuery) (*query_dto.GetPersonalRecordsResponse, error) {
 	// クエリサービスから生データを取得
-	queryResults, err := u.queryService.GetPersonalRecords(query.ExerciseName)
+	queryResults, err := u.queryService.GetPersonalRecords(ctx, query.ExerciseName, query.CarryDistanceMeters)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get personal records: %w", err)
 	}
@@ -46,6 +49,47 @@ func (u *personalRecordsUsecaseImpl) GetPersonalRecords(query query_dto.GetPerso
 	return response, nil
 }
 
+// GetPRHistory はエクササイズごとの時系列PR履歴を取得します
+func (u *personalRecordsUsecaseImpl) GetPRHistory(ctx context.Context, query query_dto.GetPRHistoryQuery) (*query_dto.GetPRHistoryResponse, error) {
+	if query.RecordType != nil {
+		if _, err := strength.NewRecordType(*query.RecordType); err != nil {
+			return nil, err
+		}
+	}
+
+	queryResults, err := u.queryService.GetPersonalRecordHistory(ctx, query.ExerciseName, query.RecordType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal record history: %w", err)
+	}
+
+	// エクササイズごとにまとめる（クエリ結果はエクササイズ名・日付順）
+	timelines := make([]query_dto.PRTimeline, 0)
+	for _, queryResult := range queryResults {
+		if len(timelines) == 0 || timelines[len(timelines)-1].ExerciseName != queryResult.ExerciseName {
+			timelines = append(timelines, query_dto.PRTimeline{
+				ExerciseName: queryResult.ExerciseName,
+				Events:       make([]query_dto.PREvent, 0),
+			})
+		}
+		timeline := &timelines[len(timelines)-1]
+		timeline.Events = append(timeline.Events, query_dto.PREvent{
+			Date:          queryResult.Date,
+			RecordType:    queryResult.RecordType,
+			Label:         strength.RecordType(queryResult.RecordType).Label(queryResult.Reps),
+			Reps:          queryResult.Reps,
+			Value:         queryResult.Value,
+			PreviousValue: queryResult.PreviousValue,
+			TrainingID:    queryResult.TrainingID,
+			SetDetails:    convertSetQueryDetailsToDTO(queryResult.SetDetails),
+		})
+	}
+
+	return &query_dto.GetPRHistoryResponse{
+		Timelines: timelines,
+		Count:     len(queryResults),
+	}, nil
+}
+
 // convertQueryResultToDTO はQuery結果をDTOに変換します
 func convertQueryResultToDTO(queryResult query_dto.PersonalRecordQueryResult) query_dto.PersonalRecord {
 	return query_dto.PersonalRecord{
@@ -68,11 +112,27 @@ func convertQueryResultToDTO(queryResult query_dto.PersonalRecordQueryResult) qu
 			TrainingID: queryResult.MaxVolume.TrainingID,
 			SetDetails: convertSetQueryDetailsToDTO(queryResult.MaxVolume.SetDetails),
 		},
+		LongestHold:   convertOptionalDetailToDTO(queryResult.LongestHold),
+		HeaviestCarry: convertOptionalDetailToDTO(queryResult.HeaviestCarry),
 		TotalSessions: queryResult.TotalSessions,
 		LastPerformed: queryResult.LastPerformed,
 	}
 }
 
+// convertOptionalDetailToDTO はオプションの記録詳細をDTOに変換します
+func convertOptionalDetailToDTO(detail *query_dto.PersonalRecordQueryDetail) *query_dto.PersonalRecordDetail {
+	if detail == nil {
+		return nil
+	}
+
+	return &query_dto.PersonalRecordDetail{
+		Value:      detail.Value,
+		Date:       detail.Date,
+		TrainingID: detail.TrainingID,
+		SetDetails: convertSetQueryDetailsToDTO(detail.SetDetails),
+	}
+}
+
 // convertSetQueryDetailsToDTO はQuery SetDetailsをDTO SetInfoに変換します
 func convertSetQueryDetailsToDTO(setDetails *query_dto.SetQueryDetails) *query_dto.SetInfo {
 	if setDetails == nil {
@@ -80,8 +140,10 @@ func convertSetQueryDetailsToDTO(setDetails *query_dto.SetQueryDetails) *query_d
 	}
 
 	return &query_dto.SetInfo{
-		WeightKg: setDetails.WeightKg,
-		Reps:     setDetails.Reps,
-		RPE:      setDetails.RPE,
+		WeightKg:        setDetails.WeightKg,
+		Reps:            setDetails.Reps,
+		DurationSeconds: setDetails.DurationSeconds,
+		DistanceMeters:  setDetails.DistanceMeters,
+		RPE:             setDetails.RPE,
 	}
 }
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+
+	"fmt"
+
+	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/query"
+)
+
+// DefaultPredictionDays は予測の基準にするセッションのデフォルト対象期間（日数）です
+const DefaultPredictionDays = 90
+
+// RacePredictionUsecase はレースタイム予測のユースケースインターフェース
+type RacePredictionUsecase interface {
+	PredictRaceTimes(ctx context.Context, query dto.PredictRaceTimesQuery) (*dto.PredictRaceTimesResponse, error)
+}
+
+// racePredictionUsecaseImpl はRacePredictionUsecaseの実装
+type racePredictionUsecaseImpl struct {
+	queryService query.RunningQueryService
+	predictor    *running.RacePredictionService
+	calendar     shared.Calendar
+}
+
+// NewRacePredictionUsecase は新しいRacePredictionUsecaseを作成します
+func NewRacePredictionUsecase(queryService query.RunningQueryService, calendar shared.Calendar) RacePredictionUsecase {
+	return &racePredictionUsecaseImpl{
+		queryService: queryService,
+		predictor:    running.NewRacePredictionService(),
+		calendar:     calendar,
+	}
+}
+
+// PredictRaceTimes は最近のRace・Tempoセッションから各種目のタイムを予測します
+func (u *racePredictionUsecaseImpl) PredictRaceTimes(ctx context.Context, query dto.PredictRaceTimesQuery) (*dto.PredictRaceTimesResponse, error) {
+	days := query.Days
+	if days <= 0 {
+		days = DefaultPredictionDays
+	}
+	if days > 365 {
+		return nil, fmt.Errorf("period too long: maximum 365 days allowed")
+	}
+
+	start, end := recentPeriod(u.calendar, query.ReferenceDate, days)
+
+	sessions, err := u.queryService.FindByDateRange(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get running sessions: %w", err)
+	}
+
+	basisCount := 0
+	for _, session := range sessions {
+		if running.IsPredictionBasis(session) {
+			basisCount++
+		}
+	}
+
+	response := &dto.PredictRaceTimesResponse{
+		Period:      fmt.Sprintf("%s 〜 %s", start.Format("2006-01-02"), end.Format("2006-01-02")),
+		BasisCount:  basisCount,
+		Predictions: make([]dto.EventPrediction, 0),
+	}
+	if basisCount == 0 {
+		return response, nil
+	}
+
+	predictions, err := u.predictor.Predict(sessions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to predict race times: %w", err)
+	}
+
+	// 種目ごとにまとめる（ドメインサービスは種目・モデル順に返す）
+	for _, prediction := range predictions {
+		eventType := prediction.EventType().String()
+		if len(response.Predictions) == 0 || response.Predictions[len(response.Predictions)-1].EventType != eventType {
+			distance, err := prediction.EventType().GetStandardDistance()
+			if err != nil {
+				return nil, err
+			}
+			response.Predictions = append(response.Predictions, dto.EventPrediction{
+				EventType:  eventType,
+				DistanceKm: distance.Km(),
+				Models:     make([]dto.ModelPrediction, 0),
+			})
+		}
+
+		pace, err := prediction.PredictedPace()
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate predicted pace: %w", err)
+		}
+
+		basis := prediction.Basis()
+		event := &response.Predictions[len(response.Predictions)-1]
+		event.Models = append(event.Models, dto.ModelPrediction{
+			Model:            prediction.Model().String(),
+			PredictedSeconds: int(prediction.PredictedTime().Seconds()),
+			PredictedTime:    prediction.PredictedTime().Clock(),
+			Pace:             pace.String(),
+			Basis: dto.BasisSession{
+				SessionID:  basis.ID().String(),
+				Date:       basis.Date(),
+				RunType:    basis.RunType().String(),
+				DistanceKm: basis.Distance().Km(),
+				Duration:   basis.Duration().Clock(),
+				Pace:       basis.Pace().String(),
+			},
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/interface/query"
+)
+
+// RunningGoalsUsecase はランニング目標取得のユースケースインターフェース
+type RunningGoalsUsecase interface {
+	GetRunningGoals(ctx context.Context) (*dto.GetRunningGoalsResponse, error)
+}
+
+// runningGoalsUsecaseImpl はRunningGoalsUsecaseの実装
+type runningGoalsUsecaseImpl struct {
+	queryService query.RunningQueryService
+}
+
+// NewRunningGoalsUsecase は新しいRunningGoalsUsecaseを作成します
+func NewRunningGoalsUsecase(queryService query.RunningQueryService) RunningGoalsUsecase {
+	return &runningGoalsUsecaseImpl{
+		queryService: queryService,
+	}
+}
+
+// GetRunningGoals はすべてのランニング目標を作成日時順に取得します
+func (u *runningGoalsUsecaseImpl) GetRunningGoals(ctx context.Context) (*dto.GetRunningGoalsResponse, error) {
+	goals, err := u.queryService.FindGoals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get running goals: %w", err)
+	}
+
+	response := &dto.GetRunningGoalsResponse{
+		Count: len(goals),
+		Goals: make([]dto.RunningGoalDTO, 0, len(goals)),
+	}
+	for _, goal := range goals {
+		if goal.Status().IsActive() {
+			response.ActiveCount++
+		}
+		response.Goals = append(response.Goals, toRunningGoalDTO(goal))
+	}
+	return response, nil
+}
+
+// toRunningGoalDTO はランニング目標をDTOに変換します
+func toRunningGoalDTO(goal *running.RunningGoal) dto.RunningGoalDTO {
+	return dto.RunningGoalDTO{
+		ID:             goal.ID().String(),
+		EventType:      goal.EventType().String(),
+		DistanceKm:     goal.Distance().Km(),
+		TargetTime:     goal.TargetTime().Clock(),
+		TargetPace:     goal.TargetPace().String(),
+		EventDate:      goal.EventDate(),
+		DaysUntilEvent: goal.DaysUntilEvent(),
+		Status:         goal.Status().String(),
+		Description:    goal.Description(),
+		CreatedAt:      goal.CreatedAt(),
+		AchievedAt:     goal.AchievedAt(),
+	}
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+
+	"fmt"
+	"math"
+	"time"
+
+	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/query"
+)
+
+// DefaultRecentRunDays は直近のランニングのデフォルト対象期間（日数）です
+const DefaultRecentRunDays = 28
+
+// RunningHistoryUsecase はランニング履歴取得のユースケースインターフェース
+type RunningHistoryUsecase interface {
+	GetRecentRuns(ctx context.Context, query dto.GetRecentRunsQuery) (*dto.GetRecentRunsResponse, error)
+}
+
+// runningHistoryUsecaseImpl はRunningHistoryUsecaseの実装
+type runningHistoryUsecaseImpl struct {
+	queryService query.RunningQueryService
+	calendar     shared.Calendar
+}
+
+// NewRunningHistoryUsecase は新しいRunningHistoryUsecaseを作成します
+func NewRunningHistoryUsecase(queryService query.RunningQueryService, calendar shared.Calendar) RunningHistoryUsecase {
+	return &runningHistoryUsecaseImpl{
+		queryService: queryService,
+		calendar:     calendar,
+	}
+}
+
+// GetRecentRuns は基準日までの指定日数のランニングセッションを新しい順に取得します
+func (u *runningHistoryUsecaseImpl) GetRecentRuns(ctx context.Context, query dto.GetRecentRunsQuery) (*dto.GetRecentRunsResponse, error) {
+	days := query.Days
+	if days <= 0 {
+		days = DefaultRecentRunDays
+	}
+	if days > 365 {
+		return nil, fmt.Errorf("period too long: maximum 365 days allowed")
+	}
+
+	start, end := recentPeriod(u.calendar, query.ReferenceDate, days)
+
+	sessions, err := u.queryService.FindByDateRange(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get running sessions: %w", err)
+	}
+
+	response := &dto.GetRecentRunsResponse{
+		Period: fmt.Sprintf("%s 〜 %s", start.Format("2006-01-02"), end.Format("2006-01-02")),
+		Count:  len(sessions),
+		Runs:   make([]dto.RunDTO, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		response.TotalDistanceKm += session.Distance().Km()
+		response.Runs = append(response.Runs, toRunDTO(session))
+	}
+	response.TotalDistanceKm = math.Round(response.TotalDistanceKm*100) / 100
+
+	return response, nil
+}
+
+// recentPeriod は基準日（ゼロ値の場合は今日）までの指定日数の期間を、ローカル日付の初日の0時と最終日の終わりで返します
+func recentPeriod(calendar shared.Calendar, referenceDate time.Time, days int) (time.Time, time.Time) {
+	if referenceDate.IsZero() {
+		referenceDate = time.Now()
+	}
+	start := calendar.StartOfDay(referenceDate).AddDate(0, 0, -(days - 1))
+	return start, calendar.EndOfDay(referenceDate)
+}
+
+// toRunDTO はランニングセッションをDTOに変換します
+func toRunDTO(session *running.RunningSession) dto.RunDTO {
+	run := dto.RunDTO{
+		ID:              session.ID().String(),
+		Date:            session.Date(),
+		RunType:         session.RunType().String(),
+		DistanceKm:      session.Distance().Km(),
+		DurationSeconds: int(math.Round(session.Duration().Seconds())),
+		Duration:        session.Duration().Clock(),
+		Pace:            session.Pace().String(),
+		Laps:            len(session.Laps()),
+		Notes:           session.Notes(),
+	}
+	if session.HeartRate() != nil {
+		bpm := session.HeartRate().BPM()
+		run.HeartRateBPM = &bpm
+	}
+	return run
+}
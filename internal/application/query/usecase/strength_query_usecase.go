@@ -1,16 +1,21 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/domain/strength"
 	"fitness-mcp-server/internal/interface/query"
 )
 
 // StrengthQueryUsecase は筋トレデータの読み取り系ユースケースインターフェース
 type StrengthQueryUsecase interface {
-	GetTrainingsByDateRange(query dto.GetTrainingsByDateRangeQuery) (*dto.GetTrainingsByDateRangeResponse, error)
+	GetTrainingsByDateRange(ctx context.Context, query dto.GetTrainingsByDateRangeQuery) (*dto.GetTrainingsByDateRangeResponse, error)
+	SearchTrainings(ctx context.Context, query dto.SearchTrainingsQuery) (*dto.SearchTrainingsResponse, error)
 }
 
 // strengthQueryUsecaseImpl はStrengthQueryUsecaseの実装
@@ -26,7 +31,7 @@ func NewStrengthQueryUsecase(queryService query.StrengthQueryService) StrengthQu
 }
 
 // GetTrainingsByDateRange は指定した期間のトレーニングセッションを取得します
-func (u *strengthQueryUsecaseImpl) GetTrainingsByDateRange(query dto.GetTrainingsByDateRangeQuery) (*dto.GetTrainingsByDateRangeResponse, error) {
+func (u *strengthQueryUsecaseImpl) GetTrainingsByDateRange(ctx context.Context, query dto.GetTrainingsByDateRangeQuery) (*dto.GetTrainingsByDateRangeResponse, error) {
 	// 入力値の検証
 	if query.StartDate.After(query.EndDate) {
 		return nil, fmt.Errorf("start date must be before or equal to end date")
@@ -38,15 +43,27 @@ func (u *strengthQueryUsecaseImpl) GetTrainingsByDateRange(query dto.GetTraining
 		return nil, fmt.Errorf("period too long: maximum 1 year allowed")
 	}
 
+	// 絞り込み条件の変換
+	criteria, err := query.SetCriteria()
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
 	// クエリサービスからデータを取得
-	trainings, err := u.queryService.FindByDateRange(query.StartDate, query.EndDate)
+	trainings, err := u.queryService.FindByDateRange(ctx, query.StartDate, query.EndDate)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get trainings by date range: %w", err)
 	}
 
-	// ドメインエンティティをDTOに変換
+	// ドメインエンティティをDTOに変換（条件指定時は一致するセットのみ）
 	trainingDTOs := make([]*dto.TrainingDTO, 0, len(trainings))
 	for _, training := range trainings {
+		if !criteria.IsEmpty() {
+			training = training.FilterSets(criteria)
+			if training.ExerciseCount() == 0 {
+				continue
+			}
+		}
 		trainingDTOs = append(trainingDTOs, dto.TrainingToDTO(training))
 	}
 
@@ -59,5 +76,111 @@ func (u *strengthQueryUsecaseImpl) GetTrainingsByDateRange(query dto.GetTraining
 		Trainings: trainingDTOs,
 		Count:     len(trainingDTOs),
 		Period:    period,
+		Filter:    describeCriteria(criteria),
+	}, nil
+}
+
+// SearchTrainings は条件に一致するセットを含む筋トレセッションを並び順に1ページ分検索します
+// セッションは一致しないセットも含めて全てのセットを返します
+func (u *strengthQueryUsecaseImpl) SearchTrainings(ctx context.Context, query dto.SearchTrainingsQuery) (*dto.SearchTrainingsResponse, error) {
+	// 入力値の検証（エクササイズの別名はこのアプリのエクササイズ名に解決する）
+	criteria, err := query.SearchCriteria()
+	if err != nil {
+		return nil, fmt.Errorf("invalid search: %w", err)
+	}
+	setCriteria, err := criteria.SetCriteria()
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	result, err := u.queryService.Search(ctx, criteria)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search trainings: %w", err)
+	}
+
+	trainingDTOs := make([]*dto.TrainingDTO, 0, len(result.Trainings))
+	for _, training := range result.Trainings {
+		trainingDTOs = append(trainingDTOs, dto.TrainingToDTO(training))
+	}
+
+	// セッションの条件の説明を加える
+	var conditions []string
+	if filter := describeCriteria(setCriteria); filter != "" {
+		conditions = append(conditions, filter)
+	}
+	if criteria.NotesContains != nil {
+		conditions = append(conditions, fmt.Sprintf("notes contains %q", *criteria.NotesContains))
+	}
+	if len(criteria.Weekdays) > 0 {
+		weekdays := make([]string, len(criteria.Weekdays))
+		for i, weekday := range criteria.Weekdays {
+			weekdays[i] = weekday.String()[:3]
+		}
+		conditions = append(conditions, strings.Join(weekdays, "/"))
+	}
+	if criteria.StartDate != nil {
+		conditions = append(conditions, "from "+criteria.StartDate.Format("2006-01-02"))
+	}
+	if criteria.EndDate != nil {
+		conditions = append(conditions, "to "+criteria.EndDate.Format("2006-01-02"))
+	}
+
+	return &dto.SearchTrainingsResponse{
+		Trainings:  trainingDTOs,
+		Count:      len(trainingDTOs),
+		Sort:       criteria.Sort,
+		Filter:     strings.Join(conditions, ", "),
+		NextCursor: result.NextCursor,
 	}, nil
 }
+
+// describeCriteria は絞り込み条件の説明文を作成します
+func describeCriteria(criteria strength.SetCriteria) string {
+	var conditions []string
+	if criteria.ExerciseName != nil {
+		conditions = append(conditions, criteria.ExerciseName.String())
+	}
+	if criteria.Variation != nil {
+		conditions = append(conditions, criteria.Variation.String())
+	}
+	if criteria.RangeOfMotion != nil {
+		conditions = append(conditions, criteria.RangeOfMotion.String())
+	}
+	if criteria.TempoOnly {
+		conditions = append(conditions, "tempo")
+	}
+	if criteria.MinWeightKg != nil || criteria.MaxWeightKg != nil {
+		conditions = append(conditions, describeRange(criteria.MinWeightKg, criteria.MaxWeightKg, "kg"))
+	}
+	if criteria.MinReps != nil || criteria.MaxReps != nil {
+		conditions = append(conditions, describeRange(toFloat(criteria.MinReps), toFloat(criteria.MaxReps), " reps"))
+	}
+	if criteria.MinRPE != nil || criteria.MaxRPE != nil {
+		conditions = append(conditions, "RPE "+describeRange(criteria.MinRPE, criteria.MaxRPE, ""))
+	}
+	return strings.Join(conditions, ", ")
+}
+
+// describeRange は範囲の説明文を作成します（例: 80-100kg、>=80kg、<=5 reps）
+func describeRange(min, max *float64, unit string) string {
+	format := func(value float64) string { return strconv.FormatFloat(value, 'f', -1, 64) }
+	switch {
+	case min != nil && max != nil && *min == *max:
+		return format(*min) + unit
+	case min != nil && max != nil:
+		return format(*min) + "-" + format(*max) + unit
+	case min != nil:
+		return ">=" + format(*min) + unit
+	default:
+		return "<=" + format(*max) + unit
+	}
+}
+
+// toFloat は整数のオプション値を小数に変換します
+func toFloat(value *int) *float64 {
+	if value == nil {
+		return nil
+	}
+	converted := float64(*value)
+	return &converted
+}
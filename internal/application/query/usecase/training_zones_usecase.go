@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+
+	"fmt"
+	"time"
+
+	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/query"
+)
+
+// TrainingZonesUsecase はトレーニングゾーン取得のユースケースインターフェース
+type TrainingZonesUsecase interface {
+	GetTrainingZones(ctx context.Context, query dto.GetTrainingZonesQuery) (*dto.GetTrainingZonesResponse, error)
+}
+
+// trainingZonesUsecaseImpl はTrainingZonesUsecaseの実装
+type trainingZonesUsecaseImpl struct {
+	queryService   query.RunningQueryService
+	defaultProfile *running.AthleteProfile // プロファイルが未登録の場合に使用する設定の基準値（nilの場合はなし）
+	calendar       shared.Calendar
+}
+
+// NewTrainingZonesUsecase は新しいTrainingZonesUsecaseを作成します
+func NewTrainingZonesUsecase(queryService query.RunningQueryService, defaultProfile *running.AthleteProfile, calendar shared.Calendar) TrainingZonesUsecase {
+	return &trainingZonesUsecaseImpl{
+		queryService:   queryService,
+		defaultProfile: defaultProfile,
+		calendar:       calendar,
+	}
+}
+
+// GetTrainingZones はアスリートプロファイル（またはクエリの指定値）からペースゾーンと心拍ゾーンを計算します
+func (u *trainingZonesUsecaseImpl) GetTrainingZones(ctx context.Context, query dto.GetTrainingZonesQuery) (*dto.GetTrainingZonesResponse, error) {
+	profile, err := u.queryService.FindAthleteProfile(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get athlete profile: %w", err)
+	}
+	if profile == nil {
+		profile = u.defaultProfile
+	}
+
+	profile, err = applyZoneOverrides(profile, query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.GetTrainingZonesResponse{
+		PaceZones:      make([]dto.PaceZoneDTO, 0),
+		HeartRateZones: make([]dto.HeartRateZoneDTO, 0),
+		Notes:          make([]string, 0),
+	}
+
+	// ペースゾーン（プロファイルにVDOT・閾値ペースがなければ直近のレース・テンポ走から推定）
+	start, end := recentPeriod(u.calendar, time.Time{}, DefaultPredictionDays)
+	recent, err := u.queryService.FindByDateRange(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get running sessions: %w", err)
+	}
+
+	paces, source, err := running.ResolveTrainingPaces(profile, recent)
+	if err != nil {
+		response.Notes = append(response.Notes, fmt.Sprintf("ペースゾーンを算出できません: VDOT・閾値ペースを設定するか、直近%d日以内にレース・テンポ走を記録してください", DefaultPredictionDays))
+	} else {
+		response.PaceSource = string(source)
+		response.VDOT = paces.VDOT()
+		for _, paceRange := range paces.Ranges() {
+			response.PaceZones = append(response.PaceZones, dto.PaceZoneDTO{
+				Zone:                paceRange.Zone().String(),
+				Fastest:             paceRange.Fastest().String(),
+				Slowest:             paceRange.Slowest().String(),
+				FastestSecondsPerKm: paceRange.Fastest().SecondsPerKm(),
+				SlowestSecondsPerKm: paceRange.Slowest().SecondsPerKm(),
+			})
+		}
+	}
+
+	// 心拍ゾーン
+	heartRateZones, err := profile.HeartRateZones()
+	if err != nil {
+		response.Notes = append(response.Notes, "心拍ゾーンを算出できません: 最大心拍数と安静時心拍数、またはLTHRを設定してください")
+	} else {
+		response.HeartRateMethod = heartRateZones.Method()
+		for _, zone := range heartRateZones.Zones() {
+			response.HeartRateZones = append(response.HeartRateZones, dto.HeartRateZoneDTO{
+				Zone:     zone.Number(),
+				LowerBPM: zone.Lower(),
+				UpperBPM: zone.Upper(),
+			})
+		}
+	}
+
+	return response, nil
+}
+
+// applyZoneOverrides はクエリで指定された値を保存済みのプロファイルに上書きしたプロファイルを返します
+// VDOTまたは閾値ペースを指定した場合は、保存済みのVDOT・閾値ペースは使用しません
+func applyZoneOverrides(stored *running.AthleteProfile, query dto.GetTrainingZonesQuery) (*running.AthleteProfile, error) {
+	profile := running.NewAthleteProfile()
+
+	if stored != nil {
+		if err := profile.SetHeartRates(stored.MaxHeartRate(), stored.RestingHeartRate(), stored.ThresholdHeartRate()); err != nil {
+			return nil, err
+		}
+		if query.VDOT == nil && query.ThresholdPaceSecondsPerKm == nil {
+			if stored.VDOT() != nil {
+				if err := profile.SetVDOT(*stored.VDOT()); err != nil {
+					return nil, err
+				}
+			}
+			if stored.ThresholdPace() != nil {
+				profile.SetThresholdPace(*stored.ThresholdPace())
+			}
+		}
+	}
+
+	if query.VDOT != nil {
+		if err := profile.SetVDOT(*query.VDOT); err != nil {
+			return nil, err
+		}
+	}
+	if query.ThresholdPaceSecondsPerKm != nil {
+		pace, err := running.NewPace(*query.ThresholdPaceSecondsPerKm / 60)
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold pace: %w", err)
+		}
+		profile.SetThresholdPace(pace)
+	}
+
+	// 最大・安静時心拍数のどちらかだけ指定した場合は保存済みの値と組み合わせる
+	toHeartRate := func(bpm *int) (*running.HeartRate, error) {
+		if bpm == nil {
+			return nil, nil
+		}
+		heartRate, err := running.NewHeartRate(*bpm)
+		if err != nil {
+			return nil, err
+		}
+		return &heartRate, nil
+	}
+	maxHR, err := toHeartRate(query.MaxHeartRate)
+	if err != nil {
+		return nil, err
+	}
+	restingHR, err := toHeartRate(query.RestingHeartRate)
+	if err != nil {
+		return nil, err
+	}
+	lthr, err := toHeartRate(query.ThresholdHeartRate)
+	if err != nil {
+		return nil, err
+	}
+	if err := profile.SetHeartRates(maxHR, restingHR, lthr); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
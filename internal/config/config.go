@@ -1,69 +1,234 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 )
 
 // Config はアプリケーションの設定を管理します
+// 既定値・設定ファイル（YAML）・環境変数の順に読み込み、後から読み込んだ値を優先します
 type Config struct {
-	Database DatabaseConfig `json:"database"`
-	MCP      MCPConfig      `json:"mcp"`
-	Server   ServerConfig   `json:"server"`
+	Database  DatabaseConfig  `json:"database" yaml:"database"`
+	Backup    BackupConfig    `json:"backup" yaml:"backup"`
+	MCP       MCPConfig       `json:"mcp" yaml:"mcp"`
+	Transport TransportConfig `json:"transport" yaml:"transport"`
+	Auth      AuthConfig      `json:"auth" yaml:"auth"`
+	Server    ServerConfig    `json:"server" yaml:"server"`
+	Units     UnitsConfig     `json:"units" yaml:"units"`
+	Timezone  string          `json:"timezone" yaml:"timezone"` // ユーザーのタイムゾーン（IANA名、Localは実行環境のタイムゾーン）
+	Plates    PlatesConfig    `json:"plates" yaml:"plates"`
+	Zones     ZonesConfig     `json:"zones" yaml:"zones"`
+	Features  FeaturesConfig  `json:"features" yaml:"features"`
+
+	// envErrors は環境変数の値を解釈できなかったエラー（Validateでまとめて報告する）
+	envErrors []string
 }
 
 // DatabaseConfig はデータベース関連の設定です
 type DatabaseConfig struct {
-	SQLitePath      string `json:"sqlite_path"`
-	MaxOpenConns    int    `json:"max_open_conns"`
-	MaxIdleConns    int    `json:"max_idle_conns"`
-	ConnMaxLifetime int    `json:"conn_max_lifetime_hours"`
+	SQLitePath      string `json:"sqlite_path" yaml:"sqlite_path"`
+	MaxOpenConns    int    `json:"max_open_conns" yaml:"max_open_conns"`
+	MaxIdleConns    int    `json:"max_idle_conns" yaml:"max_idle_conns"`
+	ConnMaxLifetime int    `json:"conn_max_lifetime_hours" yaml:"conn_max_lifetime_hours"`
+	BusyTimeoutMs   int    `json:"busy_timeout_ms" yaml:"busy_timeout_ms"` // ロック解除を待つ時間
+}
+
+// BackupConfig はデータベースのスナップショット関連の設定です
+type BackupConfig struct {
+	Dir      string `json:"dir" yaml:"dir"`             // スナップショットの保存先（空の場合はデータベースと同じディレクトリのbackups）
+	KeepLast int    `json:"keep_last" yaml:"keep_last"` // 新しい順に残すスナップショット数
+	KeepDays int    `json:"keep_days" yaml:"keep_days"` // 日ごとに最新の1件を残す日数
 }
 
 // MCPConfig はMCPサーバー関連の設定です
 type MCPConfig struct {
-	Name        string `json:"name"`
-	Version     string `json:"version"`
-	Description string `json:"description"`
+	Name        string `json:"name" yaml:"name"`
+	Version     string `json:"version" yaml:"version"`
+	Description string `json:"description" yaml:"description"`
+}
+
+// トランスポートの種類
+const (
+	TransportStdio          = "stdio" // 標準入出力（MCPクライアントがプロセスを起動する）
+	TransportSSE            = "sse"   // HTTP + Server-Sent Events（GET /sse・POST /message）
+	TransportStreamableHTTP = "http"  // Streamable HTTP（/mcp）
+)
+
+// TransportConfig はMCPクライアントとの通信方式の設定です
+type TransportConfig struct {
+	Type            string `json:"type" yaml:"type"`                                         // stdio・sse・http
+	Addr            string `json:"addr" yaml:"addr"`                                         // HTTP系のトランスポートの待ち受けアドレス
+	BaseURL         string `json:"base_url" yaml:"base_url"`                                 // SSEでクライアントに通知するURL（リバースプロキシ配下の場合）
+	TLSCertFile     string `json:"tls_cert_file" yaml:"tls_cert_file"`                       // 証明書と秘密鍵を指定するとHTTPSで待ち受ける
+	TLSKeyFile      string `json:"tls_key_file" yaml:"tls_key_file"`                         // TLSCertFileと併せて指定
+	ShutdownTimeout int    `json:"shutdown_timeout_seconds" yaml:"shutdown_timeout_seconds"` // 終了時に処理中のリクエストを待つ時間
 }
 
+// 認証の方式
+const (
+	AuthToken = "token" // HTTP系のトランスポートではトークンでユーザーを特定する
+	AuthNone  = "none"  // 全てのリクエストを既定のユーザーとして扱う（信頼できるネットワーク内のみ）
+)
+
+// AuthConfig はユーザーの認証の設定です
+type AuthConfig struct {
+	Mode        string `json:"mode" yaml:"mode"`                 // token・none
+	DefaultUser string `json:"default_user" yaml:"default_user"` // 標準入出力・CLI・認証なしの場合に使用するユーザー
+}
+
+// ログの形式
+const (
+	LogFormatText = "text" // key=value形式
+	LogFormatJSON = "json" // 1行1オブジェクトのJSON
+)
+
 // ServerConfig はサーバー関連の設定です
 type ServerConfig struct {
-	Environment    string `json:"environment"`
-	LogLevel       string `json:"log_level"`
-	RequestTimeout int    `json:"request_timeout_seconds"`
+	Environment    string `json:"environment" yaml:"environment"`
+	LogLevel       string `json:"log_level" yaml:"log_level"`   // debug・info・warn・error
+	LogFormat      string `json:"log_format" yaml:"log_format"` // text・json
+	LogFile        string `json:"log_file" yaml:"log_file"`     // 指定すると標準エラー出力に加えてファイルにも出力する
+	LogMaxSizeMB   int    `json:"log_max_size_mb" yaml:"log_max_size_mb"`
+	LogMaxBackups  int    `json:"log_max_backups" yaml:"log_max_backups"` // ローテーションで残す古いログファイルの数
+	RequestTimeout int    `json:"request_timeout_seconds" yaml:"request_timeout_seconds"`
+}
+
+// 重量の単位
+const (
+	WeightUnitKg  = "kg"
+	WeightUnitLbs = "lbs"
+)
+
+// UnitsConfig は単位の設定です
+type UnitsConfig struct {
+	Weight string `json:"weight" yaml:"weight"` // 単位のないCSVを取り込む場合の重量の単位（kg・lbs）
+}
+
+// PlatesConfig は使用できるバーとプレートの設定です（重量の提案を実際に組める重量にするために使用）
+type PlatesConfig struct {
+	BarKg       float64   `json:"bar_kg" yaml:"bar_kg"`             // バーの重量
+	AvailableKg []float64 `json:"available_kg" yaml:"available_kg"` // 使用できるプレートの重量（1枚あたり、左右1組ずつ付ける）
+}
+
+// ZonesConfig はアスリートプロファイルが未設定の場合に使用するトレーニングゾーンの基準値です（0・空は未設定）
+type ZonesConfig struct {
+	MaxHeartRate       int    `json:"max_heart_rate" yaml:"max_heart_rate"`
+	RestingHeartRate   int    `json:"resting_heart_rate" yaml:"resting_heart_rate"`
+	ThresholdHeartRate int    `json:"threshold_heart_rate" yaml:"threshold_heart_rate"` // LTHR
+	ThresholdPace      string `json:"threshold_pace" yaml:"threshold_pace"`             // m:ss/km
 }
 
-// NewConfig は新しい設定を作成します
+// FeaturesConfig は機能の有効・無効の設定です
+type FeaturesConfig struct {
+	Resources bool `json:"resources" yaml:"resources"` // MCPリソース（fitness://）を公開する
+	Prompts   bool `json:"prompts" yaml:"prompts"`     // MCPプロンプトを公開する
+}
+
+// NewConfig は既定値と環境変数から新しい設定を作成します（設定ファイルは読み込みません）
 func NewConfig() *Config {
+	cfg := defaultConfig()
+	cfg.applyEnv()
+	return cfg
+}
+
+// defaultConfig は既定値の設定を作成します
+func defaultConfig() *Config {
 	return &Config{
 		Database: DatabaseConfig{
 			SQLitePath:      getDefaultDatabasePath(),
-			MaxOpenConns:    getEnvInt("DB_MAX_OPEN_CONNS", 10),
-			MaxIdleConns:    getEnvInt("DB_MAX_IDLE_CONNS", 2),
-			ConnMaxLifetime: getEnvInt("DB_CONN_MAX_LIFETIME_HOURS", 1),
+			MaxOpenConns:    10,
+			MaxIdleConns:    2,
+			ConnMaxLifetime: 1,
+			BusyTimeoutMs:   5000,
+		},
+		Backup: BackupConfig{
+			KeepLast: 10,
+			KeepDays: 7,
 		},
 		MCP: MCPConfig{
-			Name:        getEnvString("MCP_SERVER_NAME", "fitness-mcp-server"),
-			Version:     getEnvString("MCP_SERVER_VERSION", "1.0.0"),
+			Name:        "fitness-mcp-server",
+			Version:     "1.0.0",
 			Description: "筋トレ・ランニング記録管理MCPサーバー",
 		},
+		Transport: TransportConfig{
+			Type:            TransportStdio,
+			Addr:            "127.0.0.1:8080",
+			ShutdownTimeout: 10,
+		},
+		Auth: AuthConfig{
+			Mode:        AuthToken,
+			DefaultUser: "default",
+		},
 		Server: ServerConfig{
-			Environment:    getEnvString("APP_ENV", "development"),
-			LogLevel:       getEnvString("LOG_LEVEL", "info"),
-			RequestTimeout: getEnvInt("REQUEST_TIMEOUT_SECONDS", 30),
+			Environment:    "development",
+			LogLevel:       "info",
+			LogFormat:      LogFormatText,
+			LogMaxSizeMB:   10,
+			LogMaxBackups:  3,
+			RequestTimeout: 30,
+		},
+		Units:    UnitsConfig{Weight: WeightUnitKg},
+		Timezone: "Local",
+		Plates: PlatesConfig{
+			BarKg:       20,
+			AvailableKg: []float64{25, 20, 15, 10, 5, 2.5, 1.25},
+		},
+		Features: FeaturesConfig{
+			Resources: true,
+			Prompts:   true,
 		},
 	}
 }
 
-// getDefaultDatabasePath はデフォルトのデータベースパスを取得します
-func getDefaultDatabasePath() string {
-	// 環境変数でデータディレクトリが指定されている場合はそれを使用
+// applyEnv は環境変数で指定された値を設定に上書きします
+func (c *Config) applyEnv() {
 	if dataDir := os.Getenv("MCP_DATA_DIR"); dataDir != "" {
-		return filepath.Join(dataDir, "fitness.db")
+		c.Database.SQLitePath = filepath.Join(dataDir, "fitness.db")
 	}
+	c.Database.MaxOpenConns = c.getEnvInt("DB_MAX_OPEN_CONNS", c.Database.MaxOpenConns)
+	c.Database.MaxIdleConns = c.getEnvInt("DB_MAX_IDLE_CONNS", c.Database.MaxIdleConns)
+	c.Database.ConnMaxLifetime = c.getEnvInt("DB_CONN_MAX_LIFETIME_HOURS", c.Database.ConnMaxLifetime)
+	c.Database.BusyTimeoutMs = c.getEnvInt("DB_BUSY_TIMEOUT_MS", c.Database.BusyTimeoutMs)
+
+	c.Backup.Dir = getEnvString("BACKUP_DIR", c.Backup.Dir)
+	c.Backup.KeepLast = c.getEnvInt("BACKUP_KEEP_LAST", c.Backup.KeepLast)
+	c.Backup.KeepDays = c.getEnvInt("BACKUP_KEEP_DAYS", c.Backup.KeepDays)
+	if c.Backup.Dir == "" {
+		c.Backup.Dir = filepath.Join(filepath.Dir(c.Database.SQLitePath), "backups")
+	}
+
+	c.MCP.Name = getEnvString("MCP_SERVER_NAME", c.MCP.Name)
+	c.MCP.Version = getEnvString("MCP_SERVER_VERSION", c.MCP.Version)
 
+	c.Transport.Type = getEnvString("MCP_TRANSPORT", c.Transport.Type)
+	c.Transport.Addr = getEnvString("MCP_ADDR", c.Transport.Addr)
+	c.Transport.BaseURL = getEnvString("MCP_BASE_URL", c.Transport.BaseURL)
+	c.Transport.TLSCertFile = getEnvString("MCP_TLS_CERT_FILE", c.Transport.TLSCertFile)
+	c.Transport.TLSKeyFile = getEnvString("MCP_TLS_KEY_FILE", c.Transport.TLSKeyFile)
+	c.Transport.ShutdownTimeout = c.getEnvInt("MCP_SHUTDOWN_TIMEOUT_SECONDS", c.Transport.ShutdownTimeout)
+
+	c.Auth.Mode = getEnvString("MCP_AUTH", c.Auth.Mode)
+	c.Auth.DefaultUser = getEnvString("MCP_USER", c.Auth.DefaultUser)
+
+	c.Server.Environment = getEnvString("APP_ENV", c.Server.Environment)
+	c.Server.LogLevel = getEnvString("LOG_LEVEL", c.Server.LogLevel)
+	c.Server.LogFormat = getEnvString("LOG_FORMAT", c.Server.LogFormat)
+	c.Server.LogFile = getEnvString("LOG_FILE", c.Server.LogFile)
+	c.Server.LogMaxSizeMB = c.getEnvInt("LOG_MAX_SIZE_MB", c.Server.LogMaxSizeMB)
+	c.Server.LogMaxBackups = c.getEnvInt("LOG_MAX_BACKUPS", c.Server.LogMaxBackups)
+	c.Server.RequestTimeout = c.getEnvInt("REQUEST_TIMEOUT_SECONDS", c.Server.RequestTimeout)
+
+	c.Units.Weight = getEnvString("WEIGHT_UNIT", c.Units.Weight)
+	c.Timezone = getEnvString("MCP_TIMEZONE", c.Timezone)
+
+	c.Features.Resources = c.getEnvBool("MCP_ENABLE_RESOURCES", c.Features.Resources)
+	c.Features.Prompts = c.getEnvBool("MCP_ENABLE_PROMPTS", c.Features.Prompts)
+}
+
+// getDefaultDatabasePath はデフォルトのデータベースパスを取得します
+func getDefaultDatabasePath() string {
 	// 実行ファイルのディレクトリを基準にしたパスを取得
 	execPath, err := os.Executable()
 	if err != nil {
@@ -89,14 +254,32 @@ func getEnvString(key, defaultValue string) string {
 	return defaultValue
 }
 
-// getEnvInt は環境変数から整数を取得します（デフォルト値付き）
-func getEnvInt(key string, defaultValue int) int {
-	if value := os.Getenv(key); value != "" {
-		if intValue, err := strconv.Atoi(value); err == nil {
-			return intValue
-		}
+// getEnvInt は環境変数から整数を取得します（デフォルト値付き、解釈できない値はValidateで報告）
+func (c *Config) getEnvInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
 	}
-	return defaultValue
+	intValue, err := strconv.Atoi(value)
+	if err != nil {
+		c.envErrors = append(c.envErrors, fmt.Sprintf("%s must be an integer: %q", key, value))
+		return defaultValue
+	}
+	return intValue
+}
+
+// getEnvBool は環境変数から真偽値を取得します（デフォルト値付き、解釈できない値はValidateで報告）
+func (c *Config) getEnvBool(key string, defaultValue bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	boolValue, err := strconv.ParseBool(value)
+	if err != nil {
+		c.envErrors = append(c.envErrors, fmt.Sprintf("%s must be a boolean: %q", key, value))
+		return defaultValue
+	}
+	return boolValue
 }
 
 // EnsureDatabaseDir はデータベースディレクトリが存在することを確認します
@@ -115,9 +298,12 @@ func (c *Config) IsProduction() bool {
 	return c.Server.Environment == "production"
 }
 
-// Validate は設定の妥当性をチェックします
-func (c *Config) Validate() error {
-	// TODO: 設定値の妥当性チェックを実装
-	// 例: データベースパスの有効性、設定値の範囲チェックなど
-	return nil
+// RequiresToken はHTTPのリクエストにトークンが必要かどうかを判定します
+func (a AuthConfig) RequiresToken() bool {
+	return a.Mode == AuthToken
+}
+
+// UsesTLS はHTTPSで待ち受けるかどうかを判定します
+func (t TransportConfig) UsesTLS() bool {
+	return t.TLSCertFile != "" && t.TLSKeyFile != ""
 }
@@ -0,0 +1,176 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeConfigFile は一時ディレクトリに設定ファイルを書き込みます
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("正常系:設定ファイルの値を既定値に重ね、環境変数を優先する", func(t *testing.T) {
+		// Arrange
+		path := writeConfigFile(t, "fitness.yaml", `
+database:
+  sqlite_path: /var/lib/fitness/fitness.db
+transport:
+  type: http
+  addr: 0.0.0.0:9090
+units:
+  weight: lbs
+timezone: Asia/Tokyo
+plates:
+  bar_kg: 15
+  available_kg: [20, 10, 5]
+zones:
+  max_heart_rate: 190
+  resting_heart_rate: 50
+  threshold_pace: "4:30"
+features:
+  prompts: false
+`)
+		t.Setenv("MCP_ADDR", "127.0.0.1:7070")
+
+		// Act
+		cfg, err := Load(path)
+
+		// Assert
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "/var/lib/fitness/fitness.db", cfg.Database.SQLitePath)
+		assert.Equal(t, 10, cfg.Database.MaxOpenConns, "ファイルにないキーは既定値のまま")
+		assert.Equal(t, "/var/lib/fitness/backups", cfg.Backup.Dir, "バックアップ先はデータベースのパスから決める")
+		assert.Equal(t, TransportStreamableHTTP, cfg.Transport.Type)
+		assert.Equal(t, "127.0.0.1:7070", cfg.Transport.Addr, "環境変数を優先する")
+		assert.Equal(t, WeightUnitLbs, cfg.Units.Weight)
+		assert.Equal(t, "Asia/Tokyo", cfg.Timezone)
+		assert.Equal(t, 15.0, cfg.Plates.BarKg)
+		assert.Equal(t, []float64{20, 10, 5}, cfg.Plates.AvailableKg)
+		assert.Equal(t, 190, cfg.Zones.MaxHeartRate)
+		assert.True(t, cfg.Features.Resources)
+		assert.False(t, cfg.Features.Prompts)
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("正常系:パスを省略した場合はMCP_CONFIGの設定ファイルを読み込む", func(t *testing.T) {
+		// Arrange
+		t.Setenv(ConfigEnv, writeConfigFile(t, "fitness.yml", "auth:\n  default_user: alice\n"))
+
+		// Act
+		cfg, err := Load("")
+
+		// Assert
+		if assert.NoError(t, err) {
+			assert.Equal(t, "alice", cfg.Auth.DefaultUser)
+		}
+	})
+
+	t.Run("異常系:未知のキーはエラー", func(t *testing.T) {
+		// Arrange
+		path := writeConfigFile(t, "fitness.yaml", "transport:\n  adress: 0.0.0.0:8080\n")
+
+		// Act
+		_, err := Load(path)
+
+		// Assert
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "field adress not found")
+		}
+	})
+
+	t.Run("異常系:YAML以外の形式はエラー", func(t *testing.T) {
+		// Arrange
+		path := writeConfigFile(t, "fitness.toml", "[transport]\ntype = \"http\"\n")
+
+		// Act
+		_, err := Load(path)
+
+		// Assert
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "unsupported config file format")
+		}
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Run("正常系:既定値は妥当", func(t *testing.T) {
+		// Act
+		err := defaultConfig().Validate()
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系:全てのセクションの問題をまとめて返す", func(t *testing.T) {
+		// Arrange
+		t.Setenv("DB_MAX_OPEN_CONNS", "ten")
+		cfg := NewConfig()
+		cfg.Transport.Type = "websocket"
+		cfg.Server.LogLevel = "verbose"
+		cfg.Units.Weight = "stone"
+		cfg.Timezone = "Mars/Olympus"
+		cfg.Plates.AvailableKg = []float64{20, 0}
+		cfg.Zones = ZonesConfig{MaxHeartRate: 180, RestingHeartRate: 190, ThresholdPace: "4m30s"}
+
+		// Act
+		err := cfg.Validate()
+
+		// Assert
+		var validationErr *ValidationError
+		if !assert.True(t, errors.As(err, &validationErr)) {
+			return
+		}
+		assert.Equal(t, []string{
+			`DB_MAX_OPEN_CONNS must be an integer: "ten"`,
+			`transport: unknown transport "websocket" (expected stdio, sse or http)`,
+			`server: unknown log level "verbose" (expected debug, info, warn or error)`,
+			`units: unknown weight unit "stone" (expected kg or lbs)`,
+			`timezone: unknown timezone "Mars/Olympus": unknown time zone Mars/Olympus`,
+			`plates: plate weight must be positive: 0`,
+			`zones: resting heart rate must be lower than max heart rate: 190 >= 180`,
+			`zones: threshold pace must be m:ss per km: "4m30s"`,
+		}, validationErr.Problems)
+		assert.Contains(t, err.Error(), "invalid configuration (8 problems):\n  - DB_MAX_OPEN_CONNS")
+	})
+}
+
+func TestZonesConfig_ThresholdPaceSecondsPerKm(t *testing.T) {
+	tests := []struct {
+		name    string
+		pace    string
+		want    float64
+		wantErr bool
+	}{
+		{name: "正常系:m:ssを秒に変換する", pace: "4:30", want: 270},
+		{name: "正常系:未設定は0", pace: "", want: 0},
+		{name: "異常系:秒が60以上", pace: "4:75", wantErr: true},
+		{name: "異常系:区切りがない", pace: "270", wantErr: true},
+		{name: "異常系:0:00", pace: "0:00", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got, err := ZonesConfig{ThresholdPace: tt.pace}.ThresholdPaceSecondsPerKm()
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigEnv は設定ファイルのパスを指定する環境変数です
+const ConfigEnv = "MCP_CONFIG"
+
+// Load は既定値に設定ファイル（YAML）と環境変数の値を重ねた設定を作成します
+// pathが空の場合はMCP_CONFIGのパスを使用し、どちらも空の場合は設定ファイルを読み込みません
+func Load(path string) (*Config, error) {
+	if path == "" {
+		path = os.Getenv(ConfigEnv)
+	}
+
+	cfg := defaultConfig()
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	cfg.applyEnv()
+	return cfg, nil
+}
+
+// loadFile は設定ファイルの値を設定に上書きします（ファイルにないキーは既定値のまま）
+func (c *Config) loadFile(path string) error {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+	default:
+		return fmt.Errorf("unsupported config file format %q (expected .yaml or .yml)", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	// 綴りの誤りに気付けるよう、未知のキーはエラーにする
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return nil
+}
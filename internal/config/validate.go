@@ -0,0 +1,242 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValidationError は設定の全ての問題をまとめたエラーです
+type ValidationError struct {
+	Problems []string // 「セクション: 問題」の形式
+}
+
+// Error は問題を1行ずつ列挙したメッセージを返します
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid configuration (%d problems):\n  - %s", len(e.Problems), strings.Join(e.Problems, "\n  - "))
+}
+
+// Validate は設定の妥当性をチェックし、全ての問題をValidationErrorにまとめて返します
+func (c *Config) Validate() error {
+	problems := append([]string(nil), c.envErrors...)
+	sections := []struct {
+		name string
+		err  error
+	}{
+		{name: "database", err: c.Database.Validate()},
+		{name: "backup", err: c.Backup.Validate()},
+		{name: "mcp", err: c.MCP.Validate()},
+		{name: "transport", err: c.Transport.Validate()},
+		{name: "auth", err: c.Auth.Validate()},
+		{name: "server", err: c.Server.Validate()},
+		{name: "units", err: c.Units.Validate()},
+		{name: "timezone", err: validateTimezone(c.Timezone)},
+		{name: "plates", err: c.Plates.Validate()},
+		{name: "zones", err: c.Zones.Validate()},
+	}
+	for _, section := range sections {
+		for _, err := range unwrapJoined(section.err) {
+			problems = append(problems, section.name+": "+err.Error())
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// unwrapJoined はerrors.Joinでまとめたエラーを個々のエラーに分解します
+func unwrapJoined(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
+
+// Validate はデータベースの設定の妥当性をチェックします
+func (d DatabaseConfig) Validate() error {
+	var errs []error
+	if d.SQLitePath == "" {
+		errs = append(errs, fmt.Errorf("sqlite path is required"))
+	}
+	if d.MaxOpenConns <= 0 {
+		errs = append(errs, fmt.Errorf("max open connections must be positive: %d", d.MaxOpenConns))
+	}
+	if d.MaxIdleConns < 0 || d.MaxIdleConns > d.MaxOpenConns {
+		errs = append(errs, fmt.Errorf("max idle connections must be between 0 and max open connections: %d", d.MaxIdleConns))
+	}
+	if d.ConnMaxLifetime < 0 {
+		errs = append(errs, fmt.Errorf("connection max lifetime must not be negative: %d", d.ConnMaxLifetime))
+	}
+	if d.BusyTimeoutMs < 0 {
+		errs = append(errs, fmt.Errorf("busy timeout must not be negative: %d", d.BusyTimeoutMs))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate はバックアップの設定の妥当性をチェックします
+func (b BackupConfig) Validate() error {
+	var errs []error
+	if b.KeepLast < 0 {
+		errs = append(errs, fmt.Errorf("keep last must not be negative: %d", b.KeepLast))
+	}
+	if b.KeepDays < 0 {
+		errs = append(errs, fmt.Errorf("keep days must not be negative: %d", b.KeepDays))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate はMCPサーバーの設定の妥当性をチェックします
+func (m MCPConfig) Validate() error {
+	var errs []error
+	if m.Name == "" {
+		errs = append(errs, fmt.Errorf("server name is required"))
+	}
+	if m.Version == "" {
+		errs = append(errs, fmt.Errorf("server version is required"))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate はトランスポートの設定の妥当性をチェックします
+func (t TransportConfig) Validate() error {
+	switch t.Type {
+	case TransportStdio:
+		return nil
+	case TransportSSE, TransportStreamableHTTP:
+	default:
+		return fmt.Errorf("unknown transport %q (expected %s, %s or %s)", t.Type, TransportStdio, TransportSSE, TransportStreamableHTTP)
+	}
+
+	var errs []error
+	if t.Addr == "" {
+		errs = append(errs, fmt.Errorf("listen address is required for %s transport", t.Type))
+	}
+	if (t.TLSCertFile == "") != (t.TLSKeyFile == "") {
+		errs = append(errs, fmt.Errorf("both TLS certificate and key files are required to enable TLS"))
+	}
+	if t.ShutdownTimeout < 0 {
+		errs = append(errs, fmt.Errorf("shutdown timeout must not be negative: %d", t.ShutdownTimeout))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate は認証の設定の妥当性をチェックします
+func (a AuthConfig) Validate() error {
+	var errs []error
+	switch a.Mode {
+	case AuthToken, AuthNone:
+	default:
+		errs = append(errs, fmt.Errorf("unknown auth mode %q (expected %s or %s)", a.Mode, AuthToken, AuthNone))
+	}
+	if a.DefaultUser == "" {
+		errs = append(errs, fmt.Errorf("default user is required"))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate はサーバーの設定の妥当性をチェックします
+func (s ServerConfig) Validate() error {
+	var errs []error
+	switch strings.ToLower(s.LogLevel) {
+	case "debug", "info", "warn", "warning", "error":
+	default:
+		errs = append(errs, fmt.Errorf("unknown log level %q (expected debug, info, warn or error)", s.LogLevel))
+	}
+	switch s.LogFormat {
+	case LogFormatText, LogFormatJSON:
+	default:
+		errs = append(errs, fmt.Errorf("unknown log format %q (expected %s or %s)", s.LogFormat, LogFormatText, LogFormatJSON))
+	}
+	if s.LogFile != "" && s.LogMaxSizeMB <= 0 {
+		errs = append(errs, fmt.Errorf("log max size must be positive: %d", s.LogMaxSizeMB))
+	}
+	if s.LogMaxBackups < 0 {
+		errs = append(errs, fmt.Errorf("log max backups must not be negative: %d", s.LogMaxBackups))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate は単位の設定の妥当性をチェックします
+func (u UnitsConfig) Validate() error {
+	switch u.Weight {
+	case WeightUnitKg, WeightUnitLbs:
+		return nil
+	default:
+		return fmt.Errorf("unknown weight unit %q (expected %s or %s)", u.Weight, WeightUnitKg, WeightUnitLbs)
+	}
+}
+
+// validateTimezone はタイムゾーンがIANAのタイムゾーン名かどうかをチェックします
+func validateTimezone(name string) error {
+	if name == "" {
+		return fmt.Errorf("timezone is required")
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("unknown timezone %q: %w", name, err)
+	}
+	return nil
+}
+
+// Location はユーザーのタイムゾーンを返します（Validate済みであること）
+func (c *Config) Location() (*time.Location, error) {
+	return time.LoadLocation(c.Timezone)
+}
+
+// Validate はバーとプレートの設定の妥当性をチェックします
+func (p PlatesConfig) Validate() error {
+	var errs []error
+	if p.BarKg < 0 {
+		errs = append(errs, fmt.Errorf("bar weight must not be negative: %g", p.BarKg))
+	}
+	for _, plate := range p.AvailableKg {
+		if plate <= 0 {
+			errs = append(errs, fmt.Errorf("plate weight must be positive: %g", plate))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate はトレーニングゾーンの基準値の妥当性をチェックします
+func (z ZonesConfig) Validate() error {
+	var errs []error
+	for _, heartRate := range []struct {
+		name string
+		bpm  int
+	}{
+		{name: "max heart rate", bpm: z.MaxHeartRate},
+		{name: "resting heart rate", bpm: z.RestingHeartRate},
+		{name: "threshold heart rate", bpm: z.ThresholdHeartRate},
+	} {
+		if heartRate.bpm != 0 && (heartRate.bpm < 30 || heartRate.bpm > 250) {
+			errs = append(errs, fmt.Errorf("%s must be between 30 and 250 bpm: %d", heartRate.name, heartRate.bpm))
+		}
+	}
+	if z.MaxHeartRate != 0 && z.RestingHeartRate != 0 && z.RestingHeartRate >= z.MaxHeartRate {
+		errs = append(errs, fmt.Errorf("resting heart rate must be lower than max heart rate: %d >= %d", z.RestingHeartRate, z.MaxHeartRate))
+	}
+	if _, err := z.ThresholdPaceSecondsPerKm(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// ThresholdPaceSecondsPerKm は閾値ペース（m:ss）を1kmあたりの秒数に変換します（未設定の場合は0）
+func (z ZonesConfig) ThresholdPaceSecondsPerKm() (float64, error) {
+	if z.ThresholdPace == "" {
+		return 0, nil
+	}
+	minutes, seconds, ok := strings.Cut(z.ThresholdPace, ":")
+	m, minutesErr := strconv.Atoi(minutes)
+	s, secondsErr := strconv.Atoi(seconds)
+	if !ok || minutesErr != nil || secondsErr != nil || m < 0 || s < 0 || s >= 60 || m*60+s == 0 {
+		return 0, fmt.Errorf("threshold pace must be m:ss per km: %q", z.ThresholdPace)
+	}
+	return float64(m*60 + s), nil
+}
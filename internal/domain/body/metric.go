@@ -0,0 +1,134 @@
+package body
+
+import (
+	"fmt"
+	"time"
+
+	"fitness-mcp-server/internal/domain/shared"
+)
+
+// =============================================================================
+// 身体測定コンテキスト - 体重・体脂肪率の記録
+// =============================================================================
+
+// 体重・体脂肪率の現実的な範囲
+const (
+	minWeightKg       = 20.0
+	maxWeightKg       = 300.0
+	minBodyFatPercent = 2.0
+	maxBodyFatPercent = 70.0
+)
+
+// Weight は体重（kg）を表す値オブジェクト
+type Weight struct {
+	kg float64
+}
+
+// NewWeight は体重を作成します
+func NewWeight(kg float64) (Weight, error) {
+	if kg < minWeightKg || kg > maxWeightKg {
+		return Weight{}, fmt.Errorf("body weight must be between %.0f and %.0f kg: %g", minWeightKg, maxWeightKg, kg)
+	}
+	return Weight{kg: kg}, nil
+}
+
+// Kg は体重をkgで返します
+func (w Weight) Kg() float64 {
+	return w.kg
+}
+
+// String は体重の文字列表現を返します
+func (w Weight) String() string {
+	return fmt.Sprintf("%.1fkg", w.kg)
+}
+
+// BodyFat は体脂肪率（%）を表す値オブジェクト
+type BodyFat struct {
+	percent float64
+}
+
+// NewBodyFat は体脂肪率を作成します
+func NewBodyFat(percent float64) (BodyFat, error) {
+	if percent < minBodyFatPercent || percent > maxBodyFatPercent {
+		return BodyFat{}, fmt.Errorf("body fat must be between %.0f and %.0f %%: %g", minBodyFatPercent, maxBodyFatPercent, percent)
+	}
+	return BodyFat{percent: percent}, nil
+}
+
+// Percent は体脂肪率を%で返します
+func (bf BodyFat) Percent() float64 {
+	return bf.percent
+}
+
+// String は体脂肪率の文字列表現を返します
+func (bf BodyFat) String() string {
+	return fmt.Sprintf("%.1f%%", bf.percent)
+}
+
+// Metric は1回分の身体測定値を表すエンティティ
+type Metric struct {
+	id      shared.BodyMetricID // 測定値ID
+	date    time.Time           // 測定日時
+	weight  Weight              // 体重
+	bodyFat *BodyFat            // 体脂肪率（オプション）
+	notes   string              // メモ
+}
+
+// NewMetric は新しい身体測定値を作成します
+func NewMetric(id shared.BodyMetricID, date time.Time, weight Weight, notes string) (*Metric, error) {
+	if id.IsEmpty() {
+		return nil, fmt.Errorf("body metric id cannot be empty")
+	}
+	if date.IsZero() {
+		return nil, fmt.Errorf("body metric date is required")
+	}
+	return &Metric{id: id, date: date, weight: weight, notes: notes}, nil
+}
+
+// ID は測定値IDを返します
+func (m *Metric) ID() shared.BodyMetricID {
+	return m.id
+}
+
+// Date は測定日時を返します
+func (m *Metric) Date() time.Time {
+	return m.date
+}
+
+// Weight は体重を返します
+func (m *Metric) Weight() Weight {
+	return m.weight
+}
+
+// BodyFat は体脂肪率を返します（オプション）
+func (m *Metric) BodyFat() *BodyFat {
+	return m.bodyFat
+}
+
+// Notes はメモを返します
+func (m *Metric) Notes() string {
+	return m.notes
+}
+
+// SetBodyFat は体脂肪率を設定します
+func (m *Metric) SetBodyFat(bodyFat BodyFat) {
+	m.bodyFat = &bodyFat
+}
+
+// LeanMassKg は除脂肪体重（kg）を返します（体脂肪率が未記録の場合はnil）
+func (m *Metric) LeanMassKg() *float64 {
+	if m.bodyFat == nil {
+		return nil
+	}
+	lean := m.weight.kg * (1 - m.bodyFat.percent/100)
+	return &lean
+}
+
+// String は身体測定値の文字列表現を返します
+func (m *Metric) String() string {
+	text := fmt.Sprintf("%s 体重 %s", m.date.Format("2006-01-02"), m.weight)
+	if m.bodyFat != nil {
+		text += fmt.Sprintf(" 体脂肪率 %s", m.bodyFat)
+	}
+	return text
+}
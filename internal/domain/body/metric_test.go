@@ -0,0 +1,91 @@
+package body
+
+import (
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/domain/shared"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// 身体測定コンテキストのテスト
+// =============================================================================
+
+func TestNewWeight(t *testing.T) {
+	tests := []struct {
+		name    string
+		kg      float64
+		wantErr bool
+	}{
+		{name: "正常系:範囲内の体重", kg: 68.4},
+		{name: "正常系:下限の体重", kg: 20},
+		{name: "異常系:下限未満の体重はエラー", kg: 19.9, wantErr: true},
+		{name: "異常系:上限を超える体重はエラー", kg: 300.1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			weight, err := NewWeight(tt.kg)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.kg, weight.Kg())
+		})
+	}
+}
+
+func TestNewBodyFat(t *testing.T) {
+	_, err := NewBodyFat(15.2)
+	assert.NoError(t, err)
+
+	_, err = NewBodyFat(1.5)
+	assert.Error(t, err)
+
+	_, err = NewBodyFat(70.5)
+	assert.Error(t, err)
+}
+
+func TestMetric_LeanMassKg(t *testing.T) {
+	// Arrange
+	weight, _ := NewWeight(70)
+	metric, err := NewMetric(shared.NewBodyMetricID(), time.Date(2025, 6, 1, 7, 0, 0, 0, time.UTC), weight, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	t.Run("正常系:体脂肪率が未記録の場合はnil", func(t *testing.T) {
+		// Act & Assert
+		assert.Nil(t, metric.LeanMassKg())
+	})
+
+	t.Run("正常系:体重から体脂肪量を除いた値を返す", func(t *testing.T) {
+		// Arrange
+		bodyFat, _ := NewBodyFat(20)
+		metric.SetBodyFat(bodyFat)
+
+		// Act
+		lean := metric.LeanMassKg()
+
+		// Assert
+		if assert.NotNil(t, lean) {
+			assert.InDelta(t, 56.0, *lean, 1e-9)
+		}
+	})
+}
+
+func TestNewMetric(t *testing.T) {
+	weight, _ := NewWeight(70)
+
+	_, err := NewMetric(shared.BodyMetricID{}, time.Now(), weight, "")
+	assert.Error(t, err)
+
+	_, err = NewMetric(shared.NewBodyMetricID(), time.Time{}, weight, "")
+	assert.Error(t, err)
+}
@@ -115,6 +115,7 @@ func (gs GoalStatus) IsActive() bool {
 type RunningGoal struct {
 	id          shared.GoalID // 目標ID
 	eventType   EventType     // イベントタイプ
+	distance    Distance      // 目標の距離（カスタム以外はイベントタイプの標準距離）
 	targetTime  Duration      // 目標タイム
 	targetPace  Pace          // 目標ペース
 	eventDate   *time.Time    // イベント日（オプション）
@@ -146,6 +147,7 @@ func NewRunningGoal(
 	return &RunningGoal{
 		id:          id,
 		eventType:   eventType,
+		distance:    distance,
 		targetTime:  targetTime,
 		targetPace:  targetPace,
 		eventDate:   nil,
@@ -172,6 +174,7 @@ func NewCustomRunningGoal(
 	return &RunningGoal{
 		id:          id,
 		eventType:   Custom,
+		distance:    distance,
 		targetTime:  targetTime,
 		targetPace:  targetPace,
 		eventDate:   nil,
@@ -192,6 +195,11 @@ func (rg *RunningGoal) EventType() EventType {
 	return rg.eventType
 }
 
+// Distance は目標の距離を返します
+func (rg *RunningGoal) Distance() Distance {
+	return rg.distance
+}
+
 // TargetTime は目標タイムを返します
 func (rg *RunningGoal) TargetTime() Duration {
 	return rg.targetTime
@@ -261,16 +269,17 @@ func (rg *RunningGoal) Resume() {
 	}
 }
 
+// Restore は永続化された状態・作成日時・達成日時を復元します
+func (rg *RunningGoal) Restore(status GoalStatus, createdAt time.Time, achievedAt *time.Time) {
+	rg.status = status
+	rg.createdAt = createdAt
+	rg.achievedAt = achievedAt
+}
+
 // IsAchievable は指定されたセッションで目標が達成可能かを判定します
 func (rg *RunningGoal) IsAchievable(session *RunningSession) (bool, error) {
-	// イベントタイプの標準距離を取得
-	goalDistance, err := rg.eventType.GetStandardDistance()
-	if err != nil {
-		return false, fmt.Errorf("failed to get goal distance: %w", err)
-	}
-
 	// 距離が一致するかチェック
-	if !session.Distance().Equals(goalDistance) {
+	if !session.Distance().Equals(rg.distance) {
 		return false, nil
 	}
 
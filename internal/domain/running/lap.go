@@ -0,0 +1,279 @@
+package running
+
+import (
+	"fmt"
+	"math"
+)
+
+// =============================================================================
+// ラップコンテキスト - ラップ・スプリットとその分析
+// =============================================================================
+
+// LapType はラップの種類（疾走区間/休息区間）を表す値オブジェクト
+type LapType struct {
+	value string
+}
+
+// 定義済みラップタイプの定数
+var (
+	WorkLap = LapType{value: "Work"} // 疾走区間（通常のラップ・インターバルの本数）
+	RestLap = LapType{value: "Rest"} // 休息区間（リカバリージョグ・レスト）
+)
+
+// NewLapType はラップタイプを作成します
+func NewLapType(lapType string) (LapType, error) {
+	switch lapType {
+	case WorkLap.value:
+		return WorkLap, nil
+	case RestLap.value:
+		return RestLap, nil
+	default:
+		return LapType{}, fmt.Errorf("invalid lap type: %s", lapType)
+	}
+}
+
+// String はラップタイプの文字列表現を返します
+func (lt LapType) String() string {
+	return lt.value
+}
+
+// Equals は2つのラップタイプが等しいかを判定します
+func (lt LapType) Equals(other LapType) bool {
+	return lt.value == other.value
+}
+
+// Lap はランニングセッション内のラップ（スプリット）を表すエンティティ
+type Lap struct {
+	number    int        // ラップ番号（1始まり）
+	distance  Distance   // 距離
+	duration  Duration   // 時間
+	pace      Pace       // ペース
+	heartRate *HeartRate // 平均心拍数（オプション）
+	lapType   LapType    // 疾走区間/休息区間
+}
+
+// NewLap は新しいLapを作成します
+func NewLap(number int, distance Distance, duration Duration, lapType LapType) (*Lap, error) {
+	if number <= 0 {
+		return nil, fmt.Errorf("lap number must be positive: %d", number)
+	}
+
+	pace, err := CalculatePace(distance, duration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate lap pace: %w", err)
+	}
+
+	return &Lap{
+		number:   number,
+		distance: distance,
+		duration: duration,
+		pace:     pace,
+		lapType:  lapType,
+	}, nil
+}
+
+// Number はラップ番号を返します
+func (l *Lap) Number() int {
+	return l.number
+}
+
+// Distance は距離を返します
+func (l *Lap) Distance() Distance {
+	return l.distance
+}
+
+// Duration は時間を返します
+func (l *Lap) Duration() Duration {
+	return l.duration
+}
+
+// Pace はペースを返します
+func (l *Lap) Pace() Pace {
+	return l.pace
+}
+
+// HeartRate は平均心拍数を返します（オプション）
+func (l *Lap) HeartRate() *HeartRate {
+	return l.heartRate
+}
+
+// Type はラップタイプを返します
+func (l *Lap) Type() LapType {
+	return l.lapType
+}
+
+// IsWork は疾走区間かを判定します
+func (l *Lap) IsWork() bool {
+	return l.lapType.Equals(WorkLap)
+}
+
+// SetHeartRate は平均心拍数を設定します
+func (l *Lap) SetHeartRate(heartRate HeartRate) {
+	l.heartRate = &heartRate
+}
+
+// String はラップの文字列表現を返します
+func (l *Lap) String() string {
+	return fmt.Sprintf("Lap %d (%s) - %s, %s, ペース: %s",
+		l.number, l.lapType.String(), l.distance.String(), l.duration.Clock(), l.pace.String())
+}
+
+// ConsistentCVPercent はインターバルのペースが安定しているとみなす変動係数（%）の上限です
+const ConsistentCVPercent = 3.0
+
+// IntervalConsistency は疾走区間のペースのばらつきを表す値オブジェクト
+type IntervalConsistency struct {
+	workLaps    int     // 疾走区間の本数
+	averagePace Pace    // 平均ペース（各本のペースの単純平均）
+	fastest     *Lap    // 最も速い本
+	slowest     *Lap    // 最も遅い本
+	stdDev      float64 // ペースの標準偏差（秒/km）
+	cvPercent   float64 // ペースの変動係数（%）
+}
+
+// WorkLaps は疾走区間の本数を返します
+func (ic IntervalConsistency) WorkLaps() int {
+	return ic.workLaps
+}
+
+// AveragePace は平均ペースを返します
+func (ic IntervalConsistency) AveragePace() Pace {
+	return ic.averagePace
+}
+
+// Fastest は最も速い本を返します
+func (ic IntervalConsistency) Fastest() *Lap {
+	return ic.fastest
+}
+
+// Slowest は最も遅い本を返します
+func (ic IntervalConsistency) Slowest() *Lap {
+	return ic.slowest
+}
+
+// StdDevSecondsPerKm はペースの標準偏差（秒/km）を返します
+func (ic IntervalConsistency) StdDevSecondsPerKm() float64 {
+	return ic.stdDev
+}
+
+// CVPercent はペースの変動係数（%）を返します
+func (ic IntervalConsistency) CVPercent() float64 {
+	return ic.cvPercent
+}
+
+// SpreadSecondsPerKm は最も速い本と遅い本のペース差（秒/km）を返します
+func (ic IntervalConsistency) SpreadSecondsPerKm() float64 {
+	return ic.slowest.Pace().SecondsPerKm() - ic.fastest.Pace().SecondsPerKm()
+}
+
+// IsConsistent はペースが安定しているか（変動係数がConsistentCVPercent以下か）を判定します
+func (ic IntervalConsistency) IsConsistent() bool {
+	return ic.cvPercent <= ConsistentCVPercent
+}
+
+// AnalyzeIntervalConsistency は疾走区間のペースのばらつきを分析します（疾走区間が2本未満の場合はnil）
+func AnalyzeIntervalConsistency(laps []*Lap) *IntervalConsistency {
+	workLaps := make([]*Lap, 0, len(laps))
+	for _, lap := range laps {
+		if lap.IsWork() {
+			workLaps = append(workLaps, lap)
+		}
+	}
+	if len(workLaps) < 2 {
+		return nil
+	}
+
+	result := &IntervalConsistency{
+		workLaps: len(workLaps),
+		fastest:  workLaps[0],
+		slowest:  workLaps[0],
+	}
+
+	sum := 0.0
+	for _, lap := range workLaps {
+		sum += lap.Pace().SecondsPerKm()
+		if lap.Pace().IsFasterThan(result.fastest.Pace()) {
+			result.fastest = lap
+		}
+		if result.slowest.Pace().IsFasterThan(lap.Pace()) {
+			result.slowest = lap
+		}
+	}
+	mean := sum / float64(len(workLaps))
+
+	variance := 0.0
+	for _, lap := range workLaps {
+		diff := lap.Pace().SecondsPerKm() - mean
+		variance += diff * diff
+	}
+	result.stdDev = math.Sqrt(variance / float64(len(workLaps)))
+	result.cvPercent = result.stdDev / mean * 100
+	result.averagePace = Pace{minutesPerKm: mean / 60}
+
+	return result
+}
+
+// SplitComparison は前半と後半のペースの比較結果を表す値オブジェクト
+type SplitComparison struct {
+	firstHalf  Pace // 前半のペース
+	secondHalf Pace // 後半のペース
+}
+
+// FirstHalf は前半のペースを返します
+func (sc SplitComparison) FirstHalf() Pace {
+	return sc.firstHalf
+}
+
+// SecondHalf は後半のペースを返します
+func (sc SplitComparison) SecondHalf() Pace {
+	return sc.secondHalf
+}
+
+// DifferenceSecondsPerKm は後半と前半のペース差（秒/km、負の値は後半の方が速い）を返します
+func (sc SplitComparison) DifferenceSecondsPerKm() float64 {
+	return sc.secondHalf.SecondsPerKm() - sc.firstHalf.SecondsPerKm()
+}
+
+// IsNegativeSplit は後半の方が速い（ネガティブスプリット）かを判定します
+func (sc SplitComparison) IsNegativeSplit() bool {
+	return sc.secondHalf.IsFasterThan(sc.firstHalf)
+}
+
+// CompareSplits は疾走区間の距離の中間点で前半と後半に分け、それぞれのペースを比較します
+// 中間点をまたぐラップはラップ内のペースが一定とみなして按分します（疾走区間が2本未満の場合はnil）
+func CompareSplits(laps []*Lap) *SplitComparison {
+	workLaps := make([]*Lap, 0, len(laps))
+	totalKm := 0.0
+	for _, lap := range laps {
+		if lap.IsWork() {
+			workLaps = append(workLaps, lap)
+			totalKm += lap.Distance().Km()
+		}
+	}
+	if len(workLaps) < 2 {
+		return nil
+	}
+
+	halfKm := totalKm / 2
+	var firstSeconds, secondSeconds, coveredKm float64
+	for _, lap := range workLaps {
+		lapKm := lap.Distance().Km()
+		lapSeconds := lap.Duration().Seconds()
+		switch {
+		case coveredKm+lapKm <= halfKm:
+			firstSeconds += lapSeconds
+		case coveredKm >= halfKm:
+			secondSeconds += lapSeconds
+		default:
+			ratio := (halfKm - coveredKm) / lapKm
+			firstSeconds += lapSeconds * ratio
+			secondSeconds += lapSeconds * (1 - ratio)
+		}
+		coveredKm += lapKm
+	}
+
+	return &SplitComparison{
+		firstHalf:  Pace{minutesPerKm: firstSeconds / 60 / halfKm},
+		secondHalf: Pace{minutesPerKm: secondSeconds / 60 / halfKm},
+	}
+}
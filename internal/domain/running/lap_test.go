@@ -0,0 +1,158 @@
+package running
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// ラップコンテキストのテスト
+// =============================================================================
+
+// lapSpec はテスト用のラップの距離（km）・秒数・ラップタイプの組です
+type lapSpec struct {
+	km      float64
+	seconds float64
+	lapType LapType
+}
+
+// newTestLaps はテスト用のラップを作成するヘルパーです
+func newTestLaps(t *testing.T, specs ...lapSpec) []*Lap {
+	t.Helper()
+	session := newTestSession(t, 10, time.Hour, Interval)
+	for _, spec := range specs {
+		distance, err := NewDistance(spec.km)
+		assert.NoError(t, err)
+		duration, err := NewDuration(time.Duration(spec.seconds * float64(time.Second)))
+		assert.NoError(t, err)
+		_, err = session.AddLap(distance, duration, spec.lapType)
+		assert.NoError(t, err)
+	}
+	return session.Laps()
+}
+
+func TestRunningSession_AddLap(t *testing.T) {
+	// Arrange
+	session := newTestSession(t, 5, 25*time.Minute, Interval)
+	distance, _ := NewDistance(0.8)
+	duration, _ := NewDuration(176 * time.Second)
+
+	// Act
+	first, err1 := session.AddLap(distance, duration, WorkLap)
+	second, err2 := session.AddLap(distance, duration, RestLap)
+
+	// Assert
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.Equal(t, 1, first.Number())
+	assert.Equal(t, 2, second.Number())
+	assert.Equal(t, "3:40/km", first.Pace().String())
+	assert.Len(t, session.Laps(), 2)
+}
+
+func TestNewLapType(t *testing.T) {
+	lapType, err := NewLapType("Rest")
+	assert.NoError(t, err)
+	assert.Equal(t, RestLap, lapType)
+
+	_, err = NewLapType("Cooldown")
+	assert.Error(t, err)
+}
+
+func TestAnalyzeIntervalConsistency(t *testing.T) {
+	t.Run("正常系:休息区間を除いた疾走区間のばらつき", func(t *testing.T) {
+		// Arrange: 800m×3本（2:56, 2:52, 3:00）、間に400mジョグ
+		laps := newTestLaps(t,
+			lapSpec{0.8, 176, WorkLap},
+			lapSpec{0.4, 150, RestLap},
+			lapSpec{0.8, 172, WorkLap},
+			lapSpec{0.4, 150, RestLap},
+			lapSpec{0.8, 180, WorkLap},
+		)
+
+		// Act
+		consistency := AnalyzeIntervalConsistency(laps)
+
+		// Assert
+		assert.NotNil(t, consistency)
+		assert.Equal(t, 3, consistency.WorkLaps())
+		assert.Equal(t, 3, consistency.Fastest().Number())
+		assert.Equal(t, 5, consistency.Slowest().Number())
+		assert.InDelta(t, 220, consistency.AveragePace().SecondsPerKm(), 0.01)
+		assert.InDelta(t, 10, consistency.SpreadSecondsPerKm(), 0.01)
+		assert.InDelta(t, 1.86, consistency.CVPercent(), 0.01)
+		assert.True(t, consistency.IsConsistent())
+	})
+
+	t.Run("正常系:ばらつきが大きい場合は安定していない", func(t *testing.T) {
+		// Arrange
+		laps := newTestLaps(t,
+			lapSpec{1, 200, WorkLap},
+			lapSpec{1, 230, WorkLap},
+		)
+
+		// Act
+		consistency := AnalyzeIntervalConsistency(laps)
+
+		// Assert
+		assert.False(t, consistency.IsConsistent())
+	})
+
+	t.Run("正常系:疾走区間が1本ならnil", func(t *testing.T) {
+		// Arrange
+		laps := newTestLaps(t, lapSpec{1, 200, WorkLap}, lapSpec{0.4, 150, RestLap})
+
+		// Act & Assert
+		assert.Nil(t, AnalyzeIntervalConsistency(laps))
+	})
+}
+
+func TestCompareSplits(t *testing.T) {
+	t.Run("正常系:後半が速ければネガティブスプリット", func(t *testing.T) {
+		// Arrange: 1kmラップ4本（5:10, 5:05, 4:55, 4:50）
+		laps := newTestLaps(t,
+			lapSpec{1, 310, WorkLap},
+			lapSpec{1, 305, WorkLap},
+			lapSpec{1, 295, WorkLap},
+			lapSpec{1, 290, WorkLap},
+		)
+
+		// Act
+		splits := CompareSplits(laps)
+
+		// Assert
+		assert.InDelta(t, 307.5, splits.FirstHalf().SecondsPerKm(), 0.01)
+		assert.InDelta(t, 292.5, splits.SecondHalf().SecondsPerKm(), 0.01)
+		assert.InDelta(t, -15, splits.DifferenceSecondsPerKm(), 0.01)
+		assert.True(t, splits.IsNegativeSplit())
+	})
+
+	t.Run("正常系:中間点をまたぐラップは按分する", func(t *testing.T) {
+		// Arrange: 2km(10:00) + 1km(4:00)、中間点1.5kmは1本目の途中
+		laps := newTestLaps(t,
+			lapSpec{2, 600, WorkLap},
+			lapSpec{1, 240, WorkLap},
+		)
+
+		// Act
+		splits := CompareSplits(laps)
+
+		// Assert: 前半1.5km=450秒、後半=150秒+240秒
+		assert.InDelta(t, 300, splits.FirstHalf().SecondsPerKm(), 0.01)
+		assert.InDelta(t, 260, splits.SecondHalf().SecondsPerKm(), 0.01)
+		assert.True(t, splits.IsNegativeSplit())
+	})
+
+	t.Run("正常系:後半が遅ければポジティブスプリット", func(t *testing.T) {
+		// Arrange
+		laps := newTestLaps(t,
+			lapSpec{1, 280, WorkLap},
+			lapSpec{1, 300, WorkLap},
+		)
+
+		// Act & Assert
+		assert.False(t, CompareSplits(laps).IsNegativeSplit())
+	})
+}
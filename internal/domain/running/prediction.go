@@ -0,0 +1,210 @@
+package running
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// =============================================================================
+// レースタイム予測コンテキスト - Riegel / Daniels VDOT / Cameron モデル
+// =============================================================================
+
+// PredictionModel はレースタイム予測モデルを表す値オブジェクト
+type PredictionModel struct {
+	value string
+}
+
+// 定義済み予測モデルの定数
+var (
+	RiegelModel  = PredictionModel{value: "Riegel"}  // Riegel式（T2 = T1 × (D2/D1)^1.06）
+	VDOTModel    = PredictionModel{value: "VDOT"}    // Jack DanielsのVDOT
+	CameronModel = PredictionModel{value: "Cameron"} // Dave Cameronのモデル
+)
+
+// PredictionModels は全ての予測モデルを表示順に返します
+func PredictionModels() []PredictionModel {
+	return []PredictionModel{RiegelModel, VDOTModel, CameronModel}
+}
+
+// Name は予測モデル名を返します
+func (pm PredictionModel) Name() string {
+	return pm.value
+}
+
+// String は予測モデルの文字列表現を返します
+func (pm PredictionModel) String() string {
+	return pm.value
+}
+
+// Equals は2つの予測モデルが等しいかを判定します
+func (pm PredictionModel) Equals(other PredictionModel) bool {
+	return pm.value == other.value
+}
+
+// RiegelExponent はRiegel式の疲労係数です
+const RiegelExponent = 1.06
+
+// MinPredictionBasisKm は予測の基準にできる最短距離です（短すぎる記録は外挿誤差が大きいため）
+const MinPredictionBasisKm = 1.5
+
+// PredictRiegel はRiegel式で目標距離のタイムを予測します
+func PredictRiegel(distance Distance, duration Duration, target Distance) (Duration, error) {
+	seconds := duration.Seconds() * math.Pow(target.Km()/distance.Km(), RiegelExponent)
+	return NewDuration(time.Duration(seconds * float64(time.Second)))
+}
+
+// PredictCameron はCameronモデルで目標距離のタイムを予測します
+func PredictCameron(distance Distance, duration Duration, target Distance) (Duration, error) {
+	factor := func(meters float64) float64 {
+		return 13.49681 - 0.000030363*meters + 835.7114/math.Pow(meters, 0.7905)
+	}
+	seconds := duration.Seconds() / distance.Meters() * (factor(distance.Meters()) / factor(target.Meters())) * target.Meters()
+	return NewDuration(time.Duration(seconds * float64(time.Second)))
+}
+
+// CalculateVDOT はDanielsの式で距離とタイムからVDOTを計算します
+func CalculateVDOT(distance Distance, duration Duration) float64 {
+	minutes := duration.Minutes()
+	velocity := distance.Meters() / minutes // m/分
+	vo2 := -4.60 + 0.182258*velocity + 0.000104*velocity*velocity
+	percentMax := 0.8 + 0.1894393*math.Exp(-0.012778*minutes) + 0.2989558*math.Exp(-0.1932605*minutes)
+	return vo2 / percentMax
+}
+
+// PredictVDOT は指定したVDOTで目標距離を走るタイムを予測します
+// VDOTはタイムに対して単調減少するため二分探索で求めます
+func PredictVDOT(vdot float64, target Distance) (Duration, error) {
+	if vdot <= 0 {
+		return Duration{}, fmt.Errorf("VDOT must be positive: %f", vdot)
+	}
+
+	low, high := 1.0, 24*60.0 // 分
+	for i := 0; i < 100; i++ {
+		mid := (low + high) / 2
+		candidate := Duration{duration: time.Duration(mid * float64(time.Minute))}
+		if CalculateVDOT(target, candidate) > vdot {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return NewDuration(time.Duration((low + high) / 2 * float64(time.Minute)))
+}
+
+// RacePrediction はモデルごとの予測結果を表す値オブジェクト
+type RacePrediction struct {
+	eventType     EventType       // 予測対象の種目
+	model         PredictionModel // 予測モデル
+	predictedTime Duration        // 予測タイム
+	basis         *RunningSession // 予測の基準にしたセッション
+}
+
+// EventType は予測対象の種目を返します
+func (rp RacePrediction) EventType() EventType {
+	return rp.eventType
+}
+
+// Model は予測モデルを返します
+func (rp RacePrediction) Model() PredictionModel {
+	return rp.model
+}
+
+// PredictedTime は予測タイムを返します
+func (rp RacePrediction) PredictedTime() Duration {
+	return rp.predictedTime
+}
+
+// PredictedPace は予測タイムから求めたペースを返します
+func (rp RacePrediction) PredictedPace() (Pace, error) {
+	distance, err := rp.eventType.GetStandardDistance()
+	if err != nil {
+		return Pace{}, err
+	}
+	return CalculatePace(distance, rp.predictedTime)
+}
+
+// Basis は予測の基準にしたセッションを返します
+func (rp RacePrediction) Basis() *RunningSession {
+	return rp.basis
+}
+
+// RacePredictionService は最近のレース・テンポ走からレースタイムを予測するドメインサービス
+type RacePredictionService struct{}
+
+// NewRacePredictionService は新しいRacePredictionServiceを作成します
+func NewRacePredictionService() *RacePredictionService {
+	return &RacePredictionService{}
+}
+
+// PredictionEventTypes は予測対象の種目を返します（標準距離のある種目のみ）
+func PredictionEventTypes() []EventType {
+	return []EventType{FiveK, TenK, HalfMarathon, Marathon}
+}
+
+// IsPredictionBasis はセッションが予測の基準として使えるかを判定します（RaceまたはTempoのみ）
+func IsPredictionBasis(session *RunningSession) bool {
+	if session.Distance().Km() < MinPredictionBasisKm {
+		return false
+	}
+	return session.RunType().Equals(Race) || session.RunType().Equals(Tempo)
+}
+
+// Predict はセッションを基準に各種目・各モデルの予測タイムを計算します
+// 種目・モデルごとに、最も速い予測となる（＝最もパフォーマンスの高い）セッションを基準とします
+func (s *RacePredictionService) Predict(sessions []*RunningSession) ([]RacePrediction, error) {
+	bases := make([]*RunningSession, 0, len(sessions))
+	for _, session := range sessions {
+		if IsPredictionBasis(session) {
+			bases = append(bases, session)
+		}
+	}
+	if len(bases) == 0 {
+		return nil, fmt.Errorf("no race or tempo sessions of at least %.1fkm to base predictions on", MinPredictionBasisKm)
+	}
+
+	predictions := make([]RacePrediction, 0)
+	for _, eventType := range PredictionEventTypes() {
+		target, err := eventType.GetStandardDistance()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, model := range PredictionModels() {
+			var best *RacePrediction
+			for _, basis := range bases {
+				predicted, err := s.predictWithModel(model, basis, target)
+				if err != nil {
+					continue
+				}
+				if best == nil || predicted.Value() < best.predictedTime.Value() {
+					best = &RacePrediction{
+						eventType:     eventType,
+						model:         model,
+						predictedTime: predicted,
+						basis:         basis,
+					}
+				}
+			}
+			if best != nil {
+				predictions = append(predictions, *best)
+			}
+		}
+	}
+
+	return predictions, nil
+}
+
+// predictWithModel は指定したモデルでセッションから目標距離のタイムを予測します
+func (s *RacePredictionService) predictWithModel(model PredictionModel, basis *RunningSession, target Distance) (Duration, error) {
+	switch model {
+	case RiegelModel:
+		return PredictRiegel(basis.Distance(), basis.Duration(), target)
+	case VDOTModel:
+		return PredictVDOT(CalculateVDOT(basis.Distance(), basis.Duration()), target)
+	case CameronModel:
+		return PredictCameron(basis.Distance(), basis.Duration(), target)
+	default:
+		return Duration{}, fmt.Errorf("unknown prediction model: %s", model.String())
+	}
+}
@@ -0,0 +1,147 @@
+package running
+
+import (
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// レースタイム予測コンテキストのテスト
+// =============================================================================
+
+// newTestSession はテスト用のランニングセッションを作成するヘルパーです
+func newTestSession(t *testing.T, km float64, d time.Duration, runType RunType) *RunningSession {
+	t.Helper()
+	distance, err := NewDistance(km)
+	assert.NoError(t, err)
+	duration, err := NewDuration(d)
+	assert.NoError(t, err)
+	session, err := NewRunningSession(shared.NewSessionID(), time.Now(), distance, duration, runType, "")
+	assert.NoError(t, err)
+	return session
+}
+
+func TestCalculateVDOT(t *testing.T) {
+	tests := []struct {
+		name     string
+		km       float64
+		duration time.Duration
+		expected float64
+	}{
+		{
+			name:     "正常系:5K 20:00はVDOT約50",
+			km:       5,
+			duration: 20 * time.Minute,
+			expected: 49.8,
+		},
+		{
+			name:     "正常系:10K 40:00はVDOT約52",
+			km:       10,
+			duration: 40 * time.Minute,
+			expected: 51.9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			distance, _ := NewDistance(tt.km)
+			duration, _ := NewDuration(tt.duration)
+
+			// Act
+			vdot := CalculateVDOT(distance, duration)
+
+			// Assert
+			assert.InDelta(t, tt.expected, vdot, 0.3)
+		})
+	}
+}
+
+func TestPredictVDOT(t *testing.T) {
+	// Arrange
+	distance, _ := NewDistance(10)
+	duration, _ := NewDuration(40 * time.Minute)
+	vdot := CalculateVDOT(distance, duration)
+
+	// Act: 同じ距離を予測すると元のタイムに戻る
+	predicted, err := PredictVDOT(vdot, distance)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.InDelta(t, duration.Seconds(), predicted.Seconds(), 1)
+}
+
+func TestPredictRiegel(t *testing.T) {
+	// Arrange
+	distance, _ := NewDistance(10)
+	duration, _ := NewDuration(40 * time.Minute)
+	half, _ := HalfMarathon.GetStandardDistance()
+
+	// Act
+	predicted, err := PredictRiegel(distance, duration, half)
+
+	// Assert: 40分 × (21.0975/10)^1.06 ≒ 88.3分
+	assert.NoError(t, err)
+	assert.InDelta(t, 88.3, predicted.Minutes(), 0.1)
+}
+
+func TestPredictCameron(t *testing.T) {
+	// Arrange
+	distance, _ := NewDistance(10)
+	duration, _ := NewDuration(40 * time.Minute)
+	half, _ := HalfMarathon.GetStandardDistance()
+
+	// Act
+	predicted, err := PredictCameron(distance, duration, half)
+
+	// Assert: 10Kより遅いペースになる
+	assert.NoError(t, err)
+	assert.Greater(t, predicted.Minutes()/half.Km(), duration.Minutes()/distance.Km())
+	assert.InDelta(t, 88.5, predicted.Minutes(), 1.5)
+}
+
+func TestRacePredictionService_Predict(t *testing.T) {
+	t.Run("正常系:種目・モデルごとに最速の予測となるセッションを基準にする", func(t *testing.T) {
+		// Arrange
+		slowRace := newTestSession(t, 10, 45*time.Minute, Race)
+		fastRace := newTestSession(t, 10, 40*time.Minute, Race)
+		easy := newTestSession(t, 10, 30*time.Minute, Easy) // Easyは対象外
+		service := NewRacePredictionService()
+
+		// Act
+		predictions, err := service.Predict([]*RunningSession{slowRace, fastRace, easy})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, predictions, len(PredictionEventTypes())*len(PredictionModels()))
+		for _, prediction := range predictions {
+			assert.Equal(t, fastRace, prediction.Basis())
+		}
+	})
+
+	t.Run("異常系:基準となるセッションがない", func(t *testing.T) {
+		// Arrange
+		easy := newTestSession(t, 10, 50*time.Minute, Easy)
+		shortRace := newTestSession(t, 1, 3*time.Minute, Race)
+		service := NewRacePredictionService()
+
+		// Act
+		_, err := service.Predict([]*RunningSession{easy, shortRace})
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestDuration_Clock(t *testing.T) {
+	// Arrange
+	short, _ := NewDuration(25*time.Minute + 30*time.Second)
+	long, _ := NewDuration(time.Hour + 45*time.Minute + 30*time.Second)
+
+	// Act & Assert
+	assert.Equal(t, "25:30", short.Clock())
+	assert.Equal(t, "1:45:30", long.Clock())
+}
@@ -0,0 +1,225 @@
+package running
+
+import (
+	"fmt"
+	"time"
+)
+
+// =============================================================================
+// アスリートプロファイルコンテキスト - 生理学的指標とランの強度判定
+// =============================================================================
+
+// AthleteProfile はアスリートの生理学的指標を表すエンティティ
+type AthleteProfile struct {
+	maxHeartRate       *HeartRate // 最大心拍数
+	restingHeartRate   *HeartRate // 安静時心拍数
+	thresholdHeartRate *HeartRate // 乳酸閾値心拍数（LTHR）
+	vdot               *float64   // VDOT（オプション）
+	thresholdPace      *Pace      // 閾値ペース（オプション）
+	updatedAt          time.Time  // 更新日時
+}
+
+// NewAthleteProfile は空のAthleteProfileを作成します
+func NewAthleteProfile() *AthleteProfile {
+	return &AthleteProfile{updatedAt: time.Now()}
+}
+
+// MaxHeartRate は最大心拍数を返します（オプション）
+func (ap *AthleteProfile) MaxHeartRate() *HeartRate {
+	return ap.maxHeartRate
+}
+
+// RestingHeartRate は安静時心拍数を返します（オプション）
+func (ap *AthleteProfile) RestingHeartRate() *HeartRate {
+	return ap.restingHeartRate
+}
+
+// ThresholdHeartRate は乳酸閾値心拍数を返します（オプション）
+func (ap *AthleteProfile) ThresholdHeartRate() *HeartRate {
+	return ap.thresholdHeartRate
+}
+
+// VDOT はVDOTを返します（オプション）
+func (ap *AthleteProfile) VDOT() *float64 {
+	return ap.vdot
+}
+
+// ThresholdPace は閾値ペースを返します（オプション）
+func (ap *AthleteProfile) ThresholdPace() *Pace {
+	return ap.thresholdPace
+}
+
+// UpdatedAt は更新日時を返します
+func (ap *AthleteProfile) UpdatedAt() time.Time {
+	return ap.updatedAt
+}
+
+// SetHeartRates は最大心拍数・安静時心拍数・LTHRを設定します（nilの項目は変更しません）
+func (ap *AthleteProfile) SetHeartRates(maxHR, restingHR, lthr *HeartRate) error {
+	newMax, newResting := ap.maxHeartRate, ap.restingHeartRate
+	if maxHR != nil {
+		newMax = maxHR
+	}
+	if restingHR != nil {
+		newResting = restingHR
+	}
+	if newMax != nil && newResting != nil && newResting.BPM() >= newMax.BPM() {
+		return fmt.Errorf("resting heart rate must be lower than max heart rate: %d >= %d", newResting.BPM(), newMax.BPM())
+	}
+	if lthr != nil && newMax != nil && lthr.BPM() > newMax.BPM() {
+		return fmt.Errorf("threshold heart rate must not exceed max heart rate: %d > %d", lthr.BPM(), newMax.BPM())
+	}
+
+	ap.maxHeartRate, ap.restingHeartRate = newMax, newResting
+	if lthr != nil {
+		ap.thresholdHeartRate = lthr
+	}
+	ap.updatedAt = time.Now()
+	return nil
+}
+
+// SetVDOT はVDOTを設定します
+func (ap *AthleteProfile) SetVDOT(vdot float64) error {
+	if vdot < 20 || vdot > 90 {
+		return fmt.Errorf("VDOT must be between 20 and 90: %.1f", vdot)
+	}
+	ap.vdot = &vdot
+	ap.updatedAt = time.Now()
+	return nil
+}
+
+// SetThresholdPace は閾値ペースを設定します
+func (ap *AthleteProfile) SetThresholdPace(pace Pace) {
+	ap.thresholdPace = &pace
+	ap.updatedAt = time.Now()
+}
+
+// Restore は永続化された更新日時を復元します
+func (ap *AthleteProfile) Restore(updatedAt time.Time) {
+	ap.updatedAt = updatedAt
+}
+
+// HeartRateZones はプロファイルから心拍ゾーンを計算します
+// 最大心拍数と安静時心拍数があればKarvonen法、なければLTHRを使用します
+func (ap *AthleteProfile) HeartRateZones() (*HeartRateZones, error) {
+	if ap.maxHeartRate != nil && ap.restingHeartRate != nil {
+		return NewKarvonenZones(*ap.maxHeartRate, *ap.restingHeartRate)
+	}
+	if ap.thresholdHeartRate != nil {
+		return NewLTHRZones(*ap.thresholdHeartRate)
+	}
+	return nil, fmt.Errorf("max and resting heart rate or threshold heart rate is required")
+}
+
+// PaceSource はトレーニングペースの算出元を表します
+type PaceSource string
+
+const (
+	PaceSourceVDOT          PaceSource = "VDOT"          // プロファイルのVDOT
+	PaceSourceThresholdPace PaceSource = "ThresholdPace" // プロファイルの閾値ペース
+	PaceSourceRecentRuns    PaceSource = "RecentRuns"    // 最近のレース・テンポ走
+)
+
+// ResolveTrainingPaces はトレーニングペースを求めます
+// プロファイルのVDOT、閾値ペース、最近のレース・テンポ走から推定したVDOTの順に使用します
+func ResolveTrainingPaces(profile *AthleteProfile, recent []*RunningSession) (*TrainingPaces, PaceSource, error) {
+	if profile != nil && profile.vdot != nil {
+		paces, err := NewTrainingPaces(*profile.vdot)
+		return paces, PaceSourceVDOT, err
+	}
+	if profile != nil && profile.thresholdPace != nil {
+		paces, err := NewTrainingPacesFromThreshold(*profile.thresholdPace)
+		return paces, PaceSourceThresholdPace, err
+	}
+
+	bestVDOT := 0.0
+	for _, session := range recent {
+		if !IsPredictionBasis(session) {
+			continue
+		}
+		if vdot := CalculateVDOT(session.Distance(), session.Duration()); vdot > bestVDOT {
+			bestVDOT = vdot
+		}
+	}
+	if bestVDOT == 0 {
+		return nil, "", fmt.Errorf("VDOT, threshold pace or a recent race/tempo run is required")
+	}
+	paces, err := NewTrainingPaces(bestVDOT)
+	return paces, PaceSourceRecentRuns, err
+}
+
+// RunClassification はランの強度判定結果を表す値オブジェクト
+type RunClassification struct {
+	paceZone      *PaceZone // ペースゾーン（ペースが算出できない場合はnil）
+	heartRateZone *int      // 心拍ゾーン（心拍数・ゾーンがない場合はnil）
+	warnings      []string  // ランニングタイプと強度が合わない場合の警告
+}
+
+// PaceZone はペースゾーンを返します（オプション）
+func (rc RunClassification) PaceZone() *PaceZone {
+	return rc.paceZone
+}
+
+// HeartRateZone は心拍ゾーンを返します（オプション）
+func (rc RunClassification) HeartRateZone() *int {
+	return rc.heartRateZone
+}
+
+// Warnings は警告を返します
+func (rc RunClassification) Warnings() []string {
+	result := make([]string, len(rc.warnings))
+	copy(result, rc.warnings)
+	return result
+}
+
+// expectedZones はランニングタイプごとに想定されるペースゾーンの範囲（最も楽なゾーン, 最もきついゾーン）です
+// Interval（リカバリーを含む平均ペース）とRaceは判定しません
+var expectedZones = map[string][2]PaceZone{
+	Easy.String():  {EasyZone, EasyZone},
+	Long.String():  {EasyZone, MarathonZone},
+	Tempo.String(): {MarathonZone, ThresholdZone},
+}
+
+// zoneOrder はペースゾーンの強度順です
+func zoneOrder(zone PaceZone) int {
+	for i, intensity := range zoneIntensities {
+		if intensity.zone.Equals(zone) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ClassifyRun はランをペースゾーン・心拍ゾーンで判定し、ランニングタイプと強度が合わない場合に警告します
+func ClassifyRun(session *RunningSession, paces *TrainingPaces, heartRateZones *HeartRateZones) RunClassification {
+	classification := RunClassification{warnings: make([]string, 0)}
+	runType := session.RunType().String()
+
+	if paces != nil {
+		zone := paces.Classify(session.Pace())
+		classification.paceZone = &zone
+
+		if expected, exists := expectedZones[runType]; exists {
+			switch {
+			case zoneOrder(zone) > zoneOrder(expected[1]):
+				classification.warnings = append(classification.warnings,
+					fmt.Sprintf("%sランが%sペース（%s）で行われています。想定より強度が高すぎます", runType, zone.String(), session.Pace().String()))
+			case zoneOrder(zone) < zoneOrder(expected[0]):
+				classification.warnings = append(classification.warnings,
+					fmt.Sprintf("%sランが%sペース（%s）で行われています。想定より強度が低すぎます", runType, zone.String(), session.Pace().String()))
+			}
+		}
+	}
+
+	if heartRateZones != nil && session.HeartRate() != nil {
+		zone := heartRateZones.Classify(*session.HeartRate())
+		classification.heartRateZone = &zone
+
+		if (session.RunType().Equals(Easy) || session.RunType().Equals(Long)) && zone >= 4 {
+			classification.warnings = append(classification.warnings,
+				fmt.Sprintf("%sランの平均心拍数（%s）が心拍ゾーン%dです。強度を下げることを検討してください", runType, session.HeartRate().String(), zone))
+		}
+	}
+
+	return classification
+}
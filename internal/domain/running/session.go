@@ -61,6 +61,7 @@ type RunningSession struct {
 	heartRate *HeartRate       // 心拍数（オプション）
 	runType   RunType          // ランニングタイプ
 	notes     string           // メモ
+	laps      []*Lap           // ラップ（オプション、記録順）
 }
 
 // NewRunningSession は新しいRunningSessionを作成します
@@ -87,6 +88,7 @@ func NewRunningSession(
 		heartRate: nil,
 		runType:   runType,
 		notes:     notes,
+		laps:      make([]*Lap, 0),
 	}, nil
 }
 
@@ -130,6 +132,23 @@ func (rs *RunningSession) Notes() string {
 	return rs.notes
 }
 
+// Laps はラップを記録順に返します
+func (rs *RunningSession) Laps() []*Lap {
+	result := make([]*Lap, len(rs.laps))
+	copy(result, rs.laps)
+	return result
+}
+
+// AddLap はラップを追加します（ラップ番号は追加順に自動で採番されます）
+func (rs *RunningSession) AddLap(distance Distance, duration Duration, lapType LapType) (*Lap, error) {
+	lap, err := NewLap(len(rs.laps)+1, distance, duration, lapType)
+	if err != nil {
+		return nil, err
+	}
+	rs.laps = append(rs.laps, lap)
+	return lap, nil
+}
+
 // SetHeartRate は心拍数を設定します
 func (rs *RunningSession) SetHeartRate(heartRate HeartRate) {
 	rs.heartRate = &heartRate
@@ -2,6 +2,7 @@ package running
 
 import (
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -93,6 +94,18 @@ func (d Duration) String() string {
 	return fmt.Sprintf("%02d:%02d", minutes, seconds)
 }
 
+// Clock は時間を時計表記で返します（1時間未満はMM:SS、1時間以上はH:MM:SS形式）
+func (d Duration) Clock() string {
+	totalSeconds := int(math.Round(d.duration.Seconds()))
+	hours := totalSeconds / 3600
+	minutes := (totalSeconds % 3600) / 60
+	seconds := totalSeconds % 60
+	if hours > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", hours, minutes, seconds)
+	}
+	return fmt.Sprintf("%02d:%02d", minutes, seconds)
+}
+
 // Equals は2つの時間が等しいかを判定します
 func (d Duration) Equals(other Duration) bool {
 	return d.duration == other.duration
@@ -0,0 +1,247 @@
+package running
+
+import (
+	"fmt"
+	"math"
+)
+
+// =============================================================================
+// トレーニングゾーンコンテキスト - ペースゾーン（Daniels）と心拍ゾーン（Karvonen）
+// =============================================================================
+
+// PaceZone はペースゾーンを表す値オブジェクト
+type PaceZone struct {
+	value string
+}
+
+// 定義済みペースゾーンの定数
+var (
+	EasyZone       = PaceZone{value: "Easy"}       // E: イージー
+	MarathonZone   = PaceZone{value: "Marathon"}   // M: マラソン
+	ThresholdZone  = PaceZone{value: "Threshold"}  // T: 閾値
+	IntervalZone   = PaceZone{value: "Interval"}   // I: インターバル
+	RepetitionZone = PaceZone{value: "Repetition"} // R: レペティション
+)
+
+// Name はペースゾーン名を返します
+func (pz PaceZone) Name() string {
+	return pz.value
+}
+
+// String はペースゾーンの文字列表現を返します
+func (pz PaceZone) String() string {
+	return pz.value
+}
+
+// Equals は2つのペースゾーンが等しいかを判定します
+func (pz PaceZone) Equals(other PaceZone) bool {
+	return pz.value == other.value
+}
+
+// zoneIntensity はペースゾーンごとのVDOTに対する強度（%VO2max）の範囲です
+var zoneIntensities = []struct {
+	zone      PaceZone
+	low, high float64 // 表示用の範囲
+	upper     float64 // 分類時の上限（この強度未満をこのゾーンとみなす、次のゾーンとの中間）
+}{
+	{zone: EasyZone, low: 0.59, high: 0.74, upper: 0.745},
+	{zone: MarathonZone, low: 0.75, high: 0.82, upper: 0.825},
+	{zone: ThresholdZone, low: 0.83, high: 0.88, upper: 0.915},
+	{zone: IntervalZone, low: 0.95, high: 1.00, upper: 1.025},
+	{zone: RepetitionZone, low: 1.05, high: 1.10, upper: math.Inf(1)},
+}
+
+// thresholdIntensity は閾値ペースに相当する強度（%VO2max）です
+const thresholdIntensity = 0.88
+
+// VO2AtVelocity は速度（m/分）での酸素摂取量を計算します（Danielsの式）
+func VO2AtVelocity(metersPerMinute float64) float64 {
+	return -4.60 + 0.182258*metersPerMinute + 0.000104*metersPerMinute*metersPerMinute
+}
+
+// VelocityAtVO2 は酸素摂取量に相当する速度（m/分）を計算します（VO2AtVelocityの逆関数）
+func VelocityAtVO2(vo2 float64) float64 {
+	a, b, c := 0.000104, 0.182258, -4.60-vo2
+	return (-b + math.Sqrt(b*b-4*a*c)) / (2 * a)
+}
+
+// paceFromVelocity は速度（m/分）をペースに変換します
+func paceFromVelocity(metersPerMinute float64) (Pace, error) {
+	return NewPace(1000 / metersPerMinute)
+}
+
+// VDOTFromThresholdPace は閾値ペースからVDOTを推定します
+func VDOTFromThresholdPace(pace Pace) float64 {
+	velocity := 1000 / pace.MinutesPerKm()
+	return VO2AtVelocity(velocity) / thresholdIntensity
+}
+
+// PaceRange はペースゾーンのペース範囲を表す値オブジェクト
+type PaceRange struct {
+	zone    PaceZone
+	fastest Pace
+	slowest Pace
+}
+
+// Zone はペースゾーンを返します
+func (pr PaceRange) Zone() PaceZone {
+	return pr.zone
+}
+
+// Fastest は範囲内で最も速いペースを返します
+func (pr PaceRange) Fastest() Pace {
+	return pr.fastest
+}
+
+// Slowest は範囲内で最も遅いペースを返します
+func (pr PaceRange) Slowest() Pace {
+	return pr.slowest
+}
+
+// String はペース範囲の文字列表現を返します
+func (pr PaceRange) String() string {
+	return fmt.Sprintf("%s: %s 〜 %s", pr.zone.String(), pr.fastest.String(), pr.slowest.String())
+}
+
+// TrainingPaces はVDOTから求めたトレーニングペースを表す値オブジェクト
+type TrainingPaces struct {
+	vdot   float64
+	ranges []PaceRange
+}
+
+// NewTrainingPaces はVDOTからトレーニングペースを計算します
+func NewTrainingPaces(vdot float64) (*TrainingPaces, error) {
+	if vdot < 20 || vdot > 90 {
+		return nil, fmt.Errorf("VDOT must be between 20 and 90: %.1f", vdot)
+	}
+
+	ranges := make([]PaceRange, 0, len(zoneIntensities))
+	for _, intensity := range zoneIntensities {
+		fastest, err := paceFromVelocity(VelocityAtVO2(vdot * intensity.high))
+		if err != nil {
+			return nil, err
+		}
+		slowest, err := paceFromVelocity(VelocityAtVO2(vdot * intensity.low))
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, PaceRange{zone: intensity.zone, fastest: fastest, slowest: slowest})
+	}
+
+	return &TrainingPaces{vdot: vdot, ranges: ranges}, nil
+}
+
+// NewTrainingPacesFromThreshold は閾値ペースからトレーニングペースを計算します
+func NewTrainingPacesFromThreshold(thresholdPace Pace) (*TrainingPaces, error) {
+	return NewTrainingPaces(VDOTFromThresholdPace(thresholdPace))
+}
+
+// VDOT はVDOTを返します
+func (tp *TrainingPaces) VDOT() float64 {
+	return tp.vdot
+}
+
+// Ranges はゾーンごとのペース範囲を遅い順に返します
+func (tp *TrainingPaces) Ranges() []PaceRange {
+	result := make([]PaceRange, len(tp.ranges))
+	copy(result, tp.ranges)
+	return result
+}
+
+// Classify はペースがどのゾーンに相当するかを判定します
+// ゾーンの間のペースは隣接するゾーンの中間を境界として近い方のゾーンとみなします
+func (tp *TrainingPaces) Classify(pace Pace) PaceZone {
+	intensity := VO2AtVelocity(1000/pace.MinutesPerKm()) / tp.vdot
+	for _, zone := range zoneIntensities {
+		if intensity < zone.upper {
+			return zone.zone
+		}
+	}
+	return RepetitionZone
+}
+
+// HeartRateZone は心拍ゾーン（1〜5）の範囲を表す値オブジェクト
+type HeartRateZone struct {
+	number int // ゾーン番号（1〜5）
+	lower  int // 下限（bpm、0の場合は下限なし）
+	upper  int // 上限（bpm）
+}
+
+// Number はゾーン番号を返します
+func (hz HeartRateZone) Number() int {
+	return hz.number
+}
+
+// Lower は下限心拍数を返します（0の場合は下限なし）
+func (hz HeartRateZone) Lower() int {
+	return hz.lower
+}
+
+// Upper は上限心拍数を返します
+func (hz HeartRateZone) Upper() int {
+	return hz.upper
+}
+
+// HeartRateZones は心拍ゾーン1〜5を表す値オブジェクト
+type HeartRateZones struct {
+	method string // 算出方法（Karvonen / LTHR）
+	zones  []HeartRateZone
+}
+
+// NewKarvonenZones は最大心拍数と安静時心拍数からKarvonen法で心拍ゾーンを計算します
+// 各ゾーンは心拍予備量（HRR）の50/60/70/80/90/100%を境界とします
+func NewKarvonenZones(maxHR, restingHR HeartRate) (*HeartRateZones, error) {
+	if restingHR.BPM() >= maxHR.BPM() {
+		return nil, fmt.Errorf("resting heart rate must be lower than max heart rate: %d >= %d", restingHR.BPM(), maxHR.BPM())
+	}
+
+	reserve := float64(maxHR.BPM() - restingHR.BPM())
+	boundary := func(percent float64) int {
+		return int(math.Round(float64(restingHR.BPM()) + reserve*percent))
+	}
+
+	percents := []float64{0.5, 0.6, 0.7, 0.8, 0.9, 1.0}
+	zones := make([]HeartRateZone, 0, 5)
+	for i := 0; i < 5; i++ {
+		zones = append(zones, HeartRateZone{number: i + 1, lower: boundary(percents[i]), upper: boundary(percents[i+1])})
+	}
+	return &HeartRateZones{method: "Karvonen", zones: zones}, nil
+}
+
+// NewLTHRZones は乳酸閾値心拍数（LTHR）から心拍ゾーンを計算します（Frielのランニング用ゾーン）
+func NewLTHRZones(lthr HeartRate) (*HeartRateZones, error) {
+	boundary := func(percent float64) int {
+		return int(math.Round(float64(lthr.BPM()) * percent))
+	}
+
+	zones := []HeartRateZone{
+		{number: 1, lower: 0, upper: boundary(0.85)},
+		{number: 2, lower: boundary(0.85), upper: boundary(0.90)},
+		{number: 3, lower: boundary(0.90), upper: boundary(0.95)},
+		{number: 4, lower: boundary(0.95), upper: boundary(1.00)},
+		{number: 5, lower: boundary(1.00), upper: boundary(1.06)},
+	}
+	return &HeartRateZones{method: "LTHR", zones: zones}, nil
+}
+
+// Method は算出方法を返します
+func (hz *HeartRateZones) Method() string {
+	return hz.method
+}
+
+// Zones は心拍ゾーン1〜5を返します
+func (hz *HeartRateZones) Zones() []HeartRateZone {
+	result := make([]HeartRateZone, len(hz.zones))
+	copy(result, hz.zones)
+	return result
+}
+
+// Classify は心拍数がどのゾーンに相当するかを返します（ゾーン1未満は1、ゾーン5超は5）
+func (hz *HeartRateZones) Classify(heartRate HeartRate) int {
+	for _, zone := range hz.zones {
+		if heartRate.BPM() < zone.upper {
+			return zone.number
+		}
+	}
+	return 5
+}
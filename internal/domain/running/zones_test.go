@@ -0,0 +1,296 @@
+package running
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// トレーニングゾーンコンテキストのテスト
+// =============================================================================
+
+func TestNewTrainingPaces(t *testing.T) {
+	t.Run("正常系:VDOT50の閾値ペースは約4:15/km", func(t *testing.T) {
+		// Act
+		paces, err := NewTrainingPaces(50)
+
+		// Assert
+		assert.NoError(t, err)
+		ranges := paces.Ranges()
+		assert.Len(t, ranges, 5)
+		assert.Equal(t, ThresholdZone, ranges[2].Zone())
+		assert.InDelta(t, 255, ranges[2].Fastest().SecondsPerKm(), 3)
+		assert.InDelta(t, 230, ranges[3].Fastest().SecondsPerKm(), 3)
+	})
+
+	t.Run("正常系:ゾーンは遅い順に並び重ならない", func(t *testing.T) {
+		// Act
+		paces, err := NewTrainingPaces(45)
+
+		// Assert
+		assert.NoError(t, err)
+		ranges := paces.Ranges()
+		for i := 1; i < len(ranges); i++ {
+			assert.True(t, ranges[i].Slowest().IsFasterThan(ranges[i-1].Fastest()))
+		}
+	})
+
+	t.Run("異常系:範囲外のVDOTはエラー", func(t *testing.T) {
+		// Act
+		_, err := NewTrainingPaces(10)
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestNewTrainingPacesFromThreshold(t *testing.T) {
+	// Arrange
+	thresholdPace, _ := NewPace(4.25) // 4:15/km
+
+	// Act
+	paces, err := NewTrainingPacesFromThreshold(thresholdPace)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.InDelta(t, 50, paces.VDOT(), 0.5)
+}
+
+func TestTrainingPaces_Classify(t *testing.T) {
+	paces, _ := NewTrainingPaces(50)
+
+	tests := []struct {
+		name         string
+		minutesPerKm float64
+		expected     PaceZone
+	}{
+		{name: "正常系:5:30/kmはEasy", minutesPerKm: 5.5, expected: EasyZone},
+		{name: "正常系:4:36/kmはMarathon", minutesPerKm: 4.6, expected: MarathonZone},
+		{name: "正常系:4:15/kmはThreshold", minutesPerKm: 4.25, expected: ThresholdZone},
+		{name: "正常系:3:54/kmはInterval", minutesPerKm: 3.9, expected: IntervalZone},
+		{name: "正常系:3:36/kmはRepetition", minutesPerKm: 3.6, expected: RepetitionZone},
+		{name: "正常系:Easyより遅いペースもEasy", minutesPerKm: 8.0, expected: EasyZone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			pace, err := NewPace(tt.minutesPerKm)
+			assert.NoError(t, err)
+
+			// Act & Assert
+			assert.Equal(t, tt.expected, paces.Classify(pace))
+		})
+	}
+}
+
+func TestNewKarvonenZones(t *testing.T) {
+	t.Run("正常系:心拍予備量の50〜100%を5分割", func(t *testing.T) {
+		// Arrange
+		maxHR, _ := NewHeartRate(190)
+		restingHR, _ := NewHeartRate(50)
+
+		// Act
+		zones, err := NewKarvonenZones(maxHR, restingHR)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Karvonen", zones.Method())
+		assert.Equal(t, []HeartRateZone{
+			{number: 1, lower: 120, upper: 134},
+			{number: 2, lower: 134, upper: 148},
+			{number: 3, lower: 148, upper: 162},
+			{number: 4, lower: 162, upper: 176},
+			{number: 5, lower: 176, upper: 190},
+		}, zones.Zones())
+	})
+
+	t.Run("異常系:安静時心拍数が最大心拍数以上はエラー", func(t *testing.T) {
+		// Arrange
+		maxHR, _ := NewHeartRate(150)
+		restingHR, _ := NewHeartRate(150)
+
+		// Act
+		_, err := NewKarvonenZones(maxHR, restingHR)
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestHeartRateZones_Classify(t *testing.T) {
+	lthr, _ := NewHeartRate(170)
+	zones, _ := NewLTHRZones(lthr)
+
+	tests := []struct {
+		name     string
+		bpm      int
+		expected int
+	}{
+		{name: "正常系:LTHRの85%未満はゾーン1", bpm: 130, expected: 1},
+		{name: "正常系:LTHRの87%はゾーン2", bpm: 148, expected: 2},
+		{name: "正常系:LTHRの97%はゾーン4", bpm: 165, expected: 4},
+		{name: "正常系:LTHRはゾーン5", bpm: 170, expected: 5},
+		{name: "正常系:ゾーン5の上限超もゾーン5", bpm: 200, expected: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			heartRate, err := NewHeartRate(tt.bpm)
+			assert.NoError(t, err)
+
+			// Act & Assert
+			assert.Equal(t, tt.expected, zones.Classify(heartRate))
+		})
+	}
+}
+
+func TestAthleteProfile_SetHeartRates(t *testing.T) {
+	t.Run("正常系:指定した項目のみ更新", func(t *testing.T) {
+		// Arrange
+		profile := NewAthleteProfile()
+		maxHR, _ := NewHeartRate(190)
+		restingHR, _ := NewHeartRate(50)
+		assert.NoError(t, profile.SetHeartRates(&maxHR, &restingHR, nil))
+
+		// Act
+		newResting, _ := NewHeartRate(48)
+		err := profile.SetHeartRates(nil, &newResting, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 190, profile.MaxHeartRate().BPM())
+		assert.Equal(t, 48, profile.RestingHeartRate().BPM())
+	})
+
+	t.Run("異常系:安静時心拍数が最大心拍数以上はエラーで変更しない", func(t *testing.T) {
+		// Arrange
+		profile := NewAthleteProfile()
+		maxHR, _ := NewHeartRate(190)
+		assert.NoError(t, profile.SetHeartRates(&maxHR, nil, nil))
+
+		// Act
+		restingHR, _ := NewHeartRate(195)
+		err := profile.SetHeartRates(nil, &restingHR, nil)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, profile.RestingHeartRate())
+	})
+}
+
+func TestResolveTrainingPaces(t *testing.T) {
+	t.Run("正常系:プロファイルのVDOTを優先", func(t *testing.T) {
+		// Arrange
+		profile := NewAthleteProfile()
+		assert.NoError(t, profile.SetVDOT(45))
+		race := newTestSession(t, 5, 20*time.Minute, Race)
+
+		// Act
+		paces, source, err := ResolveTrainingPaces(profile, []*RunningSession{race})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, PaceSourceVDOT, source)
+		assert.Equal(t, 45.0, paces.VDOT())
+	})
+
+	t.Run("正常系:プロファイルがなければ最近のレースから推定", func(t *testing.T) {
+		// Arrange
+		race := newTestSession(t, 5, 20*time.Minute, Race)
+		easy := newTestSession(t, 10, 60*time.Minute, Easy)
+
+		// Act
+		paces, source, err := ResolveTrainingPaces(nil, []*RunningSession{race, easy})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, PaceSourceRecentRuns, source)
+		assert.InDelta(t, 49.8, paces.VDOT(), 0.1)
+	})
+
+	t.Run("異常系:基準になるデータがなければエラー", func(t *testing.T) {
+		// Arrange
+		easy := newTestSession(t, 10, 60*time.Minute, Easy)
+
+		// Act
+		_, _, err := ResolveTrainingPaces(NewAthleteProfile(), []*RunningSession{easy})
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestClassifyRun(t *testing.T) {
+	paces, _ := NewTrainingPaces(50)
+	maxHR, _ := NewHeartRate(190)
+	restingHR, _ := NewHeartRate(50)
+	heartRateZones, _ := NewKarvonenZones(maxHR, restingHR)
+
+	t.Run("正常系:閾値ペースのEasyランは警告", func(t *testing.T) {
+		// Arrange
+		session := newTestSession(t, 8, 34*time.Minute, Easy) // 4:15/km
+
+		// Act
+		classification := ClassifyRun(session, paces, nil)
+
+		// Assert
+		assert.Equal(t, ThresholdZone, *classification.PaceZone())
+		assert.Nil(t, classification.HeartRateZone())
+		assert.Len(t, classification.Warnings(), 1)
+	})
+
+	t.Run("正常系:Easyペースのイージーランは警告なし", func(t *testing.T) {
+		// Arrange
+		session := newTestSession(t, 10, 55*time.Minute, Easy) // 5:30/km
+		heartRate, _ := NewHeartRate(140)
+		session.SetHeartRate(heartRate)
+
+		// Act
+		classification := ClassifyRun(session, paces, heartRateZones)
+
+		// Assert
+		assert.Equal(t, EasyZone, *classification.PaceZone())
+		assert.Equal(t, 2, *classification.HeartRateZone())
+		assert.Empty(t, classification.Warnings())
+	})
+
+	t.Run("正常系:Easyペースのテンポ走は強度不足で警告", func(t *testing.T) {
+		// Arrange
+		session := newTestSession(t, 8, 44*time.Minute, Tempo) // 5:30/km
+
+		// Act
+		classification := ClassifyRun(session, paces, nil)
+
+		// Assert
+		assert.Len(t, classification.Warnings(), 1)
+	})
+
+	t.Run("正常系:心拍ゾーン4以上のロングランは警告", func(t *testing.T) {
+		// Arrange
+		session := newTestSession(t, 20, 110*time.Minute, Long) // 5:30/km
+		heartRate, _ := NewHeartRate(170)
+		session.SetHeartRate(heartRate)
+
+		// Act
+		classification := ClassifyRun(session, paces, heartRateZones)
+
+		// Assert
+		assert.Equal(t, 4, *classification.HeartRateZone())
+		assert.Len(t, classification.Warnings(), 1)
+	})
+
+	t.Run("正常系:インターバルとレースはペースで警告しない", func(t *testing.T) {
+		// Arrange
+		race := newTestSession(t, 5, 20*time.Minute, Race)
+
+		// Act
+		classification := ClassifyRun(race, paces, nil)
+
+		// Assert
+		assert.Empty(t, classification.Warnings())
+	})
+}
@@ -0,0 +1,75 @@
+package shared
+
+import (
+	"fmt"
+	"time"
+)
+
+// =============================================================================
+// カレンダー - ユーザーのタイムゾーンでの日付（ローカル日付）の計算
+// =============================================================================
+
+// DateLayout はローカル日付（YYYY-MM-DD）の形式です
+const DateLayout = "2006-01-02"
+
+// Calendar はユーザーのタイムゾーンで日付を扱う値オブジェクト
+// 日時はUTCで保存し、日付の判定・期間の計算・表示はこのタイムゾーンで行います（ゼロ値はUTC）
+type Calendar struct {
+	location *time.Location
+}
+
+// NewCalendar は指定したタイムゾーンのカレンダーを作成します（nilの場合はUTC）
+func NewCalendar(location *time.Location) Calendar {
+	return Calendar{location: location}
+}
+
+// Location はカレンダーのタイムゾーンを返します
+func (c Calendar) Location() *time.Location {
+	if c.location == nil {
+		return time.UTC
+	}
+	return c.location
+}
+
+// ParseDate はローカル日付（YYYY-MM-DD）をその日のタイムゾーンでの0時に変換します
+func (c Calendar) ParseDate(value string) (time.Time, error) {
+	date, err := time.ParseInLocation(DateLayout, value, c.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", value)
+	}
+	return date, nil
+}
+
+// In は日時をカレンダーのタイムゾーンの時刻に変換します
+func (c Calendar) In(t time.Time) time.Time {
+	return t.In(c.Location())
+}
+
+// DateOf は日時のローカル日付（YYYY-MM-DD）を返します
+func (c Calendar) DateOf(t time.Time) string {
+	return c.In(t).Format(DateLayout)
+}
+
+// StartOfDay は日時のローカル日付の0時を返します（夏時間の切り替え日も正しく扱います）
+func (c Calendar) StartOfDay(t time.Time) time.Time {
+	local := c.In(t)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.Location())
+}
+
+// EndOfDay は日時のローカル日付の最後の時刻（翌日0時の直前）を返します
+func (c Calendar) EndOfDay(t time.Time) time.Time {
+	return c.StartOfDay(t).AddDate(0, 0, 1).Add(-time.Nanosecond)
+}
+
+// Today は現在のローカル日付の0時を返します
+func (c Calendar) Today() time.Time {
+	return c.StartOfDay(time.Now())
+}
+
+// WeekRange は日時を含む週（月曜〜日曜）の月曜の0時と日曜の0時を返します
+func (c Calendar) WeekRange(t time.Time) (time.Time, time.Time) {
+	day := c.StartOfDay(t)
+	offset := (int(day.Weekday()) + 6) % 7 // 月曜からの日数
+	monday := day.AddDate(0, 0, -offset)
+	return monday, monday.AddDate(0, 0, 6)
+}
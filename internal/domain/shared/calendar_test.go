@@ -0,0 +1,102 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mustLoadLocation はテスト用にタイムゾーンを読み込みます
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("timezone %s is not available: %v", name, err)
+	}
+	return location
+}
+
+func TestCalendar_DateOf(t *testing.T) {
+	tokyo := NewCalendar(mustLoadLocation(t, "Asia/Tokyo"))
+
+	tests := []struct {
+		name     string
+		calendar Calendar
+		instant  time.Time
+		want     string
+	}{
+		{name: "正常系:JSTの深夜（UTCでは前日）はJSTの日付", calendar: tokyo, instant: time.Date(2025, 6, 12, 14, 30, 0, 0, time.UTC), want: "2025-06-12"},
+		{name: "正常系:JSTの0時ちょうどは当日", calendar: tokyo, instant: time.Date(2025, 6, 12, 15, 0, 0, 0, time.UTC), want: "2025-06-13"},
+		{name: "正常系:JSTの0時の直前は前日", calendar: tokyo, instant: time.Date(2025, 6, 12, 14, 59, 59, 0, time.UTC), want: "2025-06-12"},
+		{name: "正常系:ゼロ値はUTC", calendar: Calendar{}, instant: time.Date(2025, 6, 12, 23, 30, 0, 0, time.UTC), want: "2025-06-12"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := tt.calendar.DateOf(tt.instant)
+
+			// Assert
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCalendar_ParseDate(t *testing.T) {
+	t.Run("正常系:タイムゾーンの0時に変換する", func(t *testing.T) {
+		// Arrange
+		calendar := NewCalendar(mustLoadLocation(t, "Asia/Tokyo"))
+
+		// Act
+		date, err := calendar.ParseDate("2025-06-13")
+
+		// Assert
+		if assert.NoError(t, err) {
+			assert.Equal(t, time.Date(2025, 6, 12, 15, 0, 0, 0, time.UTC), date.UTC())
+			assert.Equal(t, "2025-06-13", calendar.DateOf(date))
+		}
+	})
+
+	t.Run("異常系:形式が不正", func(t *testing.T) {
+		// Act
+		_, err := Calendar{}.ParseDate("2025/06/13")
+
+		// Assert
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "expected YYYY-MM-DD")
+		}
+	})
+}
+
+func TestCalendar_DaylightSavingTime(t *testing.T) {
+	// 2025-03-09 2:00にEST（-5:00）からEDT（-4:00）に切り替わり、この日は23時間
+	calendar := NewCalendar(mustLoadLocation(t, "America/New_York"))
+
+	t.Run("正常系:切り替え日の0時から最後の時刻までは23時間", func(t *testing.T) {
+		// Arrange
+		instant := time.Date(2025, 3, 9, 12, 0, 0, 0, time.UTC)
+
+		// Act
+		start := calendar.StartOfDay(instant)
+		end := calendar.EndOfDay(instant)
+
+		// Assert
+		assert.Equal(t, time.Date(2025, 3, 9, 5, 0, 0, 0, time.UTC), start.UTC())
+		assert.Equal(t, 23*time.Hour, end.Sub(start)+time.Nanosecond)
+		assert.Equal(t, "2025-03-09", calendar.DateOf(end))
+	})
+
+	t.Run("正常系:切り替えをまたぐ週も月曜〜日曜の0時", func(t *testing.T) {
+		// Arrange
+		instant := time.Date(2025, 3, 10, 3, 30, 0, 0, time.UTC) // 3/9（日）23:30 EDT
+
+		// Act
+		monday, sunday := calendar.WeekRange(instant)
+
+		// Assert
+		assert.Equal(t, "2025-03-03", calendar.DateOf(monday))
+		assert.Equal(t, 0, calendar.In(monday).Hour())
+		assert.Equal(t, "2025-03-09", calendar.DateOf(sunday))
+		assert.Equal(t, 0, calendar.In(sunday).Hour())
+	})
+}
@@ -114,3 +114,39 @@ func (id GoalID) IsEmpty() bool {
 func (id GoalID) Equals(other GoalID) bool {
 	return id.value == other.value
 }
+
+// BodyMetricID は身体測定値を一意に識別するID
+type BodyMetricID struct {
+	value string
+}
+
+// NewBodyMetricID は新しいBodyMetricIDを生成します
+func NewBodyMetricID() BodyMetricID {
+	return BodyMetricID{value: uuid.New().String()}
+}
+
+// NewBodyMetricIDFromString は文字列からBodyMetricIDを作成します
+func NewBodyMetricIDFromString(s string) (BodyMetricID, error) {
+	if s == "" {
+		return BodyMetricID{}, fmt.Errorf("id cannot be empty")
+	}
+	if _, err := uuid.Parse(s); err != nil {
+		return BodyMetricID{}, fmt.Errorf("invalid uuid format: %w", err)
+	}
+	return BodyMetricID{value: s}, nil
+}
+
+// String はIDの文字列表現を返します
+func (id BodyMetricID) String() string {
+	return id.value
+}
+
+// IsEmpty はIDが空かどうかを判定します
+func (id BodyMetricID) IsEmpty() bool {
+	return id.value == ""
+}
+
+// Equals は2つのIDが等しいかを判定します
+func (id BodyMetricID) Equals(other BodyMetricID) bool {
+	return id.value == other.value
+}
@@ -0,0 +1,72 @@
+package strength
+
+import (
+	"fmt"
+	"strings"
+)
+
+// =============================================================================
+// エクササイズ別名コンテキスト - 他アプリのエクササイズ名の対応付け
+// =============================================================================
+
+// ExerciseAliasResolver は他アプリ（Strong / Hevy / FitNotes 等）のエクササイズ名を
+// このアプリのエクササイズ名に対応付けます
+// 大文字・小文字と連続する空白は区別しません
+type ExerciseAliasResolver struct {
+	aliases map[string]ExerciseName
+}
+
+// defaultExerciseAliases は組み込みの別名です
+// BIG3は定義済みエクササイズ名に対応付けてPR判定の対象にします
+var defaultExerciseAliases = map[string]ExerciseName{
+	"bench press":              BenchPress,
+	"bench press (barbell)":    BenchPress,
+	"barbell bench press":      BenchPress,
+	"flat barbell bench press": BenchPress,
+	"squat":                    Squat,
+	"squat (barbell)":          Squat,
+	"barbell squat":            Squat,
+	"back squat":               Squat,
+	"barbell back squat":       Squat,
+	"deadlift":                 Deadlift,
+	"deadlift (barbell)":       Deadlift,
+	"barbell deadlift":         Deadlift,
+	"conventional deadlift":    Deadlift,
+}
+
+// NewExerciseAliasResolver は組み込みの別名に、指定した対応表（別名→エクササイズ名）を加えたResolverを作成します
+// 対応表は組み込みの別名より優先されます
+func NewExerciseAliasResolver(mapping map[string]string) (*ExerciseAliasResolver, error) {
+	aliases := make(map[string]ExerciseName, len(defaultExerciseAliases)+len(mapping))
+	for alias, name := range defaultExerciseAliases {
+		aliases[alias] = name
+	}
+
+	for alias, name := range mapping {
+		key := normalizeAlias(alias)
+		if key == "" {
+			return nil, fmt.Errorf("exercise alias cannot be empty")
+		}
+		exerciseName, err := NewExerciseName(strings.TrimSpace(name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid exercise name for alias %q: %w", alias, err)
+		}
+		aliases[key] = exerciseName
+	}
+
+	return &ExerciseAliasResolver{aliases: aliases}, nil
+}
+
+// Resolve はエクササイズ名を解決します
+// 別名として登録されていない場合は前後の空白を除いた名前をそのまま使用します
+func (r *ExerciseAliasResolver) Resolve(name string) (ExerciseName, error) {
+	if resolved, exists := r.aliases[normalizeAlias(name)]; exists {
+		return resolved, nil
+	}
+	return NewExerciseName(strings.TrimSpace(name))
+}
+
+// normalizeAlias は別名を照合用に正規化します（小文字化・空白の正規化）
+func normalizeAlias(alias string) string {
+	return strings.Join(strings.Fields(strings.ToLower(alias)), " ")
+}
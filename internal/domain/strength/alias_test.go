@@ -0,0 +1,88 @@
+package strength
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// エクササイズ別名コンテキストのテスト
+// =============================================================================
+
+func TestExerciseAliasResolver_Resolve(t *testing.T) {
+	resolver, err := NewExerciseAliasResolver(map[string]string{
+		"Incline Bench Press (Dumbbell)": "インクラインダンベルプレス",
+		"Squat (Barbell)":                "ハイバースクワット",
+	})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected ExerciseName
+	}{
+		{
+			name:     "正常系:組み込みの別名はBIG3に対応付ける",
+			input:    "Bench Press (Barbell)",
+			expected: BenchPress,
+		},
+		{
+			name:     "正常系:大文字・小文字と連続する空白は区別しない",
+			input:    "  barbell   DEADLIFT ",
+			expected: Deadlift,
+		},
+		{
+			name:     "正常系:指定した対応表を使用する",
+			input:    "incline bench press (dumbbell)",
+			expected: ExerciseName{value: "インクラインダンベルプレス"},
+		},
+		{
+			name:     "正常系:指定した対応表は組み込みの別名より優先する",
+			input:    "Squat (Barbell)",
+			expected: ExerciseName{value: "ハイバースクワット"},
+		},
+		{
+			name:     "正常系:未登録の名前はそのまま使用する",
+			input:    " Lat Pulldown (Cable) ",
+			expected: ExerciseName{value: "Lat Pulldown (Cable)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			result, err := resolver.Resolve(tt.input)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("異常系:空の名前はエラー", func(t *testing.T) {
+		// Act
+		_, err := resolver.Resolve("   ")
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestNewExerciseAliasResolver(t *testing.T) {
+	t.Run("異常系:空の別名はエラー", func(t *testing.T) {
+		// Act
+		_, err := NewExerciseAliasResolver(map[string]string{" ": "ベンチプレス"})
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系:空のエクササイズ名はエラー", func(t *testing.T) {
+		// Act
+		_, err := NewExerciseAliasResolver(map[string]string{"Bench": ""})
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,289 @@
+package strength
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// セット注釈コンテキスト - テンポ、可動域、バリエーション
+// =============================================================================
+
+type (
+	// Tempo はテンポ（エキセントリック/ボトム停止/コンセントリック/トップ停止の秒数）を表す値オブジェクト
+	Tempo struct {
+		eccentric  int // 下ろす局面（秒）
+		pause      int // ボトムでの停止（秒）
+		concentric int // 挙上局面（秒）
+		top        int // トップでの停止（秒）
+		explosive  int // 爆発的（"X"）な局面のビット集合（1=エキセントリック, 2=ボトム停止, 4=コンセントリック, 8=トップ停止）
+	}
+
+	// RangeOfMotion は可動域を表す値オブジェクト
+	RangeOfMotion struct {
+		value string
+	}
+
+	// Variation はセットのバリエーションタグを表す値オブジェクト（例: paused, pin, deficit）
+	Variation struct {
+		value string
+	}
+)
+
+// 定義済み可動域の定数
+var (
+	FullROM    = RangeOfMotion{value: "Full"}    // フルレンジ
+	PartialROM = RangeOfMotion{value: "Partial"} // パーシャルレンジ（ピンプレス等）
+)
+
+// 定義済みバリエーションの定数
+var (
+	Paused  = Variation{value: "paused"}  // ポーズ（停止）
+	Pin     = Variation{value: "pin"}     // ピン（セーフティから挙上）
+	Deficit = Variation{value: "deficit"} // デフィシット
+	Box     = Variation{value: "box"}     // ボックス
+)
+
+const maxTempoPhaseSeconds = 20 // 各局面の現実的な上限（秒）
+
+// NewTempo はテンポを作成します
+func NewTempo(eccentric, pause, concentric, top int) (Tempo, error) {
+	for _, phase := range []int{eccentric, pause, concentric, top} {
+		if phase < 0 {
+			return Tempo{}, fmt.Errorf("tempo phase cannot be negative: %d", phase)
+		}
+		if phase > maxTempoPhaseSeconds {
+			return Tempo{}, fmt.Errorf("tempo phase is too long: %d", phase)
+		}
+	}
+	return Tempo{eccentric: eccentric, pause: pause, concentric: concentric, top: top}, nil
+}
+
+// allExplosivePhases は全ての局面を爆発的とするビット集合です
+const allExplosivePhases = 1<<4 - 1
+
+// WithExplosivePhases は指定した局面（ビット集合）を爆発的（"X"）としたテンポを返します
+// 爆発的な局面の秒数は0である必要があります
+func (t Tempo) WithExplosivePhases(phases int) (Tempo, error) {
+	if phases < 0 || phases > allExplosivePhases {
+		return Tempo{}, fmt.Errorf("invalid explosive tempo phases: %d", phases)
+	}
+	for i, seconds := range t.phases() {
+		if phases&(1<<i) != 0 && seconds != 0 {
+			return Tempo{}, fmt.Errorf("explosive tempo phase must be 0 seconds: %d", seconds)
+		}
+	}
+	t.explosive = phases
+	return t, nil
+}
+
+// ParseTempo は "3-1-1-0" または "31X0" 形式の文字列からテンポを作成します
+// "X" は爆発的な局面（0秒）として扱い、文字列表現でも "X" として保持します
+func ParseTempo(s string) (Tempo, error) {
+	s = strings.TrimSpace(s)
+	var parts []string
+	if strings.Contains(s, "-") {
+		parts = strings.Split(s, "-")
+	} else {
+		parts = strings.Split(s, "")
+	}
+	if len(parts) != 4 {
+		return Tempo{}, fmt.Errorf("invalid tempo format: %q (expected e.g. 3-1-1-0)", s)
+	}
+
+	phases := make([]int, 4)
+	explosive := 0
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if strings.EqualFold(part, "x") {
+			explosive |= 1 << i
+			continue
+		}
+		value, err := strconv.Atoi(part)
+		if err != nil {
+			return Tempo{}, fmt.Errorf("invalid tempo phase %q: %w", part, err)
+		}
+		phases[i] = value
+	}
+
+	tempo, err := NewTempo(phases[0], phases[1], phases[2], phases[3])
+	if err != nil {
+		return Tempo{}, err
+	}
+	return tempo.WithExplosivePhases(explosive)
+}
+
+// Eccentric はエキセントリック局面の秒数を返します
+func (t Tempo) Eccentric() int {
+	return t.eccentric
+}
+
+// Pause はボトムでの停止秒数を返します
+func (t Tempo) Pause() int {
+	return t.pause
+}
+
+// Concentric はコンセントリック局面の秒数を返します
+func (t Tempo) Concentric() int {
+	return t.concentric
+}
+
+// Top はトップでの停止秒数を返します
+func (t Tempo) Top() int {
+	return t.top
+}
+
+// ExplosivePhases は爆発的（"X"）な局面のビット集合を返します
+func (t Tempo) ExplosivePhases() int {
+	return t.explosive
+}
+
+// phases は各局面の秒数を表記順に返します
+func (t Tempo) phases() [4]int {
+	return [4]int{t.eccentric, t.pause, t.concentric, t.top}
+}
+
+// RepDuration は1レップあたりの所要時間を返します
+func (t Tempo) RepDuration() time.Duration {
+	return time.Duration(t.eccentric+t.pause+t.concentric+t.top) * time.Second
+}
+
+// IsPaused はボトムで停止するテンポかを判定します
+func (t Tempo) IsPaused() bool {
+	return t.pause > 0
+}
+
+// String はテンポの文字列表現を返します
+func (t Tempo) String() string {
+	parts := make([]string, 0, 4)
+	for i, seconds := range t.phases() {
+		if t.explosive&(1<<i) != 0 {
+			parts = append(parts, "X")
+			continue
+		}
+		parts = append(parts, strconv.Itoa(seconds))
+	}
+	return strings.Join(parts, "-")
+}
+
+// Equals は2つのテンポが等しいかを判定します
+func (t Tempo) Equals(other Tempo) bool {
+	return t == other
+}
+
+// NewRangeOfMotion は可動域を作成します
+func NewRangeOfMotion(rom string) (RangeOfMotion, error) {
+	validValues := []string{"Full", "Partial"}
+	for _, valid := range validValues {
+		if strings.EqualFold(rom, valid) {
+			return RangeOfMotion{value: valid}, nil
+		}
+	}
+	return RangeOfMotion{}, fmt.Errorf("invalid range of motion: %s", rom)
+}
+
+// Value は可動域を返します
+func (r RangeOfMotion) Value() string {
+	return r.value
+}
+
+// String は可動域の文字列表現を返します
+func (r RangeOfMotion) String() string {
+	return r.value
+}
+
+// Equals は2つの可動域が等しいかを判定します
+func (r RangeOfMotion) Equals(other RangeOfMotion) bool {
+	return r.value == other.value
+}
+
+// NewVariation はバリエーションタグを作成します（小文字に正規化）
+func NewVariation(tag string) (Variation, error) {
+	normalized := strings.ToLower(strings.TrimSpace(tag))
+	if normalized == "" {
+		return Variation{}, fmt.Errorf("variation cannot be empty")
+	}
+	if len(normalized) > 30 {
+		return Variation{}, fmt.Errorf("variation is too long: %s", tag)
+	}
+	return Variation{value: normalized}, nil
+}
+
+// Tag はバリエーションタグを返します
+func (v Variation) Tag() string {
+	return v.value
+}
+
+// String はバリエーションタグの文字列表現を返します
+func (v Variation) String() string {
+	return v.value
+}
+
+// Equals は2つのバリエーションタグが等しいかを判定します
+func (v Variation) Equals(other Variation) bool {
+	return v.value == other.value
+}
+
+// SetCriteria はセットの絞り込み条件を表します（未指定の条件は無視されます）
+type SetCriteria struct {
+	ExerciseName  *ExerciseName  // エクササイズ名
+	Variation     *Variation     // バリエーションタグ（pausedはテンポのボトム停止も含む）
+	RangeOfMotion *RangeOfMotion // 可動域（Fullは未指定のセットも含む）
+	TempoOnly     bool           // テンポが記録されたセットのみ
+
+	// 数値の範囲（両端を含む）
+	MinWeightKg *float64 // 最小重量（kg）
+	MaxWeightKg *float64 // 最大重量（kg）
+	MinReps     *int     // 最小回数
+	MaxReps     *int     // 最大回数
+	MinRPE      *float64 // 最小RPE（RPEが記録されていないセットは除く）
+	MaxRPE      *float64 // 最大RPE（RPEが記録されていないセットは除く）
+}
+
+// IsEmpty は絞り込み条件が指定されていないかを判定します
+func (c SetCriteria) IsEmpty() bool {
+	return c.ExerciseName == nil && c.Variation == nil && c.RangeOfMotion == nil && !c.TempoOnly &&
+		c.MinWeightKg == nil && c.MaxWeightKg == nil && c.MinReps == nil && c.MaxReps == nil && c.MinRPE == nil && c.MaxRPE == nil
+}
+
+// Matches はセットが条件に一致するかを判定します（エクササイズ名はFilterSetsで判定）
+func (c SetCriteria) Matches(set Set) bool {
+	if c.Variation != nil {
+		if c.Variation.Equals(Paused) {
+			if !set.IsPaused() {
+				return false
+			}
+		} else if !set.HasVariation(*c.Variation) {
+			return false
+		}
+	}
+
+	if c.RangeOfMotion != nil {
+		if c.RangeOfMotion.Equals(PartialROM) != set.IsPartial() {
+			return false
+		}
+	}
+
+	if c.TempoOnly && set.Tempo() == nil {
+		return false
+	}
+
+	weight := set.Weight().Kg()
+	if (c.MinWeightKg != nil && weight < *c.MinWeightKg) || (c.MaxWeightKg != nil && weight > *c.MaxWeightKg) {
+		return false
+	}
+	reps := set.Reps().Count()
+	if (c.MinReps != nil && reps < *c.MinReps) || (c.MaxReps != nil && reps > *c.MaxReps) {
+		return false
+	}
+	if c.MinRPE != nil || c.MaxRPE != nil {
+		rpe := set.RPE()
+		if rpe == nil || (c.MinRPE != nil && rpe.Value() < *c.MinRPE) || (c.MaxRPE != nil && rpe.Value() > *c.MaxRPE) {
+			return false
+		}
+	}
+
+	return true
+}
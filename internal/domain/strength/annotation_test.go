@@ -0,0 +1,217 @@
+package strength
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// セット注釈コンテキストのテスト
+// =============================================================================
+
+func TestTempo_ParseTempo(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		wantError bool
+	}{
+		{
+			name:     "正常系:ハイフン区切り",
+			input:    "3-1-1-0",
+			expected: "3-1-1-0",
+		},
+		{
+			name:     "正常系:4桁表記と爆発的挙上X",
+			input:    "31X0",
+			expected: "3-1-X-0",
+		},
+		{
+			name:     "正常系:ハイフン区切りの小文字x",
+			input:    "x-0-x-1",
+			expected: "X-0-X-1",
+		},
+		{
+			name:      "異常系:局面が足りない",
+			input:     "3-1-1",
+			wantError: true,
+		},
+		{
+			name:      "異常系:数値以外",
+			input:     "3-a-1-0",
+			wantError: true,
+		},
+		{
+			name:      "異常系:局面が長すぎる",
+			input:     "30-1-1-0",
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			tempo, err := ParseTempo(tt.input)
+
+			// Assert
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, tempo.String())
+			}
+		})
+	}
+}
+
+func TestTempo_ExplosivePhases(t *testing.T) {
+	t.Run("正常系:Xの局面は0秒として扱い、文字列表現と局面のビット集合から同じテンポを復元できる", func(t *testing.T) {
+		// Arrange
+		tempo, err := ParseTempo("31X0")
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		// Act
+		reparsed, reparseErr := ParseTempo(tempo.String())
+		plain, _ := NewTempo(tempo.Eccentric(), tempo.Pause(), tempo.Concentric(), tempo.Top())
+		restored, restoreErr := plain.WithExplosivePhases(tempo.ExplosivePhases())
+
+		// Assert
+		assert.Equal(t, 4, tempo.ExplosivePhases())
+		assert.Equal(t, 0, tempo.Concentric())
+		assert.Equal(t, 4*time.Second, tempo.RepDuration())
+		assert.False(t, tempo.Equals(plain), "Xの局面と0秒の局面は区別する")
+		if assert.NoError(t, reparseErr) {
+			assert.True(t, tempo.Equals(reparsed))
+		}
+		if assert.NoError(t, restoreErr) {
+			assert.True(t, tempo.Equals(restored))
+		}
+	})
+
+	t.Run("異常系:0秒でない局面・範囲外の局面は爆発的にできない", func(t *testing.T) {
+		// Arrange
+		tempo, _ := NewTempo(3, 1, 1, 0)
+
+		// Act
+		_, secondsErr := tempo.WithExplosivePhases(4)
+		_, rangeErr := tempo.WithExplosivePhases(16)
+
+		// Assert
+		assert.Error(t, secondsErr)
+		assert.Error(t, rangeErr)
+	})
+}
+
+func TestTempo_RepDuration(t *testing.T) {
+	// Arrange
+	tempo, _ := NewTempo(3, 1, 1, 0)
+
+	// Act & Assert
+	assert.Equal(t, 5*time.Second, tempo.RepDuration())
+	assert.True(t, tempo.IsPaused())
+}
+
+func TestRangeOfMotion_NewRangeOfMotion(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  RangeOfMotion
+		wantError bool
+	}{
+		{name: "Full", input: "Full", expected: FullROM},
+		{name: "小文字のpartial", input: "partial", expected: PartialROM},
+		{name: "無効な値", input: "Half", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			rom, err := NewRangeOfMotion(tt.input)
+
+			// Assert
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, rom.Equals(tt.expected))
+			}
+		})
+	}
+}
+
+func TestVariation_NewVariation(t *testing.T) {
+	// Act
+	variation, err := NewVariation("  Paused ")
+	_, emptyErr := NewVariation(" ")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, variation.Equals(Paused))
+	assert.Error(t, emptyErr)
+}
+
+func TestSet_TimeUnderTension(t *testing.T) {
+	// Arrange
+	weight, _ := NewWeight(80)
+	reps, _ := NewReps(5)
+	tempo, _ := NewTempo(3, 1, 1, 0)
+
+	// Act
+	withTempo := NewSet(weight, reps, nil).WithTempo(tempo)
+	withoutTempo := NewSet(weight, reps, nil)
+
+	// Assert
+	assert.Equal(t, 25*time.Second, withTempo.TimeUnderTension())
+	assert.Equal(t, time.Duration(0), withoutTempo.TimeUnderTension())
+	assert.Equal(t, "80.0kg × 5回 @3-1-1-0", withTempo.String())
+}
+
+func TestSetCriteria_Matches(t *testing.T) {
+	weight, _ := NewWeight(80)
+	reps, _ := NewReps(5)
+	pausedTempo, _ := NewTempo(3, 1, 1, 0)
+	plainTempo, _ := NewTempo(2, 0, 1, 0)
+
+	plain := NewSet(weight, reps, nil)
+	tempoPaused := plain.WithTempo(pausedTempo)
+	taggedPaused := plain.WithVariations(Paused)
+	tempoOnly := plain.WithTempo(plainTempo)
+	pinPress := plain.WithRangeOfMotion(PartialROM).WithVariations(Pin)
+	rpe8, _ := NewRPE(8)
+	withRPE := NewSet(weight, reps, &rpe8)
+	weight80, weight85, reps5, reps6, rpe9 := 80.0, 85.0, 5, 6, 9.0
+
+	tests := []struct {
+		name     string
+		criteria SetCriteria
+		set      Set
+		expected bool
+	}{
+		{name: "条件なしは全て一致", criteria: SetCriteria{}, set: plain, expected: true},
+		{name: "pausedはテンポのボトム停止に一致", criteria: SetCriteria{Variation: &Paused}, set: tempoPaused, expected: true},
+		{name: "pausedはタグに一致", criteria: SetCriteria{Variation: &Paused}, set: taggedPaused, expected: true},
+		{name: "pausedは停止なしテンポに不一致", criteria: SetCriteria{Variation: &Paused}, set: tempoOnly, expected: false},
+		{name: "pinタグに一致", criteria: SetCriteria{Variation: &Pin}, set: pinPress, expected: true},
+		{name: "Partialに一致", criteria: SetCriteria{RangeOfMotion: &PartialROM}, set: pinPress, expected: true},
+		{name: "Fullは未指定のセットに一致", criteria: SetCriteria{RangeOfMotion: &FullROM}, set: plain, expected: true},
+		{name: "FullはPartialに不一致", criteria: SetCriteria{RangeOfMotion: &FullROM}, set: pinPress, expected: false},
+		{name: "テンポのみ", criteria: SetCriteria{TempoOnly: true}, set: plain, expected: false},
+		{name: "重量の範囲は両端を含む", criteria: SetCriteria{MinWeightKg: &weight80, MaxWeightKg: &weight80}, set: plain, expected: true},
+		{name: "最小重量未満は不一致", criteria: SetCriteria{MinWeightKg: &weight85}, set: plain, expected: false},
+		{name: "最小回数未満は不一致", criteria: SetCriteria{MinReps: &reps6}, set: plain, expected: false},
+		{name: "最大回数以下に一致", criteria: SetCriteria{MaxReps: &reps5}, set: plain, expected: true},
+		{name: "RPEの範囲に一致", criteria: SetCriteria{MaxRPE: &rpe9}, set: withRPE, expected: true},
+		{name: "RPEの条件はRPEが未記録のセットに不一致", criteria: SetCriteria{MaxRPE: &rpe9}, set: plain, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act & Assert
+			assert.Equal(t, tt.expected, tt.criteria.Matches(tt.set))
+		})
+	}
+}
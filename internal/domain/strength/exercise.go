@@ -2,6 +2,7 @@ package strength
 
 import (
 	"fmt"
+	"time"
 )
 
 // =============================================================================
@@ -109,6 +110,15 @@ func (e *Exercise) TotalVolume() float64 {
 	return volume
 }
 
+// TimeUnderTension は総筋緊張時間（テンポが記録されたセットのみ）を計算します
+func (e *Exercise) TimeUnderTension() time.Duration {
+	total := time.Duration(0)
+	for _, set := range e.sets {
+		total += set.TimeUnderTension()
+	}
+	return total
+}
+
 // String はエクササイズの文字列表現を返します
 func (e *Exercise) String() string {
 	return fmt.Sprintf("%s - %dセット",
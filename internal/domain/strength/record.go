@@ -0,0 +1,279 @@
+package strength
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"fitness-mcp-server/internal/domain/shared"
+)
+
+// =============================================================================
+// 個人記録コンテキスト - PR（自己ベスト）の検出と履歴管理
+// =============================================================================
+
+// RecordType は個人記録の種類を表す値オブジェクト
+type RecordType string
+
+const (
+	RepMaxRecord        RecordType = "RepMax"        // nRM（指定回数での最大重量）
+	EstimatedMaxRecord  RecordType = "E1RM"          // 推定1RM（Epley式）
+	SessionVolumeRecord RecordType = "SessionVolume" // 1セッションあたりの総ボリューム
+)
+
+// MaxRepMaxReps はレップマックスとして追跡する最大回数です（1RM〜10RM）
+const MaxRepMaxReps = 10
+
+// NewRecordType は個人記録の種類を作成します
+func NewRecordType(value string) (RecordType, error) {
+	switch RecordType(value) {
+	case RepMaxRecord, EstimatedMaxRecord, SessionVolumeRecord:
+		return RecordType(value), nil
+	default:
+		return "", fmt.Errorf("invalid record type: %s", value)
+	}
+}
+
+// String は個人記録の種類の文字列表現を返します
+func (rt RecordType) String() string {
+	return string(rt)
+}
+
+// Label は記録の表示名を返します（RepMaxの場合は回数を含みます）
+func (rt RecordType) Label(reps int) string {
+	switch rt {
+	case RepMaxRecord:
+		return fmt.Sprintf("%dRM", reps)
+	case EstimatedMaxRecord:
+		return "推定1RM"
+	default:
+		return "セッションボリューム"
+	}
+}
+
+// EstimateOneRepMax はEpley式（重量 × (1 + 回数/30)）で推定1RMを計算します
+func EstimateOneRepMax(weight Weight, reps Reps) float64 {
+	if reps.Count() == 1 {
+		return weight.Kg()
+	}
+	return weight.Kg() * (1 + float64(reps.Count())/30)
+}
+
+type (
+	// PersonalRecordEvent はPRが更新されたことを表すイベント
+	PersonalRecordEvent struct {
+		exerciseName  ExerciseName      // エクササイズ名
+		recordType    RecordType        // 記録の種類
+		reps          int               // レップマックスの回数（RepMax以外は0）
+		value         float64           // 新しい記録値（kg）
+		previousValue *float64          // 更新前の記録値（初記録の場合はnil）
+		trainingID    shared.TrainingID // 記録したトレーニングID
+		date          time.Time         // 記録日
+		set           *Set              // 記録を更新したセット（SessionVolumeの場合はnil）
+	}
+
+	// recordKey は記録を識別するキー
+	recordKey struct {
+		exerciseName string
+		recordType   RecordType
+		reps         int
+	}
+
+	// RecordBook はエクササイズごとの現在の自己ベストを保持し、PRを検出します
+	RecordBook struct {
+		bests    map[recordKey]float64
+		lastDate time.Time
+	}
+)
+
+// ExerciseName はエクササイズ名を返します
+func (e PersonalRecordEvent) ExerciseName() ExerciseName {
+	return e.exerciseName
+}
+
+// RecordType は記録の種類を返します
+func (e PersonalRecordEvent) RecordType() RecordType {
+	return e.recordType
+}
+
+// Reps はレップマックスの回数を返します（RepMax以外は0）
+func (e PersonalRecordEvent) Reps() int {
+	return e.reps
+}
+
+// Value は新しい記録値を返します
+func (e PersonalRecordEvent) Value() float64 {
+	return e.value
+}
+
+// PreviousValue は更新前の記録値を返します（初記録の場合はnil）
+func (e PersonalRecordEvent) PreviousValue() *float64 {
+	return e.previousValue
+}
+
+// IsFirstRecord は初記録かを判定します
+func (e PersonalRecordEvent) IsFirstRecord() bool {
+	return e.previousValue == nil
+}
+
+// Improvement は更新前の記録からの伸びを返します（初記録の場合は0）
+func (e PersonalRecordEvent) Improvement() float64 {
+	if e.previousValue == nil {
+		return 0
+	}
+	return e.value - *e.previousValue
+}
+
+// TrainingID は記録したトレーニングIDを返します
+func (e PersonalRecordEvent) TrainingID() shared.TrainingID {
+	return e.trainingID
+}
+
+// Date は記録日を返します
+func (e PersonalRecordEvent) Date() time.Time {
+	return e.date
+}
+
+// Set は記録を更新したセットを返します（SessionVolumeの場合はnil）
+func (e PersonalRecordEvent) Set() *Set {
+	return e.set
+}
+
+// Label は記録の表示名を返します（例: 5RM、推定1RM、セッションボリューム）
+func (e PersonalRecordEvent) Label() string {
+	return e.recordType.Label(e.reps)
+}
+
+// String はイベントの文字列表現を返します
+func (e PersonalRecordEvent) String() string {
+	return fmt.Sprintf("%s %s %.1fkg (%s)", e.exerciseName.String(), e.Label(), e.value, e.date.Format("2006-01-02"))
+}
+
+// NewRecordBook は空のRecordBookを作成します
+func NewRecordBook() *RecordBook {
+	return &RecordBook{bests: make(map[recordKey]float64)}
+}
+
+// Restore は永続化された自己ベストをRecordBookに復元します
+func (b *RecordBook) Restore(exerciseName ExerciseName, recordType RecordType, reps int, value float64, date time.Time) {
+	key := recordKey{exerciseName: exerciseName.String(), recordType: recordType, reps: reps}
+	if best, exists := b.bests[key]; !exists || value > best {
+		b.bests[key] = value
+	}
+	if date.After(b.lastDate) {
+		b.lastDate = date
+	}
+}
+
+// Best は現在の自己ベストを返します
+func (b *RecordBook) Best(exerciseName ExerciseName, recordType RecordType, reps int) (float64, bool) {
+	best, exists := b.bests[recordKey{exerciseName: exerciseName.String(), recordType: recordType, reps: reps}]
+	return best, exists
+}
+
+// LastDate は最後に記録が更新された日を返します
+func (b *RecordBook) LastDate() time.Time {
+	return b.lastDate
+}
+
+// CanApply はトレーニングを追記として評価できるかを判定します
+// 最後の記録日より前のトレーニング（過去分の追加）は履歴の再構築が必要です
+func (b *RecordBook) CanApply(training *StrengthTraining) bool {
+	return !training.Date().Before(b.lastDate)
+}
+
+// Apply はトレーニングを評価し、更新されたPRのイベントを返します
+// 同一セッション内で同じ記録を複数回更新した場合は、最も良いセットのみをイベントとします
+func (b *RecordBook) Apply(training *StrengthTraining) []PersonalRecordEvent {
+	type candidate struct {
+		value float64
+		set   *Set
+	}
+
+	candidates := make(map[recordKey]candidate)
+	order := make([]recordKey, 0)
+	names := make(map[string]ExerciseName)
+	propose := func(key recordKey, value float64, set *Set) {
+		current, exists := candidates[key]
+		if !exists {
+			order = append(order, key)
+		}
+		if !exists || value > current.value {
+			candidates[key] = candidate{value: value, set: set}
+		}
+	}
+
+	for _, exercise := range training.Exercises() {
+		name := exercise.Name()
+		names[name.String()] = name
+		volume := 0.0
+		for _, set := range exercise.Sets() {
+			// 回数ベースで重量のあるフル可動域のセットのみを対象とします
+			if !set.HasReps() || set.Weight().Kg() <= 0 || set.IsPartial() {
+				continue
+			}
+			set := set
+			volume += set.Weight().Kg() * float64(set.Reps().Count())
+			if set.Reps().Count() <= MaxRepMaxReps {
+				propose(recordKey{exerciseName: name.String(), recordType: RepMaxRecord, reps: set.Reps().Count()}, set.Weight().Kg(), &set)
+				propose(recordKey{exerciseName: name.String(), recordType: EstimatedMaxRecord}, EstimateOneRepMax(set.Weight(), set.Reps()), &set)
+			}
+		}
+		if volume > 0 {
+			key := recordKey{exerciseName: name.String(), recordType: SessionVolumeRecord}
+			// 同じエクササイズが複数回登場した場合はセッション合計とします
+			if current, exists := candidates[key]; exists {
+				volume += current.value
+				candidates[key] = candidate{value: volume}
+			} else {
+				propose(key, volume, nil)
+			}
+		}
+	}
+
+	events := make([]PersonalRecordEvent, 0)
+	for _, key := range order {
+		candidate := candidates[key]
+		best, exists := b.bests[key]
+		if exists && candidate.value <= best {
+			continue
+		}
+
+		event := PersonalRecordEvent{
+			exerciseName: names[key.exerciseName],
+			recordType:   key.recordType,
+			reps:         key.reps,
+			value:        candidate.value,
+			trainingID:   training.ID(),
+			date:         training.Date(),
+			set:          candidate.set,
+		}
+		if exists {
+			previous := best
+			event.previousValue = &previous
+		}
+		events = append(events, event)
+		b.bests[key] = candidate.value
+	}
+
+	if training.Date().After(b.lastDate) {
+		b.lastDate = training.Date()
+	}
+	return events
+}
+
+// BuildRecordHistory はトレーニング履歴から日付順にPRイベントを再構築します
+func BuildRecordHistory(trainings []*StrengthTraining) []PersonalRecordEvent {
+	sorted := make([]*StrengthTraining, len(trainings))
+	copy(sorted, trainings)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date().Before(sorted[j].Date())
+	})
+
+	book := NewRecordBook()
+	events := make([]PersonalRecordEvent, 0)
+	for _, training := range sorted {
+		events = append(events, book.Apply(training)...)
+	}
+	return events
+}
@@ -0,0 +1,189 @@
+package strength
+
+import (
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/domain/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// 個人記録コンテキストのテスト
+// =============================================================================
+
+// newBenchTraining はベンチプレスのセット（重量, 回数）からトレーニングを作成するテストヘルパーです
+func newBenchTraining(t *testing.T, date time.Time, sets ...[2]float64) *StrengthTraining {
+	t.Helper()
+	training := NewStrengthTraining(shared.NewTrainingID(), date, "")
+	exercise := NewExercise(BenchPress)
+	for _, s := range sets {
+		weight, err := NewWeight(s[0])
+		assert.NoError(t, err)
+		reps, err := NewReps(int(s[1]))
+		assert.NoError(t, err)
+		exercise.AddSet(NewSet(weight, reps, nil))
+	}
+	training.AddExercise(exercise)
+	return training
+}
+
+// findEvent はラベルに一致するイベントを探すテストヘルパーです
+func findEvent(events []PersonalRecordEvent, label string) *PersonalRecordEvent {
+	for _, event := range events {
+		if event.Label() == label {
+			return &event
+		}
+	}
+	return nil
+}
+
+func TestEstimateOneRepMax(t *testing.T) {
+	tests := []struct {
+		name     string
+		weight   float64
+		reps     int
+		expected float64
+	}{
+		{
+			name:     "正常系:1回はそのままの重量",
+			weight:   100,
+			reps:     1,
+			expected: 100,
+		},
+		{
+			name:     "正常系:Epley式",
+			weight:   90,
+			reps:     6,
+			expected: 108,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			weight, _ := NewWeight(tt.weight)
+			reps, _ := NewReps(tt.reps)
+
+			// Act
+			result := EstimateOneRepMax(weight, reps)
+
+			// Assert
+			assert.InDelta(t, tt.expected, result, 0.001)
+		})
+	}
+}
+
+func TestRecordBook_Apply(t *testing.T) {
+	t.Run("正常系:初回は全て初記録", func(t *testing.T) {
+		// Arrange
+		book := NewRecordBook()
+		training := newBenchTraining(t, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), [2]float64{100, 5}, [2]float64{100, 5})
+
+		// Act
+		events := book.Apply(training)
+
+		// Assert
+		assert.Len(t, events, 3) // 5RM・推定1RM・セッションボリューム
+		fiveRM := findEvent(events, "5RM")
+		assert.NotNil(t, fiveRM)
+		assert.True(t, fiveRM.IsFirstRecord())
+		assert.Equal(t, 100.0, fiveRM.Value())
+		assert.NotNil(t, fiveRM.Set())
+		volume := findEvent(events, "セッションボリューム")
+		assert.NotNil(t, volume)
+		assert.Equal(t, 1000.0, volume.Value())
+		assert.Nil(t, volume.Set())
+	})
+
+	t.Run("正常系:更新された記録のみイベントになる", func(t *testing.T) {
+		// Arrange
+		book := NewRecordBook()
+		book.Apply(newBenchTraining(t, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), [2]float64{100, 5}, [2]float64{100, 5}))
+		training := newBenchTraining(t, time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC), [2]float64{102.5, 5}, [2]float64{90, 3})
+
+		// Act
+		events := book.Apply(training)
+
+		// Assert
+		fiveRM := findEvent(events, "5RM")
+		assert.NotNil(t, fiveRM)
+		assert.False(t, fiveRM.IsFirstRecord())
+		assert.InDelta(t, 2.5, fiveRM.Improvement(), 0.001)
+		assert.NotNil(t, findEvent(events, "3RM"))
+		assert.NotNil(t, findEvent(events, "推定1RM"))
+		assert.Nil(t, findEvent(events, "セッションボリューム")) // 782.5 < 1000
+	})
+
+	t.Run("正常系:同一セッション内では最良のセットのみ", func(t *testing.T) {
+		// Arrange
+		book := NewRecordBook()
+		training := newBenchTraining(t, time.Now(), [2]float64{100, 3}, [2]float64{105, 3})
+
+		// Act
+		events := book.Apply(training)
+
+		// Assert
+		threeRM := findEvent(events, "3RM")
+		assert.NotNil(t, threeRM)
+		assert.Equal(t, 105.0, threeRM.Value())
+		assert.Equal(t, 105.0, threeRM.Set().Weight().Kg())
+	})
+
+	t.Run("正常系:同じ記録はPRにならない", func(t *testing.T) {
+		// Arrange
+		book := NewRecordBook()
+		book.Restore(BenchPress, RepMaxRecord, 5, 100, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+		training := newBenchTraining(t, time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC), [2]float64{100, 5})
+
+		// Act
+		events := book.Apply(training)
+
+		// Assert
+		assert.Nil(t, findEvent(events, "5RM"))
+	})
+
+	t.Run("正常系:11回以上はレップマックスの対象外", func(t *testing.T) {
+		// Arrange
+		book := NewRecordBook()
+		training := newBenchTraining(t, time.Now(), [2]float64{60, 12})
+
+		// Act
+		events := book.Apply(training)
+
+		// Assert
+		assert.Len(t, events, 1)
+		assert.Equal(t, SessionVolumeRecord, events[0].RecordType())
+	})
+}
+
+func TestRecordBook_CanApply(t *testing.T) {
+	// Arrange
+	book := NewRecordBook()
+	book.Restore(BenchPress, RepMaxRecord, 5, 100, time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC))
+
+	// Act & Assert
+	assert.True(t, book.CanApply(newBenchTraining(t, time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC))))
+	assert.False(t, book.CanApply(newBenchTraining(t, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))))
+}
+
+func TestBuildRecordHistory(t *testing.T) {
+	// Arrange: 記録順と日付順が異なる履歴
+	later := newBenchTraining(t, time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC), [2]float64{105, 5})
+	earlier := newBenchTraining(t, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), [2]float64{100, 5})
+
+	// Act
+	events := BuildRecordHistory([]*StrengthTraining{later, earlier})
+
+	// Assert
+	var fiveRMs []PersonalRecordEvent
+	for _, event := range events {
+		if event.Label() == "5RM" {
+			fiveRMs = append(fiveRMs, event)
+		}
+	}
+	assert.Len(t, fiveRMs, 2)
+	assert.Equal(t, 100.0, fiveRMs[0].Value())
+	assert.Equal(t, 105.0, fiveRMs[1].Value())
+	assert.Equal(t, 100.0, *fiveRMs[1].PreviousValue())
+}
@@ -2,6 +2,9 @@ package strength
 
 import (
 	"fmt"
+	"math"
+	"strings"
+	"time"
 )
 
 // =============================================================================
@@ -17,14 +20,30 @@ type (
 		value int
 	}
 
-	// RPE は主観的運動強度を表す値オブジェクト
+	// Duration はセットの実施時間を表す値オブジェクト（プランク等の保持時間）
+	Duration struct {
+		seconds int
+	}
+
+	// Distance はセットの移動距離を表す値オブジェクト（キャリー・スレッド等）
+	Distance struct {
+		meters float64
+	}
+
+	// RPE は主観的運動強度を表す値オブジェクト（0.5刻み）
 	RPE struct {
-		value int
+		value float64
 	}
 	Set struct {
-		weight Weight // 重量
-		reps   Reps   // 反復回数
-		rpe    *RPE   // オプショナル
+		weight   Weight    // 重量
+		reps     Reps      // 反復回数（時間・距離のみのセットではゼロ値）
+		duration *Duration // 実施時間（オプショナル）
+		distance *Distance // 移動距離（オプショナル）
+		rpe      *RPE      // オプショナル
+
+		tempo         *Tempo         // テンポ（オプショナル）
+		rangeOfMotion *RangeOfMotion // 可動域（オプショナル、未指定はフルレンジ扱い）
+		variations    []Variation    // バリエーションタグ
 	}
 )
 
@@ -80,22 +99,113 @@ func (r Reps) Equals(other Reps) bool {
 	return r.value == other.value
 }
 
+// NewDuration はセットの実施時間を作成します
+func NewDuration(seconds int) (Duration, error) {
+	if seconds <= 0 {
+		return Duration{}, fmt.Errorf("duration must be positive: %d", seconds)
+	}
+	if seconds > 3600 { // 現実的な上限設定（1時間）
+		return Duration{}, fmt.Errorf("duration is too long: %d", seconds)
+	}
+	return Duration{seconds: seconds}, nil
+}
+
+// Seconds は実施時間を秒単位で返します
+func (d Duration) Seconds() int {
+	return d.seconds
+}
+
+// String は実施時間の文字列表現を返します
+func (d Duration) String() string {
+	return fmt.Sprintf("%d秒", d.seconds)
+}
+
+// Equals は2つの実施時間が等しいかを判定します
+func (d Duration) Equals(other Duration) bool {
+	return d.seconds == other.seconds
+}
+
+// NewDistance はセットの移動距離を作成します
+func NewDistance(meters float64) (Distance, error) {
+	if meters <= 0 {
+		return Distance{}, fmt.Errorf("distance must be positive: %f", meters)
+	}
+	if meters > 10000 { // 現実的な上限設定（10km）
+		return Distance{}, fmt.Errorf("distance is too long: %f", meters)
+	}
+	return Distance{meters: meters}, nil
+}
+
+// Meters は移動距離をメートル単位で返します
+func (d Distance) Meters() float64 {
+	return d.meters
+}
+
+// String は移動距離の文字列表現を返します
+func (d Distance) String() string {
+	return fmt.Sprintf("%gm", d.meters)
+}
+
+// Equals は2つの移動距離が等しいかを判定します
+func (d Distance) Equals(other Distance) bool {
+	return d.meters == other.meters
+}
+
 // NewRPE は主観的運動強度を作成します
 func NewRPE(rating int) (RPE, error) {
 	if rating < 1 || rating > 10 {
 		return RPE{}, fmt.Errorf("RPE must be between 1 and 10: %d", rating)
 	}
-	return RPE{value: rating}, nil
+	return RPE{value: float64(rating)}, nil
+}
+
+// NewRPEFromValue は0.5刻みの主観的運動強度を作成します（例: 8.5）
+func NewRPEFromValue(value float64) (RPE, error) {
+	if value < 1 || value > 10 {
+		return RPE{}, fmt.Errorf("RPE must be between 1 and 10: %g", value)
+	}
+	if !isHalfStep(value) {
+		return RPE{}, fmt.Errorf("RPE must be in 0.5 increments: %g", value)
+	}
+	return RPE{value: value}, nil
+}
+
+// NewRPEFromRIR はRIR（Reps in Reserve、余力レップ数）から主観的運動強度を作成します
+// RPE = 10 - RIR として換算します（例: RIR 2 → RPE 8）
+func NewRPEFromRIR(rir float64) (RPE, error) {
+	if rir < 0 || rir > 9 {
+		return RPE{}, fmt.Errorf("RIR must be between 0 and 9: %g", rir)
+	}
+	if !isHalfStep(rir) {
+		return RPE{}, fmt.Errorf("RIR must be in 0.5 increments: %g", rir)
+	}
+	return NewRPEFromValue(10 - rir)
+}
+
+// isHalfStep は値が0.5刻みかを判定します
+func isHalfStep(value float64) bool {
+	return math.Mod(value*2, 1) == 0
 }
 
-// Rating は主観的運動強度の値を返します
+// Rating は主観的運動強度の整数部分を返します（8.5の場合は8）
+// 小数点以下を含む値が必要な場合はValueを使用してください
 func (rpe RPE) Rating() int {
+	return int(math.Floor(rpe.value))
+}
+
+// Value は主観的運動強度の値を返します（0.5刻み）
+func (rpe RPE) Value() float64 {
 	return rpe.value
 }
 
+// RIR は余力レップ数（10 - RPE）を返します
+func (rpe RPE) RIR() float64 {
+	return 10 - rpe.value
+}
+
 // String は主観的運動強度の文字列表現を返します
 func (rpe RPE) String() string {
-	return fmt.Sprintf("RPE %d", rpe.value)
+	return fmt.Sprintf("RPE %g", rpe.value)
 }
 
 // Equals は2つの主観的運動強度が等しいかを判定します
@@ -112,6 +222,39 @@ func NewSet(weight Weight, reps Reps, rpe *RPE) Set {
 	}
 }
 
+// NewMeasuredSet は回数・時間・距離を組み合わせたSetを作成します
+// 回数・時間・距離のいずれか1つ以上が必要です
+func NewMeasuredSet(weight Weight, reps *Reps, duration *Duration, distance *Distance, rpe *RPE) (Set, error) {
+	if reps == nil && duration == nil && distance == nil {
+		return Set{}, fmt.Errorf("set requires at least one of reps, duration or distance")
+	}
+
+	set := Set{
+		weight:   weight,
+		duration: duration,
+		distance: distance,
+		rpe:      rpe,
+	}
+	if reps != nil {
+		set.reps = *reps
+	}
+	return set, nil
+}
+
+// hasSameMeasurement は重量・回数・時間・距離が等しいかを判定します
+func (s Set) hasSameMeasurement(other Set) bool {
+	if !s.weight.Equals(other.weight) || !s.reps.Equals(other.reps) {
+		return false
+	}
+	if (s.duration == nil) != (other.duration == nil) || (s.duration != nil && !s.duration.Equals(*other.duration)) {
+		return false
+	}
+	if (s.distance == nil) != (other.distance == nil) || (s.distance != nil && !s.distance.Equals(*other.distance)) {
+		return false
+	}
+	return true
+}
+
 // Weight は重量を返します
 func (s Set) Weight() Weight {
 	return s.weight
@@ -122,17 +265,133 @@ func (s Set) Reps() Reps {
 	return s.reps
 }
 
+// HasReps は回数が記録されたセットかを判定します
+func (s Set) HasReps() bool {
+	return s.reps.Count() > 0
+}
+
+// Duration は実施時間を返します（オプショナル）
+func (s Set) Duration() *Duration {
+	return s.duration
+}
+
+// Distance は移動距離を返します（オプショナル）
+func (s Set) Distance() *Distance {
+	return s.distance
+}
+
 // RPE は主観的運動強度を返します（オプショナル）
 func (s Set) RPE() *RPE {
 	return s.rpe
 }
 
+// WithTempo はテンポを設定したセットを返します
+func (s Set) WithTempo(tempo Tempo) Set {
+	s.tempo = &tempo
+	return s
+}
+
+// WithRangeOfMotion は可動域を設定したセットを返します
+func (s Set) WithRangeOfMotion(rom RangeOfMotion) Set {
+	s.rangeOfMotion = &rom
+	return s
+}
+
+// WithVariations はバリエーションタグを設定したセットを返します（重複は除外）
+func (s Set) WithVariations(variations ...Variation) Set {
+	result := make([]Variation, 0, len(variations))
+	for _, variation := range variations {
+		duplicated := false
+		for _, existing := range result {
+			if existing.Equals(variation) {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			result = append(result, variation)
+		}
+	}
+	s.variations = result
+	return s
+}
+
+// Tempo はテンポを返します（オプショナル）
+func (s Set) Tempo() *Tempo {
+	return s.tempo
+}
+
+// RangeOfMotion は可動域を返します（オプショナル）
+func (s Set) RangeOfMotion() *RangeOfMotion {
+	return s.rangeOfMotion
+}
+
+// Variations はバリエーションタグを返します
+func (s Set) Variations() []Variation {
+	// コピーを返して不変性を保つ
+	result := make([]Variation, len(s.variations))
+	copy(result, s.variations)
+	return result
+}
+
+// HasVariation は指定したバリエーションタグを持つかを判定します
+func (s Set) HasVariation(variation Variation) bool {
+	for _, v := range s.variations {
+		if v.Equals(variation) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPaused はポーズ付きのセットかを判定します（タグまたはテンポのボトム停止）
+func (s Set) IsPaused() bool {
+	if s.HasVariation(Paused) {
+		return true
+	}
+	return s.tempo != nil && s.tempo.IsPaused()
+}
+
+// IsPartial はパーシャルレンジのセットかを判定します
+func (s Set) IsPartial() bool {
+	return s.rangeOfMotion != nil && s.rangeOfMotion.Equals(PartialROM)
+}
+
+// TimeUnderTension は筋緊張時間を返します（テンポと回数が記録されている場合のみ、それ以外は0）
+func (s Set) TimeUnderTension() time.Duration {
+	if s.tempo == nil || !s.HasReps() {
+		return 0
+	}
+	return s.tempo.RepDuration() * time.Duration(s.reps.Count())
+}
+
 // String はセットの文字列表現を返します
 func (s Set) String() string {
-	rpeStr := ""
+	result := s.weight.String()
+	if s.HasReps() {
+		result += " × " + s.reps.String()
+	}
+	if s.distance != nil {
+		result += " × " + s.distance.String()
+	}
+	if s.duration != nil {
+		result += " × " + s.duration.String()
+	}
 	if s.rpe != nil {
-		rpeStr = fmt.Sprintf(" %s", s.rpe.String())
+		result += " " + s.rpe.String()
+	}
+	if s.tempo != nil {
+		result += " @" + s.tempo.String()
+	}
+	if s.rangeOfMotion != nil {
+		result += " (" + s.rangeOfMotion.String() + ")"
+	}
+	if len(s.variations) > 0 {
+		tags := make([]string, len(s.variations))
+		for i, variation := range s.variations {
+			tags[i] = variation.String()
+		}
+		result += " [" + strings.Join(tags, ", ") + "]"
 	}
-	return fmt.Sprintf("%s × %s%s",
-		s.weight.String(), s.reps.String(), rpeStr)
+	return result
 }
@@ -256,3 +256,244 @@ func TestSet_String(t *testing.T) {
 		})
 	}
 }
+
+func TestDuration_NewDuration(t *testing.T) {
+	tests := []struct {
+		name      string
+		seconds   int
+		wantError bool
+	}{
+		{
+			name:      "正常系:60秒",
+			seconds:   60,
+			wantError: false,
+		},
+		{
+			name:      "異常系:0秒",
+			seconds:   0,
+			wantError: true,
+		},
+		{
+			name:      "異常系:過大な時間",
+			seconds:   3601,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			duration, err := NewDuration(tt.seconds)
+
+			// Assert
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.seconds, duration.Seconds())
+				assert.Contains(t, duration.String(), "秒")
+			}
+		})
+	}
+}
+
+func TestDistance_NewDistance(t *testing.T) {
+	tests := []struct {
+		name      string
+		meters    float64
+		wantError bool
+	}{
+		{
+			name:      "正常系:40m",
+			meters:    40,
+			wantError: false,
+		},
+		{
+			name:      "異常系:0m",
+			meters:    0,
+			wantError: true,
+		},
+		{
+			name:      "異常系:過大な距離",
+			meters:    10001,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			distance, err := NewDistance(tt.meters)
+
+			// Assert
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.meters, distance.Meters())
+				assert.Contains(t, distance.String(), "m")
+			}
+		})
+	}
+}
+
+func TestSet_NewMeasuredSet(t *testing.T) {
+	weight, _ := NewWeight(32.0)
+	reps, _ := NewReps(10)
+	duration, _ := NewDuration(60)
+	distance, _ := NewDistance(40)
+
+	tests := []struct {
+		name      string
+		reps      *Reps
+		duration  *Duration
+		distance  *Distance
+		expected  string
+		wantError bool
+	}{
+		{
+			name:     "正常系:時間のみ（プランク）",
+			duration: &duration,
+			expected: "32.0kg × 60秒",
+		},
+		{
+			name:     "正常系:距離のみ（ファーマーズキャリー）",
+			distance: &distance,
+			expected: "32.0kg × 40m",
+		},
+		{
+			name:     "正常系:距離と時間（スレッドプッシュ）",
+			distance: &distance,
+			duration: &duration,
+			expected: "32.0kg × 40m × 60秒",
+		},
+		{
+			name:     "正常系:回数のみ",
+			reps:     &reps,
+			expected: "32.0kg × 10回",
+		},
+		{
+			name:      "異常系:計測値なし",
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When
+			set, err := NewMeasuredSet(weight, tt.reps, tt.duration, tt.distance, nil)
+
+			// Then
+			if tt.wantError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.reps != nil, set.HasReps())
+			assert.Equal(t, tt.duration, set.Duration())
+			assert.Equal(t, tt.distance, set.Distance())
+			assert.Equal(t, tt.expected, set.String())
+		})
+	}
+}
+
+func TestRPE_NewRPEFromValue(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          float64
+		expectedRating int
+		expectedString string
+		wantError      bool
+	}{
+		{
+			name:           "正常系:整数値",
+			value:          8,
+			expectedRating: 8,
+			expectedString: "RPE 8",
+		},
+		{
+			name:           "正常系:0.5刻み",
+			value:          8.5,
+			expectedRating: 8,
+			expectedString: "RPE 8.5",
+		},
+		{
+			name:      "異常系:0.5刻みでない",
+			value:     8.3,
+			wantError: true,
+		},
+		{
+			name:      "異常系:範囲外",
+			value:     10.5,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			rpe, err := NewRPEFromValue(tt.value)
+
+			// Assert
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.value, rpe.Value())
+				assert.Equal(t, tt.expectedRating, rpe.Rating())
+				assert.Equal(t, tt.expectedString, rpe.String())
+			}
+		})
+	}
+}
+
+func TestRPE_NewRPEFromRIR(t *testing.T) {
+	tests := []struct {
+		name      string
+		rir       float64
+		expected  float64
+		wantError bool
+	}{
+		{
+			name:     "正常系:RIR 2 → RPE 8",
+			rir:      2,
+			expected: 8,
+		},
+		{
+			name:     "正常系:RIR 1.5 → RPE 8.5",
+			rir:      1.5,
+			expected: 8.5,
+		},
+		{
+			name:     "正常系:RIR 0 → RPE 10",
+			rir:      0,
+			expected: 10,
+		},
+		{
+			name:      "異常系:負のRIR",
+			rir:       -1,
+			wantError: true,
+		},
+		{
+			name:      "異常系:RIRが大きすぎる",
+			rir:       9.5,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			rpe, err := NewRPEFromRIR(tt.rir)
+
+			// Assert
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, rpe.Value())
+				assert.Equal(t, tt.rir, rpe.RIR())
+			}
+		})
+	}
+}
@@ -87,6 +87,58 @@ func (st *StrengthTraining) TotalVolume() float64 {
 	return totalVolume
 }
 
+// TimeUnderTension は総筋緊張時間を返します
+func (st *StrengthTraining) TimeUnderTension() time.Duration {
+	total := time.Duration(0)
+	for _, exercise := range st.exercises {
+		total += exercise.TimeUnderTension()
+	}
+	return total
+}
+
+// FilterSets は条件に一致するセットのみを含むトレーニングを返します
+// 一致するセットがないエクササイズは除外されます
+func (st *StrengthTraining) FilterSets(criteria SetCriteria) *StrengthTraining {
+	filtered := NewStrengthTraining(st.id, st.date, st.notes)
+	for _, exercise := range st.exercises {
+		if criteria.ExerciseName != nil && !exercise.name.Equals(*criteria.ExerciseName) {
+			continue
+		}
+
+		matched := NewExercise(exercise.name)
+		for _, set := range exercise.sets {
+			if criteria.Matches(set) {
+				matched.AddSet(set)
+			}
+		}
+		if matched.SetCount() > 0 {
+			filtered.AddExercise(matched)
+		}
+	}
+	return filtered
+}
+
+// HasSameContent は同じ日に同じ内容（エクササイズ・セットの重量・回数・時間・距離）を記録したトレーニングかどうかを判定します
+// 他アプリから取り込む際の重複の判定に使用します（ID・時刻・メモ・RPEは比較しません）
+func (st *StrengthTraining) HasSameContent(other *StrengthTraining) bool {
+	if st.date.Format("2006-01-02") != other.date.Format("2006-01-02") || len(st.exercises) != len(other.exercises) {
+		return false
+	}
+
+	for i, exercise := range st.exercises {
+		otherExercise := other.exercises[i]
+		if !exercise.name.Equals(otherExercise.name) || len(exercise.sets) != len(otherExercise.sets) {
+			return false
+		}
+		for j, set := range exercise.sets {
+			if !set.hasSameMeasurement(otherExercise.sets[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // GetExerciseByName は名前でエクササイズを検索します
 func (st *StrengthTraining) GetExerciseByName(name ExerciseName) (*Exercise, error) {
 	for _, exercise := range st.exercises {
@@ -194,3 +194,75 @@ func TestStrengthTraining_IntegrationTest(t *testing.T) {
 	training.UpdateNotes("95kg達成！次は97.5kgに挑戦")
 	assert.Equal(t, "95kg達成！次は97.5kgに挑戦", training.Notes())
 }
+
+func TestStrengthTraining_FilterSets(t *testing.T) {
+	// Arrange
+	training := NewStrengthTraining(shared.NewTrainingID(), time.Now(), "")
+	weight, _ := NewWeight(80.0)
+	reps, _ := NewReps(5)
+	tempo, _ := NewTempo(3, 1, 1, 0)
+
+	bench := NewExercise(BenchPress)
+	bench.AddSet(NewSet(weight, reps, nil).WithTempo(tempo))
+	bench.AddSet(NewSet(weight, reps, nil))
+	squat := NewExercise(Squat)
+	squat.AddSet(NewSet(weight, reps, nil).WithVariations(Paused))
+	training.AddExercise(bench)
+	training.AddExercise(squat)
+
+	// Act
+	pausedBench := training.FilterSets(SetCriteria{ExerciseName: &BenchPress, Variation: &Paused})
+
+	// Assert
+	assert.True(t, pausedBench.ID().Equals(training.ID()))
+	assert.Equal(t, 1, pausedBench.ExerciseCount())
+	assert.Equal(t, 1, pausedBench.TotalSets())
+	assert.Equal(t, 25*time.Second, pausedBench.TimeUnderTension())
+	assert.Equal(t, 3, training.TotalSets())
+}
+
+func TestStrengthTraining_HasSameContent(t *testing.T) {
+	weight, _ := NewWeight(100.0)
+	heavier, _ := NewWeight(102.5)
+	reps, _ := NewReps(5)
+	rpe, _ := NewRPE(8)
+	morning := time.Date(2024, 3, 4, 7, 0, 0, 0, time.UTC)
+
+	newTraining := func(date time.Time, notes string, weight Weight, rpe *RPE) *StrengthTraining {
+		training := NewStrengthTraining(shared.NewTrainingID(), date, notes)
+		bench := NewExercise(BenchPress)
+		bench.AddSet(NewSet(weight, reps, rpe))
+		training.AddExercise(bench)
+		return training
+	}
+	training := newTraining(morning, "Push Day", weight, &rpe)
+
+	tests := []struct {
+		name     string
+		other    *StrengthTraining
+		expected bool
+	}{
+		{
+			name:     "正常系:同じ日の同じセットは時刻・メモ・RPEが異なっても同じ内容",
+			other:    newTraining(morning.Add(10*time.Hour), "", weight, nil),
+			expected: true,
+		},
+		{
+			name:     "正常系:重量が異なる場合は異なる内容",
+			other:    newTraining(morning, "Push Day", heavier, &rpe),
+			expected: false,
+		},
+		{
+			name:     "正常系:日付が異なる場合は異なる内容",
+			other:    newTraining(morning.AddDate(0, 0, 1), "Push Day", weight, &rpe),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act & Assert
+			assert.Equal(t, tt.expected, training.HasSameContent(tt.other))
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package user
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// defaultID は認証なしで利用する場合（標準入出力・CLI）のユーザーで、ユーザー導入前に記録したデータの所有者
+const defaultID = "default"
+
+// idPattern はユーザーIDに使用できる形式（英小文字・数字・_・-、64文字以内）
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// ID はユーザーを一意に識別するID
+type ID struct {
+	value string
+}
+
+// NewID は文字列からIDを作成します
+func NewID(s string) (ID, error) {
+	if !idPattern.MatchString(s) {
+		return ID{}, fmt.Errorf("invalid user id %q: use 1-64 lowercase letters, digits, '_' or '-'", s)
+	}
+	return ID{value: s}, nil
+}
+
+// DefaultID は既定のユーザーのIDを返します
+func DefaultID() ID {
+	return ID{value: defaultID}
+}
+
+// String はIDの文字列表現を返します
+func (id ID) String() string {
+	return id.value
+}
+
+// IsEmpty はIDが空かどうかを判定します
+func (id ID) IsEmpty() bool {
+	return id.value == ""
+}
+
+// Equals は2つのIDが等しいかを判定します
+func (id ID) Equals(other ID) bool {
+	return id.value == other.value
+}
+
+// User はサーバを利用するユーザー
+// トークンは発行時にのみ平文で表示し、ハッシュのみを保持します
+type User struct {
+	id        ID
+	tokenHash string
+	createdAt time.Time
+}
+
+// NewUser は新しいUserを作成します（tokenHashが空の場合はトークン未発行）
+func NewUser(id ID, tokenHash string, createdAt time.Time) *User {
+	return &User{id: id, tokenHash: tokenHash, createdAt: createdAt}
+}
+
+// ID はユーザーIDを返します
+func (u *User) ID() ID {
+	return u.id
+}
+
+// TokenHash はトークンのハッシュを返します
+func (u *User) TokenHash() string {
+	return u.tokenHash
+}
+
+// HasToken はトークンが発行済みかどうかを判定します
+func (u *User) HasToken() bool {
+	return u.tokenHash != ""
+}
+
+// CreatedAt は作成日時を返します
+func (u *User) CreatedAt() time.Time {
+	return u.createdAt
+}
+
+// ReplaceToken はトークンのハッシュを置き換えます（以前のトークンは使用できなくなります）
+func (u *User) ReplaceToken(tokenHash string) {
+	u.tokenHash = tokenHash
+}
@@ -0,0 +1,57 @@
+package user
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewID(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantError bool
+	}{
+		{name: "英小文字", input: "alice", wantError: false},
+		{name: "数字・区切り文字を含む", input: "team-a_01", wantError: false},
+		{name: "空文字列", input: "", wantError: true},
+		{name: "大文字", input: "Alice", wantError: true},
+		{name: "先頭が区切り文字", input: "-alice", wantError: true},
+		{name: "空白を含む", input: "alice smith", wantError: true},
+		{name: "64文字", input: strings.Repeat("a", 64), wantError: false},
+		{name: "65文字", input: strings.Repeat("a", 65), wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			id, err := NewID(tt.input)
+
+			// Assert
+			if tt.wantError {
+				assert.Error(t, err)
+				assert.True(t, id.IsEmpty())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.input, id.String())
+			}
+		})
+	}
+}
+
+func TestUser_ReplaceToken(t *testing.T) {
+	// Arrange
+	id, _ := NewID("alice")
+	u := NewUser(id, "", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// Act
+	u.ReplaceToken("hash")
+
+	// Assert
+	assert.True(t, u.HasToken())
+	assert.Equal(t, "hash", u.TokenHash())
+	assert.True(t, u.ID().Equals(id))
+	assert.False(t, u.ID().Equals(DefaultID()))
+}
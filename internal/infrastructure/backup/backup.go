@@ -0,0 +1,332 @@
+package backup
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// =============================================================================
+// SQLiteデータベースのスナップショット - VACUUM INTOによる取得・保持期間による削除・検証・復元
+// =============================================================================
+
+// timestampLayout はスナップショットのファイル名に含める日時（UTC）の形式
+const timestampLayout = "20060102-150405.000"
+
+// snapshotPattern はスナップショットのファイル名（fitness-<日時>[-<ラベル>].db）
+var snapshotPattern = regexp.MustCompile(`^fitness-(\d{8}-\d{6}\.\d{3})(?:-([a-z0-9-]+))?\.db$`)
+
+// labelPattern はラベルに使用できない文字
+var labelPattern = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// RetentionPolicy はスナップショットの保持ルール
+// 新しい順にKeepLast件と、直近KeepDays日の各日の最新の1件を残し、それ以外を削除します
+type RetentionPolicy struct {
+	KeepLast int
+	KeepDays int
+}
+
+// Snapshot はスナップショットのファイル
+type Snapshot struct {
+	Path      string
+	Label     string
+	CreatedAt time.Time
+	Size      int64
+}
+
+// Verification はスナップショットの検証結果
+type Verification struct {
+	SchemaVersion string // 適用済みの最新のマイグレーションバージョン
+	Integrity     string // PRAGMA integrity_check の結果
+}
+
+// RestoreResult は復元結果
+type RestoreResult struct {
+	Restored     Snapshot
+	Verification *Verification
+	SafetyBackup *Snapshot // 復元前のデータベースのスナップショット（データベースがなかった場合はnil）
+	DatabasePath string
+}
+
+// Service はスナップショットを管理します
+type Service struct {
+	dir    string
+	policy RetentionPolicy
+	now    func() time.Time
+}
+
+// NewService は新しいServiceを作成します
+func NewService(dir string, policy RetentionPolicy) *Service {
+	return &Service{dir: dir, policy: policy, now: time.Now}
+}
+
+// Dir はスナップショットの保存先を返します
+func (s *Service) Dir() string {
+	return s.dir
+}
+
+// Create は接続中のデータベースのスナップショットを作成し、保持ルールに従って古いスナップショットを削除します
+// VACUUM INTOは読み取りトランザクションで実行されるため、書き込み中でも一貫したスナップショットを取得できます
+func (s *Service) Create(db *sql.DB, label string) (*Snapshot, error) {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	createdAt := s.now().UTC()
+	label = normalizeLabel(label)
+	name := "fitness-" + createdAt.Format(timestampLayout)
+	if label != "" {
+		name += "-" + label
+	}
+	path := filepath.Join(s.dir, name+".db")
+
+	if _, err := db.Exec(`VACUUM INTO ?`, path); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot %s: %w", path, err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat snapshot: %w", err)
+	}
+	slog.Info("created database snapshot", "path", path, "bytes", info.Size())
+
+	if _, err := s.Prune(); err != nil {
+		return nil, err
+	}
+	return &Snapshot{Path: path, Label: label, CreatedAt: createdAt.Truncate(time.Millisecond), Size: info.Size()}, nil
+}
+
+// CreateFromFile はデータベースファイルを開いてスナップショットを作成します
+func (s *Service) CreateFromFile(dbPath, label string) (*Snapshot, error) {
+	db, err := openDatabase(dbPath, false)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	return s.Create(db, label)
+}
+
+// List はスナップショットを新しい順に返します
+func (s *Service) List() ([]Snapshot, error) {
+	entries, err := os.ReadDir(s.dir)
+	if os.IsNotExist(err) {
+		return []Snapshot{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup directory: %w", err)
+	}
+
+	snapshots := make([]Snapshot, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := snapshotPattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		createdAt, err := time.Parse(timestampLayout, match[1])
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat snapshot: %w", err)
+		}
+		snapshots = append(snapshots, Snapshot{
+			Path:      filepath.Join(s.dir, entry.Name()),
+			Label:     match[2],
+			CreatedAt: createdAt,
+			Size:      info.Size(),
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.After(snapshots[j].CreatedAt)
+	})
+	return snapshots, nil
+}
+
+// Prune は保持ルールに従って古いスナップショットを削除し、削除したスナップショットを返します
+// 保持ルールが両方とも0以下の場合は何も削除しません
+func (s *Service) Prune() ([]Snapshot, error) {
+	if s.policy.KeepLast <= 0 && s.policy.KeepDays <= 0 {
+		return nil, nil
+	}
+
+	snapshots, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	keep := make(map[string]bool, len(snapshots))
+	for i := 0; i < s.policy.KeepLast && i < len(snapshots); i++ {
+		keep[snapshots[i].Path] = true
+	}
+	if s.policy.KeepDays > 0 {
+		cutoff := s.now().UTC().AddDate(0, 0, -s.policy.KeepDays)
+		days := make(map[string]bool)
+		for _, snapshot := range snapshots {
+			day := snapshot.CreatedAt.Format("2006-01-02")
+			if snapshot.CreatedAt.Before(cutoff) || days[day] {
+				continue
+			}
+			days[day] = true
+			keep[snapshot.Path] = true
+		}
+	}
+
+	pruned := make([]Snapshot, 0)
+	for _, snapshot := range snapshots {
+		if keep[snapshot.Path] {
+			continue
+		}
+		if err := os.Remove(snapshot.Path); err != nil {
+			return pruned, fmt.Errorf("failed to remove snapshot %s: %w", snapshot.Path, err)
+		}
+		slog.Info("removed old database snapshot", "path", snapshot.Path)
+		pruned = append(pruned, snapshot)
+	}
+	return pruned, nil
+}
+
+// Verify はスナップショットの整合性とスキーマバージョンを検証します
+// latestVersion より新しいバージョンのスナップショットは、このバージョンのアプリでは扱えないためエラーにします
+func Verify(path, latestVersion string) (*Verification, error) {
+	db, err := openDatabase(path, true)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	verification := &Verification{}
+	if err := db.QueryRow(`PRAGMA integrity_check`).Scan(&verification.Integrity); err != nil {
+		return nil, fmt.Errorf("failed to check integrity of %s: %w", path, err)
+	}
+	if verification.Integrity != "ok" {
+		return verification, fmt.Errorf("integrity check failed for %s: %s", path, verification.Integrity)
+	}
+
+	var version sql.NullString
+	if err := db.QueryRow(`SELECT MAX(version) FROM schema_migrations`).Scan(&version); err != nil {
+		return verification, fmt.Errorf("%s is not a fitness database: %w", path, err)
+	}
+	if !version.Valid {
+		return verification, fmt.Errorf("%s has no applied migrations", path)
+	}
+	verification.SchemaVersion = version.String
+	if version.String > latestVersion {
+		return verification, fmt.Errorf("schema version %s of %s is newer than supported version %s", version.String, path, latestVersion)
+	}
+	return verification, nil
+}
+
+// Restore はスナップショットを検証してからデータベースファイルを置き換えます
+// 置き換える前に現在のデータベースのスナップショットを作成します。データベースを開いているプロセスがない状態で実行してください
+// 古いスキーマバージョンのスナップショットは、次回の起動時にマイグレーションを適用します
+func (s *Service) Restore(snapshotPath, dbPath, latestVersion string) (*RestoreResult, error) {
+	verification, err := Verify(snapshotPath, latestVersion)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(snapshotPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat snapshot: %w", err)
+	}
+
+	result := &RestoreResult{
+		Restored:     Snapshot{Path: snapshotPath, Size: info.Size()},
+		Verification: verification,
+		DatabasePath: dbPath,
+	}
+
+	// 一時ファイルにコピーしてから置き換え、途中で失敗しても元のデータベースを壊さない
+	// （復元前のスナップショットの作成で古いスナップショットが削除される場合があるため、先にコピーする）
+	tmpPath := dbPath + ".restore"
+	if err := copyFile(snapshotPath, tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return nil, err
+	}
+
+	if _, err := os.Stat(dbPath); err == nil {
+		safety, err := s.CreateFromFile(dbPath, "pre-restore")
+		if err != nil {
+			os.Remove(tmpPath)
+			return nil, fmt.Errorf("failed to back up current database: %w", err)
+		}
+		result.SafetyBackup = safety
+	}
+
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		if err := os.Remove(dbPath + suffix); err != nil && !os.IsNotExist(err) {
+			os.Remove(tmpPath)
+			return nil, fmt.Errorf("failed to remove %s: %w", dbPath+suffix, err)
+		}
+	}
+	if err := os.Rename(tmpPath, dbPath); err != nil {
+		os.Remove(tmpPath)
+		return nil, fmt.Errorf("failed to replace database: %w", err)
+	}
+	slog.Info("restored database from snapshot", "database", dbPath, "snapshot", snapshotPath, "schema_version", verification.SchemaVersion)
+
+	return result, nil
+}
+
+// openDatabase はデータベースファイルを開きます（存在しないファイルは作成しません）
+func openDatabase(path string, readOnly bool) (*sql.DB, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("database file not found: %w", err)
+	}
+
+	dsn := "file:" + path
+	if readOnly {
+		dsn += "?mode=ro"
+	}
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	return db, nil
+}
+
+// copyFile はファイルをコピーします
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", src, err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", dst, err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return fmt.Errorf("failed to copy %s: %w", src, err)
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return fmt.Errorf("failed to sync %s: %w", dst, err)
+	}
+	return out.Close()
+}
+
+// normalizeLabel はラベルをファイル名に使用できる形式に変換します
+func normalizeLabel(label string) string {
+	label = labelPattern.ReplaceAllString(strings.ToLower(strings.TrimSpace(label)), "-")
+	return strings.Trim(label, "-")
+}
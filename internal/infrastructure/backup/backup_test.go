@@ -0,0 +1,227 @@
+package backup
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestDatabase はスキーマバージョンを記録したテスト用のデータベースを作成します
+func newTestDatabase(t *testing.T, path, version string) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite", path)
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec(`
+		CREATE TABLE schema_migrations (version TEXT PRIMARY KEY);
+		CREATE TABLE notes (id INTEGER PRIMARY KEY, body TEXT);
+		INSERT INTO notes (body) VALUES ('original');`)
+	assert.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO schema_migrations (version) VALUES (?)`, version)
+	assert.NoError(t, err)
+	return db
+}
+
+// newTestService は指定した日時を現在時刻とするServiceを作成します
+func newTestService(dir string, policy RetentionPolicy, now *time.Time) *Service {
+	service := NewService(dir, policy)
+	service.now = func() time.Time { return *now }
+	return service
+}
+
+func TestService_Create(t *testing.T) {
+	t.Run("正常系:日時とラベルを含む名前でスナップショットを作成する", func(t *testing.T) {
+		// Arrange
+		dir := t.TempDir()
+		db := newTestDatabase(t, filepath.Join(dir, "fitness.db"), "009")
+		now := time.Date(2024, 3, 1, 12, 30, 45, 0, time.UTC)
+		service := newTestService(filepath.Join(dir, "backups"), RetentionPolicy{}, &now)
+
+		// Act
+		snapshot, err := service.Create(db, "Pre Migrate 010")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "fitness-20240301-123045.000-pre-migrate-010.db", filepath.Base(snapshot.Path))
+		assert.Equal(t, "pre-migrate-010", snapshot.Label)
+
+		verification, err := Verify(snapshot.Path, "009")
+		assert.NoError(t, err)
+		assert.Equal(t, "009", verification.SchemaVersion)
+		assert.Equal(t, "ok", verification.Integrity)
+
+		snapshots, err := service.List()
+		assert.NoError(t, err)
+		assert.Len(t, snapshots, 1)
+		assert.True(t, now.Equal(snapshots[0].CreatedAt))
+	})
+}
+
+func TestService_Prune(t *testing.T) {
+	t.Run("正常系:最新のN件と直近の各日の最新の1件を残す", func(t *testing.T) {
+		// Arrange
+		dir := t.TempDir()
+		db := newTestDatabase(t, filepath.Join(dir, "fitness.db"), "009")
+		now := time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC)
+		service := newTestService(filepath.Join(dir, "backups"), RetentionPolicy{}, &now)
+
+		// 3/1〜3/10の各日に朝・夜の2件ずつ作成（保持ルールなしで作成）
+		for day := 1; day <= 10; day++ {
+			for _, hour := range []int{8, 20} {
+				now = time.Date(2024, 3, day, hour, 0, 0, 0, time.UTC)
+				_, err := service.Create(db, "")
+				assert.NoError(t, err)
+			}
+		}
+		now = time.Date(2024, 3, 10, 21, 0, 0, 0, time.UTC)
+		service.policy = RetentionPolicy{KeepLast: 3, KeepDays: 3}
+
+		// Act
+		pruned, err := service.Prune()
+
+		// Assert
+		assert.NoError(t, err)
+		snapshots, err := service.List()
+		assert.NoError(t, err)
+
+		var kept []string
+		for _, snapshot := range snapshots {
+			kept = append(kept, snapshot.CreatedAt.Format("01-02 15"))
+		}
+		// 最新3件（3/10夜・3/10朝・3/9夜）と、3/7 21:00以降の各日の最新（3/8夜）
+		assert.Equal(t, []string{"03-10 20", "03-10 08", "03-09 20", "03-08 20"}, kept)
+		assert.Len(t, pruned, 16)
+	})
+
+	t.Run("正常系:保持ルールがない場合は削除しない", func(t *testing.T) {
+		// Arrange
+		dir := t.TempDir()
+		db := newTestDatabase(t, filepath.Join(dir, "fitness.db"), "009")
+		now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+		service := newTestService(filepath.Join(dir, "backups"), RetentionPolicy{}, &now)
+		for i := 0; i < 3; i++ {
+			now = now.Add(time.Hour)
+			_, err := service.Create(db, "")
+			assert.NoError(t, err)
+		}
+
+		// Act
+		pruned, err := service.Prune()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, pruned)
+		snapshots, _ := service.List()
+		assert.Len(t, snapshots, 3)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	t.Run("異常系:新しいスキーマバージョンのスナップショットはエラー", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "newer.db")
+		newTestDatabase(t, path, "012")
+
+		// Act
+		verification, err := Verify(path, "009")
+
+		// Assert
+		assert.ErrorContains(t, err, "schema version 012")
+		assert.Equal(t, "012", verification.SchemaVersion)
+	})
+
+	t.Run("異常系:マイグレーション履歴のないデータベースはエラー", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "other.db")
+		db, err := sql.Open("sqlite", path)
+		assert.NoError(t, err)
+		_, err = db.Exec(`CREATE TABLE other (id INTEGER)`)
+		assert.NoError(t, err)
+		db.Close()
+
+		// Act
+		_, err = Verify(path, "009")
+
+		// Assert
+		assert.ErrorContains(t, err, "not a fitness database")
+	})
+
+	t.Run("異常系:SQLiteのファイルでない場合はエラー", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "broken.db")
+		assert.NoError(t, os.WriteFile(path, []byte("this is not a database file at all, just text"), 0o644))
+
+		// Act
+		_, err := Verify(path, "009")
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestService_Restore(t *testing.T) {
+	t.Run("正常系:復元前のデータベースを保存してからスナップショットで置き換える", func(t *testing.T) {
+		// Arrange
+		dir := t.TempDir()
+		dbPath := filepath.Join(dir, "fitness.db")
+		db := newTestDatabase(t, dbPath, "008")
+		now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		service := newTestService(filepath.Join(dir, "backups"), RetentionPolicy{KeepLast: 5}, &now)
+
+		snapshot, err := service.Create(db, "")
+		assert.NoError(t, err)
+		_, err = db.Exec(`UPDATE notes SET body = 'changed'`)
+		assert.NoError(t, err)
+		assert.NoError(t, db.Close())
+		now = now.Add(time.Minute)
+
+		// Act
+		result, err := service.Restore(snapshot.Path, dbPath, "009")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "008", result.Verification.SchemaVersion)
+		assert.NotNil(t, result.SafetyBackup)
+		assert.Equal(t, "pre-restore", result.SafetyBackup.Label)
+
+		assert.Equal(t, "original", readNote(t, dbPath))
+		assert.Equal(t, "changed", readNote(t, result.SafetyBackup.Path))
+	})
+
+	t.Run("異常系:検証に失敗した場合はデータベースを置き換えない", func(t *testing.T) {
+		// Arrange
+		dir := t.TempDir()
+		dbPath := filepath.Join(dir, "fitness.db")
+		db := newTestDatabase(t, dbPath, "009")
+		assert.NoError(t, db.Close())
+		newer := filepath.Join(dir, "newer.db")
+		newTestDatabase(t, newer, "099")
+		service := NewService(filepath.Join(dir, "backups"), RetentionPolicy{})
+
+		// Act
+		_, err := service.Restore(newer, dbPath, "009")
+
+		// Assert
+		assert.ErrorContains(t, err, "newer than supported version")
+		snapshots, _ := service.List()
+		assert.Empty(t, snapshots)
+		assert.Equal(t, "original", readNote(t, dbPath))
+	})
+}
+
+// readNote はテスト用のデータベースのメモを読み込みます
+func readNote(t *testing.T, path string) string {
+	t.Helper()
+	db, err := sql.Open("sqlite", path)
+	assert.NoError(t, err)
+	defer db.Close()
+
+	var body string
+	assert.NoError(t, db.QueryRow(`SELECT body FROM notes`).Scan(&body))
+	return body
+}
@@ -0,0 +1,52 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"fitness-mcp-server/internal/config"
+
+	_ "modernc.org/sqlite"
+)
+
+// =============================================================================
+// SQLiteの接続 - リポジトリ・クエリサービス・マイグレーションで共有する単一の接続プール
+// =============================================================================
+
+// Open は設定に従ってデータベースを開きます
+// 接続プールの各接続には以下のPRAGMAを設定します
+//   - journal_mode=WAL: 書き込み中でも読み取りをブロックしない
+//   - busy_timeout: 他の接続が書き込み中の場合にエラーにせず待機する
+//   - foreign_keys=ON: 親の削除時に子の行を連鎖削除する（ON DELETE CASCADE）
+//
+// トランザクションはBEGIN IMMEDIATEで開始し、読み取りから書き込みへの昇格時に
+// busy_timeoutを待たずにSQLITE_BUSYになるのを防ぎます
+func Open(cfg config.DatabaseConfig) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", DSN(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetime) * time.Hour)
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+	return db, nil
+}
+
+// DSN は設定からPRAGMAを含む接続文字列を作成します
+func DSN(cfg config.DatabaseConfig) string {
+	params := url.Values{}
+	params.Add("_pragma", "busy_timeout("+strconv.Itoa(cfg.BusyTimeoutMs)+")")
+	params.Add("_pragma", "journal_mode(WAL)")
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Set("_txlock", "immediate")
+	return "file:" + cfg.SQLitePath + "?" + params.Encode()
+}
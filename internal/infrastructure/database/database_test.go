@@ -0,0 +1,68 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+
+	"fitness-mcp-server/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpen(t *testing.T) {
+	t.Run("正常系:全ての接続にWAL・busy_timeout・外部キー制約を設定する", func(t *testing.T) {
+		// Arrange
+		cfg := config.DatabaseConfig{
+			SQLitePath:    filepath.Join(t.TempDir(), "fitness.db"),
+			MaxOpenConns:  2,
+			MaxIdleConns:  2,
+			BusyTimeoutMs: 3000,
+		}
+
+		// Act
+		db, err := Open(cfg)
+
+		// Assert
+		assert.NoError(t, err)
+		defer db.Close()
+
+		// 2つの接続を同時に使用して、どちらにも設定されていることを確認する
+		tx1, err := db.Begin()
+		assert.NoError(t, err)
+		defer tx1.Rollback()
+		conn2, err := db.Conn(t.Context())
+		assert.NoError(t, err)
+		defer conn2.Close()
+
+		var journalMode string
+		var busyTimeout, foreignKeys int
+		assert.NoError(t, tx1.QueryRow(`PRAGMA journal_mode`).Scan(&journalMode))
+		assert.NoError(t, tx1.QueryRow(`PRAGMA busy_timeout`).Scan(&busyTimeout))
+		assert.NoError(t, conn2.QueryRowContext(t.Context(), `PRAGMA foreign_keys`).Scan(&foreignKeys))
+		assert.Equal(t, "wal", journalMode)
+		assert.Equal(t, 3000, busyTimeout)
+		assert.Equal(t, 1, foreignKeys)
+	})
+
+	t.Run("正常系:親の行の削除で子の行を連鎖削除する", func(t *testing.T) {
+		// Arrange
+		db, err := Open(config.DatabaseConfig{SQLitePath: filepath.Join(t.TempDir(), "fitness.db"), MaxOpenConns: 1})
+		assert.NoError(t, err)
+		defer db.Close()
+		_, err = db.Exec(`
+			CREATE TABLE parents (id INTEGER PRIMARY KEY);
+			CREATE TABLE children (id INTEGER PRIMARY KEY, parent_id INTEGER REFERENCES parents(id) ON DELETE CASCADE);
+			INSERT INTO parents (id) VALUES (1);
+			INSERT INTO children (parent_id) VALUES (1), (1);`)
+		assert.NoError(t, err)
+
+		// Act
+		_, err = db.Exec(`DELETE FROM parents WHERE id = 1`)
+
+		// Assert
+		assert.NoError(t, err)
+		var count int
+		assert.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM children`).Scan(&count))
+		assert.Equal(t, 0, count)
+	})
+}
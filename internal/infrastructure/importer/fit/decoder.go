@@ -0,0 +1,383 @@
+package fit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+// =============================================================================
+// FITデコーダー - ファイルヘッダー・定義メッセージ・データメッセージ・CRC
+// =============================================================================
+
+const (
+	// minHeaderSize はファイルヘッダーの最小サイズです（14バイトのヘッダーはCRCを含む）
+	minHeaderSize = 12
+
+	// fieldTimestamp はほとんどのメッセージで共通のタイムスタンプのフィールド番号です
+	fieldTimestamp = 253
+)
+
+// fitEpoch はFITのタイムスタンプの起点（1989-12-31T00:00:00Z）です
+var fitEpoch = time.Date(1989, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// crcTable はFITのCRC-16を4ビットずつ計算するためのテーブルです
+var crcTable = [16]uint16{
+	0x0000, 0xCC01, 0xD801, 0x1400, 0xF001, 0x3C00, 0x2800, 0xE401,
+	0xA001, 0x6C00, 0x7800, 0xB401, 0x5000, 0x9C01, 0x8801, 0x4400,
+}
+
+// crc16 はFITのCRC-16を計算します
+func crc16(crc uint16, data []byte) uint16 {
+	for _, b := range data {
+		tmp := crcTable[crc&0xF]
+		crc = (crc >> 4) & 0x0FFF
+		crc = crc ^ tmp ^ crcTable[b&0xF]
+
+		tmp = crcTable[crc&0xF]
+		crc = (crc >> 4) & 0x0FFF
+		crc = crc ^ tmp ^ crcTable[(b>>4)&0xF]
+	}
+	return crc
+}
+
+// baseKind はベースタイプの値の解釈方法です
+type baseKind int
+
+const (
+	kindUnsigned  baseKind = iota // 符号なし整数（全ビット1が無効値）
+	kindUnsignedZ                 // 符号なし整数（0が無効値）
+	kindSigned                    // 符号付き整数（正の最大値が無効値）
+	kindFloat                     // 浮動小数点数（全ビット1が無効値）
+	kindString                    // NUL終端のUTF-8文字列
+)
+
+// baseTypes はベースタイプ番号（下位5ビット）ごとの要素サイズと解釈方法です
+var baseTypes = []struct {
+	size int
+	kind baseKind
+}{
+	0x00: {size: 1, kind: kindUnsigned},  // enum
+	0x01: {size: 1, kind: kindSigned},    // sint8
+	0x02: {size: 1, kind: kindUnsigned},  // uint8
+	0x03: {size: 2, kind: kindSigned},    // sint16
+	0x04: {size: 2, kind: kindUnsigned},  // uint16
+	0x05: {size: 4, kind: kindSigned},    // sint32
+	0x06: {size: 4, kind: kindUnsigned},  // uint32
+	0x07: {size: 1, kind: kindString},    // string
+	0x08: {size: 4, kind: kindFloat},     // float32
+	0x09: {size: 8, kind: kindFloat},     // float64
+	0x0A: {size: 1, kind: kindUnsignedZ}, // uint8z
+	0x0B: {size: 2, kind: kindUnsignedZ}, // uint16z
+	0x0C: {size: 4, kind: kindUnsignedZ}, // uint32z
+	0x0D: {size: 1, kind: kindUnsigned},  // byte
+	0x0E: {size: 8, kind: kindSigned},    // sint64
+	0x0F: {size: 8, kind: kindUnsigned},  // uint64
+	0x10: {size: 8, kind: kindUnsignedZ}, // uint64z
+}
+
+// baseTypeNumberMask はベースタイプのバイトからベースタイプ番号を取り出すマスクです
+const baseTypeNumberMask = 0x1F
+
+// fieldValue はフィールドの値（無効値を除いた数値の配列、または文字列）
+type fieldValue struct {
+	numbers []float64
+	text    string
+}
+
+// message はデコードしたデータメッセージ
+type message struct {
+	num    uint16 // グローバルメッセージ番号
+	fields map[uint8]fieldValue
+}
+
+// number はフィールドの最初の有効な値を返します
+func (m message) number(field uint8) (float64, bool) {
+	value, exists := m.fields[field]
+	if !exists || len(value.numbers) == 0 {
+		return 0, false
+	}
+	return value.numbers[0], true
+}
+
+// scaled はフィールドの値をスケールで割った値を返します（例: ミリ秒→秒はscale=1000）
+func (m message) scaled(field uint8, scale float64) (float64, bool) {
+	value, ok := m.number(field)
+	if !ok {
+		return 0, false
+	}
+	return value / scale, true
+}
+
+// time はdate_time型のフィールドを時刻に変換します
+func (m message) time(field uint8) (time.Time, bool) {
+	value, ok := m.number(field)
+	if !ok {
+		return time.Time{}, false
+	}
+	return fitEpoch.Add(time.Duration(value) * time.Second), true
+}
+
+// text は文字列型のフィールドの値を返します
+func (m message) text(field uint8) string {
+	return m.fields[field].text
+}
+
+// fieldDefinition は定義メッセージ内のフィールド定義
+type fieldDefinition struct {
+	num      uint8
+	size     int
+	baseType uint8
+}
+
+// definition はローカルメッセージタイプに対応付けられた定義メッセージ
+type definition struct {
+	num            uint16
+	order          binary.ByteOrder
+	fields         []fieldDefinition
+	developerBytes int // 読み飛ばす開発者フィールドの合計サイズ
+}
+
+// decode はFITファイルをデコードし、データメッセージを出現順に返します（連結されたFITファイルにも対応します）
+func decode(data []byte) ([]message, error) {
+	messages := make([]message, 0)
+	for offset := 0; offset < len(data); {
+		decoded, size, err := decodeFile(data[offset:])
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, decoded...)
+		offset += size
+	}
+	return messages, nil
+}
+
+// decodeFile はFITファイル1つ分をデコードし、データメッセージと消費したバイト数を返します
+func decodeFile(data []byte) ([]message, int, error) {
+	if len(data) < minHeaderSize {
+		return nil, 0, fmt.Errorf("FIT file is too short: %d bytes", len(data))
+	}
+	if string(data[8:12]) != ".FIT" {
+		return nil, 0, fmt.Errorf("not a FIT file: missing .FIT signature")
+	}
+	headerSize := int(data[0])
+	if headerSize < minHeaderSize || len(data) < headerSize {
+		return nil, 0, fmt.Errorf("invalid FIT header size: %d", headerSize)
+	}
+	if headerSize >= 14 {
+		// ヘッダーCRCが0の場合は計算されていない
+		if headerCRC := binary.LittleEndian.Uint16(data[12:14]); headerCRC != 0 && headerCRC != crc16(0, data[:12]) {
+			return nil, 0, fmt.Errorf("FIT header CRC mismatch")
+		}
+	}
+
+	end := headerSize + int(binary.LittleEndian.Uint32(data[4:8]))
+	if len(data) < end+2 {
+		return nil, 0, fmt.Errorf("FIT file is truncated: %d bytes, expected %d", len(data), end+2)
+	}
+	if crc16(0, data[:end]) != binary.LittleEndian.Uint16(data[end:end+2]) {
+		return nil, 0, fmt.Errorf("FIT file CRC mismatch")
+	}
+
+	d := &decoder{data: data[headerSize:end]}
+	messages, err := d.run()
+	if err != nil {
+		return nil, 0, err
+	}
+	return messages, end + 2, nil
+}
+
+// decoder はFITファイルのデータレコードを順に読み取ります
+type decoder struct {
+	data          []byte
+	pos           int
+	definitions   [16]*definition // ローカルメッセージタイプごとの定義
+	lastTimestamp uint32          // 圧縮タイムスタンプの基準となる直前のタイムスタンプ
+}
+
+// run はすべてのデータレコードを読み取ります
+func (d *decoder) run() ([]message, error) {
+	messages := make([]message, 0)
+	for d.pos < len(d.data) {
+		header, err := d.take(1)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case header[0]&0x80 != 0:
+			// 圧縮タイムスタンプヘッダー: 直前のタイムスタンプの下位5ビットを置き換える
+			offset := uint32(header[0] & 0x1F)
+			timestamp := d.lastTimestamp&^0x1F + offset
+			if offset < d.lastTimestamp&0x1F {
+				timestamp += 0x20
+			}
+			d.lastTimestamp = timestamp
+
+			msg, err := d.readData((header[0] >> 5) & 0x03)
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := msg.fields[fieldTimestamp]; !exists {
+				msg.fields[fieldTimestamp] = fieldValue{numbers: []float64{float64(timestamp)}}
+			}
+			messages = append(messages, msg)
+		case header[0]&0x40 != 0:
+			if err := d.readDefinition(header[0]&0x0F, header[0]&0x20 != 0); err != nil {
+				return nil, err
+			}
+		default:
+			msg, err := d.readData(header[0] & 0x0F)
+			if err != nil {
+				return nil, err
+			}
+			messages = append(messages, msg)
+		}
+	}
+	return messages, nil
+}
+
+// take はnバイトを読み取ります
+func (d *decoder) take(n int) ([]byte, error) {
+	if d.pos+n > len(d.data) {
+		return nil, fmt.Errorf("unexpected end of FIT data at offset %d", d.pos)
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+// readDefinition は定義メッセージを読み取り、ローカルメッセージタイプに対応付けます
+func (d *decoder) readDefinition(local uint8, hasDeveloperFields bool) error {
+	fixed, err := d.take(5)
+	if err != nil {
+		return err
+	}
+
+	def := &definition{order: binary.LittleEndian}
+	if fixed[1] == 1 {
+		def.order = binary.BigEndian
+	}
+	def.num = def.order.Uint16(fixed[2:4])
+
+	raw, err := d.take(int(fixed[4]) * 3)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(raw); i += 3 {
+		def.fields = append(def.fields, fieldDefinition{num: raw[i], size: int(raw[i+1]), baseType: raw[i+2]})
+	}
+
+	if hasDeveloperFields {
+		count, err := d.take(1)
+		if err != nil {
+			return err
+		}
+		raw, err := d.take(int(count[0]) * 3)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(raw); i += 3 {
+			def.developerBytes += int(raw[i+1])
+		}
+	}
+
+	d.definitions[local] = def
+	return nil
+}
+
+// readData はローカルメッセージタイプの定義に従ってデータメッセージを読み取ります
+func (d *decoder) readData(local uint8) (message, error) {
+	def := d.definitions[local]
+	if def == nil {
+		return message{}, fmt.Errorf("data message refers to undefined local message type %d", local)
+	}
+
+	msg := message{num: def.num, fields: make(map[uint8]fieldValue, len(def.fields))}
+	for _, field := range def.fields {
+		raw, err := d.take(field.size)
+		if err != nil {
+			return message{}, err
+		}
+		value := decodeValue(raw, field.baseType, def.order)
+		if len(value.numbers) == 0 && value.text == "" {
+			continue
+		}
+		msg.fields[field.num] = value
+		if field.num == fieldTimestamp && len(value.numbers) > 0 {
+			d.lastTimestamp = uint32(value.numbers[0])
+		}
+	}
+
+	if _, err := d.take(def.developerBytes); err != nil {
+		return message{}, err
+	}
+	return msg, nil
+}
+
+// decodeValue はフィールドの生バイト列をベースタイプに従って解釈します（無効値は除外します）
+func decodeValue(raw []byte, baseType uint8, order binary.ByteOrder) fieldValue {
+	number := int(baseType & baseTypeNumberMask)
+	if number >= len(baseTypes) {
+		return fieldValue{}
+	}
+	info := baseTypes[number]
+
+	if info.kind == kindString {
+		if end := bytes.IndexByte(raw, 0); end >= 0 {
+			raw = raw[:end]
+		}
+		return fieldValue{text: string(raw)}
+	}
+	if len(raw)%info.size != 0 {
+		return fieldValue{}
+	}
+
+	numbers := make([]float64, 0, len(raw)/info.size)
+	for i := 0; i < len(raw); i += info.size {
+		if value, ok := decodeNumber(raw[i:i+info.size], info.kind, order); ok {
+			numbers = append(numbers, value)
+		}
+	}
+	return fieldValue{numbers: numbers}
+}
+
+// decodeNumber は1要素分のバイト列を数値に変換します（無効値の場合はfalse）
+func decodeNumber(raw []byte, kind baseKind, order binary.ByteOrder) (float64, bool) {
+	var u uint64
+	switch len(raw) {
+	case 1:
+		u = uint64(raw[0])
+	case 2:
+		u = uint64(order.Uint16(raw))
+	case 4:
+		u = uint64(order.Uint32(raw))
+	case 8:
+		u = order.Uint64(raw)
+	}
+	bits := uint(len(raw) * 8)
+	allOnes := ^uint64(0) >> (64 - bits)
+
+	switch kind {
+	case kindUnsigned:
+		return float64(u), u != allOnes
+	case kindUnsignedZ:
+		return float64(u), u != 0
+	case kindSigned:
+		if u == allOnes>>1 {
+			return 0, false
+		}
+		return float64(int64(u<<(64-bits)) >> (64 - bits)), true
+	case kindFloat:
+		if u == allOnes {
+			return 0, false
+		}
+		if len(raw) == 4 {
+			return float64(math.Float32frombits(uint32(u))), true
+		}
+		return math.Float64frombits(u), true
+	}
+	return 0, false
+}
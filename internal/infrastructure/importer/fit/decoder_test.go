@@ -0,0 +1,266 @@
+package fit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// テスト用FITエンコーダーとデコーダーのテスト
+// =============================================================================
+
+// テストで使用するベースタイプ
+const (
+	testEnum   uint8 = 0x00
+	testUint8  uint8 = 0x02
+	testUint16 uint8 = 0x84
+	testSint32 uint8 = 0x85
+	testUint32 uint8 = 0x86
+)
+
+// testField はテスト用の定義メッセージのフィールド
+type testField struct {
+	num      uint8
+	baseType uint8
+	count    int // 配列の要素数（0は1要素）
+}
+
+// testDefinition はローカルメッセージタイプに対応付けたテスト用の定義
+type testDefinition struct {
+	order          binary.ByteOrder
+	fields         []testField
+	developerBytes int
+}
+
+// fitBuilder はテスト用のFITファイルを組み立てます
+type fitBuilder struct {
+	records     bytes.Buffer
+	definitions map[uint8]testDefinition
+}
+
+func newFitBuilder() *fitBuilder {
+	return &fitBuilder{definitions: make(map[uint8]testDefinition)}
+}
+
+// elementCount はフィールドの要素数を返します
+func (f testField) elementCount() int {
+	if f.count == 0 {
+		return 1
+	}
+	return f.count
+}
+
+// define は定義メッセージを追加します
+func (b *fitBuilder) define(local uint8, global uint16, bigEndian bool, fields ...testField) *fitBuilder {
+	return b.defineWithDeveloperData(local, global, bigEndian, 0, fields...)
+}
+
+// defineWithDeveloperData は開発者フィールド（developerBytesバイト）を持つ定義メッセージを追加します
+func (b *fitBuilder) defineWithDeveloperData(local uint8, global uint16, bigEndian bool, developerBytes int, fields ...testField) *fitBuilder {
+	header := 0x40 | local
+	if developerBytes > 0 {
+		header |= 0x20
+	}
+	def := testDefinition{order: binary.LittleEndian, fields: fields, developerBytes: developerBytes}
+	architecture := byte(0)
+	if bigEndian {
+		def.order = binary.BigEndian
+		architecture = 1
+	}
+
+	b.records.WriteByte(header)
+	b.records.Write([]byte{0, architecture})
+	global16 := make([]byte, 2)
+	def.order.PutUint16(global16, global)
+	b.records.Write(global16)
+	b.records.WriteByte(byte(len(fields)))
+	for _, f := range fields {
+		size := baseTypes[f.baseType&baseTypeNumberMask].size * f.elementCount()
+		b.records.Write([]byte{f.num, byte(size), f.baseType})
+	}
+	if developerBytes > 0 {
+		b.records.Write([]byte{1, 0, byte(developerBytes), 0})
+	}
+
+	b.definitions[local] = def
+	return b
+}
+
+// data は通常ヘッダーのデータメッセージを追加します（配列フィールドは要素数分の値を続けて指定します）
+func (b *fitBuilder) data(local uint8, values ...uint64) *fitBuilder {
+	b.records.WriteByte(local)
+	b.writeValues(local, values)
+	return b
+}
+
+// compressed は圧縮タイムスタンプヘッダーのデータメッセージを追加します（ローカルメッセージタイプは0〜3）
+func (b *fitBuilder) compressed(local uint8, offset uint8, values ...uint64) *fitBuilder {
+	b.records.WriteByte(0x80 | local<<5 | offset&0x1F)
+	b.writeValues(local, values)
+	return b
+}
+
+func (b *fitBuilder) writeValues(local uint8, values []uint64) {
+	def := b.definitions[local]
+	i := 0
+	for _, f := range def.fields {
+		size := baseTypes[f.baseType&baseTypeNumberMask].size
+		for n := 0; n < f.elementCount(); n++ {
+			raw := make([]byte, 8)
+			if def.order == binary.BigEndian {
+				binary.BigEndian.PutUint64(raw, values[i])
+				raw = raw[8-size:]
+			} else {
+				binary.LittleEndian.PutUint64(raw, values[i])
+				raw = raw[:size]
+			}
+			b.records.Write(raw)
+			i++
+		}
+	}
+	b.records.Write(make([]byte, def.developerBytes))
+}
+
+// bytes は14バイトのヘッダーとCRCを付けたFITファイルを返します
+func (b *fitBuilder) bytes() []byte {
+	header := make([]byte, 14)
+	header[0] = 14
+	header[1] = 0x20
+	binary.LittleEndian.PutUint16(header[2:4], 2195)
+	binary.LittleEndian.PutUint32(header[4:8], uint32(b.records.Len()))
+	copy(header[8:12], ".FIT")
+	binary.LittleEndian.PutUint16(header[12:14], crc16(0, header[:12]))
+
+	file := append(header, b.records.Bytes()...)
+	crc := make([]byte, 2)
+	binary.LittleEndian.PutUint16(crc, crc16(0, file))
+	return append(file, crc...)
+}
+
+// fitTime は時刻をFITのタイムスタンプに変換します
+func fitTime(t time.Time) uint64 {
+	return uint64(t.Sub(fitEpoch) / time.Second)
+}
+
+func TestCRC16(t *testing.T) {
+	// FITのCRCはCRC-16/ARCと同じ値になる
+	assert.Equal(t, uint16(0xBB3D), crc16(0, []byte("123456789")))
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("正常系:圧縮タイムスタンプは直前のタイムスタンプの下位5ビットを置き換え、桁上がりする", func(t *testing.T) {
+		// Arrange: 基準の下位5ビットを30にすると、オフセット31は1秒後、続くオフセット2は桁上がりして4秒後
+		start := fitEpoch.Add(time.Duration(34_500_000*32+30) * time.Second)
+		data := newFitBuilder().
+			define(0, mesgRecord, false, testField{num: fieldTimestamp, baseType: testUint32}, testField{num: recordHeartRate, baseType: testUint8}).
+			define(1, mesgRecord, false, testField{num: recordHeartRate, baseType: testUint8}).
+			data(0, fitTime(start), 140).
+			compressed(1, 31, 141).
+			compressed(1, 2, 142).
+			bytes()
+
+		// Act
+		messages, err := decode(data)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, messages, 3)
+		second, _ := messages[1].time(fieldTimestamp)
+		third, _ := messages[2].time(fieldTimestamp)
+		assert.Equal(t, start.Add(time.Second), second)
+		assert.Equal(t, start.Add(4*time.Second), third)
+		heartRate, _ := messages[2].number(recordHeartRate)
+		assert.Equal(t, 142.0, heartRate)
+	})
+
+	t.Run("正常系:ビッグエンディアン・符号付き・配列の無効値を除外し、開発者フィールドを読み飛ばす", func(t *testing.T) {
+		// Arrange
+		data := newFitBuilder().
+			defineWithDeveloperData(3, mesgSet, true, 2,
+				testField{num: setWeight, baseType: testUint16},
+				testField{num: setRepetitions, baseType: testUint16},
+				testField{num: setCategory, baseType: testUint16, count: 2},
+				testField{num: 0x10, baseType: testSint32}).
+			data(3, 1320, 0xFFFF, 28, 0xFFFF, 0xFFFFFFD6 /* -42 */).
+			data(3, 1600, 5, 0, 0xFFFF, 0x7FFFFFFF).
+			bytes()
+
+		// Act
+		messages, err := decode(data)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, messages, 2)
+		assert.Equal(t, mesgSet, messages[0].num)
+		weight, _ := messages[0].scaled(setWeight, scaleWeight)
+		assert.Equal(t, 82.5, weight)
+		_, hasReps := messages[0].number(setRepetitions)
+		assert.False(t, hasReps)
+		assert.Equal(t, []float64{28}, messages[0].fields[setCategory].numbers)
+		signed, _ := messages[0].number(0x10)
+		assert.Equal(t, -42.0, signed)
+		_, hasSigned := messages[1].number(0x10)
+		assert.False(t, hasSigned)
+	})
+
+	t.Run("正常系:連結されたFITファイルを続けてデコードする", func(t *testing.T) {
+		// Arrange
+		file := newFitBuilder().
+			define(0, mesgRecord, false, testField{num: recordHeartRate, baseType: testUint8}).
+			data(0, 150).
+			bytes()
+
+		// Act
+		messages, err := decode(append(append([]byte{}, file...), file...))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, messages, 2)
+	})
+
+	t.Run("異常系:CRCが一致しない場合はエラー", func(t *testing.T) {
+		// Arrange
+		data := newFitBuilder().
+			define(0, mesgRecord, false, testField{num: recordHeartRate, baseType: testUint8}).
+			data(0, 150).
+			bytes()
+		data[len(data)-3] ^= 0xFF
+
+		// Act
+		_, err := decode(data)
+
+		// Assert
+		assert.ErrorContains(t, err, "CRC mismatch")
+	})
+
+	t.Run("異常系:.FITシグネチャがない場合はエラー", func(t *testing.T) {
+		// Act
+		_, err := decode([]byte("<gpx version=\"1.1\"></gpx>"))
+
+		// Assert
+		assert.ErrorContains(t, err, "not a FIT file")
+	})
+
+	t.Run("異常系:未定義のローカルメッセージタイプはエラー", func(t *testing.T) {
+		// Arrange
+		data := newFitBuilder().
+			define(0, mesgRecord, false, testField{num: recordHeartRate, baseType: testUint8}).
+			data(0, 150).
+			bytes()
+		// ローカルメッセージタイプ0のデータを未定義の5に書き換えてCRCを付け直す
+		body := data[:len(data)-2]
+		body[len(body)-2] = 5
+		crc := make([]byte, 2)
+		binary.LittleEndian.PutUint16(crc, crc16(0, body))
+
+		// Act
+		_, err := decode(append(body, crc...))
+
+		// Assert
+		assert.ErrorContains(t, err, "undefined local message type 5")
+	})
+}
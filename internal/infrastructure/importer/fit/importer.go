@@ -0,0 +1,425 @@
+package fit
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/strength"
+	"fitness-mcp-server/internal/interface/importer"
+)
+
+// Format はFITファイルの形式名です
+const Format = "FIT"
+
+// maxFileBytes は取り込めるファイルサイズの上限です
+const maxFileBytes = 50 << 20
+
+// Importer はFITファイルを解析するActivityFileImporter実装
+type Importer struct{}
+
+// NewImporter は新しいImporterを作成します
+func NewImporter() importer.ActivityFileImporter {
+	return &Importer{}
+}
+
+// ParseFile はローカルのFITファイルを解析します
+func (i *Importer) ParseFile(path string, runType running.RunType, notes string) (*importer.ImportedActivity, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open FIT file: %w", err)
+	}
+	if info.Size() > maxFileBytes {
+		return nil, fmt.Errorf("FIT file is too large: %d bytes", info.Size())
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read FIT file: %w", err)
+	}
+
+	return i.parse(data, runType, notes, filepath.Base(path))
+}
+
+// Parse はFITファイルの内容を解析し、ランまたは筋トレを作成します
+func (i *Importer) Parse(data []byte, runType running.RunType, notes string) (*importer.ImportedActivity, error) {
+	return i.parse(data, runType, notes, "")
+}
+
+// parse はFITファイルの内容を解析します（メモが省略された場合はfallbackNotesを使用します）
+func (i *Importer) parse(data []byte, runType running.RunType, notes, fallbackNotes string) (*importer.ImportedActivity, error) {
+	if len(data) > maxFileBytes {
+		return nil, fmt.Errorf("FIT file is too large: %d bytes", len(data))
+	}
+
+	messages, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+	a := newActivity(messages)
+
+	if notes == "" {
+		notes = fallbackNotes
+	}
+
+	result := &importer.ImportedActivity{Warnings: make([]string, 0)}
+	if len(a.sessions) > 1 {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("%d個のセッションのうち最初のセッションの種目で取り込みました", len(a.sessions)))
+	}
+
+	sport, hasSport := a.sport()
+	if hasSport {
+		result.Sport = sportName(sport)
+	}
+
+	switch {
+	case sport == sportRunning || (!hasSport && a.activeSetCount() == 0 && len(a.records) > 0):
+		result.Run, err = a.buildRun(runType, notes, &result.Warnings)
+	case a.activeSetCount() > 0:
+		result.Strength, err = a.buildStrength(notes, &result.Warnings)
+	default:
+		return nil, fmt.Errorf("unsupported FIT activity (%s): running or strength training with sets is required", result.Sport)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Sport == "" {
+		if result.Run != nil {
+			result.Sport = sportName(sportRunning)
+		} else {
+			result.Sport = sportName(sportTraining)
+		}
+	}
+
+	slog.Debug("parsed FIT file", "sport", result.Sport, "messages", len(messages))
+	return result, nil
+}
+
+// activity はFITファイルのデータメッセージを種類ごとにまとめたもの
+type activity struct {
+	timeCreated *time.Time
+	sessions    []message
+	laps        []message
+	records     []message
+	sets        []message
+}
+
+// newActivity はデータメッセージを種類ごとに振り分けます
+func newActivity(messages []message) *activity {
+	a := &activity{}
+	for _, msg := range messages {
+		switch msg.num {
+		case mesgFileID:
+			if created, ok := msg.time(fileIDTimeCreated); ok && a.timeCreated == nil {
+				a.timeCreated = &created
+			}
+		case mesgSession:
+			a.sessions = append(a.sessions, msg)
+		case mesgLap:
+			a.laps = append(a.laps, msg)
+		case mesgRecord:
+			a.records = append(a.records, msg)
+		case mesgSet:
+			a.sets = append(a.sets, msg)
+		}
+	}
+	return a
+}
+
+// session は最初のsessionメッセージを返します（ない場合は空のメッセージ）
+func (a *activity) session() message {
+	if len(a.sessions) == 0 {
+		return message{fields: map[uint8]fieldValue{}}
+	}
+	return a.sessions[0]
+}
+
+// sport はセッションの種目を返します
+func (a *activity) sport() (int, bool) {
+	sport, ok := a.session().number(sessionSport)
+	return int(sport), ok
+}
+
+// activeSetCount は休息以外のセット数を返します
+func (a *activity) activeSetCount() int {
+	count := 0
+	for _, set := range a.sets {
+		if isActiveSet(set) {
+			count++
+		}
+	}
+	return count
+}
+
+// isActiveSet は休息セットでないかを判定します（set_typeがない場合は実施セットとみなします）
+func isActiveSet(set message) bool {
+	value, ok := set.number(setType)
+	return !ok || int(value) == setTypeActive
+}
+
+// recordTimeRange は最初と最後のrecordメッセージの時刻を返します
+func (a *activity) recordTimeRange() (time.Time, time.Time, bool) {
+	var first, last time.Time
+	for _, record := range a.records {
+		timestamp, ok := record.time(fieldTimestamp)
+		if !ok {
+			continue
+		}
+		if first.IsZero() {
+			first = timestamp
+		}
+		last = timestamp
+	}
+	return first, last, !first.IsZero()
+}
+
+// buildRun はsession・lap・recordメッセージからランニングセッションを作成します
+// sessionメッセージの値を優先し、ない項目はrecordメッセージから求めます
+func (a *activity) buildRun(runType running.RunType, notes string, warnings *[]string) (*importer.ImportedRun, error) {
+	session := a.session()
+	firstRecord, lastRecord, hasRecords := a.recordTimeRange()
+
+	start, ok := session.time(sessionStartTime)
+	if !ok {
+		if !hasRecords {
+			return nil, fmt.Errorf("FIT file has no start time")
+		}
+		start = firstRecord
+	}
+
+	elapsed := lastRecord.Sub(firstRecord)
+	if seconds, ok := session.scaled(sessionTotalElapsedTime, scaleMilliseconds); ok {
+		elapsed = secondsToDuration(seconds)
+	}
+	moving := elapsed
+	if seconds, ok := session.scaled(sessionTotalTimerTime, scaleMilliseconds); ok {
+		moving = secondsToDuration(seconds)
+	}
+
+	distanceMeters, ok := session.scaled(sessionTotalDistance, scaleCentimeters)
+	if !ok {
+		for _, record := range a.records {
+			if meters, ok := record.scaled(recordDistance, scaleCentimeters); ok && meters > distanceMeters {
+				distanceMeters = meters
+			}
+		}
+	}
+
+	distance, err := running.NewDistance(distanceMeters / 1000)
+	if err != nil {
+		return nil, fmt.Errorf("invalid run distance: %w", err)
+	}
+	duration, err := running.NewDuration(moving)
+	if err != nil {
+		return nil, fmt.Errorf("invalid run duration: %w", err)
+	}
+	runningSession, err := running.NewRunningSession(shared.NewSessionID(), start, distance, duration, runType, notes)
+	if err != nil {
+		return nil, err
+	}
+
+	heartRate, ok := session.number(sessionAvgHeartRate)
+	if !ok {
+		heartRate, ok = a.averageRecordHeartRate()
+	}
+	if ok {
+		if hr, err := running.NewHeartRate(int(math.Round(heartRate))); err == nil {
+			runningSession.SetHeartRate(hr)
+		}
+	}
+
+	elevationGain, hasAscent := session.number(sessionTotalAscent)
+	for i, lap := range a.laps {
+		if ascent, ok := lap.number(lapTotalAscent); ok && !hasAscent {
+			elevationGain += ascent
+		}
+		addLap(runningSession, i+1, lap, warnings)
+	}
+
+	return &importer.ImportedRun{
+		Format:         Format,
+		Session:        runningSession,
+		ElapsedTime:    elapsed,
+		MovingTime:     moving,
+		ElevationGainM: elevationGain,
+		PointCount:     len(a.records),
+	}, nil
+}
+
+// addLap はlapメッセージをセッションのラップとして追加します（indexはファイル内のラップの順番）
+// 距離・時間がない、またはペースが算出できない（立ち止まった休息等）ラップは警告して読み飛ばします
+func addLap(session *running.RunningSession, index int, lap message, warnings *[]string) {
+	label := fmt.Sprintf("FITのラップ%d", index)
+
+	meters, hasDistance := lap.scaled(lapTotalDistance, scaleCentimeters)
+	seconds, hasDuration := lap.scaled(lapTotalTimerTime, scaleMilliseconds)
+	if !hasDistance || !hasDuration || meters <= 0 || seconds <= 0 {
+		*warnings = append(*warnings, fmt.Sprintf("%sは距離または時間がないため取り込みませんでした", label))
+		return
+	}
+
+	lapType := running.WorkLap
+	if intensity, ok := lap.number(lapIntensity); ok && int(intensity) != intensityActive && int(intensity) != intensityInterval {
+		lapType = running.RestLap
+	}
+
+	distance, err := running.NewDistance(meters / 1000)
+	if err != nil {
+		*warnings = append(*warnings, fmt.Sprintf("%sは取り込めませんでした: %v", label, err))
+		return
+	}
+	duration, err := running.NewDuration(secondsToDuration(seconds))
+	if err != nil {
+		*warnings = append(*warnings, fmt.Sprintf("%sは取り込めませんでした: %v", label, err))
+		return
+	}
+	added, err := session.AddLap(distance, duration, lapType)
+	if err != nil {
+		*warnings = append(*warnings, fmt.Sprintf("%sは取り込めませんでした: %v", label, err))
+		return
+	}
+
+	if heartRate, ok := lap.number(lapAvgHeartRate); ok {
+		if hr, err := running.NewHeartRate(int(math.Round(heartRate))); err == nil {
+			added.SetHeartRate(hr)
+		}
+	}
+}
+
+// averageRecordHeartRate はrecordメッセージの心拍数の平均を返します
+func (a *activity) averageRecordHeartRate() (float64, bool) {
+	sum, count := 0.0, 0
+	for _, record := range a.records {
+		if heartRate, ok := record.number(recordHeartRate); ok && heartRate > 0 {
+			sum += heartRate
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return sum / float64(count), true
+}
+
+// buildStrength はsetメッセージから筋トレセッションを作成します
+// 同じカテゴリのセットは1つのエクササイズにまとめ、エクササイズは最初に実施した順に並べます
+func (a *activity) buildStrength(notes string, warnings *[]string) (*importer.ImportedStrength, error) {
+	start, err := a.strengthStartTime()
+	if err != nil {
+		return nil, err
+	}
+
+	exercises := make([]*strength.Exercise, 0)
+	byName := make(map[string]*strength.Exercise)
+	restSets := 0
+	for i, msg := range a.sets {
+		if !isActiveSet(msg) {
+			restSets++
+			continue
+		}
+
+		set, err := toStrengthSet(msg)
+		if err != nil {
+			*warnings = append(*warnings, fmt.Sprintf("セット%dは取り込めませんでした: %v", i+1, err))
+			continue
+		}
+
+		category := -1
+		if value, ok := msg.number(setCategory); ok {
+			category = int(value)
+		}
+		name := exerciseCategoryName(category)
+		exercise, exists := byName[name]
+		if !exists {
+			exerciseName, err := strength.NewExerciseName(name)
+			if err != nil {
+				return nil, err
+			}
+			exercise = strength.NewExercise(exerciseName)
+			byName[name] = exercise
+			exercises = append(exercises, exercise)
+		}
+		exercise.AddSet(set)
+	}
+	if len(exercises) == 0 {
+		return nil, fmt.Errorf("FIT file has no importable strength sets")
+	}
+
+	training := strength.NewStrengthTraining(shared.NewTrainingID(), start, notes)
+	for _, exercise := range exercises {
+		training.AddExercise(exercise)
+	}
+
+	elapsed := time.Duration(0)
+	if seconds, ok := a.session().scaled(sessionTotalElapsedTime, scaleMilliseconds); ok {
+		elapsed = secondsToDuration(seconds)
+	} else if last, ok := a.sets[len(a.sets)-1].time(setTimestamp); ok {
+		elapsed = last.Sub(start)
+	}
+
+	return &importer.ImportedStrength{
+		Training:    training,
+		ElapsedTime: elapsed,
+		RestSets:    restSets,
+	}, nil
+}
+
+// strengthStartTime は筋トレの開始時刻を返します
+// sessionの開始時刻、最初のセットの開始時刻、ファイルの作成時刻の順に使用します
+func (a *activity) strengthStartTime() (time.Time, error) {
+	if start, ok := a.session().time(sessionStartTime); ok {
+		return start, nil
+	}
+	for _, set := range a.sets {
+		if start, ok := set.time(setStartTime); ok {
+			return start, nil
+		}
+	}
+	if a.timeCreated != nil {
+		return *a.timeCreated, nil
+	}
+	return time.Time{}, fmt.Errorf("FIT file has no start time")
+}
+
+// toStrengthSet はsetメッセージをセットに変換します
+// 重量がない場合は自重（0kg）、回数がない場合は時間ベースのセットとみなします
+func toStrengthSet(msg message) (strength.Set, error) {
+	kg, _ := msg.scaled(setWeight, scaleWeight)
+	weight, err := strength.NewWeight(kg)
+	if err != nil {
+		return strength.Set{}, err
+	}
+
+	if count, ok := msg.number(setRepetitions); ok && count > 0 {
+		reps, err := strength.NewReps(int(count))
+		if err != nil {
+			return strength.Set{}, err
+		}
+		return strength.NewSet(weight, reps, nil), nil
+	}
+
+	seconds, ok := msg.scaled(setDuration, scaleMilliseconds)
+	if !ok || math.Round(seconds) <= 0 {
+		return strength.Set{}, fmt.Errorf("set has neither repetitions nor duration")
+	}
+	duration, err := strength.NewDuration(int(math.Round(seconds)))
+	if err != nil {
+		return strength.Set{}, err
+	}
+	return strength.NewMeasuredSet(weight, nil, &duration, nil, nil)
+}
+
+// secondsToDuration は秒数をtime.Durationに変換します（ミリ秒単位に丸めます）
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Round(seconds*1000)) * time.Millisecond
+}
+
+// コンパイル時のインターフェース実装チェック
+var _ importer.ActivityFileImporter = (*Importer)(nil)
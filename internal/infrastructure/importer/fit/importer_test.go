@@ -0,0 +1,319 @@
+package fit
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/interface/importer"
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// FIT取り込みのゴールデンファイルテスト
+// =============================================================================
+
+var update = flag.Bool("update", false, "update golden files")
+
+// activityStart はテスト用アクティビティの開始時刻です
+var activityStart = time.Date(2025, 6, 14, 6, 30, 0, 0, time.UTC)
+
+// at は開始からseconds秒後のFITタイムスタンプを返します
+func at(seconds int) uint64 {
+	return fitTime(activityStart.Add(time.Duration(seconds) * time.Second))
+}
+
+// intervalRunFIT はウォームアップ・1km×4本・クールダウンのインターバル走のFITファイルを作成します
+// 立ち止まった休息ラップ（3m/90秒）はペースが算出できないため取り込まれない想定です
+func intervalRunFIT() []byte {
+	const (
+		warmup    = 2
+		cooldown  = 3
+		active    = 0
+		rest      = 1
+		noAscent  = 0xFFFF
+		localLap  = 2
+		localSess = 4
+	)
+
+	b := newFitBuilder().
+		define(0, mesgFileID, false, testField{num: 0, baseType: testEnum}, testField{num: fileIDTimeCreated, baseType: testUint32}).
+		data(0, 4, at(0)).
+		// recordは開発者フィールド（フットポッドのパワー等）付きで、2件目以降は圧縮タイムスタンプ
+		defineWithDeveloperData(1, mesgRecord, false, 2,
+			testField{num: fieldTimestamp, baseType: testUint32},
+			testField{num: recordHeartRate, baseType: testUint8},
+			testField{num: recordDistance, baseType: testUint32}).
+		data(1, at(0), 118, 0).
+		define(3, mesgRecord, false,
+			testField{num: recordHeartRate, baseType: testUint8},
+			testField{num: recordDistance, baseType: testUint32}).
+		compressed(3, uint8(at(10)&0x1F), 125, 2800).
+		compressed(3, uint8(at(20)&0x1F), 131, 5600).
+		define(localLap, mesgLap, false,
+			testField{num: fieldTimestamp, baseType: testUint32},
+			testField{num: lapTotalTimerTime, baseType: testUint32},
+			testField{num: lapTotalDistance, baseType: testUint32},
+			testField{num: lapAvgHeartRate, baseType: testUint8},
+			testField{num: lapTotalAscent, baseType: testUint16},
+			testField{num: lapIntensity, baseType: testEnum})
+
+	laps := []struct {
+		seconds   float64
+		meters    float64
+		heartRate uint64
+		intensity uint64
+	}{
+		{seconds: 720, meters: 2000, heartRate: 138, intensity: warmup},
+		{seconds: 240.0, meters: 1000, heartRate: 168, intensity: active},
+		{seconds: 120, meters: 400, heartRate: 150, intensity: rest},
+		{seconds: 238.5, meters: 1000, heartRate: 171, intensity: active},
+		{seconds: 90, meters: 3, heartRate: 140, intensity: rest},
+		{seconds: 241.2, meters: 1000, heartRate: 172, intensity: active},
+		{seconds: 125, meters: 400, heartRate: 152, intensity: rest},
+		{seconds: 236.8, meters: 1000, heartRate: 174, intensity: active},
+		{seconds: 390, meters: 1000, heartRate: 145, intensity: cooldown},
+	}
+	elapsed := 0.0
+	for _, lap := range laps {
+		elapsed += lap.seconds
+		b.data(localLap, at(int(elapsed)), uint64(lap.seconds*1000), uint64(lap.meters*100), lap.heartRate, noAscent, lap.intensity)
+	}
+
+	b.define(localSess, mesgSession, false,
+		testField{num: fieldTimestamp, baseType: testUint32},
+		testField{num: sessionStartTime, baseType: testUint32},
+		testField{num: sessionSport, baseType: testEnum},
+		testField{num: sessionTotalElapsedTime, baseType: testUint32},
+		testField{num: sessionTotalTimerTime, baseType: testUint32},
+		testField{num: sessionTotalDistance, baseType: testUint32},
+		testField{num: sessionAvgHeartRate, baseType: testUint8},
+		testField{num: sessionTotalAscent, baseType: testUint16}).
+		data(localSess, at(2460), at(0), sportRunning, 2460000, 2401500, 780300, 152, 18)
+
+	return b.bytes()
+}
+
+// recordOnlyRunFIT はsession・lapメッセージがなく、recordメッセージのみのランのFITファイルを作成します
+func recordOnlyRunFIT() []byte {
+	b := newFitBuilder().
+		define(0, mesgRecord, false,
+			testField{num: fieldTimestamp, baseType: testUint32},
+			testField{num: recordHeartRate, baseType: testUint8},
+			testField{num: recordDistance, baseType: testUint32})
+	for i := 0; i <= 10; i++ {
+		heartRate := uint64(140 + i)
+		if i == 5 {
+			heartRate = 0xFF // 心拍数の欠損
+		}
+		b.data(0, at(i*30), heartRate, uint64(i*30*3*100))
+	}
+	return b.bytes()
+}
+
+// strengthFIT は筋トレ（休息セット・自重・時間ベース・カテゴリ不明のセットを含む）のFITファイルを作成します
+// setメッセージはビッグエンディアンで定義します
+func strengthFIT() []byte {
+	const (
+		invalid16 = 0xFFFF
+		invalid32 = 0xFFFFFFFF
+		rest      = 0
+		active    = 1
+		squat     = 28
+		bench     = 0
+		plank     = 19
+		unknown   = 65534
+	)
+
+	b := newFitBuilder().
+		define(0, mesgFileID, false, testField{num: 0, baseType: testEnum}, testField{num: fileIDTimeCreated, baseType: testUint32}).
+		data(0, 4, at(0)).
+		define(1, mesgSet, true,
+			testField{num: setTimestamp, baseType: testUint32},
+			testField{num: setDuration, baseType: testUint32},
+			testField{num: setRepetitions, baseType: testUint16},
+			testField{num: setWeight, baseType: testUint16},
+			testField{num: setType, baseType: testUint8},
+			testField{num: setStartTime, baseType: testUint32},
+			testField{num: setCategory, baseType: testUint16, count: 2})
+
+	sets := []struct {
+		duration, reps, weight, setType, category uint64
+	}{
+		{duration: 30000, reps: 5, weight: 100 * 16, setType: active, category: squat},
+		{duration: 180000, reps: invalid16, weight: invalid16, setType: rest, category: invalid16},
+		{duration: 32000, reps: 5, weight: 100 * 16, setType: active, category: squat},
+		{duration: 180000, reps: invalid16, weight: invalid16, setType: rest, category: invalid16},
+		{duration: 35000, reps: 8, weight: 80 * 16, setType: active, category: bench},
+		{duration: 120000, reps: invalid16, weight: invalid16, setType: rest, category: invalid16},
+		{duration: 31000, reps: 6, weight: 1320, setType: active, category: bench},
+		{duration: 60000, reps: invalid16, weight: invalid16, setType: active, category: plank},
+		{duration: 40000, reps: 15, weight: invalid16, setType: active, category: unknown},
+		{duration: invalid32, reps: invalid16, weight: 20 * 16, setType: active, category: squat},
+		{duration: 28000, reps: 5, weight: 105 * 16, setType: active, category: squat},
+	}
+	elapsed := 60
+	for _, set := range sets {
+		seconds := 30
+		if set.duration != invalid32 {
+			seconds = int(set.duration / 1000)
+		}
+		b.data(1, at(elapsed+seconds), set.duration, set.reps, set.weight, set.setType, at(elapsed), set.category, invalid16)
+		elapsed += seconds
+	}
+
+	b.define(2, mesgSession, false,
+		testField{num: sessionStartTime, baseType: testUint32},
+		testField{num: sessionSport, baseType: testEnum},
+		testField{num: sessionTotalElapsedTime, baseType: testUint32}).
+		data(2, at(0), sportTraining, uint64(elapsed+60)*1000)
+
+	return b.bytes()
+}
+
+// renderImportedActivity は解析結果をゴールデンファイルと比較するためのテキストに変換します
+func renderImportedActivity(activity *importer.ImportedActivity) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "sport: %s\n", activity.Sport)
+
+	if run := activity.Run; run != nil {
+		session := run.Session
+		fmt.Fprintf(&b, "format: %s\n", run.Format)
+		fmt.Fprintf(&b, "points: %d\n", run.PointCount)
+		fmt.Fprintf(&b, "date: %s\n", session.Date().UTC().Format("2006-01-02T15:04:05Z"))
+		fmt.Fprintf(&b, "notes: %s\n", session.Notes())
+		fmt.Fprintf(&b, "distance: %.1fm\n", session.Distance().Meters())
+		fmt.Fprintf(&b, "elapsed: %s\n", run.ElapsedTime)
+		fmt.Fprintf(&b, "moving: %s\n", run.MovingTime)
+		fmt.Fprintf(&b, "pace: %s\n", session.Pace().String())
+		fmt.Fprintf(&b, "elevation_gain: %.1fm\n", run.ElevationGainM)
+		if session.HeartRate() != nil {
+			fmt.Fprintf(&b, "heart_rate: %s\n", session.HeartRate().String())
+		} else {
+			fmt.Fprintf(&b, "heart_rate: -\n")
+		}
+		fmt.Fprintf(&b, "laps:\n")
+		for _, lap := range session.Laps() {
+			heartRate := "-"
+			if lap.HeartRate() != nil {
+				heartRate = lap.HeartRate().String()
+			}
+			fmt.Fprintf(&b, "  %d %s: %.1fm %.1fs %s %s\n",
+				lap.Number(), lap.Type().String(), lap.Distance().Meters(), lap.Duration().Seconds(), lap.Pace().String(), heartRate)
+		}
+	}
+
+	if imported := activity.Strength; imported != nil {
+		training := imported.Training
+		fmt.Fprintf(&b, "date: %s\n", training.Date().UTC().Format("2006-01-02T15:04:05Z"))
+		fmt.Fprintf(&b, "notes: %s\n", training.Notes())
+		fmt.Fprintf(&b, "elapsed: %s\n", imported.ElapsedTime)
+		fmt.Fprintf(&b, "rest_sets: %d\n", imported.RestSets)
+		fmt.Fprintf(&b, "volume: %.1fkg\n", training.TotalVolume())
+		fmt.Fprintf(&b, "exercises:\n")
+		for _, exercise := range training.Exercises() {
+			fmt.Fprintf(&b, "  %s:\n", exercise.Name().String())
+			for _, set := range exercise.Sets() {
+				if set.HasReps() {
+					fmt.Fprintf(&b, "    - %s x %d\n", set.Weight().String(), set.Reps().Count())
+				} else {
+					fmt.Fprintf(&b, "    - %s %s\n", set.Weight().String(), set.Duration().String())
+				}
+			}
+		}
+	}
+
+	fmt.Fprintf(&b, "warnings:\n")
+	for _, warning := range activity.Warnings {
+		fmt.Fprintf(&b, "  - %s\n", warning)
+	}
+	return b.String()
+}
+
+func TestImporter_Parse_Golden(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		data   []byte
+	}{
+		{name: "正常系:インターバル走（ウォームアップ・クールダウン・立ち止まった休息を含む）", golden: "interval_run.golden", data: intervalRunFIT()},
+		{name: "正常系:recordメッセージのみのラン", golden: "record_only_run.golden", data: recordOnlyRunFIT()},
+		{name: "正常系:筋トレ（休息セット・自重・時間ベース・カテゴリ不明を含む）", golden: "strength.golden", data: strengthFIT()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			activity, err := NewImporter().Parse(tt.data, running.Interval, "")
+			assert.NoError(t, err)
+			got := renderImportedActivity(activity)
+
+			// Assert
+			golden := filepath.Join("testdata", tt.golden)
+			if *update {
+				assert.NoError(t, os.MkdirAll("testdata", 0o755))
+				assert.NoError(t, os.WriteFile(golden, []byte(got), 0o644))
+			}
+			want, err := os.ReadFile(golden)
+			assert.NoError(t, err)
+			assert.Equal(t, string(want), got)
+		})
+	}
+}
+
+func TestImporter_ParseFile(t *testing.T) {
+	t.Run("正常系:メモが省略された場合はファイル名を使用する", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "2025-06-14-strength.fit")
+		assert.NoError(t, os.WriteFile(path, strengthFIT(), 0o644))
+
+		// Act
+		activity, err := NewImporter().ParseFile(path, running.Easy, "")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, activity.Run)
+		assert.Equal(t, "2025-06-14-strength.fit", activity.Strength.Training.Notes())
+	})
+
+	t.Run("異常系:ファイルが存在しない場合はエラー", func(t *testing.T) {
+		// Act
+		_, err := NewImporter().ParseFile(filepath.Join(t.TempDir(), "missing.fit"), running.Easy, "")
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestImporter_Parse(t *testing.T) {
+	t.Run("正常系:指定したランニングタイプ・メモを使用する", func(t *testing.T) {
+		// Act
+		activity, err := NewImporter().Parse(intervalRunFIT(), running.Tempo, "トラック練習")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, running.Tempo, activity.Run.Session.RunType())
+		assert.Equal(t, "トラック練習", activity.Run.Session.Notes())
+	})
+
+	t.Run("異常系:ラン・筋トレ以外の種目はエラー", func(t *testing.T) {
+		// Arrange
+		data := newFitBuilder().
+			define(0, mesgSession, false,
+				testField{num: sessionStartTime, baseType: testUint32},
+				testField{num: sessionSport, baseType: testEnum}).
+			data(0, at(0), 2).
+			bytes()
+
+		// Act
+		_, err := NewImporter().Parse(data, running.Easy, "")
+
+		// Assert
+		assert.ErrorContains(t, err, "cycling")
+	})
+}
@@ -0,0 +1,153 @@
+package fit
+
+import "fmt"
+
+// =============================================================================
+// FITプロファイル - 取り込みに使用するメッセージ・フィールド番号と列挙値
+// =============================================================================
+
+// グローバルメッセージ番号
+const (
+	mesgFileID  uint16 = 0
+	mesgSession uint16 = 18
+	mesgLap     uint16 = 19
+	mesgRecord  uint16 = 20
+	mesgSet     uint16 = 225
+)
+
+// file_idメッセージのフィールド番号
+const (
+	fileIDTimeCreated uint8 = 4
+)
+
+// sessionメッセージのフィールド番号
+const (
+	sessionStartTime        uint8 = 2
+	sessionSport            uint8 = 5
+	sessionTotalElapsedTime uint8 = 7  // ミリ秒
+	sessionTotalTimerTime   uint8 = 8  // ミリ秒（一時停止を除く）
+	sessionTotalDistance    uint8 = 9  // センチメートル
+	sessionAvgHeartRate     uint8 = 16 // bpm
+	sessionTotalAscent      uint8 = 22 // m
+)
+
+// lapメッセージのフィールド番号
+const (
+	lapTotalTimerTime uint8 = 8  // ミリ秒
+	lapTotalDistance  uint8 = 9  // センチメートル
+	lapAvgHeartRate   uint8 = 15 // bpm
+	lapTotalAscent    uint8 = 21 // m
+	lapIntensity      uint8 = 23
+)
+
+// recordメッセージのフィールド番号
+const (
+	recordHeartRate uint8 = 3 // bpm
+	recordDistance  uint8 = 5 // センチメートル
+)
+
+// setメッセージのフィールド番号（setメッセージのタイムスタンプは254番）
+const (
+	setDuration    uint8 = 0 // ミリ秒
+	setRepetitions uint8 = 3
+	setWeight      uint8 = 4 // 1/16kg
+	setType        uint8 = 5
+	setStartTime   uint8 = 6
+	setCategory    uint8 = 7
+	setTimestamp   uint8 = 254
+)
+
+// スケール（フィールドの値をこの値で割ると単位の値になる）
+const (
+	scaleMilliseconds = 1000.0
+	scaleCentimeters  = 100.0
+	scaleWeight       = 16.0
+)
+
+// sport の列挙値
+const (
+	sportRunning  = 1
+	sportTraining = 10
+)
+
+// sportNames は取り込み結果に表示するsportの名前です
+var sportNames = map[int]string{
+	0:  "generic",
+	1:  "running",
+	2:  "cycling",
+	4:  "fitness_equipment",
+	5:  "swimming",
+	10: "training",
+	11: "walking",
+	17: "hiking",
+}
+
+// sportName はsportの名前を返します
+func sportName(sport int) string {
+	if name, exists := sportNames[sport]; exists {
+		return name
+	}
+	return fmt.Sprintf("sport(%d)", sport)
+}
+
+// intensity の列挙値のうち疾走区間とみなすもの
+// ドメインのラップタイプは疾走区間/休息区間の2種類のため、ウォームアップ・クールダウンは休息区間として
+// インターバルの安定度・前後半比較の対象から除外します
+const (
+	intensityActive   = 0
+	intensityInterval = 5
+)
+
+// set_type の列挙値
+const (
+	setTypeActive = 1
+)
+
+// exerciseCategoryNames はexercise_categoryの列挙値に対応するエクササイズ名です
+// BIG3はドメインの定義済みエクササイズ名と一致させ、PR判定の対象にします
+var exerciseCategoryNames = map[int]string{
+	0:  "ベンチプレス",
+	1:  "カーフレイズ",
+	2:  "カーディオ",
+	3:  "キャリー",
+	4:  "チョップ",
+	5:  "コア",
+	6:  "クランチ",
+	7:  "カール",
+	8:  "デッドリフト",
+	9:  "フライ",
+	10: "ヒップレイズ",
+	11: "ヒップスタビリティ",
+	12: "ヒップスイング",
+	13: "バックエクステンション",
+	14: "サイドレイズ",
+	15: "レッグカール",
+	16: "レッグレイズ",
+	17: "ランジ",
+	18: "オリンピックリフト",
+	19: "プランク",
+	20: "プライオメトリクス",
+	21: "懸垂",
+	22: "腕立て伏せ",
+	23: "ロウ",
+	24: "ショルダープレス",
+	25: "ショルダースタビリティ",
+	26: "シュラッグ",
+	27: "シットアップ",
+	28: "スクワット",
+	29: "トータルボディ",
+	30: "トライセプスエクステンション",
+	31: "ウォームアップ",
+	32: "ラン",
+}
+
+// unknownExerciseName はカテゴリが記録されていない（unknown）セットのエクササイズ名です
+const unknownExerciseName = "未分類"
+
+// exerciseCategoryName はexercise_categoryに対応するエクササイズ名を返します
+func exerciseCategoryName(category int) string {
+	if name, exists := exerciseCategoryNames[category]; exists {
+		return name
+	}
+	return unknownExerciseName
+}
@@ -0,0 +1,382 @@
+package strengthcsv
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// アプリごとのCSVの列の対応付け
+// =============================================================================
+
+// 対応するアプリ
+const (
+	FormatStrong   = "Strong"
+	FormatHevy     = "Hevy"
+	FormatFitNotes = "FitNotes"
+)
+
+// 重量の単位
+const (
+	unitKg  = "kg"
+	unitLbs = "lbs"
+)
+
+// 重量・距離の換算係数
+const (
+	kgPerLb     = 0.45359237
+	metersPerKm = 1000.0
+	metersPerMi = 1609.344
+	metersPerFt = 0.3048
+	metersPerYd = 0.9144
+)
+
+// row はアプリごとの列を共通の形式に変換したCSVの1行
+type row struct {
+	start          time.Time // セッションの開始日時
+	workout        string    // ワークアウト名
+	workoutNotes   string    // ワークアウトのメモ
+	exercise       string    // アプリのエクササイズ名
+	weight         float64   // 重量（unitの単位、自重の場合は0）
+	unit           string    // 重量の単位（空の場合はファイルの単位）
+	reps           int
+	seconds        int
+	distanceMeters float64
+	rpe            string
+}
+
+// format はアプリごとのCSVの列の対応付け
+type format struct {
+	name string
+	// matches はヘッダーがこのアプリの形式かどうかを判定します
+	matches func(c columns) bool
+	// headerUnit はヘッダーの列名から重量の単位を判定します（判定できない場合は空）
+	headerUnit func(c columns) string
+	// parseRow はCSVの1行を変換します（取り込まない行は理由をエラーで返します）
+	// タイムゾーンのない日時はlocationの時刻として解析します
+	parseRow func(c columns, record []string, fileUnit string, location *time.Location) (row, error)
+}
+
+// formats は対応するアプリの形式（ヘッダーの判定順）
+var formats = []format{
+	{name: FormatStrong, matches: matchesStrong, headerUnit: noHeaderUnit, parseRow: parseStrongRow},
+	{name: FormatHevy, matches: matchesHevy, headerUnit: hevyHeaderUnit, parseRow: parseHevyRow},
+	{name: FormatFitNotes, matches: matchesFitNotes, headerUnit: fitNotesHeaderUnit, parseRow: parseFitNotesRow},
+}
+
+// findFormat は名前（大文字・小文字を区別しない）に対応する形式を返します
+func findFormat(name string) (format, bool) {
+	for _, f := range formats {
+		if strings.EqualFold(f.name, name) {
+			return f, true
+		}
+	}
+	return format{}, false
+}
+
+// detectFormat はヘッダーからアプリの形式を判定します
+func detectFormat(c columns) (format, bool) {
+	for _, f := range formats {
+		if f.matches(c) {
+			return f, true
+		}
+	}
+	return format{}, false
+}
+
+func noHeaderUnit(columns) string {
+	return ""
+}
+
+// -----------------------------------------------------------------------------
+// Strong
+// Date,Workout Name,Duration,Exercise Name,Set Order,Weight,Reps,Distance,Seconds,Notes,Workout Notes,RPE
+// 地域設定によっては区切り文字がセミコロンになり、Weight Unit・Distance Unit列が付きます
+// -----------------------------------------------------------------------------
+
+var strongTimeLayouts = []string{"2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+func matchesStrong(c columns) bool {
+	return c.has("date", "workout name", "exercise name", "set order")
+}
+
+func parseStrongRow(c columns, record []string, fileUnit string, location *time.Location) (row, error) {
+	setOrder := strings.ToLower(c.get(record, "set order"))
+	switch setOrder {
+	case "rest timer":
+		return row{}, fmt.Errorf("休憩タイマーの行")
+	case "w":
+		return row{}, fmt.Errorf("ウォームアップセット")
+	}
+
+	r := row{
+		workout:      c.get(record, "workout name"),
+		workoutNotes: c.get(record, "workout notes"),
+		exercise:     c.get(record, "exercise name"),
+		unit:         c.get(record, "weight unit"),
+		rpe:          c.get(record, "rpe"),
+	}
+
+	var err error
+	if r.start, err = parseTime(c.get(record, "date"), strongTimeLayouts, location); err != nil {
+		return row{}, err
+	}
+	if r.weight, err = parseNumber(c.get(record, "weight"), "重量"); err != nil {
+		return row{}, err
+	}
+	if r.reps, err = parseCount(c.get(record, "reps"), "回数"); err != nil {
+		return row{}, err
+	}
+	if r.seconds, err = parseCount(c.get(record, "seconds"), "時間"); err != nil {
+		return row{}, err
+	}
+
+	// 距離の単位がない場合は重量の単位に合わせる（kgならkm、lbsならマイル）
+	distanceUnit := c.get(record, "distance unit")
+	if distanceUnit == "" {
+		distanceUnit = "km"
+		if unit := normalizeWeightUnit(r.unit); unit == unitLbs || (unit == "" && fileUnit == unitLbs) {
+			distanceUnit = "mi"
+		}
+	}
+	if r.distanceMeters, err = parseDistance(c.get(record, "distance"), distanceUnit); err != nil {
+		return row{}, err
+	}
+
+	return r, nil
+}
+
+// -----------------------------------------------------------------------------
+// Hevy
+// title,start_time,end_time,description,exercise_title,superset_id,exercise_notes,set_index,set_type,
+// weight_kg,reps,distance_km,duration_seconds,rpe（ポンド・マイル設定の場合はweight_lbs・distance_miles）
+// -----------------------------------------------------------------------------
+
+var hevyTimeLayouts = []string{"2 Jan 2006, 15:04", "2 Jan 2006 15:04", "2006-01-02 15:04:05", time.RFC3339}
+
+func matchesHevy(c columns) bool {
+	return c.has("title", "start_time", "exercise_title") && (c.has("weight_kg") || c.has("weight_lbs"))
+}
+
+func hevyHeaderUnit(c columns) string {
+	if c.has("weight_lbs") {
+		return unitLbs
+	}
+	return unitKg
+}
+
+func parseHevyRow(c columns, record []string, _ string, location *time.Location) (row, error) {
+	if strings.EqualFold(c.get(record, "set_type"), "warmup") {
+		return row{}, fmt.Errorf("ウォームアップセット")
+	}
+
+	r := row{
+		workout:      c.get(record, "title"),
+		workoutNotes: c.get(record, "description"),
+		exercise:     c.get(record, "exercise_title"),
+		rpe:          c.get(record, "rpe"),
+	}
+
+	var err error
+	if r.start, err = parseTime(c.get(record, "start_time"), hevyTimeLayouts, location); err != nil {
+		return row{}, err
+	}
+	weightColumn := "weight_kg"
+	if c.has("weight_lbs") {
+		weightColumn = "weight_lbs"
+	}
+	if r.weight, err = parseNumber(c.get(record, weightColumn), "重量"); err != nil {
+		return row{}, err
+	}
+	if r.reps, err = parseCount(c.get(record, "reps"), "回数"); err != nil {
+		return row{}, err
+	}
+	if r.seconds, err = parseCount(c.get(record, "duration_seconds"), "時間"); err != nil {
+		return row{}, err
+	}
+	if c.has("distance_miles") {
+		r.distanceMeters, err = parseDistance(c.get(record, "distance_miles"), "mi")
+	} else {
+		r.distanceMeters, err = parseDistance(c.get(record, "distance_km"), "km")
+	}
+	if err != nil {
+		return row{}, err
+	}
+
+	return r, nil
+}
+
+// -----------------------------------------------------------------------------
+// FitNotes
+// Date,Exercise,Category,Weight (kgs),Reps,Distance,Distance Unit,Time,Comment
+// ワークアウト名がないため、日付ごとに1セッションにまとめます
+// -----------------------------------------------------------------------------
+
+var fitNotesTimeLayouts = []string{"2006-01-02"}
+
+func matchesFitNotes(c columns) bool {
+	return c.has("date", "exercise", "category") && fitNotesHeaderUnit(c) != ""
+}
+
+func fitNotesHeaderUnit(c columns) string {
+	switch {
+	case c.has("weight (kgs)") || c.has("weight (kg)"):
+		return unitKg
+	case c.has("weight (lbs)"):
+		return unitLbs
+	}
+	return ""
+}
+
+func parseFitNotesRow(c columns, record []string, _ string, location *time.Location) (row, error) {
+	r := row{exercise: c.get(record, "exercise")}
+
+	var err error
+	if r.start, err = parseTime(c.get(record, "date"), fitNotesTimeLayouts, location); err != nil {
+		return row{}, err
+	}
+	weight := c.get(record, "weight (kgs)")
+	if weight == "" {
+		weight = c.get(record, "weight (kg)")
+	}
+	if weight == "" {
+		weight = c.get(record, "weight (lbs)")
+	}
+	if r.weight, err = parseNumber(weight, "重量"); err != nil {
+		return row{}, err
+	}
+	if r.reps, err = parseCount(c.get(record, "reps"), "回数"); err != nil {
+		return row{}, err
+	}
+	if r.seconds, err = parseClock(c.get(record, "time")); err != nil {
+		return row{}, err
+	}
+	if r.distanceMeters, err = parseDistance(c.get(record, "distance"), c.get(record, "distance unit")); err != nil {
+		return row{}, err
+	}
+
+	return r, nil
+}
+
+// -----------------------------------------------------------------------------
+// 列・値の変換
+// -----------------------------------------------------------------------------
+
+// columns はヘッダーの列名（小文字）から列番号を引くための対応表
+type columns map[string]int
+
+// newColumns はヘッダーから対応表を作成します
+func newColumns(header []string) columns {
+	c := make(columns, len(header))
+	for i, name := range header {
+		key := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, exists := c[key]; !exists {
+			c[key] = i
+		}
+	}
+	return c
+}
+
+// has は全ての列があるかどうかを判定します
+func (c columns) has(names ...string) bool {
+	for _, name := range names {
+		if _, exists := c[name]; !exists {
+			return false
+		}
+	}
+	return true
+}
+
+// get は列の値を返します（列がない場合は空）
+func (c columns) get(record []string, name string) string {
+	i, exists := c[name]
+	if !exists || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// normalizeWeightUnit は重量の単位を正規化します（判定できない場合は空）
+func normalizeWeightUnit(unit string) string {
+	switch strings.ToLower(strings.TrimSpace(unit)) {
+	case "kg", "kgs":
+		return unitKg
+	case "lb", "lbs":
+		return unitLbs
+	}
+	return ""
+}
+
+// parseTime はいずれかのレイアウトで日時を解析します（タイムゾーンのない日時はlocationの時刻）
+func parseTime(value string, layouts []string, location *time.Location) (time.Time, error) {
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, value, location); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("日時を解析できません: %q", value)
+}
+
+// parseNumber は数値を解析します（空の場合は0、小数点のカンマも受け付けます）
+func parseNumber(value, label string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if !strings.Contains(value, ".") {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%sを解析できません: %q", label, value)
+	}
+	return n, nil
+}
+
+// parseCount は回数・秒数を解析します（"8.0"のような表記も受け付けます）
+func parseCount(value, label string) (int, error) {
+	n, err := parseNumber(value, label)
+	if err != nil {
+		return 0, err
+	}
+	return int(n + 0.5), nil
+}
+
+// parseClock は"1:30"・"0:01:30"形式の時間を秒数に変換します
+func parseClock(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	seconds := 0
+	for _, part := range strings.Split(value, ":") {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("時間を解析できません: %q", value)
+		}
+		seconds = seconds*60 + n
+	}
+	return seconds, nil
+}
+
+// parseDistance は距離をメートルに換算します
+func parseDistance(value, unit string) (float64, error) {
+	n, err := parseNumber(value, "距離")
+	if err != nil || n == 0 {
+		return 0, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(unit)) {
+	case "km", "kms":
+		return n * metersPerKm, nil
+	case "m", "":
+		return n, nil
+	case "mi", "mile", "miles":
+		return n * metersPerMi, nil
+	case "ft", "feet":
+		return n * metersPerFt, nil
+	case "yd", "yds", "yards":
+		return n * metersPerYd, nil
+	}
+	return 0, fmt.Errorf("距離の単位を解析できません: %q", unit)
+}
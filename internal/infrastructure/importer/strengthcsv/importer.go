@@ -0,0 +1,340 @@
+package strengthcsv
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/strength"
+	"fitness-mcp-server/internal/interface/importer"
+)
+
+// maxFileBytes は取り込めるファイルサイズの上限です
+const maxFileBytes = 50 << 20
+
+// 重量の単位の判定方法
+const (
+	unitSourceHeader  = "ヘッダー"
+	unitSourceColumn  = "列"
+	unitSourceOption  = "指定"
+	unitSourceDefault = "既定"
+)
+
+// Importer はStrong・Hevy・FitNotesのCSVエクスポートを解析するStrengthCSVImporter実装
+type Importer struct{}
+
+// NewImporter は新しいImporterを作成します
+func NewImporter() importer.StrengthCSVImporter {
+	return &Importer{}
+}
+
+// ParseFile はローカルのCSVファイルを解析します
+func (i *Importer) ParseFile(path string, options importer.StrengthCSVOptions) (*importer.ImportedStrengthCSV, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open CSV file: %w", err)
+	}
+	if info.Size() > maxFileBytes {
+		return nil, fmt.Errorf("CSV file is too large: %d bytes", info.Size())
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV file: %w", err)
+	}
+
+	return i.Parse(data, options)
+}
+
+// Parse はCSVの内容を解析し、日時・ワークアウト名ごとのトレーニングを作成します
+func (i *Importer) Parse(data []byte, options importer.StrengthCSVOptions) (*importer.ImportedStrengthCSV, error) {
+	if len(data) > maxFileBytes {
+		return nil, fmt.Errorf("CSV file is too large: %d bytes", len(data))
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	c := newColumns(header)
+
+	f, err := resolveFormat(c, options.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	fileUnit, unitSource, err := resolveWeightUnit(f, c, options.WeightUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	location := options.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	aliases := options.Aliases
+	if aliases == nil {
+		if aliases, err = strength.NewExerciseAliasResolver(nil); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &importer.ImportedStrengthCSV{
+		Format:          f.name,
+		WeightUnit:      fileUnit,
+		UnitSource:      unitSource,
+		SkippedSessions: make([]importer.SkippedCSVSession, 0),
+		SkippedRows:     make([]importer.SkippedCSVRow, 0),
+		ExerciseMapping: make(map[string]string),
+	}
+	b := newSessionBuilder()
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		if isBlank(record) {
+			continue
+		}
+		result.RowCount++
+
+		r, err := f.parseRow(c, record, fileUnit, location)
+		if err != nil {
+			result.SkippedRows = append(result.SkippedRows, importer.SkippedCSVRow{Line: line, Reason: err.Error()})
+			continue
+		}
+		s := b.session(r)
+
+		name, err := aliases.Resolve(r.exercise)
+		if err != nil {
+			result.SkippedRows = append(result.SkippedRows, importer.SkippedCSVRow{Line: line, Reason: "エクササイズ名がありません"})
+			continue
+		}
+		if name.Name() != r.exercise {
+			result.ExerciseMapping[r.exercise] = name.Name()
+		}
+
+		unit := fileUnit
+		if rowUnit := normalizeWeightUnit(r.unit); rowUnit != "" {
+			unit = rowUnit
+			result.WeightUnit = rowUnit
+			result.UnitSource = unitSourceColumn
+		}
+		set, err := toSet(r, unit)
+		if err != nil {
+			result.SkippedRows = append(result.SkippedRows, importer.SkippedCSVRow{Line: line, Reason: err.Error()})
+			continue
+		}
+		s.addSet(name, set)
+	}
+
+	for _, s := range b.sessions {
+		if len(s.exercises) == 0 {
+			result.SkippedSessions = append(result.SkippedSessions, importer.SkippedCSVSession{
+				Date:    s.start,
+				Workout: s.workout,
+				Reason:  "有効なセットがありません",
+			})
+			continue
+		}
+		result.Trainings = append(result.Trainings, s.training())
+	}
+	sort.SliceStable(result.Trainings, func(a, b int) bool {
+		return result.Trainings[a].Date().Before(result.Trainings[b].Date())
+	})
+
+	return result, nil
+}
+
+// resolveFormat は指定されたアプリ、またはヘッダーから判定したアプリの形式を返します
+func resolveFormat(c columns, name string) (format, error) {
+	if name == "" {
+		f, ok := detectFormat(c)
+		if !ok {
+			return format{}, fmt.Errorf("unrecognized CSV header: expected a Strong, Hevy or FitNotes export")
+		}
+		return f, nil
+	}
+
+	f, ok := findFormat(name)
+	if !ok {
+		return format{}, fmt.Errorf("unsupported CSV format: %s", name)
+	}
+	if !f.matches(c) {
+		return format{}, fmt.Errorf("CSV header does not match the %s export format", f.name)
+	}
+	return f, nil
+}
+
+// resolveWeightUnit はファイルの重量の単位と判定方法を返します
+// ヘッダーから判定できる場合はヘッダー、できない場合は指定された単位、指定がなければkgを使用します
+func resolveWeightUnit(f format, c columns, specified string) (string, string, error) {
+	if unit := f.headerUnit(c); unit != "" {
+		return unit, unitSourceHeader, nil
+	}
+	if specified == "" {
+		return unitKg, unitSourceDefault, nil
+	}
+	unit := normalizeWeightUnit(specified)
+	if unit == "" {
+		return "", "", fmt.Errorf("unsupported weight unit: %s", specified)
+	}
+	return unit, unitSourceOption, nil
+}
+
+// detectDelimiter はヘッダー行の区切り文字（カンマまたはセミコロン）を判定します
+func detectDelimiter(data []byte) rune {
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+// isBlank は空行かどうかを判定します
+func isBlank(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// toSet は行をセットに変換します（重量はkgに換算します）
+func toSet(r row, unit string) (strength.Set, error) {
+	kg := r.weight
+	if unit == unitLbs {
+		kg = math.Round(r.weight*kgPerLb*100) / 100
+	}
+	weight, err := strength.NewWeight(kg)
+	if err != nil {
+		return strength.Set{}, fmt.Errorf("重量が範囲外です: %.2fkg", kg)
+	}
+
+	var reps *strength.Reps
+	if r.reps > 0 {
+		value, err := strength.NewReps(r.reps)
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("回数が範囲外です: %d", r.reps)
+		}
+		reps = &value
+	}
+	var duration *strength.Duration
+	if r.seconds > 0 {
+		value, err := strength.NewDuration(r.seconds)
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("時間が範囲外です: %d秒", r.seconds)
+		}
+		duration = &value
+	}
+	var distance *strength.Distance
+	if r.distanceMeters > 0 {
+		value, err := strength.NewDistance(math.Round(r.distanceMeters*10) / 10)
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("距離が範囲外です: %.1fm", r.distanceMeters)
+		}
+		distance = &value
+	}
+	if reps == nil && duration == nil && distance == nil {
+		return strength.Set{}, fmt.Errorf("回数・時間・距離がありません")
+	}
+
+	// RPEはアプリによって刻みが異なるため、ドメインで扱えない値は記録しない（セットは取り込む）
+	var rpe *strength.RPE
+	if value, err := parseNumber(r.rpe, "RPE"); err == nil && value > 0 {
+		if parsed, err := strength.NewRPEFromValue(value); err == nil {
+			rpe = &parsed
+		}
+	}
+
+	return strength.NewMeasuredSet(weight, reps, duration, distance, rpe)
+}
+
+// -----------------------------------------------------------------------------
+// 日時・ワークアウト名ごとのセッションの組み立て
+// -----------------------------------------------------------------------------
+
+// session は日時・ワークアウト名が同じ行をまとめたセッション
+type session struct {
+	start     time.Time
+	workout   string
+	notes     string
+	exercises []*strength.Exercise // 最初に現れた順
+}
+
+// sessionBuilder は行をセッションにまとめます
+type sessionBuilder struct {
+	sessions []*session // 最初に現れた順
+	index    map[string]*session
+}
+
+func newSessionBuilder() *sessionBuilder {
+	return &sessionBuilder{index: make(map[string]*session)}
+}
+
+// session は行が属するセッションを返します（初めての日時・ワークアウト名の場合は作成します）
+func (b *sessionBuilder) session(r row) *session {
+	key := r.start.Format(time.RFC3339) + "\x00" + r.workout
+	if s, exists := b.index[key]; exists {
+		if s.notes == "" {
+			s.notes = r.workoutNotes
+		}
+		return s
+	}
+
+	s := &session{start: r.start, workout: r.workout, notes: r.workoutNotes}
+	b.sessions = append(b.sessions, s)
+	b.index[key] = s
+	return s
+}
+
+// addSet はエクササイズにセットを追加します（同じエクササイズは1つにまとめます）
+func (s *session) addSet(name strength.ExerciseName, set strength.Set) {
+	for _, exercise := range s.exercises {
+		if exercise.Name().Equals(name) {
+			exercise.AddSet(set)
+			return
+		}
+	}
+	exercise := strength.NewExercise(name)
+	exercise.AddSet(set)
+	s.exercises = append(s.exercises, exercise)
+}
+
+// training はセッションからトレーニングを作成します（メモはワークアウト名とワークアウトのメモ）
+func (s *session) training() *strength.StrengthTraining {
+	notes := s.workout
+	if s.notes != "" {
+		if notes != "" {
+			notes += ": "
+		}
+		notes += s.notes
+	}
+
+	training := strength.NewStrengthTraining(shared.NewTrainingID(), s.start, notes)
+	for _, exercise := range s.exercises {
+		training.AddExercise(exercise)
+	}
+	return training
+}
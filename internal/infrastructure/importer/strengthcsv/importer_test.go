@@ -0,0 +1,192 @@
+package strengthcsv
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/domain/strength"
+	"fitness-mcp-server/internal/interface/importer"
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// 筋トレCSV取り込みのゴールデンファイルテスト
+// =============================================================================
+
+var update = flag.Bool("update", false, "update golden files")
+
+// renderImportedCSV は解析結果をゴールデンファイルと比較するためのテキストに変換します
+func renderImportedCSV(imported *importer.ImportedStrengthCSV) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "format: %s\n", imported.Format)
+	fmt.Fprintf(&b, "weight_unit: %s (%s)\n", imported.WeightUnit, imported.UnitSource)
+	fmt.Fprintf(&b, "rows: %d\n", imported.RowCount)
+
+	fmt.Fprintf(&b, "trainings:\n")
+	for _, training := range imported.Trainings {
+		fmt.Fprintf(&b, "  - date: %s\n", training.Date().UTC().Format("2006-01-02T15:04:05Z"))
+		fmt.Fprintf(&b, "    notes: %s\n", training.Notes())
+		for _, exercise := range training.Exercises() {
+			fmt.Fprintf(&b, "    %s:\n", exercise.Name().String())
+			for _, set := range exercise.Sets() {
+				line := set.Weight().String()
+				if set.HasReps() {
+					line += fmt.Sprintf(" x %d", set.Reps().Count())
+				}
+				if set.Duration() != nil {
+					line += " " + set.Duration().String()
+				}
+				if set.Distance() != nil {
+					line += " " + set.Distance().String()
+				}
+				if set.RPE() != nil {
+					line += " @" + set.RPE().String()
+				}
+				fmt.Fprintf(&b, "      - %s\n", line)
+			}
+		}
+	}
+
+	fmt.Fprintf(&b, "skipped_sessions:\n")
+	for _, skipped := range imported.SkippedSessions {
+		fmt.Fprintf(&b, "  - %s %s: %s\n", skipped.Date.UTC().Format("2006-01-02T15:04:05Z"), skipped.Workout, skipped.Reason)
+	}
+	fmt.Fprintf(&b, "skipped_rows:\n")
+	for _, skipped := range imported.SkippedRows {
+		fmt.Fprintf(&b, "  - line %d: %s\n", skipped.Line, skipped.Reason)
+	}
+
+	names := make([]string, 0, len(imported.ExerciseMapping))
+	for name := range imported.ExerciseMapping {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintf(&b, "exercise_mapping:\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %s: %s\n", name, imported.ExerciseMapping[name])
+	}
+	return b.String()
+}
+
+func TestImporter_Parse_Golden(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		golden  string
+		options importer.StrengthCSVOptions
+	}{
+		{
+			name:   "正常系:Strong（ウォームアップ・休憩タイマー・時間・距離・不正な値を含む）",
+			input:  "strong.csv",
+			golden: "strong.golden",
+		},
+		{
+			name:   "正常系:Strong（セミコロン区切り・Weight Unit列のポンド・小数点のカンマ）",
+			input:  "strong_semicolon_lbs.csv",
+			golden: "strong_semicolon_lbs.golden",
+		},
+		{
+			name:   "正常系:Hevy（ヘッダーのweight_lbs・distance_milesで単位を判定）",
+			input:  "hevy.csv",
+			golden: "hevy.golden",
+		},
+		{
+			name:    "正常系:FitNotes（日付ごとにまとめ、指定した対応表で名前を対応付け）",
+			input:   "fitnotes.csv",
+			golden:  "fitnotes.golden",
+			options: importer.StrengthCSVOptions{Aliases: mustAliases(t, map[string]string{"Treadmill": "トレッドミル"})},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			imported, err := NewImporter().ParseFile(filepath.Join("testdata", tt.input), tt.options)
+			assert.NoError(t, err)
+			got := renderImportedCSV(imported)
+
+			// Assert
+			golden := filepath.Join("testdata", tt.golden)
+			if *update {
+				assert.NoError(t, os.WriteFile(golden, []byte(got), 0o644))
+			}
+			want, err := os.ReadFile(golden)
+			assert.NoError(t, err)
+			assert.Equal(t, string(want), got)
+		})
+	}
+}
+
+func mustAliases(t *testing.T, mapping map[string]string) *strength.ExerciseAliasResolver {
+	aliases, err := strength.NewExerciseAliasResolver(mapping)
+	assert.NoError(t, err)
+	return aliases
+}
+
+func TestImporter_Parse(t *testing.T) {
+	strongCSV := "Date,Workout Name,Exercise Name,Set Order,Weight,Reps\n2024-01-01 10:00:00,A,Row,1,100,5\n"
+
+	t.Run("正常系:ファイルに単位がない場合は指定した単位を使用する", func(t *testing.T) {
+		// Act
+		imported, err := NewImporter().Parse([]byte(strongCSV), importer.StrengthCSVOptions{WeightUnit: "lbs"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "lbs", imported.WeightUnit)
+		assert.Equal(t, "指定", imported.UnitSource)
+		assert.Equal(t, 45.36, imported.Trainings[0].Exercises()[0].Sets()[0].Weight().Kg())
+	})
+
+	t.Run("正常系:BOM付きのファイルもヘッダーから形式を判定する", func(t *testing.T) {
+		// Act
+		imported, err := NewImporter().Parse([]byte("\xef\xbb\xbf"+strongCSV), importer.StrengthCSVOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, FormatStrong, imported.Format)
+		assert.Equal(t, "既定", imported.UnitSource)
+	})
+
+	t.Run("正常系:タイムゾーンのない日時は指定したタイムゾーンの時刻として解析する", func(t *testing.T) {
+		// Arrange
+		tokyo := time.FixedZone("JST", 9*60*60)
+
+		// Act
+		imported, err := NewImporter().Parse([]byte(strongCSV), importer.StrengthCSVOptions{Location: tokyo})
+
+		// Assert
+		if assert.NoError(t, err) && assert.Len(t, imported.Trainings, 1) {
+			assert.Equal(t, time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC), imported.Trainings[0].Date().UTC())
+		}
+	})
+
+	t.Run("異常系:指定したアプリとヘッダーが一致しない場合はエラー", func(t *testing.T) {
+		// Act
+		_, err := NewImporter().Parse([]byte(strongCSV), importer.StrengthCSVOptions{Format: "hevy"})
+
+		// Assert
+		assert.ErrorContains(t, err, "does not match the Hevy export format")
+	})
+
+	t.Run("異常系:対応していないヘッダーはエラー", func(t *testing.T) {
+		// Act
+		_, err := NewImporter().Parse([]byte("foo,bar\n1,2\n"), importer.StrengthCSVOptions{})
+
+		// Assert
+		assert.ErrorContains(t, err, "unrecognized CSV header")
+	})
+
+	t.Run("異常系:対応していない単位はエラー", func(t *testing.T) {
+		// Act
+		_, err := NewImporter().Parse([]byte(strongCSV), importer.StrengthCSVOptions{WeightUnit: "stone"})
+
+		// Assert
+		assert.ErrorContains(t, err, "unsupported weight unit")
+	})
+}
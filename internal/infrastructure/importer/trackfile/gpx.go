@@ -0,0 +1,79 @@
+package trackfile
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// =============================================================================
+// GPX 1.1 - Garmin TrackPointExtension（心拍数）に対応
+// =============================================================================
+
+type gpxFile struct {
+	XMLName  xml.Name    `xml:"gpx"`
+	Metadata gpxMetadata `xml:"metadata"`
+	Tracks   []gpxTrack  `xml:"trk"`
+}
+
+type gpxMetadata struct {
+	Time string `xml:"time"`
+}
+
+type gpxTrack struct {
+	Name     string       `xml:"name"`
+	Segments []gpxSegment `xml:"trkseg"`
+}
+
+type gpxSegment struct {
+	Points []gpxPoint `xml:"trkpt"`
+}
+
+type gpxPoint struct {
+	Lat       float64  `xml:"lat,attr"`
+	Lon       float64  `xml:"lon,attr"`
+	Elevation *float64 `xml:"ele"`
+	Time      string   `xml:"time"`
+	HeartRate *int     `xml:"extensions>TrackPointExtension>hr"`
+}
+
+// parseGPX はGPXファイルからトラックポイントとトラック名を取り出します
+func parseGPX(data []byte) ([]trackPoint, string, error) {
+	var file gpxFile
+	if err := xml.Unmarshal(data, &file); err != nil {
+		return nil, "", fmt.Errorf("failed to parse GPX: %w", err)
+	}
+
+	points := make([]trackPoint, 0)
+	name := ""
+	for _, track := range file.Tracks {
+		if name == "" {
+			name = track.Name
+		}
+		for _, segment := range track.Segments {
+			segmentStart := true
+			for _, p := range segment.Points {
+				// 時刻のない点は時間を計算できないため使用しない
+				if p.Time == "" {
+					continue
+				}
+				t, err := time.Parse(time.RFC3339, p.Time)
+				if err != nil {
+					return nil, "", fmt.Errorf("invalid GPX point time %q: %w", p.Time, err)
+				}
+				lat, lon := p.Lat, p.Lon
+				points = append(points, trackPoint{
+					time:         t,
+					lat:          &lat,
+					lon:          &lon,
+					elevation:    p.Elevation,
+					heartRate:    p.HeartRate,
+					segmentStart: segmentStart,
+				})
+				segmentStart = false
+			}
+		}
+	}
+
+	return points, name, nil
+}
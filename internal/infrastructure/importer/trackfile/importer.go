@@ -0,0 +1,176 @@
+package trackfile
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/importer"
+)
+
+// 対応するファイル形式
+const (
+	FormatGPX = "GPX"
+	FormatTCX = "TCX"
+)
+
+// maxFileBytes は取り込めるファイルサイズの上限です
+const maxFileBytes = 50 << 20
+
+// Importer はGPX・TCXファイルを解析するRunFileImporter実装
+type Importer struct{}
+
+// NewImporter は新しいImporterを作成します
+func NewImporter() importer.RunFileImporter {
+	return &Importer{}
+}
+
+// ParseFile はローカルのGPX・TCXファイルを解析します
+func (i *Importer) ParseFile(path string, runType running.RunType, notes string) (*importer.ImportedRun, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open run file: %w", err)
+	}
+	if info.Size() > maxFileBytes {
+		return nil, fmt.Errorf("run file is too large: %d bytes", info.Size())
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read run file: %w", err)
+	}
+
+	return i.parse(data, runType, notes, filepath.Base(path))
+}
+
+// Parse はGPX・TCXファイルの内容を解析し、1kmごとのスプリットをラップに持つセッションを作成します
+func (i *Importer) Parse(data []byte, runType running.RunType, notes string) (*importer.ImportedRun, error) {
+	return i.parse(data, runType, notes, "")
+}
+
+// parse はファイルの内容を解析します
+// メモが省略された場合はトラック名（GPX）・メモ（TCX）、それもなければfallbackNotesを使用します
+func (i *Importer) parse(data []byte, runType running.RunType, notes, fallbackNotes string) (*importer.ImportedRun, error) {
+	if len(data) > maxFileBytes {
+		return nil, fmt.Errorf("run file is too large: %d bytes", len(data))
+	}
+
+	format, err := detectFormat(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var points []trackPoint
+	var name string
+	switch format {
+	case FormatGPX:
+		points, name, err = parseGPX(data)
+	case FormatTCX:
+		points, name, err = parseTCX(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := summarize(points)
+	if err != nil {
+		return nil, err
+	}
+
+	if notes == "" {
+		notes = strings.TrimSpace(name)
+	}
+	if notes == "" {
+		notes = fallbackNotes
+	}
+
+	session, err := buildSession(summary, runType, notes)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Debug("parsed run file", "format", format, "points", len(points), "distance_km", summary.distanceMeters/1000)
+
+	return &importer.ImportedRun{
+		Format:         format,
+		Session:        session,
+		ElapsedTime:    summary.elapsed,
+		MovingTime:     summary.moving,
+		ElevationGainM: summary.elevationGainM,
+		PointCount:     len(points),
+	}, nil
+}
+
+// detectFormat はルート要素からファイル形式を判定します
+func detectFormat(data []byte) (string, error) {
+	switch {
+	case bytes.Contains(data, []byte("<gpx")):
+		return FormatGPX, nil
+	case bytes.Contains(data, []byte("<TrainingCenterDatabase")):
+		return FormatTCX, nil
+	default:
+		return "", fmt.Errorf("unsupported run file format: GPX or TCX is required")
+	}
+}
+
+// buildSession は解析結果からランニングセッションを作成します（時間は移動時間を使用）
+func buildSession(summary *trackSummary, runType running.RunType, notes string) (*running.RunningSession, error) {
+	distance, err := running.NewDistance(summary.distanceMeters / 1000)
+	if err != nil {
+		return nil, err
+	}
+	duration, err := running.NewDuration(summary.moving)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := running.NewRunningSession(shared.NewSessionID(), summary.start, distance, duration, runType, notes)
+	if err != nil {
+		return nil, err
+	}
+
+	if summary.heartRate != nil {
+		heartRate, err := running.NewHeartRate(*summary.heartRate)
+		if err != nil {
+			return nil, err
+		}
+		session.SetHeartRate(heartRate)
+	}
+
+	for _, s := range summary.splits {
+		// 停止中のみの端数スプリットは時間がないため記録しない
+		if s.movingSeconds <= 0 {
+			continue
+		}
+		lapDistance, err := running.NewDistance(s.distanceMeters / 1000)
+		if err != nil {
+			return nil, err
+		}
+		lapDuration, err := running.NewDuration(time.Duration(s.movingSeconds * float64(time.Second)))
+		if err != nil {
+			return nil, err
+		}
+		lap, err := session.AddLap(lapDistance, lapDuration, running.WorkLap)
+		if err != nil {
+			return nil, err
+		}
+		if s.heartRate != nil {
+			heartRate, err := running.NewHeartRate(*s.heartRate)
+			if err != nil {
+				return nil, err
+			}
+			lap.SetHeartRate(heartRate)
+		}
+	}
+
+	return session, nil
+}
+
+// コンパイル時のインターフェース実装チェック
+var _ importer.RunFileImporter = (*Importer)(nil)
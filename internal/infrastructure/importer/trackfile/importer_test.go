@@ -0,0 +1,122 @@
+package trackfile
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/interface/importer"
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// GPX・TCX取り込みのゴールデンファイルテスト
+// =============================================================================
+
+var update = flag.Bool("update", false, "update golden files")
+
+// renderImportedRun は解析結果をゴールデンファイルと比較するためのテキストに変換します
+func renderImportedRun(run *importer.ImportedRun) string {
+	var b strings.Builder
+	session := run.Session
+	fmt.Fprintf(&b, "format: %s\n", run.Format)
+	fmt.Fprintf(&b, "points: %d\n", run.PointCount)
+	fmt.Fprintf(&b, "date: %s\n", session.Date().UTC().Format("2006-01-02T15:04:05Z"))
+	fmt.Fprintf(&b, "notes: %s\n", session.Notes())
+	fmt.Fprintf(&b, "distance: %.1fm\n", session.Distance().Meters())
+	fmt.Fprintf(&b, "elapsed: %s\n", run.ElapsedTime)
+	fmt.Fprintf(&b, "moving: %s\n", run.MovingTime)
+	fmt.Fprintf(&b, "pace: %s\n", session.Pace().String())
+	fmt.Fprintf(&b, "elevation_gain: %.1fm\n", run.ElevationGainM)
+	if session.HeartRate() != nil {
+		fmt.Fprintf(&b, "heart_rate: %s\n", session.HeartRate().String())
+	} else {
+		fmt.Fprintf(&b, "heart_rate: -\n")
+	}
+	fmt.Fprintf(&b, "splits:\n")
+	for _, lap := range session.Laps() {
+		heartRate := "-"
+		if lap.HeartRate() != nil {
+			heartRate = lap.HeartRate().String()
+		}
+		fmt.Fprintf(&b, "  %d: %.1fm %.1fs %s %s\n",
+			lap.Number(), lap.Distance().Meters(), lap.Duration().Seconds(), lap.Pace().String(), heartRate)
+	}
+	return b.String()
+}
+
+func TestImporter_ParseFile_Golden(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{name: "正常系:GPX（一時停止・セグメント分割・心拍数あり）", file: "morning_run.gpx"},
+		{name: "正常系:TCX（位置なしのトレッドミル）", file: "treadmill.tcx"},
+		{name: "正常系:TCX（位置・標高あり、心拍数なし）", file: "hill_run.tcx"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			run, err := NewImporter().ParseFile(filepath.Join("testdata", tt.file), running.Easy, "")
+			assert.NoError(t, err)
+			got := renderImportedRun(run)
+
+			// Assert
+			golden := filepath.Join("testdata", tt.file+".golden")
+			if *update {
+				assert.NoError(t, os.WriteFile(golden, []byte(got), 0o644))
+			}
+			want, err := os.ReadFile(golden)
+			assert.NoError(t, err)
+			assert.Equal(t, string(want), got)
+		})
+	}
+}
+
+func TestImporter_Parse(t *testing.T) {
+	t.Run("正常系:内容から形式を判定しメモを優先する", func(t *testing.T) {
+		// Arrange
+		data, err := os.ReadFile(filepath.Join("testdata", "morning_run.gpx"))
+		assert.NoError(t, err)
+
+		// Act
+		run, err := NewImporter().Parse(data, running.Long, "週末ラン")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, FormatGPX, run.Format)
+		assert.Equal(t, running.Long, run.Session.RunType())
+		assert.Equal(t, "週末ラン", run.Session.Notes())
+	})
+
+	t.Run("異常系:GPX・TCX以外はエラー", func(t *testing.T) {
+		// Act
+		_, err := NewImporter().Parse([]byte(`{"type": "FeatureCollection"}`), running.Easy, "")
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系:時刻付きのポイントが2点未満はエラー", func(t *testing.T) {
+		// Arrange
+		data := []byte(`<gpx><trk><trkseg><trkpt lat="35.68" lon="139.76"><time>2025-06-14T06:30:00Z</time></trkpt></trkseg></trk></gpx>`)
+
+		// Act
+		_, err := NewImporter().Parse(data, running.Easy, "")
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestHaversineMeters(t *testing.T) {
+	// 緯度1度は約111.2km
+	assert.InDelta(t, 111195, haversineMeters(35, 139, 36, 139), 1)
+	// 東京駅〜新大阪駅は約403km
+	assert.InDelta(t, 403000, haversineMeters(35.6812, 139.7671, 34.7335, 135.5001), 2000)
+}
@@ -0,0 +1,86 @@
+package trackfile
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// =============================================================================
+// TCX（Training Center XML）- 位置のないトレッドミルの記録にも対応
+// =============================================================================
+
+type tcxFile struct {
+	XMLName    xml.Name      `xml:"TrainingCenterDatabase"`
+	Activities []tcxActivity `xml:"Activities>Activity"`
+}
+
+type tcxActivity struct {
+	Sport string   `xml:"Sport,attr"`
+	Notes string   `xml:"Notes"`
+	Laps  []tcxLap `xml:"Lap"`
+}
+
+type tcxLap struct {
+	Tracks []tcxTrack `xml:"Track"`
+}
+
+type tcxTrack struct {
+	Points []tcxPoint `xml:"Trackpoint"`
+}
+
+type tcxPoint struct {
+	Time      string       `xml:"Time"`
+	Position  *tcxPosition `xml:"Position"`
+	Altitude  *float64     `xml:"AltitudeMeters"`
+	Distance  *float64     `xml:"DistanceMeters"`
+	HeartRate *int         `xml:"HeartRateBpm>Value"`
+}
+
+type tcxPosition struct {
+	Lat float64 `xml:"LatitudeDegrees"`
+	Lon float64 `xml:"LongitudeDegrees"`
+}
+
+// parseTCX はTCXファイルからトラックポイントとメモを取り出します
+func parseTCX(data []byte) ([]trackPoint, string, error) {
+	var file tcxFile
+	if err := xml.Unmarshal(data, &file); err != nil {
+		return nil, "", fmt.Errorf("failed to parse TCX: %w", err)
+	}
+
+	points := make([]trackPoint, 0)
+	notes := ""
+	for _, activity := range file.Activities {
+		if notes == "" {
+			notes = activity.Notes
+		}
+		for _, lap := range activity.Laps {
+			// ラップ・トラックの境界は連続しているものとして扱う
+			for _, track := range lap.Tracks {
+				for _, p := range track.Points {
+					if p.Time == "" {
+						continue
+					}
+					t, err := time.Parse(time.RFC3339, p.Time)
+					if err != nil {
+						return nil, "", fmt.Errorf("invalid TCX trackpoint time %q: %w", p.Time, err)
+					}
+					point := trackPoint{
+						time:      t,
+						elevation: p.Altitude,
+						distance:  p.Distance,
+						heartRate: p.HeartRate,
+					}
+					if p.Position != nil {
+						lat, lon := p.Position.Lat, p.Position.Lon
+						point.lat, point.lon = &lat, &lon
+					}
+					points = append(points, point)
+				}
+			}
+		}
+	}
+
+	return points, notes, nil
+}
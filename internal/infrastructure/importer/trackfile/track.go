@@ -0,0 +1,186 @@
+package trackfile
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// =============================================================================
+// トラック解析 - 距離（ハーバサイン）、移動時間、獲得標高、1kmスプリット
+// =============================================================================
+
+const (
+	// earthRadiusMeters は地球の平均半径（m）です
+	earthRadiusMeters = 6371008.8
+
+	// movingSpeedThreshold は移動中とみなす最低速度（m/秒、約2.2km/h）です
+	// スプリットのペースがドメインの上限（30分/km）を超えないよう、これより遅い区間は停止とみなします
+	movingSpeedThreshold = 0.6
+
+	// elevationNoiseMeters は獲得標高に数えない標高変化（GPS・気圧計のノイズ）の幅（m）です
+	elevationNoiseMeters = 2.0
+
+	// minFinalSplitMeters は最後の端数スプリットとして残す最短距離（m）です
+	minFinalSplitMeters = 10.0
+)
+
+// trackPoint はトラックポイント1点を表します
+type trackPoint struct {
+	time         time.Time
+	lat, lon     *float64 // 緯度・経度（トレッドミル等では記録されない）
+	elevation    *float64 // 標高（m）
+	distance     *float64 // 累積距離（m、TCXのみ。位置がない場合に使用）
+	heartRate    *int     // 心拍数（bpm）
+	segmentStart bool     // トラックセグメントの先頭（前の点とは繋がっていない）
+}
+
+// split は1kmごとのスプリットを表します
+type split struct {
+	distanceMeters float64
+	movingSeconds  float64
+	heartRate      *int
+}
+
+// trackSummary はトラックの解析結果を表します
+type trackSummary struct {
+	start          time.Time
+	distanceMeters float64
+	elapsed        time.Duration
+	moving         time.Duration
+	elevationGainM float64
+	heartRate      *int
+	splits         []split
+}
+
+// haversineMeters は2点間の大円距離（m）をハーバサインの公式で計算します
+func haversineMeters(lat1, lon1, lat2, lon2 float64) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// segmentDistance は連続する2点間の距離（m）を返します
+// 位置があればハーバサイン距離、なければ累積距離の差を使用します
+func segmentDistance(prev, curr trackPoint) float64 {
+	if prev.lat != nil && prev.lon != nil && curr.lat != nil && curr.lon != nil {
+		return haversineMeters(*prev.lat, *prev.lon, *curr.lat, *curr.lon)
+	}
+	if prev.distance != nil && curr.distance != nil && *curr.distance > *prev.distance {
+		return *curr.distance - *prev.distance
+	}
+	return 0
+}
+
+// summarize はトラックポイントから距離・移動時間・獲得標高・1kmスプリットを計算します
+func summarize(points []trackPoint) (*trackSummary, error) {
+	if len(points) < 2 {
+		return nil, fmt.Errorf("track must have at least 2 points with time: %d", len(points))
+	}
+
+	summary := &trackSummary{
+		start:   points[0].time,
+		elapsed: points[len(points)-1].time.Sub(points[0].time),
+		splits:  make([]split, 0),
+	}
+
+	var heartRateSum, heartRateCount int
+	var splitHeartRateSum, splitHeartRateCount int
+	current := split{}
+	var elevationRef *float64
+
+	for i, point := range points {
+		if point.heartRate != nil {
+			heartRateSum += *point.heartRate
+			heartRateCount++
+		}
+
+		// 獲得標高（ヒステリシスでノイズを除去）
+		if point.elevation != nil {
+			switch {
+			case elevationRef == nil || *point.elevation < *elevationRef:
+				elevation := *point.elevation
+				elevationRef = &elevation
+			case *point.elevation-*elevationRef >= elevationNoiseMeters:
+				summary.elevationGainM += *point.elevation - *elevationRef
+				elevation := *point.elevation
+				elevationRef = &elevation
+			}
+		}
+
+		if i == 0 || point.segmentStart {
+			continue
+		}
+
+		prev := points[i-1]
+		meters := segmentDistance(prev, point)
+		seconds := point.time.Sub(prev.time).Seconds()
+		if seconds < 0 {
+			return nil, fmt.Errorf("track points are not in chronological order at point %d", i)
+		}
+		movingSeconds := 0.0
+		if seconds > 0 && meters/seconds >= movingSpeedThreshold {
+			movingSeconds = seconds
+		}
+
+		summary.distanceMeters += meters
+		summary.moving += time.Duration(movingSeconds * float64(time.Second))
+
+		if point.heartRate != nil {
+			splitHeartRateSum += *point.heartRate
+			splitHeartRateCount++
+		}
+
+		// 1kmの境界をまたぐ区間は距離で按分する
+		for meters > 0 {
+			remaining := 1000 - current.distanceMeters
+			if meters < remaining {
+				current.distanceMeters += meters
+				current.movingSeconds += movingSeconds
+				break
+			}
+			ratio := remaining / meters
+			current.distanceMeters = 1000
+			current.movingSeconds += movingSeconds * ratio
+			current.heartRate = averageHeartRate(splitHeartRateSum, splitHeartRateCount)
+			summary.splits = append(summary.splits, current)
+
+			current = split{}
+			splitHeartRateSum, splitHeartRateCount = 0, 0
+			meters -= remaining
+			movingSeconds -= movingSeconds * ratio
+		}
+	}
+
+	// 端数のスプリット（短すぎる場合は直前のスプリットに含める）
+	if current.distanceMeters >= minFinalSplitMeters || len(summary.splits) == 0 {
+		current.heartRate = averageHeartRate(splitHeartRateSum, splitHeartRateCount)
+		summary.splits = append(summary.splits, current)
+	} else if current.distanceMeters > 0 {
+		last := &summary.splits[len(summary.splits)-1]
+		last.distanceMeters += current.distanceMeters
+		last.movingSeconds += current.movingSeconds
+	}
+
+	summary.heartRate = averageHeartRate(heartRateSum, heartRateCount)
+
+	if summary.distanceMeters <= 0 {
+		return nil, fmt.Errorf("track has no distance")
+	}
+	if summary.moving <= 0 {
+		return nil, fmt.Errorf("track has no moving time")
+	}
+	return summary, nil
+}
+
+// averageHeartRate は心拍数の平均を四捨五入して返します（サンプルがない場合はnil）
+func averageHeartRate(sum, count int) *int {
+	if count == 0 {
+		return nil
+	}
+	average := int(math.Round(float64(sum) / float64(count)))
+	return &average
+}
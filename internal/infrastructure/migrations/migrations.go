@@ -0,0 +1,457 @@
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"regexp"
+	"sort"
+	"time"
+)
+
+// =============================================================================
+// スキーママイグレーション - 埋め込んだSQLファイルの検出・適用・ロールバック・チェックサム検証
+// =============================================================================
+
+//go:embed sql/*.sql
+var embeddedFiles embed.FS
+
+// filePattern はマイグレーションファイル名（<3桁のバージョン>_<名前>.up.sql / .down.sql）
+var filePattern = regexp.MustCompile(`^(\d{3})_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Direction はマイグレーションの方向
+type Direction string
+
+const (
+	Up   Direction = "up"
+	Down Direction = "down"
+)
+
+// BeforeHook は既存のデータベースにマイグレーションを適用・ロールバックする前に呼び出されます
+// エラーを返した場合は何も変更しません（スナップショットの作成に使用）
+type BeforeHook func(db *sql.DB, direction Direction, versions []string) error
+
+// Migration は1つのマイグレーション
+type Migration struct {
+	Version  string
+	Name     string
+	Up       string
+	Down     string // ロールバック用のSQL（ない場合は空）
+	Checksum string // UpのSQLのSHA-256
+}
+
+// Status はマイグレーションの適用状況
+type Status struct {
+	Version   string
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+	Modified  bool // 適用後にファイルが変更された（チェックサムが一致しない）
+	HasDown   bool
+}
+
+// Runner はマイグレーションを実行します
+type Runner struct {
+	db         *sql.DB
+	migrations []Migration
+	before     BeforeHook
+}
+
+// NewRunner は埋め込んだマイグレーションファイルを使うRunnerを作成します
+// before がnilの場合は適用・ロールバック前に何もしません
+func NewRunner(db *sql.DB, before BeforeHook) (*Runner, error) {
+	sub, err := fs.Sub(embeddedFiles, "sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open embedded migrations: %w", err)
+	}
+	return NewRunnerFS(db, sub, before)
+}
+
+// NewRunnerFS は指定したファイルシステムのマイグレーションファイルを使うRunnerを作成します
+func NewRunnerFS(db *sql.DB, fsys fs.FS, before BeforeHook) (*Runner, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Runner{db: db, migrations: migrations, before: before}, nil
+}
+
+// LatestVersion は埋め込んだマイグレーションの最新のバージョンを返します
+func LatestVersion() string {
+	migrations, err := Load(mustSub(embeddedFiles, "sql"))
+	if err != nil || len(migrations) == 0 {
+		return ""
+	}
+	return migrations[len(migrations)-1].Version
+}
+
+// Load はファイルシステムの直下にあるマイグレーションファイルを読み込み、バージョン順に返します
+// 対応するupのないdownファイルや、同じバージョンの重複はエラーにします
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[string]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+		match := filePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s (expected NNN_name.up.sql or NNN_name.down.sql)", entry.Name())
+		}
+		version, name, direction := match[1], match[2], Direction(match[3])
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration file %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if migration.Name != name {
+			return nil, fmt.Errorf("duplicate migration version %s: %s and %s", version, migration.Name, name)
+		}
+		if direction == Up {
+			migration.Up = string(content)
+			sum := sha256.Sum256(content)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %s_%s has no up script", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Latest は最新のマイグレーションのバージョンを返します
+func (r *Runner) Latest() string {
+	if len(r.migrations) == 0 {
+		return ""
+	}
+	return r.migrations[len(r.migrations)-1].Version
+}
+
+// Status はマイグレーションごとの適用状況をバージョン順に返します
+func (r *Runner) Status() ([]Status, error) {
+	applied, err := r.loadApplied()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(r.migrations))
+	for _, migration := range r.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name, HasDown: migration.Down != ""}
+		if record, ok := applied[migration.Version]; ok {
+			status.Applied = true
+			status.AppliedAt = record.appliedAt
+			status.Modified = record.checksum != migration.Checksum
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Up は未適用のマイグレーションをバージョン順に適用し、適用したマイグレーションを返します
+// 適用済みのファイルが変更されている場合は何も適用せずにエラーを返します
+func (r *Runner) Up() ([]Migration, error) {
+	applied, err := r.loadApplied()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkModified(applied); err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range r.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	if len(pending) == 0 {
+		slog.Info("database schema is up to date", "version", r.Latest())
+		return nil, nil
+	}
+
+	// 新規のデータベースは変更前の状態を残す必要がないためフックを呼び出さない
+	if len(applied) > 0 && r.before != nil {
+		if err := r.before(r.db, Up, versionsOf(pending)); err != nil {
+			return nil, fmt.Errorf("before migration hook failed: %w", err)
+		}
+	}
+
+	for i, migration := range pending {
+		slog.Info("applying migration", "version", migration.Version, "name", migration.Name)
+		if err := r.apply(migration, Up); err != nil {
+			return pending[:i], err
+		}
+	}
+	slog.Info("applied migrations", "count", len(pending), "version", r.Latest())
+	return pending, nil
+}
+
+// Down は適用済みのマイグレーションを新しい順にsteps件ロールバックし、ロールバックしたマイグレーションを返します
+// ロールバック用のSQLがないマイグレーションが含まれる場合は何もロールバックせずにエラーを返します
+func (r *Runner) Down(steps int) ([]Migration, error) {
+	if steps <= 0 {
+		return nil, fmt.Errorf("steps must be positive: %d", steps)
+	}
+
+	applied, err := r.loadApplied()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkModified(applied); err != nil {
+		return nil, err
+	}
+
+	var targets []Migration
+	for i := len(r.migrations) - 1; i >= 0 && len(targets) < steps; i-- {
+		migration := r.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if migration.Down == "" {
+			return nil, fmt.Errorf("migration %s_%s has no down script", migration.Version, migration.Name)
+		}
+		targets = append(targets, migration)
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no applied migrations to roll back")
+	}
+
+	if r.before != nil {
+		if err := r.before(r.db, Down, versionsOf(targets)); err != nil {
+			return nil, fmt.Errorf("before migration hook failed: %w", err)
+		}
+	}
+
+	for i, migration := range targets {
+		slog.Info("rolling back migration", "version", migration.Version, "name", migration.Name)
+		if err := r.apply(migration, Down); err != nil {
+			return targets[:i], err
+		}
+	}
+	return targets, nil
+}
+
+// apply は1つのマイグレーションを1つのトランザクションで適用・ロールバックします
+// テーブルを作り直すマイグレーションで参照先のテーブルを削除できるよう、実行中は外部キー制約を無効にし、
+// コミット前に外部キー違反がないことを確認します（PRAGMA foreign_keysはトランザクション内で変更できないため接続を固定する）
+func (r *Runner) apply(migration Migration, direction Direction) error {
+	ctx := context.Background()
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	var foreignKeys int
+	if err := conn.QueryRowContext(ctx, `PRAGMA foreign_keys`).Scan(&foreignKeys); err != nil {
+		return fmt.Errorf("failed to read foreign_keys setting: %w", err)
+	}
+	if foreignKeys == 1 {
+		if _, err := conn.ExecContext(ctx, `PRAGMA foreign_keys = OFF`); err != nil {
+			return fmt.Errorf("failed to disable foreign keys: %w", err)
+		}
+		defer conn.ExecContext(ctx, `PRAGMA foreign_keys = ON`)
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	script := migration.Up
+	if direction == Down {
+		script = migration.Down
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return fmt.Errorf("failed to execute migration %s_%s (%s): %w", migration.Version, migration.Name, direction, err)
+	}
+
+	rows, err := tx.QueryContext(ctx, `PRAGMA foreign_key_check`)
+	if err != nil {
+		return fmt.Errorf("failed to check foreign keys: %w", err)
+	}
+	violated := rows.Next()
+	rows.Close()
+	if violated {
+		return fmt.Errorf("migration %s_%s (%s) violates foreign key constraints", migration.Version, migration.Name, direction)
+	}
+
+	if direction == Up {
+		_, err = tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, name, checksum) VALUES (?, ?, ?)`,
+			migration.Version, migration.Name, migration.Checksum)
+	} else {
+		_, err = tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = ?`, migration.Version)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record migration %s: %w", migration.Version, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %s: %w", migration.Version, err)
+	}
+	return nil
+}
+
+// appliedRecord はschema_migrationsの1行
+type appliedRecord struct {
+	appliedAt *time.Time
+	checksum  string
+}
+
+// loadApplied は適用済みのマイグレーションを読み込みます
+// チェックサムを記録していなかった頃に適用したマイグレーションは、現在のファイルのチェックサムを記録します
+func (r *Runner) loadApplied() (map[string]appliedRecord, error) {
+	if err := r.ensureTable(); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(`SELECT version, applied_at, COALESCE(checksum, '') FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[string]appliedRecord)
+	var missing []string
+	for rows.Next() {
+		var version, checksum string
+		var appliedAt sql.NullTime
+		if err := rows.Scan(&version, &appliedAt, &checksum); err != nil {
+			return nil, fmt.Errorf("failed to scan applied migration: %w", err)
+		}
+		record := appliedRecord{checksum: checksum}
+		if appliedAt.Valid {
+			record.appliedAt = &appliedAt.Time
+		}
+		applied[version] = record
+		if checksum == "" {
+			missing = append(missing, version)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load applied migrations: %w", err)
+	}
+	rows.Close()
+
+	for _, version := range missing {
+		migration := r.find(version)
+		if migration == nil {
+			continue
+		}
+		if _, err := r.db.Exec(`UPDATE schema_migrations SET name = ?, checksum = ? WHERE version = ?`,
+			migration.Name, migration.Checksum, version); err != nil {
+			return nil, fmt.Errorf("failed to record checksum of migration %s: %w", version, err)
+		}
+		record := applied[version]
+		record.checksum = migration.Checksum
+		applied[version] = record
+	}
+	return applied, nil
+}
+
+// ensureTable はschema_migrationsテーブルを作成し、以前のバージョンのテーブルにname・checksum列を追加します
+func (r *Runner) ensureTable() error {
+	if _, err := r.db.Exec(`
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version TEXT PRIMARY KEY,
+			applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		)`); err != nil {
+		return fmt.Errorf("failed to create migrations table: %w", err)
+	}
+
+	rows, err := r.db.Query(`PRAGMA table_info(schema_migrations)`)
+	if err != nil {
+		return fmt.Errorf("failed to inspect migrations table: %w", err)
+	}
+	columns := make(map[string]bool)
+	for rows.Next() {
+		var cid, notNull, pk int
+		var name, columnType string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultValue, &pk); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to inspect migrations table: %w", err)
+		}
+		columns[name] = true
+	}
+	rows.Close()
+
+	for _, column := range []string{"name", "checksum"} {
+		if columns[column] {
+			continue
+		}
+		if _, err := r.db.Exec(`ALTER TABLE schema_migrations ADD COLUMN ` + column + ` TEXT NULL`); err != nil {
+			return fmt.Errorf("failed to add %s column to migrations table: %w", column, err)
+		}
+	}
+	return nil
+}
+
+// checkModified は適用後に変更されたマイグレーションファイルがあればエラーを返します
+func (r *Runner) checkModified(applied map[string]appliedRecord) error {
+	var modified []string
+	for _, migration := range r.migrations {
+		if record, ok := applied[migration.Version]; ok && record.checksum != migration.Checksum {
+			modified = append(modified, migration.Version+"_"+migration.Name)
+		}
+	}
+	if len(modified) > 0 {
+		return fmt.Errorf("applied migrations have been modified: %v (add a new migration instead of editing an applied one)", modified)
+	}
+	return nil
+}
+
+// find はバージョンのマイグレーションを返します（ない場合はnil）
+func (r *Runner) find(version string) *Migration {
+	for i := range r.migrations {
+		if r.migrations[i].Version == version {
+			return &r.migrations[i]
+		}
+	}
+	return nil
+}
+
+// versionsOf はマイグレーションのバージョンのリストを返します
+func versionsOf(migrations []Migration) []string {
+	versions := make([]string, 0, len(migrations))
+	for _, migration := range migrations {
+		versions = append(versions, migration.Version)
+	}
+	return versions
+}
+
+// mustSub は埋め込んだファイルのサブディレクトリを返します
+func mustSub(fsys fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
@@ -0,0 +1,409 @@
+package migrations
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	_ "modernc.org/sqlite"
+)
+
+// openTestDatabase はテスト用のデータベースを開きます
+func openTestDatabase(t *testing.T, dsnOptions string) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+filepath.Join(t.TempDir(), "fitness.db")+dsnOptions)
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// tableExists はテーブルが存在するかを返します
+func tableExists(t *testing.T, db *sql.DB, name string) bool {
+	t.Helper()
+	var count int
+	assert.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?`, name).Scan(&count))
+	return count > 0
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "正常系:バージョン順に読み込みup・downを対応付ける",
+			files: fstest.MapFS{
+				"002_add_b.up.sql":   {Data: []byte("CREATE TABLE b (x);")},
+				"001_add_a.up.sql":   {Data: []byte("CREATE TABLE a (x);")},
+				"001_add_a.down.sql": {Data: []byte("DROP TABLE a;")},
+				"README.md":          {Data: []byte("ignored")},
+			},
+			want: []string{"001", "002"},
+		},
+		{
+			name:    "異常系:ファイル名の形式が不正",
+			files:   fstest.MapFS{"1_add_a.sql": {Data: []byte("CREATE TABLE a (x);")}},
+			wantErr: "invalid migration file name",
+		},
+		{
+			name:    "異常系:upのないdownファイル",
+			files:   fstest.MapFS{"001_add_a.down.sql": {Data: []byte("DROP TABLE a;")}},
+			wantErr: "has no up script",
+		},
+		{
+			name: "異常系:同じバージョンの重複",
+			files: fstest.MapFS{
+				"001_add_a.up.sql": {Data: []byte("CREATE TABLE a (x);")},
+				"001_add_b.up.sql": {Data: []byte("CREATE TABLE b (x);")},
+			},
+			wantErr: "duplicate migration version 001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			migrations, err := Load(tt.files)
+
+			// Assert
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, versionsOf(migrations))
+			assert.NotEmpty(t, migrations[0].Down)
+			assert.Empty(t, migrations[1].Down)
+			assert.Len(t, migrations[0].Checksum, 64)
+		})
+	}
+}
+
+func TestRunner_Up(t *testing.T) {
+	t.Run("正常系:新規のデータベースに全て適用し、フックは呼び出さない", func(t *testing.T) {
+		// Arrange
+		db := openTestDatabase(t, "")
+		hookCalled := false
+		runner, err := NewRunner(db, func(*sql.DB, Direction, []string) error {
+			hookCalled = true
+			return nil
+		})
+		assert.NoError(t, err)
+
+		// Act
+		applied, err := runner.Up()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, applied, len(runner.migrations))
+		assert.False(t, hookCalled)
+		assert.True(t, tableExists(t, db, "running_laps"))
+
+		statuses, err := runner.Status()
+		assert.NoError(t, err)
+		for _, status := range statuses {
+			assert.True(t, status.Applied, status.Version)
+			assert.False(t, status.Modified, status.Version)
+			assert.True(t, status.HasDown, status.Version)
+		}
+
+		again, err := runner.Up()
+		assert.NoError(t, err)
+		assert.Empty(t, again)
+	})
+
+	t.Run("正常系:既存のデータベースに適用する前に未適用のバージョンでフックを呼び出す", func(t *testing.T) {
+		// Arrange
+		db := openTestDatabase(t, "")
+		first, err := NewRunnerFS(db, fstest.MapFS{
+			"001_add_a.up.sql": {Data: []byte("CREATE TABLE a (x);")},
+		}, nil)
+		assert.NoError(t, err)
+		_, err = first.Up()
+		assert.NoError(t, err)
+
+		var gotDirection Direction
+		var gotVersions []string
+		runner, err := NewRunnerFS(db, fstest.MapFS{
+			"001_add_a.up.sql": {Data: []byte("CREATE TABLE a (x);")},
+			"002_add_b.up.sql": {Data: []byte("CREATE TABLE b (x);")},
+			"003_add_c.up.sql": {Data: []byte("CREATE TABLE c (x);")},
+		}, func(_ *sql.DB, direction Direction, versions []string) error {
+			gotDirection, gotVersions = direction, versions
+			return nil
+		})
+		assert.NoError(t, err)
+
+		// Act
+		applied, err := runner.Up()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"002", "003"}, versionsOf(applied))
+		assert.Equal(t, Up, gotDirection)
+		assert.Equal(t, []string{"002", "003"}, gotVersions)
+	})
+
+	t.Run("異常系:失敗したマイグレーションは途中まで適用されない", func(t *testing.T) {
+		// Arrange
+		db := openTestDatabase(t, "")
+		runner, err := NewRunnerFS(db, fstest.MapFS{
+			"001_add_a.up.sql":      {Data: []byte("CREATE TABLE a (x);")},
+			"002_add_b_fail.up.sql": {Data: []byte("CREATE TABLE b (x);\nINSERT INTO missing VALUES (1);")},
+		}, nil)
+		assert.NoError(t, err)
+
+		// Act
+		applied, err := runner.Up()
+
+		// Assert
+		assert.ErrorContains(t, err, "002_add_b_fail")
+		assert.Equal(t, []string{"001"}, versionsOf(applied))
+		assert.True(t, tableExists(t, db, "a"))
+		assert.False(t, tableExists(t, db, "b"))
+
+		statuses, err := runner.Status()
+		assert.NoError(t, err)
+		assert.True(t, statuses[0].Applied)
+		assert.False(t, statuses[1].Applied)
+	})
+
+	t.Run("異常系:適用後に変更されたファイルがある場合は適用しない", func(t *testing.T) {
+		// Arrange
+		db := openTestDatabase(t, "")
+		original, err := NewRunnerFS(db, fstest.MapFS{
+			"001_add_a.up.sql": {Data: []byte("CREATE TABLE a (x);")},
+		}, nil)
+		assert.NoError(t, err)
+		_, err = original.Up()
+		assert.NoError(t, err)
+
+		runner, err := NewRunnerFS(db, fstest.MapFS{
+			"001_add_a.up.sql": {Data: []byte("CREATE TABLE a (x, y);")},
+			"002_add_b.up.sql": {Data: []byte("CREATE TABLE b (x);")},
+		}, nil)
+		assert.NoError(t, err)
+
+		// Act
+		_, err = runner.Up()
+
+		// Assert
+		assert.ErrorContains(t, err, "001_add_a")
+		assert.False(t, tableExists(t, db, "b"))
+		statuses, err := runner.Status()
+		assert.NoError(t, err)
+		assert.True(t, statuses[0].Modified)
+	})
+
+	t.Run("正常系:チェックサムのない以前の形式の履歴に現在のチェックサムを記録する", func(t *testing.T) {
+		// Arrange
+		db := openTestDatabase(t, "")
+		_, err := db.Exec(`
+			CREATE TABLE schema_migrations (version TEXT PRIMARY KEY, applied_at DATETIME DEFAULT CURRENT_TIMESTAMP);
+			CREATE TABLE a (x);
+			INSERT INTO schema_migrations (version) VALUES ('001');`)
+		assert.NoError(t, err)
+		runner, err := NewRunnerFS(db, fstest.MapFS{
+			"001_add_a.up.sql": {Data: []byte("CREATE TABLE a (x);")},
+		}, nil)
+		assert.NoError(t, err)
+
+		// Act
+		applied, err := runner.Up()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, applied)
+		var name, checksum string
+		assert.NoError(t, db.QueryRow(`SELECT name, checksum FROM schema_migrations WHERE version = '001'`).Scan(&name, &checksum))
+		assert.Equal(t, "add_a", name)
+		assert.Equal(t, runner.migrations[0].Checksum, checksum)
+	})
+
+	t.Run("正常系:外部キー制約が有効でもテーブルの作り直しで子テーブルの行を削除しない", func(t *testing.T) {
+		// Arrange
+		db := openTestDatabase(t, "?_pragma=foreign_keys(1)")
+		files := fstest.MapFS{
+			"001_add_tables.up.sql": {Data: []byte(`
+				CREATE TABLE parents (id INTEGER PRIMARY KEY, name TEXT);
+				CREATE TABLE children (id INTEGER PRIMARY KEY, parent_id INTEGER NOT NULL REFERENCES parents(id) ON DELETE CASCADE);`)},
+		}
+		runner, err := NewRunnerFS(db, files, nil)
+		assert.NoError(t, err)
+		_, err = runner.Up()
+		assert.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO parents (id, name) VALUES (1, 'a'); INSERT INTO children (id, parent_id) VALUES (1, 1);`)
+		assert.NoError(t, err)
+
+		files["002_rebuild_parents.up.sql"] = &fstest.MapFile{Data: []byte(`
+			CREATE TABLE parents_new (id INTEGER PRIMARY KEY, name TEXT NOT NULL DEFAULT '');
+			INSERT INTO parents_new (id, name) SELECT id, name FROM parents;
+			DROP TABLE parents;
+			ALTER TABLE parents_new RENAME TO parents;`)}
+		runner, err = NewRunnerFS(db, files, nil)
+		assert.NoError(t, err)
+
+		// Act
+		_, err = runner.Up()
+
+		// Assert
+		assert.NoError(t, err)
+		var children, foreignKeys int
+		assert.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM children`).Scan(&children))
+		assert.Equal(t, 1, children)
+		assert.NoError(t, db.QueryRow(`PRAGMA foreign_keys`).Scan(&foreignKeys))
+		assert.Equal(t, 1, foreignKeys)
+	})
+
+	t.Run("正常系:保存済みの日時をUTCに揃え、記録時の日付をローカル日付として残す", func(t *testing.T) {
+		// Arrange
+		db := openTestDatabase(t, "")
+		runner, err := NewRunner(db, nil)
+		assert.NoError(t, err)
+		_, err = runner.Up()
+		assert.NoError(t, err)
+		since := 0 // 011（日付の正規化）以降のマイグレーション数
+		for _, migration := range runner.migrations {
+			if migration.Version >= "011" {
+				since++
+			}
+		}
+		_, err = runner.Down(since)
+		assert.NoError(t, err)
+		_, err = db.Exec(`
+			INSERT INTO strength_trainings (id, date, notes) VALUES
+				('go', '2025-01-15 23:30:00 +0900 JST', ''),
+				('rfc3339', '2025-01-15T08:00:00-05:00', ''),
+				('utc', '2025-01-15 10:00:00', '');
+			INSERT INTO running_sessions (id, date, distance_km, duration_seconds, pace_seconds_per_km, run_type)
+				VALUES ('r1', '2025-01-01 00:15:00 +0900 JST', 5, 1800, 360, 'Easy');`)
+		assert.NoError(t, err)
+
+		// Act
+		_, err = runner.Up()
+
+		// Assert
+		assert.NoError(t, err)
+		tests := []struct {
+			id        string
+			wantDate  string
+			wantLocal string
+		}{
+			{id: "go", wantDate: "2025-01-15 14:30:00", wantLocal: "2025-01-15"},
+			{id: "rfc3339", wantDate: "2025-01-15 13:00:00", wantLocal: "2025-01-15"},
+			{id: "utc", wantDate: "2025-01-15 10:00:00", wantLocal: "2025-01-15"},
+		}
+		for _, tt := range tests {
+			var date, localDate string
+			assert.NoError(t, db.QueryRow(`SELECT CAST(date AS TEXT), local_date FROM strength_trainings WHERE id = ?`, tt.id).Scan(&date, &localDate))
+			assert.Equal(t, tt.wantDate, date, tt.id)
+			assert.Equal(t, tt.wantLocal, localDate, tt.id)
+		}
+
+		var runDate, weekStart, month string
+		assert.NoError(t, db.QueryRow(`SELECT CAST(date AS TEXT) FROM running_sessions WHERE id = 'r1'`).Scan(&runDate))
+		assert.NoError(t, db.QueryRow(`SELECT week_start FROM running_weekly_stats`).Scan(&weekStart))
+		assert.NoError(t, db.QueryRow(`SELECT month FROM running_monthly_stats`).Scan(&month))
+		assert.Equal(t, "2024-12-31 15:15:00", runDate)
+		assert.Equal(t, "2024-12-30", weekStart) // 2025-01-01（水）の週の月曜日
+		assert.Equal(t, "2025-01", month)
+	})
+}
+
+func TestRunner_Down(t *testing.T) {
+	t.Run("正常系:全てロールバックしてから再び適用できる", func(t *testing.T) {
+		// Arrange
+		db := openTestDatabase(t, "")
+		runner, err := NewRunner(db, nil)
+		assert.NoError(t, err)
+		_, err = runner.Up()
+		assert.NoError(t, err)
+
+		// Act
+		reverted, err := runner.Down(len(runner.migrations))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "013", reverted[0].Version)
+		assert.Equal(t, "001", reverted[len(reverted)-1].Version)
+		assert.False(t, tableExists(t, db, "strength_trainings"))
+		assert.False(t, tableExists(t, db, "running_sessions"))
+
+		applied, err := runner.Up()
+		assert.NoError(t, err)
+		assert.Len(t, applied, len(runner.migrations))
+	})
+
+	t.Run("正常系:ロールバックで扱えなくなるデータのみ失われる", func(t *testing.T) {
+		// Arrange
+		db := openTestDatabase(t, "")
+		runner, err := NewRunner(db, nil)
+		assert.NoError(t, err)
+		_, err = runner.Up()
+		assert.NoError(t, err)
+		_, err = db.Exec(`
+			INSERT INTO strength_trainings (id, date, notes) VALUES ('t1', '2024-01-01 10:00:00', '');
+			INSERT INTO exercises (id, training_id, name, exercise_order) VALUES (1, 't1', 'ベンチプレス', 0), (2, 't1', 'プランク', 1);
+			INSERT INTO sets (id, exercise_id, weight_kg, reps, rpe, set_order, tempo_eccentric, tempo_pause, tempo_concentric, tempo_top)
+				VALUES (1, 1, 100, 5, 8.5, 0, 3, 1, 1, 0);
+			INSERT INTO set_variations (set_id, variation) VALUES (1, 'pause');
+			INSERT INTO sets (id, exercise_id, weight_kg, duration_seconds, set_order) VALUES (2, 2, 0, 60, 0);
+			INSERT INTO users (id) VALUES ('alice');
+			INSERT INTO strength_trainings (id, date, notes, user_id) VALUES ('t2', '2024-01-02 10:00:00', '', 'alice');
+			INSERT INTO exercises (id, training_id, name, exercise_order) VALUES (3, 't2', 'スクワット', 0);
+			INSERT INTO sets (id, exercise_id, weight_kg, reps, set_order) VALUES (3, 3, 120, 5, 0);`)
+		assert.NoError(t, err)
+
+		var gotDirection Direction
+		runner.before = func(_ *sql.DB, direction Direction, _ []string) error {
+			gotDirection = direction
+			return nil
+		}
+
+		// Act: 003（時間・距離のセット・ユーザーを追加する前）までロールバックして再び適用する
+		reverted, err := runner.Down(10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"013", "012", "011", "010", "009", "008", "007", "006", "005", "004"}, versionsOf(reverted))
+		_, err = runner.Up()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, Up, gotDirection)
+
+		var trainings, exercises, sets int
+		var rpe float64
+		assert.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM strength_trainings`).Scan(&trainings))
+		assert.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM exercises`).Scan(&exercises))
+		assert.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM sets`).Scan(&sets))
+		assert.NoError(t, db.QueryRow(`SELECT rpe FROM sets WHERE id = 1`).Scan(&rpe))
+		assert.Equal(t, 1, trainings) // 既定のユーザー以外のデータは削除
+		assert.Equal(t, 1, exercises) // 時間のみのセットとそのエクササイズは削除
+		assert.Equal(t, 1, sets)
+		assert.Equal(t, 8.0, rpe) // 0.5刻みのRPEは切り捨て
+	})
+
+	t.Run("異常系:ロールバック用のSQLがない場合は何もロールバックしない", func(t *testing.T) {
+		// Arrange
+		db := openTestDatabase(t, "")
+		runner, err := NewRunnerFS(db, fstest.MapFS{
+			"001_add_a.up.sql":   {Data: []byte("CREATE TABLE a (x);")},
+			"001_add_a.down.sql": {Data: []byte("DROP TABLE a;")},
+			"002_add_b.up.sql":   {Data: []byte("CREATE TABLE b (x);")},
+		}, nil)
+		assert.NoError(t, err)
+		_, err = runner.Up()
+		assert.NoError(t, err)
+
+		// Act
+		_, err = runner.Down(2)
+
+		// Assert
+		assert.ErrorContains(t, err, "002_add_b has no down script")
+		assert.True(t, tableExists(t, db, "a"))
+		assert.True(t, tableExists(t, db, "b"))
+	})
+}
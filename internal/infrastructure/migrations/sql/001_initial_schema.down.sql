@@ -0,0 +1,14 @@
+-- Revert initial schema migration
+-- All strength trainings are discarded.
+
+DROP VIEW IF EXISTS exercise_volumes;
+DROP VIEW IF EXISTS exercise_max_weights;
+
+DROP INDEX IF EXISTS idx_sets_exercise_id;
+DROP INDEX IF EXISTS idx_exercises_name;
+DROP INDEX IF EXISTS idx_exercises_training_id;
+DROP INDEX IF EXISTS idx_strength_trainings_date;
+
+DROP TABLE IF EXISTS sets;
+DROP TABLE IF EXISTS exercises;
+DROP TABLE IF EXISTS strength_trainings;
@@ -0,0 +1,12 @@
+-- Revert running tables migration
+-- All running sessions are discarded.
+
+DROP VIEW IF EXISTS running_type_stats;
+DROP VIEW IF EXISTS running_monthly_stats;
+DROP VIEW IF EXISTS running_weekly_stats;
+
+DROP INDEX IF EXISTS idx_running_sessions_date_type;
+DROP INDEX IF EXISTS idx_running_sessions_distance;
+DROP INDEX IF EXISTS idx_running_sessions_run_type;
+DROP INDEX IF EXISTS idx_running_sessions_date;
+DROP TABLE IF EXISTS running_sessions;
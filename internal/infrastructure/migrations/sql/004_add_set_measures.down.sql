@@ -0,0 +1,54 @@
+-- Revert duration and distance measures migration
+-- reps becomes NOT NULL again, so timed and distance-only sets (planks, carries) are deleted.
+-- Exercises left without any set are deleted as well.
+
+-- 1. Drop views first to avoid dependency issues
+DROP VIEW IF EXISTS exercise_max_weights;
+DROP VIEW IF EXISTS exercise_volumes;
+
+-- 2. Recreate sets table without measure columns
+CREATE TABLE sets_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    exercise_id INTEGER NOT NULL,
+    weight_kg REAL NOT NULL,
+    reps INTEGER NOT NULL,
+    rpe INTEGER NULL,
+    set_order INTEGER NOT NULL,
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE
+);
+
+-- Copy rep-based sets only
+INSERT INTO sets_new (id, exercise_id, weight_kg, reps, rpe, set_order)
+SELECT id, exercise_id, weight_kg, reps, rpe, set_order FROM sets WHERE reps IS NOT NULL;
+
+-- Drop old table and rename new one
+DROP TABLE sets;
+ALTER TABLE sets_new RENAME TO sets;
+
+DELETE FROM exercises WHERE id NOT IN (SELECT exercise_id FROM sets);
+
+-- 3. Recreate indexes for new table structure
+CREATE INDEX IF NOT EXISTS idx_sets_exercise_id ON sets(exercise_id);
+
+-- 4. Recreate views
+CREATE VIEW exercise_max_weights AS
+SELECT 
+    e.training_id,
+    e.name as exercise_name,
+    MAX(s.weight_kg) as max_weight,
+    st.date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
+
+CREATE VIEW exercise_volumes AS
+SELECT 
+    e.training_id,
+    e.name as exercise_name,
+    SUM(s.weight_kg * s.reps) as total_volume,
+    st.date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
@@ -0,0 +1,57 @@
+-- Add duration and distance measures to sets migration
+-- This migration makes reps nullable and adds duration_seconds / distance_m so that
+-- timed sets (planks) and distance-based sets (carries, sled work) can be recorded
+
+-- 1. Drop views first to avoid dependency issues
+DROP VIEW IF EXISTS exercise_max_weights;
+DROP VIEW IF EXISTS exercise_volumes;
+
+-- 2. Recreate sets table with nullable reps and new measure columns
+CREATE TABLE sets_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    exercise_id INTEGER NOT NULL,
+    weight_kg REAL NOT NULL,
+    reps INTEGER NULL,
+    duration_seconds INTEGER NULL,
+    distance_m REAL NULL,
+    rpe INTEGER NULL,
+    set_order INTEGER NOT NULL,
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE,
+    CHECK (reps IS NOT NULL OR duration_seconds IS NOT NULL OR distance_m IS NOT NULL),
+    CHECK (duration_seconds IS NULL OR duration_seconds > 0),
+    CHECK (distance_m IS NULL OR distance_m > 0)
+);
+
+-- Copy data (existing sets are all rep-based)
+INSERT INTO sets_new (id, exercise_id, weight_kg, reps, rpe, set_order)
+SELECT id, exercise_id, weight_kg, reps, rpe, set_order FROM sets;
+
+-- Drop old table and rename new one
+DROP TABLE sets;
+ALTER TABLE sets_new RENAME TO sets;
+
+-- 3. Recreate indexes for new table structure
+CREATE INDEX IF NOT EXISTS idx_sets_exercise_id ON sets(exercise_id);
+
+-- 4. Recreate views (volume only counts rep-based sets)
+CREATE VIEW exercise_max_weights AS
+SELECT 
+    e.training_id,
+    e.name as exercise_name,
+    MAX(s.weight_kg) as max_weight,
+    st.date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
+
+CREATE VIEW exercise_volumes AS
+SELECT 
+    e.training_id,
+    e.name as exercise_name,
+    SUM(s.weight_kg * COALESCE(s.reps, 0)) as total_volume,
+    st.date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
@@ -0,0 +1,13 @@
+-- Revert tempo, range of motion and variation tags migration
+-- Tempo, range of motion and variation tags recorded on sets are discarded.
+
+-- 1. Variation tags
+DROP INDEX IF EXISTS idx_set_variations_variation;
+DROP TABLE IF EXISTS set_variations;
+
+-- 2. Tempo and range of motion columns
+ALTER TABLE sets DROP COLUMN range_of_motion;
+ALTER TABLE sets DROP COLUMN tempo_top;
+ALTER TABLE sets DROP COLUMN tempo_concentric;
+ALTER TABLE sets DROP COLUMN tempo_pause;
+ALTER TABLE sets DROP COLUMN tempo_eccentric;
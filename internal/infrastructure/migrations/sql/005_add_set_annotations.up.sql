@@ -0,0 +1,18 @@
+-- Add tempo, range of motion and variation tags to sets migration
+
+-- 1. Tempo (eccentric/pause/concentric/top seconds) and range of motion columns
+ALTER TABLE sets ADD COLUMN tempo_eccentric INTEGER NULL;
+ALTER TABLE sets ADD COLUMN tempo_pause INTEGER NULL;
+ALTER TABLE sets ADD COLUMN tempo_concentric INTEGER NULL;
+ALTER TABLE sets ADD COLUMN tempo_top INTEGER NULL;
+ALTER TABLE sets ADD COLUMN range_of_motion TEXT NULL CHECK (range_of_motion IS NULL OR range_of_motion IN ('Full', 'Partial'));
+
+-- 2. Variation tags (paused, pin, deficit ...) per set
+CREATE TABLE IF NOT EXISTS set_variations (
+    set_id INTEGER NOT NULL,
+    variation TEXT NOT NULL,
+    PRIMARY KEY (set_id, variation),
+    FOREIGN KEY (set_id) REFERENCES sets(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_set_variations_variation ON set_variations(variation);
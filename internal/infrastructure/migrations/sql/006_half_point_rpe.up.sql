@@ -0,0 +1,88 @@
+-- Half-point RPE migration
+-- This migration changes sets.rpe from INTEGER to REAL so that values such as 8.5
+-- (and RPE converted from RIR) are stored at full precision. Existing integer
+-- values are copied as-is.
+
+-- 1. Drop views first to avoid dependency issues
+DROP VIEW IF EXISTS exercise_max_weights;
+DROP VIEW IF EXISTS exercise_volumes;
+
+-- 2. Keep variation tags while the sets table is recreated
+CREATE TEMP TABLE set_variations_backup AS SELECT set_id, variation FROM set_variations;
+DROP TABLE set_variations;
+
+-- 3. Recreate sets table with REAL rpe
+CREATE TABLE sets_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    exercise_id INTEGER NOT NULL,
+    weight_kg REAL NOT NULL,
+    reps INTEGER NULL,
+    duration_seconds INTEGER NULL,
+    distance_m REAL NULL,
+    rpe REAL NULL,
+    set_order INTEGER NOT NULL,
+    tempo_eccentric INTEGER NULL,
+    tempo_pause INTEGER NULL,
+    tempo_concentric INTEGER NULL,
+    tempo_top INTEGER NULL,
+    range_of_motion TEXT NULL CHECK (range_of_motion IS NULL OR range_of_motion IN ('Full', 'Partial')),
+    FOREIGN KEY (exercise_id) REFERENCES exercises(id) ON DELETE CASCADE,
+    CHECK (reps IS NOT NULL OR duration_seconds IS NOT NULL OR distance_m IS NOT NULL),
+    CHECK (duration_seconds IS NULL OR duration_seconds > 0),
+    CHECK (distance_m IS NULL OR distance_m > 0),
+    CHECK (rpe IS NULL OR (rpe >= 1 AND rpe <= 10 AND rpe * 2 = CAST(rpe * 2 AS INTEGER)))
+);
+
+-- Copy data
+INSERT INTO sets_new (
+    id, exercise_id, weight_kg, reps, duration_seconds, distance_m, rpe, set_order,
+    tempo_eccentric, tempo_pause, tempo_concentric, tempo_top, range_of_motion
+)
+SELECT
+    id, exercise_id, weight_kg, reps, duration_seconds, distance_m, CAST(rpe AS REAL), set_order,
+    tempo_eccentric, tempo_pause, tempo_concentric, tempo_top, range_of_motion
+FROM sets;
+
+-- Drop old table and rename new one
+DROP TABLE sets;
+ALTER TABLE sets_new RENAME TO sets;
+
+-- 4. Recreate indexes for new table structure
+CREATE INDEX IF NOT EXISTS idx_sets_exercise_id ON sets(exercise_id);
+
+-- 5. Restore variation tags
+CREATE TABLE set_variations (
+    set_id INTEGER NOT NULL,
+    variation TEXT NOT NULL,
+    PRIMARY KEY (set_id, variation),
+    FOREIGN KEY (set_id) REFERENCES sets(id) ON DELETE CASCADE
+);
+
+INSERT INTO set_variations (set_id, variation)
+SELECT set_id, variation FROM set_variations_backup;
+DROP TABLE set_variations_backup;
+
+CREATE INDEX IF NOT EXISTS idx_set_variations_variation ON set_variations(variation);
+
+-- 6. Recreate views
+CREATE VIEW exercise_max_weights AS
+SELECT 
+    e.training_id,
+    e.name as exercise_name,
+    MAX(s.weight_kg) as max_weight,
+    st.date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
+
+CREATE VIEW exercise_volumes AS
+SELECT 
+    e.training_id,
+    e.name as exercise_name,
+    SUM(s.weight_kg * COALESCE(s.reps, 0)) as total_volume,
+    st.date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
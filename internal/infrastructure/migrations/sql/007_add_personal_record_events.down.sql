@@ -0,0 +1,6 @@
+-- Revert personal record event log migration
+-- The log is derived from the training history and is rebuilt when the migration is applied again.
+
+DROP INDEX IF EXISTS idx_personal_record_events_training;
+DROP INDEX IF EXISTS idx_personal_record_events_exercise;
+DROP TABLE IF EXISTS personal_record_events;
@@ -0,0 +1,22 @@
+-- Add personal record event log migration
+-- Each row records the moment a PR (nRM, e1RM or session volume) was broken and
+-- which set broke it. The log can be rebuilt from the training history at any time.
+
+CREATE TABLE IF NOT EXISTS personal_record_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    exercise_name TEXT NOT NULL,
+    record_type TEXT NOT NULL CHECK (record_type IN ('RepMax', 'E1RM', 'SessionVolume')),
+    reps INTEGER NOT NULL DEFAULT 0,          -- nRMの回数（RepMax以外は0）
+    value REAL NOT NULL,                      -- 新しい記録値（kg）
+    previous_value REAL NULL,                 -- 更新前の記録値（初記録はNULL）
+    training_id TEXT NOT NULL,
+    date DATETIME NOT NULL,
+    set_weight_kg REAL NULL,                  -- 記録を更新したセットの重量
+    set_reps INTEGER NULL,                    -- 記録を更新したセットの回数
+    set_rpe REAL NULL,                        -- 記録を更新したセットのRPE
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (training_id) REFERENCES strength_trainings(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_personal_record_events_exercise ON personal_record_events(exercise_name, date);
+CREATE INDEX IF NOT EXISTS idx_personal_record_events_training ON personal_record_events(training_id);
@@ -0,0 +1,4 @@
+-- Revert athlete profile migration
+-- The stored heart rates, VDOT and threshold pace are discarded.
+
+DROP TABLE IF EXISTS athlete_profile;
@@ -0,0 +1,21 @@
+-- Add athlete profile migration
+-- Stores the athlete's physiology used to compute training pace and heart-rate zones.
+-- The profile is a single row (id = 1); every column is optional.
+
+CREATE TABLE IF NOT EXISTS athlete_profile (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    max_hr INTEGER NULL,                          -- 最大心拍数（bpm）
+    resting_hr INTEGER NULL,                      -- 安静時心拍数（bpm）
+    lthr INTEGER NULL,                            -- 乳酸閾値心拍数（bpm）
+    vdot REAL NULL,                               -- VDOT
+    threshold_pace_seconds_per_km REAL NULL,      -- 閾値ペース（秒/km）
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    -- 制約
+    CHECK (max_hr IS NULL OR (max_hr > 0 AND max_hr <= 250)),
+    CHECK (resting_hr IS NULL OR (resting_hr > 0 AND resting_hr <= 250)),
+    CHECK (lthr IS NULL OR (lthr > 0 AND lthr <= 250)),
+    CHECK (max_hr IS NULL OR resting_hr IS NULL OR resting_hr < max_hr),
+    CHECK (vdot IS NULL OR (vdot >= 20 AND vdot <= 90)),
+    CHECK (threshold_pace_seconds_per_km IS NULL OR threshold_pace_seconds_per_km > 0)
+);
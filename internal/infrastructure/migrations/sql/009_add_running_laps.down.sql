@@ -0,0 +1,5 @@
+-- Revert running laps migration
+-- Laps recorded for running sessions are discarded; the sessions themselves are kept.
+
+DROP INDEX IF EXISTS idx_running_laps_session;
+DROP TABLE IF EXISTS running_laps;
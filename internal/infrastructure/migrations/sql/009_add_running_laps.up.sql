@@ -0,0 +1,27 @@
+-- Add running laps migration
+-- Each row is one lap (split) of a running session, e.g. one repetition of 6x800m
+-- or the recovery jog between them.
+
+CREATE TABLE IF NOT EXISTS running_laps (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    session_id TEXT NOT NULL,
+    lap_number INTEGER NOT NULL,              -- ラップ番号（1始まり）
+    distance_km REAL NOT NULL,
+    duration_seconds REAL NOT NULL,           -- トラックのラップは1秒未満まで記録できるようREAL
+    pace_seconds_per_km REAL NOT NULL,
+    heart_rate_bpm INTEGER NULL,
+    lap_type TEXT NOT NULL DEFAULT 'Work',    -- Work: 疾走区間 / Rest: 休息区間
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    -- 制約
+    CHECK (lap_number > 0),
+    CHECK (distance_km > 0),
+    CHECK (duration_seconds > 0),
+    CHECK (pace_seconds_per_km > 0),
+    CHECK (heart_rate_bpm IS NULL OR heart_rate_bpm > 0),
+    CHECK (lap_type IN ('Work', 'Rest')),
+    UNIQUE (session_id, lap_number),
+    FOREIGN KEY (session_id) REFERENCES running_sessions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_running_laps_session ON running_laps(session_id, lap_number);
@@ -0,0 +1,129 @@
+-- Revert users migration
+-- Only the default user's data is kept: trainings, runs, personal record events and profiles of other users are deleted,
+-- together with the users table and every issued token.
+
+-- 1. Delete data owned by other users (foreign keys are disabled while migrating, so children are deleted explicitly)
+DELETE FROM set_variations WHERE set_id IN (
+    SELECT s.id FROM sets s
+    JOIN exercises e ON e.id = s.exercise_id
+    JOIN strength_trainings st ON st.id = e.training_id
+    WHERE st.user_id <> 'default'
+);
+DELETE FROM sets WHERE exercise_id IN (
+    SELECT e.id FROM exercises e
+    JOIN strength_trainings st ON st.id = e.training_id
+    WHERE st.user_id <> 'default'
+);
+DELETE FROM exercises WHERE training_id IN (SELECT id FROM strength_trainings WHERE user_id <> 'default');
+DELETE FROM personal_record_events WHERE user_id <> 'default';
+DELETE FROM strength_trainings WHERE user_id <> 'default';
+DELETE FROM running_laps WHERE session_id IN (SELECT id FROM running_sessions WHERE user_id <> 'default');
+DELETE FROM running_sessions WHERE user_id <> 'default';
+
+-- 2. Drop per-user views (DROP COLUMN fails while a view references the column)
+DROP VIEW IF EXISTS exercise_max_weights;
+DROP VIEW IF EXISTS exercise_volumes;
+DROP VIEW IF EXISTS running_weekly_stats;
+DROP VIEW IF EXISTS running_monthly_stats;
+DROP VIEW IF EXISTS running_type_stats;
+
+-- 3. Drop owners
+DROP INDEX IF EXISTS idx_strength_trainings_user_date;
+DROP INDEX IF EXISTS idx_running_sessions_user_date;
+DROP INDEX IF EXISTS idx_personal_record_events_user;
+
+ALTER TABLE strength_trainings DROP COLUMN user_id;
+ALTER TABLE running_sessions DROP COLUMN user_id;
+ALTER TABLE personal_record_events DROP COLUMN user_id;
+
+-- 4. athlete_profile: back to a single row (id = 1) holding the default user's profile
+CREATE TABLE athlete_profile_old (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    max_hr INTEGER NULL,                          -- 最大心拍数（bpm）
+    resting_hr INTEGER NULL,                      -- 安静時心拍数（bpm）
+    lthr INTEGER NULL,                            -- 乳酸閾値心拍数（bpm）
+    vdot REAL NULL,                               -- VDOT
+    threshold_pace_seconds_per_km REAL NULL,      -- 閾値ペース（秒/km）
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    -- 制約
+    CHECK (max_hr IS NULL OR (max_hr > 0 AND max_hr <= 250)),
+    CHECK (resting_hr IS NULL OR (resting_hr > 0 AND resting_hr <= 250)),
+    CHECK (lthr IS NULL OR (lthr > 0 AND lthr <= 250)),
+    CHECK (max_hr IS NULL OR resting_hr IS NULL OR resting_hr < max_hr),
+    CHECK (vdot IS NULL OR (vdot >= 20 AND vdot <= 90)),
+    CHECK (threshold_pace_seconds_per_km IS NULL OR threshold_pace_seconds_per_km > 0)
+);
+
+INSERT INTO athlete_profile_old (
+    id, max_hr, resting_hr, lthr, vdot, threshold_pace_seconds_per_km, updated_at
+)
+SELECT 1, max_hr, resting_hr, lthr, vdot, threshold_pace_seconds_per_km, updated_at
+FROM athlete_profile
+WHERE user_id = 'default';
+
+DROP TABLE athlete_profile;
+ALTER TABLE athlete_profile_old RENAME TO athlete_profile;
+
+DROP TABLE IF EXISTS users;
+
+-- 5. Recreate views across all rows
+CREATE VIEW exercise_max_weights AS
+SELECT
+    e.training_id,
+    e.name as exercise_name,
+    MAX(s.weight_kg) as max_weight,
+    st.date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
+
+CREATE VIEW exercise_volumes AS
+SELECT
+    e.training_id,
+    e.name as exercise_name,
+    SUM(s.weight_kg * COALESCE(s.reps, 0)) as total_volume,
+    st.date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
+
+CREATE VIEW IF NOT EXISTS running_weekly_stats AS
+SELECT
+    DATE(date, 'weekday 0', '-6 days') as week_start,
+    COUNT(*) as total_runs,
+    SUM(distance_km) as total_distance,
+    AVG(distance_km) as avg_distance,
+    AVG(pace_seconds_per_km) as avg_pace,
+    MIN(pace_seconds_per_km) as best_pace
+FROM running_sessions
+GROUP BY week_start
+ORDER BY week_start DESC;
+
+CREATE VIEW IF NOT EXISTS running_monthly_stats AS
+SELECT
+    strftime('%Y-%m', date) as month,
+    COUNT(*) as total_runs,
+    SUM(distance_km) as total_distance,
+    AVG(distance_km) as avg_distance,
+    AVG(pace_seconds_per_km) as avg_pace,
+    MIN(pace_seconds_per_km) as best_pace,
+    MAX(distance_km) as longest_run
+FROM running_sessions
+GROUP BY month
+ORDER BY month DESC;
+
+CREATE VIEW IF NOT EXISTS running_type_stats AS
+SELECT
+    run_type,
+    COUNT(*) as total_runs,
+    SUM(distance_km) as total_distance,
+    AVG(distance_km) as avg_distance,
+    AVG(pace_seconds_per_km) as avg_pace,
+    MIN(pace_seconds_per_km) as best_pace,
+    MAX(distance_km) as longest_distance
+FROM running_sessions
+GROUP BY run_type
+ORDER BY total_runs DESC;
@@ -0,0 +1,121 @@
+-- Add users migration
+-- Adds the users table and an owner (user_id) to every aggregate so that a shared server keeps each user's data apart.
+-- Existing rows are assigned to the default user; athlete_profile becomes one row per user.
+
+CREATE TABLE IF NOT EXISTS users (
+    id TEXT PRIMARY KEY,                          -- ユーザーID（英小文字・数字・_・-）
+    token_hash TEXT NULL UNIQUE,                  -- トークンのSHA-256（平文は保存しない、未発行はNULL）
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT OR IGNORE INTO users (id) VALUES ('default');
+
+-- 1. Drop views that aggregate across owners (recreated per user below)
+DROP VIEW IF EXISTS exercise_max_weights;
+DROP VIEW IF EXISTS exercise_volumes;
+DROP VIEW IF EXISTS running_weekly_stats;
+DROP VIEW IF EXISTS running_monthly_stats;
+DROP VIEW IF EXISTS running_type_stats;
+
+-- 2. Owner of each aggregate (existing rows belong to the default user)
+ALTER TABLE strength_trainings ADD COLUMN user_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE running_sessions ADD COLUMN user_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE personal_record_events ADD COLUMN user_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX IF NOT EXISTS idx_strength_trainings_user_date ON strength_trainings(user_id, date);
+CREATE INDEX IF NOT EXISTS idx_running_sessions_user_date ON running_sessions(user_id, date);
+CREATE INDEX IF NOT EXISTS idx_personal_record_events_user ON personal_record_events(user_id, exercise_name, date);
+
+-- 3. athlete_profile: single row (id = 1) -> one row per user
+CREATE TABLE athlete_profile_new (
+    user_id TEXT PRIMARY KEY,
+    max_hr INTEGER NULL,                          -- 最大心拍数（bpm）
+    resting_hr INTEGER NULL,                      -- 安静時心拍数（bpm）
+    lthr INTEGER NULL,                            -- 乳酸閾値心拍数（bpm）
+    vdot REAL NULL,                               -- VDOT
+    threshold_pace_seconds_per_km REAL NULL,      -- 閾値ペース（秒/km）
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    -- 制約
+    CHECK (max_hr IS NULL OR (max_hr > 0 AND max_hr <= 250)),
+    CHECK (resting_hr IS NULL OR (resting_hr > 0 AND resting_hr <= 250)),
+    CHECK (lthr IS NULL OR (lthr > 0 AND lthr <= 250)),
+    CHECK (max_hr IS NULL OR resting_hr IS NULL OR resting_hr < max_hr),
+    CHECK (vdot IS NULL OR (vdot >= 20 AND vdot <= 90)),
+    CHECK (threshold_pace_seconds_per_km IS NULL OR threshold_pace_seconds_per_km > 0)
+);
+
+INSERT INTO athlete_profile_new (
+    user_id, max_hr, resting_hr, lthr, vdot, threshold_pace_seconds_per_km, updated_at
+)
+SELECT 'default', max_hr, resting_hr, lthr, vdot, threshold_pace_seconds_per_km, updated_at
+FROM athlete_profile;
+
+DROP TABLE athlete_profile;
+ALTER TABLE athlete_profile_new RENAME TO athlete_profile;
+
+-- 4. Recreate views per user
+CREATE VIEW exercise_max_weights AS
+SELECT
+    st.user_id,
+    e.training_id,
+    e.name as exercise_name,
+    MAX(s.weight_kg) as max_weight,
+    st.date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
+
+CREATE VIEW exercise_volumes AS
+SELECT
+    st.user_id,
+    e.training_id,
+    e.name as exercise_name,
+    SUM(s.weight_kg * COALESCE(s.reps, 0)) as total_volume,
+    st.date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
+
+CREATE VIEW running_weekly_stats AS
+SELECT
+    user_id,
+    DATE(date, 'weekday 0', '-6 days') as week_start,
+    COUNT(*) as total_runs,
+    SUM(distance_km) as total_distance,
+    AVG(distance_km) as avg_distance,
+    AVG(pace_seconds_per_km) as avg_pace,
+    MIN(pace_seconds_per_km) as best_pace
+FROM running_sessions
+GROUP BY user_id, week_start
+ORDER BY user_id, week_start DESC;
+
+CREATE VIEW running_monthly_stats AS
+SELECT
+    user_id,
+    strftime('%Y-%m', date) as month,
+    COUNT(*) as total_runs,
+    SUM(distance_km) as total_distance,
+    AVG(distance_km) as avg_distance,
+    AVG(pace_seconds_per_km) as avg_pace,
+    MIN(pace_seconds_per_km) as best_pace,
+    MAX(distance_km) as longest_run
+FROM running_sessions
+GROUP BY user_id, month
+ORDER BY user_id, month DESC;
+
+CREATE VIEW running_type_stats AS
+SELECT
+    user_id,
+    run_type,
+    COUNT(*) as total_runs,
+    SUM(distance_km) as total_distance,
+    AVG(distance_km) as avg_distance,
+    AVG(pace_seconds_per_km) as avg_pace,
+    MIN(pace_seconds_per_km) as best_pace,
+    MAX(distance_km) as longest_distance
+FROM running_sessions
+GROUP BY user_id, run_type
+ORDER BY user_id, total_runs DESC;
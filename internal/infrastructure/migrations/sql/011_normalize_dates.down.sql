@@ -0,0 +1,69 @@
+-- Revert normalize dates migration
+-- Drops the local date columns and restores the views on the stored date.
+-- Dates stay as UTC instants ('YYYY-MM-DD HH:MM:SS'), which the previous schema reads as UTC.
+
+-- 1. Drop views on the local date (DROP COLUMN fails while a view references the column)
+DROP VIEW IF EXISTS exercise_max_weights;
+DROP VIEW IF EXISTS exercise_volumes;
+DROP VIEW IF EXISTS running_weekly_stats;
+DROP VIEW IF EXISTS running_monthly_stats;
+
+-- 2. Drop local dates
+DROP INDEX IF EXISTS idx_strength_trainings_user_local_date;
+DROP INDEX IF EXISTS idx_running_sessions_user_local_date;
+
+ALTER TABLE strength_trainings DROP COLUMN local_date;
+ALTER TABLE running_sessions DROP COLUMN local_date;
+ALTER TABLE personal_record_events DROP COLUMN local_date;
+
+-- 3. Recreate views on the stored date
+CREATE VIEW exercise_max_weights AS
+SELECT
+    st.user_id,
+    e.training_id,
+    e.name as exercise_name,
+    MAX(s.weight_kg) as max_weight,
+    st.date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
+
+CREATE VIEW exercise_volumes AS
+SELECT
+    st.user_id,
+    e.training_id,
+    e.name as exercise_name,
+    SUM(s.weight_kg * COALESCE(s.reps, 0)) as total_volume,
+    st.date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
+
+CREATE VIEW running_weekly_stats AS
+SELECT
+    user_id,
+    DATE(date, 'weekday 0', '-6 days') as week_start,
+    COUNT(*) as total_runs,
+    SUM(distance_km) as total_distance,
+    AVG(distance_km) as avg_distance,
+    AVG(pace_seconds_per_km) as avg_pace,
+    MIN(pace_seconds_per_km) as best_pace
+FROM running_sessions
+GROUP BY user_id, week_start
+ORDER BY user_id, week_start DESC;
+
+CREATE VIEW running_monthly_stats AS
+SELECT
+    user_id,
+    strftime('%Y-%m', date) as month,
+    COUNT(*) as total_runs,
+    SUM(distance_km) as total_distance,
+    AVG(distance_km) as avg_distance,
+    AVG(pace_seconds_per_km) as avg_pace,
+    MIN(pace_seconds_per_km) as best_pace,
+    MAX(distance_km) as longest_run
+FROM running_sessions
+GROUP BY user_id, month
+ORDER BY user_id, month DESC;
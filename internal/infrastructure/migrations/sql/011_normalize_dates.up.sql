@@ -0,0 +1,104 @@
+-- Normalize dates migration
+-- Stores every session date as a UTC instant ('YYYY-MM-DD HH:MM:SS') instead of the driver's time.Time string,
+-- whose offset depended on how the value was parsed, and keeps the user's local date (YYYY-MM-DD) in its own
+-- column so that day, week and month boundaries follow the configured timezone.
+-- Existing rows keep the calendar date they were recorded with (the wall-clock date of the stored value).
+
+-- 1. Drop views that are rebuilt on the local date below
+DROP VIEW IF EXISTS exercise_max_weights;
+DROP VIEW IF EXISTS exercise_volumes;
+DROP VIEW IF EXISTS running_weekly_stats;
+DROP VIEW IF EXISTS running_monthly_stats;
+
+-- 2. Local dates and UTC instants
+-- The offset is taken from Go's time.Time string (' +0900 JST') or RFC 3339 ('+09:00'); values without one are UTC.
+ALTER TABLE strength_trainings ADD COLUMN local_date TEXT NOT NULL DEFAULT '';
+ALTER TABLE running_sessions ADD COLUMN local_date TEXT NOT NULL DEFAULT '';
+ALTER TABLE personal_record_events ADD COLUMN local_date TEXT NOT NULL DEFAULT '';
+
+UPDATE strength_trainings SET
+    local_date = substr(date, 1, 10),
+    date = COALESCE(datetime(substr(date, 1, 19) || CASE
+        WHEN instr(substr(date, 20), ' +') > 0 THEN substr(substr(date, 20), instr(substr(date, 20), ' +') + 1, 3) || ':' || substr(substr(date, 20), instr(substr(date, 20), ' +') + 4, 2)
+        WHEN instr(substr(date, 20), ' -') > 0 THEN substr(substr(date, 20), instr(substr(date, 20), ' -') + 1, 3) || ':' || substr(substr(date, 20), instr(substr(date, 20), ' -') + 4, 2)
+        WHEN instr(substr(date, 20), '+') > 0 THEN substr(substr(date, 20), instr(substr(date, 20), '+'))
+        WHEN instr(substr(date, 20), '-') > 0 THEN substr(substr(date, 20), instr(substr(date, 20), '-'))
+        ELSE ''
+    END), date);
+
+UPDATE running_sessions SET
+    local_date = substr(date, 1, 10),
+    date = COALESCE(datetime(substr(date, 1, 19) || CASE
+        WHEN instr(substr(date, 20), ' +') > 0 THEN substr(substr(date, 20), instr(substr(date, 20), ' +') + 1, 3) || ':' || substr(substr(date, 20), instr(substr(date, 20), ' +') + 4, 2)
+        WHEN instr(substr(date, 20), ' -') > 0 THEN substr(substr(date, 20), instr(substr(date, 20), ' -') + 1, 3) || ':' || substr(substr(date, 20), instr(substr(date, 20), ' -') + 4, 2)
+        WHEN instr(substr(date, 20), '+') > 0 THEN substr(substr(date, 20), instr(substr(date, 20), '+'))
+        WHEN instr(substr(date, 20), '-') > 0 THEN substr(substr(date, 20), instr(substr(date, 20), '-'))
+        ELSE ''
+    END), date);
+
+UPDATE personal_record_events SET
+    local_date = substr(date, 1, 10),
+    date = COALESCE(datetime(substr(date, 1, 19) || CASE
+        WHEN instr(substr(date, 20), ' +') > 0 THEN substr(substr(date, 20), instr(substr(date, 20), ' +') + 1, 3) || ':' || substr(substr(date, 20), instr(substr(date, 20), ' +') + 4, 2)
+        WHEN instr(substr(date, 20), ' -') > 0 THEN substr(substr(date, 20), instr(substr(date, 20), ' -') + 1, 3) || ':' || substr(substr(date, 20), instr(substr(date, 20), ' -') + 4, 2)
+        WHEN instr(substr(date, 20), '+') > 0 THEN substr(substr(date, 20), instr(substr(date, 20), '+'))
+        WHEN instr(substr(date, 20), '-') > 0 THEN substr(substr(date, 20), instr(substr(date, 20), '-'))
+        ELSE ''
+    END), date);
+
+CREATE INDEX IF NOT EXISTS idx_strength_trainings_user_local_date ON strength_trainings(user_id, local_date);
+CREATE INDEX IF NOT EXISTS idx_running_sessions_user_local_date ON running_sessions(user_id, local_date);
+
+-- 3. Recreate views on the local date (weeks start on Monday)
+CREATE VIEW exercise_max_weights AS
+SELECT
+    st.user_id,
+    e.training_id,
+    e.name as exercise_name,
+    MAX(s.weight_kg) as max_weight,
+    st.date,
+    st.local_date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
+
+CREATE VIEW exercise_volumes AS
+SELECT
+    st.user_id,
+    e.training_id,
+    e.name as exercise_name,
+    SUM(s.weight_kg * COALESCE(s.reps, 0)) as total_volume,
+    st.date,
+    st.local_date
+FROM exercises e
+JOIN sets s ON e.id = s.exercise_id
+JOIN strength_trainings st ON e.training_id = st.id
+GROUP BY e.training_id, e.name;
+
+CREATE VIEW running_weekly_stats AS
+SELECT
+    user_id,
+    DATE(local_date, 'weekday 0', '-6 days') as week_start,
+    COUNT(*) as total_runs,
+    SUM(distance_km) as total_distance,
+    AVG(distance_km) as avg_distance,
+    AVG(pace_seconds_per_km) as avg_pace,
+    MIN(pace_seconds_per_km) as best_pace
+FROM running_sessions
+GROUP BY user_id, week_start
+ORDER BY user_id, week_start DESC;
+
+CREATE VIEW running_monthly_stats AS
+SELECT
+    user_id,
+    strftime('%Y-%m', local_date) as month,
+    COUNT(*) as total_runs,
+    SUM(distance_km) as total_distance,
+    AVG(distance_km) as avg_distance,
+    AVG(pace_seconds_per_km) as avg_pace,
+    MIN(pace_seconds_per_km) as best_pace,
+    MAX(distance_km) as longest_run
+FROM running_sessions
+GROUP BY user_id, month
+ORDER BY user_id, month DESC;
@@ -0,0 +1,4 @@
+-- Revert explosive tempo phases migration
+-- Explosive phases fall back to 0 seconds (e.g. "3-1-X-0" becomes "3-1-0-0").
+
+ALTER TABLE sets DROP COLUMN tempo_explosive;
@@ -0,0 +1,5 @@
+-- Add explosive tempo phases to sets migration
+-- Bit set of the phases written as "X" (1=eccentric, 2=pause, 4=concentric, 8=top).
+-- The phase seconds stay 0, so time under tension is unchanged; NULL means no explosive phase.
+
+ALTER TABLE sets ADD COLUMN tempo_explosive INTEGER NULL CHECK (tempo_explosive IS NULL OR tempo_explosive BETWEEN 0 AND 15);
@@ -0,0 +1,7 @@
+-- Revert running goals and body metrics migration
+-- Goals and body metrics are discarded.
+
+DROP INDEX IF EXISTS idx_body_metrics_user_local_date;
+DROP TABLE IF EXISTS body_metrics;
+DROP INDEX IF EXISTS idx_running_goals_user;
+DROP TABLE IF EXISTS running_goals;
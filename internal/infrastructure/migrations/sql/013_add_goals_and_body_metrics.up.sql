@@ -0,0 +1,41 @@
+-- Add running goals and body metrics migration
+-- Both belong to a user (user_id) like every other aggregate.
+-- Dates are UTC instants; body metrics also keep the local date they were recorded on for date filtering.
+
+CREATE TABLE IF NOT EXISTS running_goals (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,                 -- 5K / 10K / Half / Marathon / Custom
+    distance_km REAL NOT NULL,                -- 目標の距離（カスタム以外は標準距離）
+    target_seconds INTEGER NOT NULL,          -- 目標タイム（秒）
+    event_date TEXT NULL,                     -- イベント日（ローカル日付 YYYY-MM-DD）
+    status TEXT NOT NULL DEFAULT 'Active',
+    description TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL,
+    achieved_at DATETIME NULL,
+
+    -- 制約
+    CHECK (event_type IN ('5K', '10K', 'Half', 'Marathon', 'Custom')),
+    CHECK (distance_km > 0),
+    CHECK (target_seconds > 0),
+    CHECK (status IN ('Active', 'Achieved', 'Paused', 'Cancelled'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_running_goals_user ON running_goals(user_id, created_at);
+
+CREATE TABLE IF NOT EXISTS body_metrics (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    date DATETIME NOT NULL,
+    local_date TEXT NOT NULL,                 -- 測定したタイムゾーンでの日付（YYYY-MM-DD）
+    weight_kg REAL NOT NULL,
+    body_fat_percent REAL NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+
+    -- 制約
+    CHECK (weight_kg > 0),
+    CHECK (body_fat_percent IS NULL OR (body_fat_percent > 0 AND body_fat_percent < 100))
+);
+
+CREATE INDEX IF NOT EXISTS idx_body_metrics_user_local_date ON body_metrics(user_id, local_date);
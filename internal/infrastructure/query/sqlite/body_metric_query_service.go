@@ -0,0 +1,108 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"fitness-mcp-server/internal/application/auth"
+	"fitness-mcp-server/internal/domain/body"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/query"
+)
+
+// BodyMetricQueryService はSQLiteを使った身体測定値クエリサービス実装
+// 日付での絞り込みは保存時のローカル日付（local_date）で行い、日時はカレンダーのタイムゾーンで返します
+type BodyMetricQueryService struct {
+	db       *sql.DB
+	calendar shared.Calendar
+}
+
+// NewBodyMetricQueryService は新しいSQLite 身体測定値クエリサービスを作成します
+func NewBodyMetricQueryService(db *sql.DB, calendar shared.Calendar) *BodyMetricQueryService {
+	return &BodyMetricQueryService{db: db, calendar: calendar}
+}
+
+// FindByDateRange はコンテキストのユーザーの指定した期間の身体測定値を測定日時順に検索します
+// 期間は開始日・終了日のローカル日付を両端に含みます
+func (s *BodyMetricQueryService) FindByDateRange(ctx context.Context, start, end time.Time) ([]*body.Metric, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, date, weight_kg, body_fat_percent, notes
+		FROM body_metrics
+		WHERE user_id = ? AND local_date BETWEEN ? AND ?
+		ORDER BY date, id`, userID.String(), s.calendar.DateOf(start), s.calendar.DateOf(end))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query body metrics: %w", err)
+	}
+	defer rows.Close()
+
+	metrics := make([]*body.Metric, 0)
+	for rows.Next() {
+		metric, err := s.scanMetric(rows)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate body metrics: %w", err)
+	}
+	return metrics, nil
+}
+
+// ExistsByID はコンテキストのユーザーにIDの身体測定値が存在するかチェックします
+func (s *BodyMetricQueryService) ExistsByID(ctx context.Context, id shared.BodyMetricID) (bool, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var count int
+	err = s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM body_metrics WHERE id = ? AND user_id = ?`, id.String(), userID.String()).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check existence: %w", err)
+	}
+	return count > 0, nil
+}
+
+// scanMetric は1行分の身体測定値をドメインモデルに変換します（日時はカレンダーのタイムゾーン）
+func (s *BodyMetricQueryService) scanMetric(rows *sql.Rows) (*body.Metric, error) {
+	var idStr, notes string
+	var date time.Time
+	var weightKg float64
+	var bodyFatPercent sql.NullFloat64
+
+	if err := rows.Scan(&idStr, &date, &weightKg, &bodyFatPercent, &notes); err != nil {
+		return nil, fmt.Errorf("failed to scan body metric: %w", err)
+	}
+
+	id, err := shared.NewBodyMetricIDFromString(idStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid body metric ID: %w", err)
+	}
+	weight, err := body.NewWeight(weightKg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid body weight: %w", err)
+	}
+	metric, err := body.NewMetric(id, s.calendar.In(date), weight, notes)
+	if err != nil {
+		return nil, err
+	}
+	if bodyFatPercent.Valid {
+		bodyFat, err := body.NewBodyFat(bodyFatPercent.Float64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid body fat: %w", err)
+		}
+		metric.SetBodyFat(bodyFat)
+	}
+	return metric, nil
+}
+
+// コンパイル時のインターフェース実装チェック
+var _ query.BodyMetricQueryService = (*BodyMetricQueryService)(nil)
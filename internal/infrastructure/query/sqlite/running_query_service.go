@@ -0,0 +1,360 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"fitness-mcp-server/internal/application/auth"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/query"
+)
+
+// RunningQueryService はSQLiteを使ったランニングクエリサービス実装
+// 日付での絞り込みは保存時のローカル日付（local_date）で行い、日時はカレンダーのタイムゾーンで返します
+type RunningQueryService struct {
+	db       *sql.DB
+	calendar shared.Calendar
+}
+
+// NewRunningQueryService は新しいSQLite ランニングクエリサービスを作成します
+func NewRunningQueryService(db *sql.DB, calendar shared.Calendar) *RunningQueryService {
+	return &RunningQueryService{db: db, calendar: calendar}
+}
+
+// FindByDateRange はコンテキストのユーザーの指定した期間のランニングセッションを検索します
+// 期間は開始日・終了日のローカル日付を両端に含みます
+func (s *RunningQueryService) FindByDateRange(ctx context.Context, start, end time.Time) ([]*running.RunningSession, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, date, distance_km, duration_seconds, heart_rate_bpm, run_type, notes
+		FROM running_sessions
+		WHERE user_id = ? AND local_date BETWEEN ? AND ?
+		ORDER BY date DESC`, userID.String(), s.calendar.DateOf(start), s.calendar.DateOf(end))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query running sessions: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := make([]*running.RunningSession, 0)
+	for rows.Next() {
+		session, err := scanRunningSession(rows, s.calendar)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate running sessions: %w", err)
+	}
+
+	// ラップは接続を解放してから読み込む
+	rows.Close()
+	for _, session := range sessions {
+		if err := s.loadLaps(ctx, session); err != nil {
+			return nil, err
+		}
+	}
+
+	return sessions, nil
+}
+
+// loadLaps はランニングセッションのラップを読み込みます（セッションは読み込み時にユーザーで絞り込み済み）
+func (s *RunningQueryService) loadLaps(ctx context.Context, session *running.RunningSession) error {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT distance_km, duration_seconds, heart_rate_bpm, lap_type
+		FROM running_laps
+		WHERE session_id = ?
+		ORDER BY lap_number`, session.ID().String())
+	if err != nil {
+		return fmt.Errorf("failed to query running laps: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var distanceKm, durationSeconds float64
+		var heartRateBPM sql.NullInt64
+		var lapTypeStr string
+		if err := rows.Scan(&distanceKm, &durationSeconds, &heartRateBPM, &lapTypeStr); err != nil {
+			return fmt.Errorf("failed to scan running lap: %w", err)
+		}
+
+		distance, err := running.NewDistance(distanceKm)
+		if err != nil {
+			return fmt.Errorf("invalid lap distance: %w", err)
+		}
+		duration, err := running.NewDuration(time.Duration(durationSeconds * float64(time.Second)))
+		if err != nil {
+			return fmt.Errorf("invalid lap duration: %w", err)
+		}
+		lapType, err := running.NewLapType(lapTypeStr)
+		if err != nil {
+			return err
+		}
+
+		lap, err := session.AddLap(distance, duration, lapType)
+		if err != nil {
+			return err
+		}
+		if heartRateBPM.Valid {
+			heartRate, err := running.NewHeartRate(int(heartRateBPM.Int64))
+			if err != nil {
+				return fmt.Errorf("invalid lap heart rate: %w", err)
+			}
+			lap.SetHeartRate(heartRate)
+		}
+	}
+
+	return rows.Err()
+}
+
+// scanRunningSession は1行分のランニングセッションをドメインモデルに変換します（日時はカレンダーのタイムゾーン）
+func scanRunningSession(rows *sql.Rows, calendar shared.Calendar) (*running.RunningSession, error) {
+	var idStr, runTypeStr string
+	var date time.Time
+	var distanceKm float64
+	var durationSeconds int
+	var heartRateBPM sql.NullInt64
+	var notes sql.NullString
+
+	if err := rows.Scan(&idStr, &date, &distanceKm, &durationSeconds, &heartRateBPM, &runTypeStr, &notes); err != nil {
+		return nil, fmt.Errorf("failed to scan running session: %w", err)
+	}
+
+	id, err := shared.NewSessionIDFromString(idStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session ID: %w", err)
+	}
+
+	distance, err := running.NewDistance(distanceKm)
+	if err != nil {
+		return nil, fmt.Errorf("invalid distance: %w", err)
+	}
+
+	duration, err := running.NewDuration(time.Duration(durationSeconds) * time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration: %w", err)
+	}
+
+	runType, err := running.NewRunType(runTypeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := running.NewRunningSession(id, calendar.In(date), distance, duration, runType, notes.String)
+	if err != nil {
+		return nil, err
+	}
+
+	if heartRateBPM.Valid {
+		heartRate, err := running.NewHeartRate(int(heartRateBPM.Int64))
+		if err != nil {
+			return nil, fmt.Errorf("invalid heart rate: %w", err)
+		}
+		session.SetHeartRate(heartRate)
+	}
+
+	return session, nil
+}
+
+// FindAthleteProfile はコンテキストのユーザーのアスリートプロファイルを取得します（未登録の場合はnil）
+func (s *RunningQueryService) FindAthleteProfile(ctx context.Context) (*running.AthleteProfile, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var maxHR, restingHR, lthr sql.NullInt64
+	var vdot, thresholdPace sql.NullFloat64
+	var updatedAt time.Time
+
+	err = s.db.QueryRowContext(ctx, `
+		SELECT max_hr, resting_hr, lthr, vdot, threshold_pace_seconds_per_km, updated_at
+		FROM athlete_profile
+		WHERE user_id = ?`, userID.String()).Scan(&maxHR, &restingHR, &lthr, &vdot, &thresholdPace, &updatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query athlete profile: %w", err)
+	}
+
+	profile := running.NewAthleteProfile()
+
+	heartRates := make([]*running.HeartRate, 3)
+	for i, value := range []sql.NullInt64{maxHR, restingHR, lthr} {
+		if !value.Valid {
+			continue
+		}
+		heartRate, err := running.NewHeartRate(int(value.Int64))
+		if err != nil {
+			return nil, fmt.Errorf("invalid heart rate in athlete profile: %w", err)
+		}
+		heartRates[i] = &heartRate
+	}
+	if err := profile.SetHeartRates(heartRates[0], heartRates[1], heartRates[2]); err != nil {
+		return nil, fmt.Errorf("invalid athlete profile: %w", err)
+	}
+
+	if vdot.Valid {
+		if err := profile.SetVDOT(vdot.Float64); err != nil {
+			return nil, fmt.Errorf("invalid athlete profile: %w", err)
+		}
+	}
+
+	if thresholdPace.Valid {
+		pace, err := running.NewPace(thresholdPace.Float64 / 60)
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold pace in athlete profile: %w", err)
+		}
+		profile.SetThresholdPace(pace)
+	}
+
+	profile.Restore(updatedAt)
+	return profile, nil
+}
+
+// コンパイル時のインターフェース実装チェック
+var _ query.RunningQueryService = (*RunningQueryService)(nil)
+
+// ExistsByID はコンテキストのユーザーにIDのランニングセッションが存在するかチェックします
+func (s *RunningQueryService) ExistsByID(ctx context.Context, id shared.SessionID) (bool, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var count int
+	err = s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM running_sessions WHERE id = ? AND user_id = ?`, id.String(), userID.String()).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check existence: %w", err)
+	}
+	return count > 0, nil
+}
+
+// goalColumns はランニング目標の復元に必要なカラム（scanRunningGoalの順序と一致させること）
+const goalColumns = `id, event_type, distance_km, target_seconds, event_date, status, description, created_at, achieved_at`
+
+// FindGoals はコンテキストのユーザーのランニング目標を作成日時順に取得します
+func (s *RunningQueryService) FindGoals(ctx context.Context) ([]*running.RunningGoal, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+goalColumns+`
+		FROM running_goals
+		WHERE user_id = ?
+		ORDER BY created_at, id`, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query running goals: %w", err)
+	}
+	defer rows.Close()
+
+	goals := make([]*running.RunningGoal, 0)
+	for rows.Next() {
+		goal, err := scanRunningGoal(rows, s.calendar)
+		if err != nil {
+			return nil, err
+		}
+		goals = append(goals, goal)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate running goals: %w", err)
+	}
+	return goals, nil
+}
+
+// FindGoalByID はコンテキストのユーザーのランニング目標をIDで取得します（存在しない場合はnil）
+func (s *RunningQueryService) FindGoalByID(ctx context.Context, id shared.GoalID) (*running.RunningGoal, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT `+goalColumns+`
+		FROM running_goals
+		WHERE id = ? AND user_id = ?`, id.String(), userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query running goal: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	return scanRunningGoal(rows, s.calendar)
+}
+
+// scanRunningGoal は1行分のランニング目標をドメインモデルに変換します（日時はカレンダーのタイムゾーン）
+func scanRunningGoal(rows *sql.Rows, calendar shared.Calendar) (*running.RunningGoal, error) {
+	var idStr, eventTypeStr, statusStr, description string
+	var distanceKm float64
+	var targetSeconds int
+	var eventDate sql.NullString
+	var createdAt time.Time
+	var achievedAt sql.NullTime
+
+	if err := rows.Scan(&idStr, &eventTypeStr, &distanceKm, &targetSeconds, &eventDate, &statusStr, &description, &createdAt, &achievedAt); err != nil {
+		return nil, fmt.Errorf("failed to scan running goal: %w", err)
+	}
+
+	id, err := shared.NewGoalIDFromString(idStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid goal ID: %w", err)
+	}
+	eventType, err := running.NewEventType(eventTypeStr)
+	if err != nil {
+		return nil, err
+	}
+	targetTime, err := running.NewDuration(time.Duration(targetSeconds) * time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target time: %w", err)
+	}
+	status, err := running.NewGoalStatus(statusStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var goal *running.RunningGoal
+	if eventType.Equals(running.Custom) {
+		distance, err := running.NewDistance(distanceKm)
+		if err != nil {
+			return nil, fmt.Errorf("invalid goal distance: %w", err)
+		}
+		goal, err = running.NewCustomRunningGoal(id, distance, targetTime, description)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		goal, err = running.NewRunningGoal(id, eventType, targetTime, description)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if eventDate.Valid {
+		date, err := calendar.ParseDate(eventDate.String)
+		if err != nil {
+			return nil, fmt.Errorf("invalid event date: %w", err)
+		}
+		goal.SetEventDate(date)
+	}
+
+	var achieved *time.Time
+	if achievedAt.Valid {
+		value := calendar.In(achievedAt.Time)
+		achieved = &value
+	}
+	goal.Restore(status, calendar.In(createdAt), achieved)
+	return goal, nil
+}
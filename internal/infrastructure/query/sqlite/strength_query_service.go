@@ -1,11 +1,13 @@
 package sqlite
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
 	"time"
 
+	"fitness-mcp-server/internal/application/auth"
 	"fitness-mcp-server/internal/application/query/dto"
 	"fitness-mcp-server/internal/domain/shared"
 	"fitness-mcp-server/internal/domain/strength"
@@ -15,22 +17,30 @@ import (
 )
 
 // StrengthQueryService はSQLiteを使った筋トレクエリサービス実装
+// 日付での絞り込みは保存時のローカル日付（local_date）で行い、日時はカレンダーのタイムゾーンで返します
 type StrengthQueryService struct {
-	db *sql.DB
+	db       *sql.DB
+	calendar shared.Calendar
 }
 
 // NewStrengthQueryService は新しいSQLite クエリサービスを作成します
-func NewStrengthQueryService(db *sql.DB) *StrengthQueryService {
-	return &StrengthQueryService{db: db}
+func NewStrengthQueryService(db *sql.DB, calendar shared.Calendar) *StrengthQueryService {
+	return &StrengthQueryService{db: db, calendar: calendar}
 }
 
 // FindByID はIDで筋トレセッションを検索します
-func (s *StrengthQueryService) FindByID(id shared.TrainingID) (*strength.StrengthTraining, error) {
+// 他のユーザーの筋トレセッションは存在しないものとして扱います
+func (s *StrengthQueryService) FindByID(ctx context.Context, id shared.TrainingID) (*strength.StrengthTraining, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// 筋トレセッションを取得
-	row := s.db.QueryRow(`
+	row := s.db.QueryRowContext(ctx, `
 		SELECT id, date, notes 
 		FROM strength_trainings 
-		WHERE id = ?`, id.String())
+		WHERE id = ? AND user_id = ?`, id.String(), userID.String())
 
 	var idStr string
 	var date time.Time
@@ -48,10 +58,10 @@ func (s *StrengthQueryService) FindByID(id shared.TrainingID) (*strength.Strengt
 		return nil, fmt.Errorf("invalid training ID: %w", err)
 	}
 
-	training := strength.NewStrengthTraining(trainingID, date, notes)
+	training := strength.NewStrengthTraining(trainingID, s.calendar.In(date), notes)
 
 	// エクササイズを取得
-	exercises, err := s.findExercisesByTrainingID(id)
+	exercises, err := s.findExercisesByTrainingID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load exercises: %w", err)
 	}
@@ -63,14 +73,25 @@ func (s *StrengthQueryService) FindByID(id shared.TrainingID) (*strength.Strengt
 	return training, nil
 }
 
-// FindByDateRange は指定した期間の筋トレセッションを検索します
-func (s *StrengthQueryService) FindByDateRange(start, end time.Time) ([]*strength.StrengthTraining, error) {
+// FindByDateRange はコンテキストのユーザーの指定した期間の筋トレセッションを検索します
+// 期間は開始日・終了日のローカル日付を両端に含みます
+func (s *StrengthQueryService) FindByDateRange(ctx context.Context, start, end time.Time) ([]*strength.StrengthTraining, error) {
+	return s.findByLocalDates(ctx, s.calendar.DateOf(start), s.calendar.DateOf(end))
+}
+
+// findByLocalDates はコンテキストのユーザーのローカル日付が範囲内の筋トレセッションを検索します
+func (s *StrengthQueryService) findByLocalDates(ctx context.Context, startDate, endDate string) ([]*strength.StrengthTraining, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// 筋トレセッションを一括取得
-	trainingRows, err := s.db.Query(`
+	trainingRows, err := s.db.QueryContext(ctx, `
 		SELECT id, date, notes 
 		FROM strength_trainings 
-		WHERE date BETWEEN ? AND ? 
-		ORDER BY date DESC`, start, end)
+		WHERE user_id = ? AND local_date BETWEEN ? AND ? 
+		ORDER BY date DESC`, userID.String(), startDate, endDate)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +124,7 @@ func (s *StrengthQueryService) FindByDateRange(start, end time.Time) ([]*strengt
 			id    shared.TrainingID
 			date  time.Time
 			notes string
-		}{id, date, notes}
+		}{id, s.calendar.In(date), notes}
 	}
 
 	if err := trainingRows.Err(); err != nil {
@@ -115,7 +136,7 @@ func (s *StrengthQueryService) FindByDateRange(start, end time.Time) ([]*strengt
 	}
 
 	// 一括でエクササイズを取得
-	exercisesByTraining, err := s.findExercisesByTrainingIDs(trainingIDs)
+	exercisesByTraining, err := s.findExercisesByTrainingIDs(ctx, trainingIDs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load exercises: %w", err)
 	}
@@ -138,25 +159,31 @@ func (s *StrengthQueryService) FindByDateRange(start, end time.Time) ([]*strengt
 	return trainings, nil
 }
 
-// FindByDate は指定した日の筋トレセッションを検索します
-func (s *StrengthQueryService) FindByDate(date time.Time) ([]*strength.StrengthTraining, error) {
-	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
-	endOfDay := startOfDay.Add(24 * time.Hour)
-	return s.FindByDateRange(startOfDay, endOfDay)
+// FindByDate は指定した日時のローカル日付の筋トレセッションを検索します
+func (s *StrengthQueryService) FindByDate(ctx context.Context, date time.Time) ([]*strength.StrengthTraining, error) {
+	localDate := s.calendar.DateOf(date)
+	return s.findByLocalDates(ctx, localDate, localDate)
 }
 
-// FindAll は全ての筋トレセッションを検索します
-func (s *StrengthQueryService) FindAll() ([]*strength.StrengthTraining, error) {
-	rows, err := s.db.Query(`
+// FindAll はコンテキストのユーザーの全ての筋トレセッションを検索します
+func (s *StrengthQueryService) FindAll(ctx context.Context) ([]*strength.StrengthTraining, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
 		SELECT id 
 		FROM strength_trainings 
-		ORDER BY date DESC`)
+		WHERE user_id = ?
+		ORDER BY date DESC`, userID.String())
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var trainings []*strength.StrengthTraining
+	// 接続数の上限が小さい場合に接続を待ち続けないよう、IDを読み終えて接続を返してからセッションを取得する
+	var ids []shared.TrainingID
 	for rows.Next() {
 		var idStr string
 		if err := rows.Scan(&idStr); err != nil {
@@ -167,22 +194,34 @@ func (s *StrengthQueryService) FindAll() ([]*strength.StrengthTraining, error) {
 		if err != nil {
 			return nil, fmt.Errorf("invalid training ID: %w", err)
 		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
 
-		training, err := s.FindByID(id)
+	trainings := make([]*strength.StrengthTraining, 0, len(ids))
+	for _, id := range ids {
+		training, err := s.FindByID(ctx, id)
 		if err != nil {
 			return nil, err
 		}
-
 		trainings = append(trainings, training)
 	}
 
-	return trainings, rows.Err()
+	return trainings, nil
 }
 
-// ExistsById はIDの筋トレセッションが存在するかチェックします
-func (s *StrengthQueryService) ExistsById(id shared.TrainingID) (bool, error) {
+// ExistsById はコンテキストのユーザーにIDの筋トレセッションが存在するかチェックします
+func (s *StrengthQueryService) ExistsById(ctx context.Context, id shared.TrainingID) (bool, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
 	var count int
-	err := s.db.QueryRow(`SELECT COUNT(*) FROM strength_trainings WHERE id = ?`, id.String()).Scan(&count)
+	err = s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM strength_trainings WHERE id = ? AND user_id = ?`, id.String(), userID.String()).Scan(&count)
 	if err != nil {
 		return false, fmt.Errorf("failed to check existence: %w", err)
 	}
@@ -190,7 +229,13 @@ func (s *StrengthQueryService) ExistsById(id shared.TrainingID) (bool, error) {
 }
 
 // GetPersonalRecords は個人記録を取得します
-func (s *StrengthQueryService) GetPersonalRecords(exerciseName *string) ([]dto.PersonalRecordQueryResult, error) {
+// carryDistanceMeters を指定すると、その距離以上のキャリーのみを最大重量キャリーの対象にします
+func (s *StrengthQueryService) GetPersonalRecords(ctx context.Context, exerciseName *string, carryDistanceMeters *float64) ([]dto.PersonalRecordQueryResult, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `
 	WITH exercise_stats AS (
 		SELECT 
@@ -200,7 +245,7 @@ func (s *StrengthQueryService) GetPersonalRecords(exerciseName *string) ([]dto.P
 		FROM exercises e
 		JOIN sets s ON e.id = s.exercise_id
 		JOIN strength_trainings st ON e.training_id = st.id
-		WHERE ($1 IS NULL OR e.name = $1)
+		WHERE st.user_id = $2 AND ($1 IS NULL OR e.name = $1)
 		GROUP BY e.name
 	),
 	max_weight_details AS (
@@ -215,7 +260,7 @@ func (s *StrengthQueryService) GetPersonalRecords(exerciseName *string) ([]dto.P
 		FROM exercises e
 		JOIN sets s ON e.id = s.exercise_id
 		JOIN strength_trainings st ON e.training_id = st.id
-		WHERE ($1 IS NULL OR e.name = $1)
+		WHERE st.user_id = $2 AND ($1 IS NULL OR e.name = $1)
 	),
 	max_reps_details AS (
 		SELECT DISTINCT
@@ -229,7 +274,7 @@ func (s *StrengthQueryService) GetPersonalRecords(exerciseName *string) ([]dto.P
 		FROM exercises e
 		JOIN sets s ON e.id = s.exercise_id
 		JOIN strength_trainings st ON e.training_id = st.id
-		WHERE ($1 IS NULL OR e.name = $1)
+		WHERE st.user_id = $2 AND ($1 IS NULL OR e.name = $1)
 	),
 	max_volume_details AS (
 		SELECT DISTINCT
@@ -244,7 +289,7 @@ func (s *StrengthQueryService) GetPersonalRecords(exerciseName *string) ([]dto.P
 		FROM exercises e
 		JOIN sets s ON e.id = s.exercise_id
 		JOIN strength_trainings st ON e.training_id = st.id
-		WHERE ($1 IS NULL OR e.name = $1)
+		WHERE st.user_id = $2 AND ($1 IS NULL OR e.name = $1)
 	)
 	SELECT 
 		es.exercise_name,
@@ -274,7 +319,7 @@ func (s *StrengthQueryService) GetPersonalRecords(exerciseName *string) ([]dto.P
 	LEFT JOIN max_volume_details mvd ON es.exercise_name = mvd.exercise_name AND mvd.rn = 1
 	ORDER BY es.exercise_name;`
 
-	rows, err := s.db.Query(query, exerciseName)
+	rows, err := s.db.QueryContext(ctx, query, exerciseName, userID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to query personal records: %w", err)
 	}
@@ -284,7 +329,7 @@ func (s *StrengthQueryService) GetPersonalRecords(exerciseName *string) ([]dto.P
 	for rows.Next() {
 		var record dto.PersonalRecordQueryResult
 		var maxWeightDetails, maxRepsDetails, maxVolumeDetails dto.SetQueryDetails
-		var maxWeightDetailsRPE, maxRepsDetailsRPE, maxVolumeDetailsRPE sql.NullInt64
+		var maxWeightDetailsRPE, maxRepsDetailsRPE, maxVolumeDetailsRPE sql.NullFloat64
 
 		// 日付を文字列として受け取る
 		var maxWeightDateStr, maxRepsDateStr, maxVolumeDateStr, lastPerformedStr string
@@ -317,41 +362,22 @@ func (s *StrengthQueryService) GetPersonalRecords(exerciseName *string) ([]dto.P
 		}
 
 		// 日付文字列をtime.Timeに変換
-		if maxWeightDate, err := time.Parse("2006-01-02 15:04:05", maxWeightDateStr); err == nil {
-			record.MaxWeight.Date = maxWeightDate
-		} else if maxWeightDate, err := time.Parse("2006-01-02", maxWeightDateStr); err == nil {
-			record.MaxWeight.Date = maxWeightDate
-		}
-
-		if maxRepsDate, err := time.Parse("2006-01-02 15:04:05", maxRepsDateStr); err == nil {
-			record.MaxReps.Date = maxRepsDate
-		} else if maxRepsDate, err := time.Parse("2006-01-02", maxRepsDateStr); err == nil {
-			record.MaxReps.Date = maxRepsDate
-		}
-
-		if maxVolumeDate, err := time.Parse("2006-01-02 15:04:05", maxVolumeDateStr); err == nil {
-			record.MaxVolume.Date = maxVolumeDate
-		} else if maxVolumeDate, err := time.Parse("2006-01-02", maxVolumeDateStr); err == nil {
-			record.MaxVolume.Date = maxVolumeDate
-		}
-
-		if lastPerformed, err := time.Parse("2006-01-02 15:04:05", lastPerformedStr); err == nil {
-			record.LastPerformed = lastPerformed
-		} else if lastPerformed, err := time.Parse("2006-01-02", lastPerformedStr); err == nil {
-			record.LastPerformed = lastPerformed
-		}
+		record.MaxWeight.Date = s.parseLocalDateTime(maxWeightDateStr)
+		record.MaxReps.Date = s.parseLocalDateTime(maxRepsDateStr)
+		record.MaxVolume.Date = s.parseLocalDateTime(maxVolumeDateStr)
+		record.LastPerformed = s.parseLocalDateTime(lastPerformedStr)
 
 		// RPEの設定（NULL許可のため）
 		if maxWeightDetailsRPE.Valid {
-			rpe := int(maxWeightDetailsRPE.Int64)
+			rpe := maxWeightDetailsRPE.Float64
 			maxWeightDetails.RPE = &rpe
 		}
 		if maxRepsDetailsRPE.Valid {
-			rpe := int(maxRepsDetailsRPE.Int64)
+			rpe := maxRepsDetailsRPE.Float64
 			maxRepsDetails.RPE = &rpe
 		}
 		if maxVolumeDetailsRPE.Valid {
-			rpe := int(maxVolumeDetailsRPE.Int64)
+			rpe := maxVolumeDetailsRPE.Float64
 			maxVolumeDetails.RPE = &rpe
 		}
 
@@ -367,14 +393,232 @@ func (s *StrengthQueryService) GetPersonalRecords(exerciseName *string) ([]dto.P
 		return nil, fmt.Errorf("failed to iterate personal records: %w", err)
 	}
 
+	// 時間・距離ベースの記録（最長保持時間・最大重量キャリー）を付与
+	measured, err := s.getMeasuredRecords(ctx, exerciseName, carryDistanceMeters)
+	if err != nil {
+		return nil, err
+	}
+	for i := range records {
+		if detail, exists := measured.longestHold[records[i].ExerciseName]; exists {
+			records[i].LongestHold = detail
+		}
+		if detail, exists := measured.heaviestCarry[records[i].ExerciseName]; exists {
+			records[i].HeaviestCarry = detail
+		}
+	}
+
 	return records, nil
 }
 
+// measuredRecords は時間・距離ベースの個人記録をエクササイズ名ごとに保持します
+type measuredRecords struct {
+	longestHold   map[string]*dto.PersonalRecordQueryDetail
+	heaviestCarry map[string]*dto.PersonalRecordQueryDetail
+}
+
+// getMeasuredRecords は最長保持時間と最大重量キャリーの記録を取得します
+func (s *StrengthQueryService) getMeasuredRecords(ctx context.Context, exerciseName *string, carryDistanceMeters *float64) (*measuredRecords, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+	WITH longest_hold AS (
+		SELECT
+			e.name as exercise_name,
+			s.weight_kg,
+			s.reps,
+			s.duration_seconds,
+			s.distance_m,
+			s.rpe,
+			st.date,
+			st.id as training_id,
+			ROW_NUMBER() OVER (PARTITION BY e.name ORDER BY s.duration_seconds DESC, s.weight_kg DESC, st.date DESC) as rn
+		FROM exercises e
+		JOIN sets s ON e.id = s.exercise_id
+		JOIN strength_trainings st ON e.training_id = st.id
+		WHERE st.user_id = $3 AND s.duration_seconds IS NOT NULL AND ($1 IS NULL OR e.name = $1)
+	),
+	heaviest_carry AS (
+		SELECT
+			e.name as exercise_name,
+			s.weight_kg,
+			s.reps,
+			s.duration_seconds,
+			s.distance_m,
+			s.rpe,
+			st.date,
+			st.id as training_id,
+			ROW_NUMBER() OVER (PARTITION BY e.name ORDER BY s.weight_kg DESC, s.distance_m DESC, st.date DESC) as rn
+		FROM exercises e
+		JOIN sets s ON e.id = s.exercise_id
+		JOIN strength_trainings st ON e.training_id = st.id
+		WHERE st.user_id = $3 AND s.distance_m IS NOT NULL AND s.distance_m >= COALESCE($2, 0) AND ($1 IS NULL OR e.name = $1)
+	)
+	SELECT 'longest_hold', exercise_name, duration_seconds, weight_kg, reps, duration_seconds, distance_m, rpe, date, training_id
+	FROM longest_hold WHERE rn = 1
+	UNION ALL
+	SELECT 'heaviest_carry', exercise_name, weight_kg, weight_kg, reps, duration_seconds, distance_m, rpe, date, training_id
+	FROM heaviest_carry WHERE rn = 1;`
+
+	rows, err := s.db.QueryContext(ctx, query, exerciseName, carryDistanceMeters, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query measured records: %w", err)
+	}
+	defer rows.Close()
+
+	result := &measuredRecords{
+		longestHold:   make(map[string]*dto.PersonalRecordQueryDetail),
+		heaviestCarry: make(map[string]*dto.PersonalRecordQueryDetail),
+	}
+	for rows.Next() {
+		var recordType, name, dateStr string
+		var detail dto.PersonalRecordQueryDetail
+		var details dto.SetQueryDetails
+		var reps sql.NullInt64
+		var rpe sql.NullFloat64
+
+		err := rows.Scan(
+			&recordType,
+			&name,
+			&detail.Value,
+			&details.WeightKg,
+			&reps,
+			&details.DurationSeconds,
+			&details.DistanceMeters,
+			&rpe,
+			&dateStr,
+			&detail.TrainingID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan measured record: %w", err)
+		}
+
+		if reps.Valid {
+			details.Reps = int(reps.Int64)
+		}
+		if rpe.Valid {
+			rpeValue := rpe.Float64
+			details.RPE = &rpeValue
+		}
+		detail.Date = s.parseLocalDateTime(dateStr)
+		detail.SetDetails = &details
+
+		switch recordType {
+		case "longest_hold":
+			result.longestHold[name] = &detail
+		case "heaviest_carry":
+			result.heaviestCarry[name] = &detail
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate measured records: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetPersonalRecordHistory はコンテキストのユーザーのPR更新イベントをエクササイズ名・日付順に取得します
+func (s *StrengthQueryService) GetPersonalRecordHistory(ctx context.Context, exerciseName *string, recordType *string) ([]dto.PersonalRecordEventQueryResult, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT
+			exercise_name, record_type, reps, value, previous_value,
+			training_id, date, set_weight_kg, set_reps, set_rpe
+		FROM personal_record_events
+		WHERE user_id = $3
+			AND ($1 IS NULL OR exercise_name = $1)
+			AND ($2 IS NULL OR record_type = $2)
+		ORDER BY exercise_name, date, id`, exerciseName, recordType, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query personal record history: %w", err)
+	}
+	defer rows.Close()
+
+	var events []dto.PersonalRecordEventQueryResult
+	for rows.Next() {
+		var event dto.PersonalRecordEventQueryResult
+		var dateStr string
+		var setWeight, setRPE sql.NullFloat64
+		var setReps sql.NullInt64
+
+		err := rows.Scan(
+			&event.ExerciseName,
+			&event.RecordType,
+			&event.Reps,
+			&event.Value,
+			&event.PreviousValue,
+			&event.TrainingID,
+			&dateStr,
+			&setWeight,
+			&setReps,
+			&setRPE,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan personal record event: %w", err)
+		}
+
+		event.Date = s.parseLocalDateTime(dateStr)
+		if setWeight.Valid {
+			details := &dto.SetQueryDetails{
+				WeightKg: setWeight.Float64,
+				Reps:     int(setReps.Int64),
+			}
+			if setRPE.Valid {
+				rpe := setRPE.Float64
+				details.RPE = &rpe
+			}
+			event.SetDetails = details
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate personal record history: %w", err)
+	}
+
+	return events, nil
+}
+
 // プライベートヘルパーメソッド
 
+// dateTimeLayouts はSQLiteに保存された日時文字列として想定される形式です
+var dateTimeLayouts = []string{
+	"2006-01-02 15:04:05",                     // UTCで保存された日時（小数秒を含む場合も解析できる）
+	"2006-01-02 15:04:05.999999999 -0700 MST", // database/sql経由で保存されたtime.Time
+	time.RFC3339Nano,
+	"2006-01-02",
+}
+
+// parseDateTime はSQLiteの日時文字列をtime.Timeに変換します（解析できない場合はゼロ値）
+func parseDateTime(value string) time.Time {
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// parseLocalDateTime はSQLiteの日時文字列をカレンダーのタイムゾーンの時刻に変換します（解析できない場合はゼロ値）
+func (s *StrengthQueryService) parseLocalDateTime(value string) time.Time {
+	t := parseDateTime(value)
+	if t.IsZero() {
+		return t
+	}
+	return s.calendar.In(t)
+}
+
 // findExercisesByTrainingID はトレーニングIDでエクササイズを検索します
-func (s *StrengthQueryService) findExercisesByTrainingID(trainingID shared.TrainingID) ([]*strength.Exercise, error) {
-	rows, err := s.db.Query(`
+func (s *StrengthQueryService) findExercisesByTrainingID(ctx context.Context, trainingID shared.TrainingID) ([]*strength.Exercise, error) {
+	rows, err := s.db.QueryContext(ctx, `
 		SELECT id, name 
 		FROM exercises 
 		WHERE training_id = ? 
@@ -401,7 +645,7 @@ func (s *StrengthQueryService) findExercisesByTrainingID(trainingID shared.Train
 		exercise := strength.NewExercise(exerciseName)
 
 		// セットを取得
-		sets, err := s.findSetsByExerciseID(id)
+		sets, err := s.findSetsByExerciseID(ctx, id)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load sets: %w", err)
 		}
@@ -417,7 +661,7 @@ func (s *StrengthQueryService) findExercisesByTrainingID(trainingID shared.Train
 }
 
 // findExercisesByTrainingIDs は複数のトレーニングIDでエクササイズを一括取得します
-func (s *StrengthQueryService) findExercisesByTrainingIDs(trainingIDs []string) (map[string][]*strength.Exercise, error) {
+func (s *StrengthQueryService) findExercisesByTrainingIDs(ctx context.Context, trainingIDs []string) (map[string][]*strength.Exercise, error) {
 	if len(trainingIDs) == 0 {
 		return make(map[string][]*strength.Exercise), nil
 	}
@@ -437,7 +681,7 @@ func (s *StrengthQueryService) findExercisesByTrainingIDs(trainingIDs []string)
 		ORDER BY training_id, exercise_order`,
 		strings.Join(placeholders, ","))
 
-	rows, err := s.db.Query(query, args...)
+	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -471,13 +715,14 @@ func (s *StrengthQueryService) findExercisesByTrainingIDs(trainingIDs []string)
 	}
 
 	// 一括でセットを取得
-	setsByExercise, err := s.findSetsByExerciseIDs(exerciseIDs)
+	setsByExercise, err := s.findSetsByExerciseIDs(ctx, exerciseIDs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load sets: %w", err)
 	}
 
-	// エクササイズオブジェクトを作成
-	for exerciseID, data := range exerciseDataMap {
+	// エクササイズオブジェクトを作成（マップの走査順は不定のため、実施順に並んだIDの順で作成する）
+	for _, exerciseID := range exerciseIDs {
+		data := exerciseDataMap[exerciseID]
 		exerciseName, err := strength.NewExerciseName(data.name)
 		if err != nil {
 			return nil, fmt.Errorf("invalid exercise name: %w", err)
@@ -498,116 +743,231 @@ func (s *StrengthQueryService) findExercisesByTrainingIDs(trainingIDs []string)
 	return exercisesByTraining, nil
 }
 
+// setColumns はセット復元に必要なカラム（setRow.scanの順序と一致させること）
+const setColumns = `id, weight_kg, reps, duration_seconds, distance_m, rpe,
+		tempo_eccentric, tempo_pause, tempo_concentric, tempo_top, tempo_explosive, range_of_motion`
+
+// setRow はsetsテーブルの1行を表します
+type setRow struct {
+	id              int64
+	weightKg        float64
+	reps            *int
+	durationSeconds *int
+	distanceMeters  *float64
+	rpe             *float64
+	tempo           [4]*int // eccentric, pause, concentric, top
+	tempoExplosive  *int
+	rangeOfMotion   *string
+}
+
+// scanFields はsetColumnsの順序でScan先を返します
+func (r *setRow) scanFields() []interface{} {
+	return []interface{}{
+		&r.id, &r.weightKg, &r.reps, &r.durationSeconds, &r.distanceMeters, &r.rpe,
+		&r.tempo[0], &r.tempo[1], &r.tempo[2], &r.tempo[3], &r.tempoExplosive, &r.rangeOfMotion,
+	}
+}
+
 // findSetsByExerciseID はエクササイズIDでセットを検索します
-func (s *StrengthQueryService) findSetsByExerciseID(exerciseID int64) ([]strength.Set, error) {
-	rows, err := s.db.Query(`
-		SELECT weight_kg, reps, rpe 
+func (s *StrengthQueryService) findSetsByExerciseID(ctx context.Context, exerciseID int64) ([]strength.Set, error) {
+	setsByExercise, err := s.findSetsByExerciseIDs(ctx, []int64{exerciseID})
+	if err != nil {
+		return nil, err
+	}
+	return setsByExercise[exerciseID], nil
+}
+
+// findSetsByExerciseIDs は複数のエクササイズIDでセットを一括取得します
+func (s *StrengthQueryService) findSetsByExerciseIDs(ctx context.Context, exerciseIDs []int64) (map[int64][]strength.Set, error) {
+	if len(exerciseIDs) == 0 {
+		return make(map[int64][]strength.Set), nil
+	}
+
+	// IN句用のプレースホルダーを生成
+	placeholders := make([]string, len(exerciseIDs))
+	args := make([]interface{}, len(exerciseIDs))
+	for i, id := range exerciseIDs {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+
+	query := fmt.Sprintf(`
+		SELECT exercise_id, %s 
 		FROM sets 
-		WHERE exercise_id = ? 
-		ORDER BY set_order`, exerciseID)
+		WHERE exercise_id IN (%s) 
+		ORDER BY exercise_id, set_order`,
+		setColumns, strings.Join(placeholders, ","))
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var sets []strength.Set
+	var exerciseOrder []int64
+	var setIDs []int64
+	rowsByExercise := make(map[int64][]setRow)
 	for rows.Next() {
-		var weightKg float64
-		var reps int
-		var rpe *int
+		var exerciseID int64
+		var row setRow
 
-		if err := rows.Scan(&weightKg, &reps, &rpe); err != nil {
+		if err := rows.Scan(append([]interface{}{&exerciseID}, row.scanFields()...)...); err != nil {
 			return nil, err
 		}
 
-		weight, err := strength.NewWeight(weightKg)
-		if err != nil {
-			return nil, fmt.Errorf("invalid weight: %w", err)
+		if _, exists := rowsByExercise[exerciseID]; !exists {
+			exerciseOrder = append(exerciseOrder, exerciseID)
 		}
+		rowsByExercise[exerciseID] = append(rowsByExercise[exerciseID], row)
+		setIDs = append(setIDs, row.id)
+	}
 
-		repsObj, err := strength.NewReps(reps)
-		if err != nil {
-			return nil, fmt.Errorf("invalid reps: %w", err)
-		}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 一括でバリエーションタグを取得
+	variationsBySet, err := s.findVariationsBySetIDs(ctx, setIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load set variations: %w", err)
+	}
 
-		var rpeObj *strength.RPE
-		if rpe != nil {
-			rpeValue, err := strength.NewRPE(*rpe)
+	setsByExercise := make(map[int64][]strength.Set)
+	for _, exerciseID := range exerciseOrder {
+		for _, row := range rowsByExercise[exerciseID] {
+			set, err := newSetFromRow(row, variationsBySet[row.id])
 			if err != nil {
-				return nil, fmt.Errorf("invalid RPE: %w", err)
+				return nil, err
 			}
-			rpeObj = &rpeValue
+			setsByExercise[exerciseID] = append(setsByExercise[exerciseID], set)
 		}
-
-		set := strength.NewSet(weight, repsObj, rpeObj)
-		sets = append(sets, set)
 	}
 
-	return sets, rows.Err()
+	return setsByExercise, nil
 }
 
-// findSetsByExerciseIDs は複数のエクササイズIDでセットを一括取得します
-func (s *StrengthQueryService) findSetsByExerciseIDs(exerciseIDs []int64) (map[int64][]strength.Set, error) {
-	if len(exerciseIDs) == 0 {
-		return make(map[int64][]strength.Set), nil
+// findVariationsBySetIDs は複数のセットIDでバリエーションタグを一括取得します
+func (s *StrengthQueryService) findVariationsBySetIDs(ctx context.Context, setIDs []int64) (map[int64][]string, error) {
+	variationsBySet := make(map[int64][]string)
+	if len(setIDs) == 0 {
+		return variationsBySet, nil
 	}
 
-	// IN句用のプレースホルダーを生成
-	placeholders := make([]string, len(exerciseIDs))
-	args := make([]interface{}, len(exerciseIDs))
-	for i, id := range exerciseIDs {
+	placeholders := make([]string, len(setIDs))
+	args := make([]interface{}, len(setIDs))
+	for i, id := range setIDs {
 		placeholders[i] = "?"
 		args[i] = id
 	}
 
 	query := fmt.Sprintf(`
-		SELECT exercise_id, weight_kg, reps, rpe 
-		FROM sets 
-		WHERE exercise_id IN (%s) 
-		ORDER BY exercise_id, set_order`,
+		SELECT set_id, variation 
+		FROM set_variations 
+		WHERE set_id IN (%s) 
+		ORDER BY set_id, rowid`,
 		strings.Join(placeholders, ","))
 
-	rows, err := s.db.Query(query, args...)
+	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	setsByExercise := make(map[int64][]strength.Set)
 	for rows.Next() {
-		var exerciseID int64
-		var weightKg float64
-		var reps int
-		var rpe *int
-
-		if err := rows.Scan(&exerciseID, &weightKg, &reps, &rpe); err != nil {
+		var setID int64
+		var variation string
+		if err := rows.Scan(&setID, &variation); err != nil {
 			return nil, err
 		}
+		variationsBySet[setID] = append(variationsBySet[setID], variation)
+	}
+
+	return variationsBySet, rows.Err()
+}
+
+// newSetFromRow はsetsテーブルの1行とバリエーションタグからSetを復元します
+func newSetFromRow(row setRow, variations []string) (strength.Set, error) {
+	weight, err := strength.NewWeight(row.weightKg)
+	if err != nil {
+		return strength.Set{}, fmt.Errorf("invalid weight: %w", err)
+	}
+
+	var repsObj *strength.Reps
+	if row.reps != nil {
+		repsValue, err := strength.NewReps(*row.reps)
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("invalid reps: %w", err)
+		}
+		repsObj = &repsValue
+	}
 
-		weight, err := strength.NewWeight(weightKg)
+	var durationObj *strength.Duration
+	if row.durationSeconds != nil {
+		durationValue, err := strength.NewDuration(*row.durationSeconds)
 		if err != nil {
-			return nil, fmt.Errorf("invalid weight: %w", err)
+			return strength.Set{}, fmt.Errorf("invalid duration: %w", err)
 		}
+		durationObj = &durationValue
+	}
 
-		repsObj, err := strength.NewReps(reps)
+	var distanceObj *strength.Distance
+	if row.distanceMeters != nil {
+		distanceValue, err := strength.NewDistance(*row.distanceMeters)
 		if err != nil {
-			return nil, fmt.Errorf("invalid reps: %w", err)
+			return strength.Set{}, fmt.Errorf("invalid distance: %w", err)
 		}
+		distanceObj = &distanceValue
+	}
 
-		var rpeObj *strength.RPE
-		if rpe != nil {
-			rpeValue, err := strength.NewRPE(*rpe)
-			if err != nil {
-				return nil, fmt.Errorf("invalid RPE: %w", err)
+	var rpeObj *strength.RPE
+	if row.rpe != nil {
+		rpeValue, err := strength.NewRPEFromValue(*row.rpe)
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("invalid RPE: %w", err)
+		}
+		rpeObj = &rpeValue
+	}
+
+	set, err := strength.NewMeasuredSet(weight, repsObj, durationObj, distanceObj, rpeObj)
+	if err != nil {
+		return strength.Set{}, err
+	}
+
+	// テンポ（4局面すべて記録されている場合のみ）
+	if row.tempo[0] != nil && row.tempo[1] != nil && row.tempo[2] != nil && row.tempo[3] != nil {
+		tempo, err := strength.NewTempo(*row.tempo[0], *row.tempo[1], *row.tempo[2], *row.tempo[3])
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("invalid tempo: %w", err)
+		}
+		if row.tempoExplosive != nil {
+			if tempo, err = tempo.WithExplosivePhases(*row.tempoExplosive); err != nil {
+				return strength.Set{}, fmt.Errorf("invalid tempo: %w", err)
 			}
-			rpeObj = &rpeValue
 		}
+		set = set.WithTempo(tempo)
+	}
 
-		set := strength.NewSet(weight, repsObj, rpeObj)
-		setsByExercise[exerciseID] = append(setsByExercise[exerciseID], set)
+	if row.rangeOfMotion != nil {
+		rom, err := strength.NewRangeOfMotion(*row.rangeOfMotion)
+		if err != nil {
+			return strength.Set{}, fmt.Errorf("invalid range of motion: %w", err)
+		}
+		set = set.WithRangeOfMotion(rom)
+	}
+
+	if len(variations) > 0 {
+		variationObjs := make([]strength.Variation, 0, len(variations))
+		for _, tag := range variations {
+			variation, err := strength.NewVariation(tag)
+			if err != nil {
+				return strength.Set{}, fmt.Errorf("invalid variation: %w", err)
+			}
+			variationObjs = append(variationObjs, variation)
+		}
+		set = set.WithVariations(variationObjs...)
 	}
 
-	return setsByExercise, rows.Err()
+	return set, nil
 }
 
 // コンパイル時のインターフェース実装チェック
@@ -0,0 +1,245 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"fitness-mcp-server/internal/application/auth"
+	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/strength"
+)
+
+// searchSortKey は検索結果の並び順のキー
+type searchSortKey struct {
+	column string
+	desc   bool
+}
+
+// searchSorts は並び順ごとのキー（同じ値の場合は日時・IDの順に並べ、カーソルで続きを一意に指定できるようにする）
+var searchSorts = map[string][]searchSortKey{
+	dto.SortDateDesc:      {{"date", true}, {"id", true}},
+	dto.SortDateAsc:       {{"date", false}, {"id", false}},
+	dto.SortMaxWeightDesc: {{"max_weight", true}, {"date", true}, {"id", true}},
+	dto.SortVolumeDesc:    {{"volume", true}, {"date", true}, {"id", true}},
+}
+
+// searchCursor は次のページの先頭を示すカーソル（前のページの最後のセッションの並び順のキーの値）
+type searchCursor struct {
+	Sort   string `json:"s"`
+	Values []any  `json:"v"`
+}
+
+// Search はコンテキストのユーザーの条件に一致するセットを含む筋トレセッションを並び順に1ページ分検索します
+// 最大重量・ボリュームの並び順は条件に一致したセットのみで計算し（時間・距離ベースのセットのボリュームは0）、セッションは全てのセットを含めて返します
+func (s *StrengthQueryService) Search(ctx context.Context, criteria dto.TrainingSearchCriteria) (*dto.TrainingSearchQueryResult, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys, exists := searchSorts[criteria.Sort]
+	if !exists {
+		return nil, fmt.Errorf("invalid sort: %s", criteria.Sort)
+	}
+
+	// セッション・セットの条件
+	conditions := []string{"st.user_id = ?"}
+	args := []any{userID.String()}
+	addCondition := func(condition string, values ...any) {
+		conditions = append(conditions, condition)
+		args = append(args, values...)
+	}
+	if criteria.ExerciseName != nil {
+		addCondition("e.name = ?", *criteria.ExerciseName)
+	}
+	if criteria.MinWeightKg != nil {
+		addCondition("s.weight_kg >= ?", *criteria.MinWeightKg)
+	}
+	if criteria.MaxWeightKg != nil {
+		addCondition("s.weight_kg <= ?", *criteria.MaxWeightKg)
+	}
+	if criteria.MinReps != nil {
+		addCondition("s.reps >= ?", *criteria.MinReps)
+	}
+	if criteria.MaxReps != nil {
+		addCondition("s.reps <= ?", *criteria.MaxReps)
+	}
+	if criteria.MinRPE != nil {
+		addCondition("s.rpe >= ?", *criteria.MinRPE)
+	}
+	if criteria.MaxRPE != nil {
+		addCondition("s.rpe <= ?", *criteria.MaxRPE)
+	}
+	if criteria.NotesContains != nil {
+		addCondition(`st.notes LIKE ? ESCAPE '\'`, "%"+escapeLike(*criteria.NotesContains)+"%")
+	}
+	if len(criteria.Weekdays) > 0 {
+		placeholders := make([]string, len(criteria.Weekdays))
+		for i, weekday := range criteria.Weekdays {
+			placeholders[i] = "?"
+			args = append(args, int(weekday))
+		}
+		conditions = append(conditions, fmt.Sprintf("CAST(strftime('%%w', st.local_date) AS INTEGER) IN (%s)", strings.Join(placeholders, ",")))
+	}
+	if criteria.StartDate != nil {
+		addCondition("st.local_date >= ?", s.calendar.DateOf(*criteria.StartDate))
+	}
+	if criteria.EndDate != nil {
+		addCondition("st.local_date <= ?", s.calendar.DateOf(*criteria.EndDate))
+	}
+
+	// 前のページの続きから取得する
+	pageCondition := "1 = 1"
+	if criteria.Cursor != "" {
+		values, err := decodeSearchCursor(criteria.Cursor, criteria.Sort, len(keys))
+		if err != nil {
+			return nil, err
+		}
+		condition, cursorArgs := keysetCondition(keys, values)
+		pageCondition = condition
+		args = append(args, cursorArgs...)
+	}
+
+	orders := make([]string, len(keys))
+	for i, key := range keys {
+		orders[i] = key.column + " ASC"
+		if key.desc {
+			orders[i] = key.column + " DESC"
+		}
+	}
+
+	// 続きの有無を判定するため1件多く取得する
+	args = append(args, criteria.Limit+1)
+	query := fmt.Sprintf(`
+		WITH matched AS (
+			SELECT st.id AS id, CAST(st.date AS TEXT) AS date, COALESCE(st.notes, '') AS notes,
+				MAX(s.weight_kg) AS max_weight, ROUND(SUM(s.weight_kg * COALESCE(s.reps, 0)), 3) AS volume
+			FROM strength_trainings st
+			JOIN exercises e ON e.training_id = st.id
+			JOIN sets s ON s.exercise_id = e.id
+			WHERE %s
+			GROUP BY st.id
+		)
+		SELECT id, date, notes, max_weight, volume
+		FROM matched
+		WHERE %s
+		ORDER BY %s
+		LIMIT ?`, strings.Join(conditions, " AND "), pageCondition, strings.Join(orders, ", "))
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search trainings: %w", err)
+	}
+	defer rows.Close()
+
+	type matchedRow struct {
+		id        string
+		date      string
+		notes     string
+		maxWeight float64
+		volume    float64
+	}
+	var matched []matchedRow
+	for rows.Next() {
+		var row matchedRow
+		if err := rows.Scan(&row.id, &row.date, &row.notes, &row.maxWeight, &row.volume); err != nil {
+			return nil, fmt.Errorf("failed to scan training: %w", err)
+		}
+		matched = append(matched, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate trainings: %w", err)
+	}
+
+	result := &dto.TrainingSearchQueryResult{Trainings: []*strength.StrengthTraining{}}
+	if len(matched) > criteria.Limit {
+		matched = matched[:criteria.Limit]
+		last := matched[len(matched)-1]
+		columns := map[string]any{"id": last.id, "date": last.date, "max_weight": last.maxWeight, "volume": last.volume}
+		values := make([]any, len(keys))
+		for i, key := range keys {
+			values[i] = columns[key.column]
+		}
+		result.NextCursor, err = encodeSearchCursor(criteria.Sort, values)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(matched) == 0 {
+		return result, nil
+	}
+
+	// 一括でエクササイズを取得し、並び順のままセッションを組み立てる
+	trainingIDs := make([]string, len(matched))
+	for i, row := range matched {
+		trainingIDs[i] = row.id
+	}
+	exercisesByTraining, err := s.findExercisesByTrainingIDs(ctx, trainingIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load exercises: %w", err)
+	}
+	for _, row := range matched {
+		id, err := shared.NewTrainingIDFromString(row.id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid training ID: %w", err)
+		}
+		training := strength.NewStrengthTraining(id, s.parseLocalDateTime(row.date), row.notes)
+		for _, exercise := range exercisesByTraining[row.id] {
+			training.AddExercise(exercise)
+		}
+		result.Trainings = append(result.Trainings, training)
+	}
+
+	return result, nil
+}
+
+// keysetCondition はカーソルの値より後に並ぶ行の条件を作成します
+// 例: (date < ? OR (date = ? AND id < ?))
+func keysetCondition(keys []searchSortKey, values []any) (string, []any) {
+	key := keys[0]
+	operator := ">"
+	if key.desc {
+		operator = "<"
+	}
+	if len(keys) == 1 {
+		return fmt.Sprintf("%s %s ?", key.column, operator), []any{values[0]}
+	}
+	rest, restArgs := keysetCondition(keys[1:], values[1:])
+	return fmt.Sprintf("(%s %s ? OR (%s = ? AND %s))", key.column, operator, key.column, rest), append([]any{values[0], values[0]}, restArgs...)
+}
+
+// encodeSearchCursor はカーソルを文字列に変換します
+func encodeSearchCursor(sort string, values []any) (string, error) {
+	data, err := json.Marshal(searchCursor{Sort: sort, Values: values})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeSearchCursor はカーソルを並び順のキーの値に変換します（並び順が異なるカーソルはエラー）
+func decodeSearchCursor(value, sort string, keyCount int) ([]any, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	var cursor searchCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	if cursor.Sort != sort {
+		return nil, fmt.Errorf("cursor was issued for sort %s, not %s", cursor.Sort, sort)
+	}
+	if len(cursor.Values) != keyCount {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	return cursor.Values, nil
+}
+
+// escapeLike はLIKEのワイルドカード（%・_）とエスケープ文字を文字として扱うようにエスケープします
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
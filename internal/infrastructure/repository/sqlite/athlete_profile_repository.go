@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"fitness-mcp-server/internal/application/auth"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/interface/repository"
+)
+
+// AthleteProfileRepository はSQLiteを使ったアスリートプロファイルRepository実装（書き込み専用）
+type AthleteProfileRepository struct {
+	db *sql.DB
+}
+
+// NewAthleteProfileRepository は新しいSQLite AthleteProfileRepositoryを作成します
+func NewAthleteProfileRepository(db *sql.DB) repository.AthleteProfileRepository {
+	return &AthleteProfileRepository{db: db}
+}
+
+// Save はコンテキストのユーザーのアスリートプロファイルを保存します（プロファイルはユーザーごとに1行のみ）
+func (r *AthleteProfileRepository) Save(ctx context.Context, profile *running.AthleteProfile) error {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	slog.DebugContext(ctx, "saving athlete profile")
+
+	var thresholdPace *float64
+	if profile.ThresholdPace() != nil {
+		seconds := profile.ThresholdPace().SecondsPerKm()
+		thresholdPace = &seconds
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO athlete_profile (
+			user_id, max_hr, resting_hr, lthr, vdot, threshold_pace_seconds_per_km, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(user_id) DO UPDATE SET
+			max_hr = excluded.max_hr,
+			resting_hr = excluded.resting_hr,
+			lthr = excluded.lthr,
+			vdot = excluded.vdot,
+			threshold_pace_seconds_per_km = excluded.threshold_pace_seconds_per_km,
+			updated_at = excluded.updated_at`,
+		userID.String(),
+		heartRateBPM(profile.MaxHeartRate()),
+		heartRateBPM(profile.RestingHeartRate()),
+		heartRateBPM(profile.ThresholdHeartRate()),
+		profile.VDOT(),
+		thresholdPace,
+		profile.UpdatedAt(),
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save athlete profile", "error", err)
+		return fmt.Errorf("failed to save athlete profile: %w", err)
+	}
+
+	slog.DebugContext(ctx, "saved athlete profile")
+	return nil
+}
+
+// heartRateBPM はオプションの心拍数をbpmに変換します（nilの場合はNULL）
+func heartRateBPM(heartRate *running.HeartRate) *int {
+	if heartRate == nil {
+		return nil
+	}
+	bpm := heartRate.BPM()
+	return &bpm
+}
+
+// コンパイル時のインターフェース実装チェック
+var _ repository.AthleteProfileRepository = (*AthleteProfileRepository)(nil)
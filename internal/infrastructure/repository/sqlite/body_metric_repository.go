@@ -0,0 +1,80 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"fitness-mcp-server/internal/application/auth"
+	"fitness-mcp-server/internal/domain/body"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/repository"
+)
+
+// BodyMetricRepository はSQLiteを使った身体測定値Repository実装（書き込み専用）
+// 日時はUTCで、カレンダーのタイムゾーンでの日付をローカル日付として併せて保存します
+type BodyMetricRepository struct {
+	db       *sql.DB
+	calendar shared.Calendar
+}
+
+// NewBodyMetricRepository は新しいSQLite BodyMetricRepositoryを作成します
+func NewBodyMetricRepository(db *sql.DB, calendar shared.Calendar) repository.BodyMetricRepository {
+	return &BodyMetricRepository{db: db, calendar: calendar}
+}
+
+// Save は身体測定値をコンテキストのユーザーのものとして保存します
+func (r *BodyMetricRepository) Save(ctx context.Context, metric *body.Metric) error {
+	return r.SaveAll(ctx, []*body.Metric{metric})
+}
+
+// SaveAll は複数の身体測定値を1つのトランザクションで保存します
+// いずれかの保存に失敗した場合は全てロールバックします
+func (r *BodyMetricRepository) SaveAll(ctx context.Context, metrics []*body.Metric) error {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, metric := range metrics {
+		slog.DebugContext(ctx, "saving body metric", "metric_id", metric.ID().String())
+
+		var bodyFat *float64
+		if metric.BodyFat() != nil {
+			percent := metric.BodyFat().Percent()
+			bodyFat = &percent
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO body_metrics (
+				id, user_id, date, local_date, weight_kg, body_fat_percent, notes
+			) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			metric.ID().String(),
+			userID.String(),
+			storedDate(metric.Date()),
+			r.calendar.DateOf(metric.Date()),
+			metric.Weight().Kg(),
+			bodyFat,
+			metric.Notes(),
+		)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to save body metric", "metric_id", metric.ID().String(), "error", err)
+			return fmt.Errorf("failed to save body metric %s: %w", metric.ID().String(), err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit body metrics: %w", err)
+	}
+	return nil
+}
+
+// コンパイル時のインターフェース実装チェック
+var _ repository.BodyMetricRepository = (*BodyMetricRepository)(nil)
@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/domain/body"
+	"fitness-mcp-server/internal/domain/shared"
+	sqlite_query "fitness-mcp-server/internal/infrastructure/query/sqlite"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestMetric は指定した日時・体重の身体測定値を作成します
+func newTestMetric(t *testing.T, date time.Time, kg float64) *body.Metric {
+	t.Helper()
+	weight, _ := body.NewWeight(kg)
+	metric, err := body.NewMetric(shared.NewBodyMetricID(), date, weight, "")
+	if err != nil {
+		t.Fatalf("invalid body metric: %v", err)
+	}
+	return metric
+}
+
+func TestBodyMetricRepository(t *testing.T) {
+	date := time.Date(2025, 6, 1, 7, 0, 0, 0, time.UTC)
+
+	t.Run("正常系:期間内の身体測定値を体脂肪率と併せて測定日時順に取得する", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		repo := NewBodyMetricRepository(db, shared.Calendar{})
+		later := newTestMetric(t, date.AddDate(0, 0, 2), 68.4)
+		bodyFat, _ := body.NewBodyFat(15.2)
+		later.SetBodyFat(bodyFat)
+		metrics := []*body.Metric{
+			later,
+			newTestMetric(t, date, 68.9),
+			newTestMetric(t, date.AddDate(0, 0, 10), 68.0),
+		}
+
+		// Act
+		saveErr := repo.SaveAll(ctx, metrics)
+		found, findErr := sqlite_query.NewBodyMetricQueryService(db, shared.Calendar{}).
+			FindByDateRange(ctx, date, date.AddDate(0, 0, 7))
+
+		// Assert
+		assert.NoError(t, saveErr)
+		if !assert.NoError(t, findErr) || !assert.Len(t, found, 2) {
+			return
+		}
+		assert.Equal(t, 68.9, found[0].Weight().Kg())
+		assert.Nil(t, found[0].BodyFat())
+		assert.Equal(t, 68.4, found[1].Weight().Kg())
+		if assert.NotNil(t, found[1].BodyFat()) {
+			assert.Equal(t, 15.2, found[1].BodyFat().Percent())
+		}
+	})
+
+	t.Run("異常系:後の測定値の保存に失敗した場合は先に保存した測定値も残さない", func(t *testing.T) {
+		// Arrange: 2件目は1件目と同じIDのため主キーの制約で保存に失敗する
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		repo := NewBodyMetricRepository(db, shared.Calendar{})
+		first := newTestMetric(t, date, 68.9)
+		weight, _ := body.NewWeight(68.4)
+		duplicate, _ := body.NewMetric(first.ID(), date.AddDate(0, 0, 1), weight, "")
+
+		// Act
+		err := repo.SaveAll(ctx, []*body.Metric{first, duplicate})
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, 0, countRows(t, db, "body_metrics"))
+	})
+}
@@ -0,0 +1,12 @@
+package sqlite
+
+import "time"
+
+// storedDateLayout は日時を保存する形式です（UTC。小数秒は必要な場合のみ付きます）
+// SQLiteの日付関数で扱え、文字列の順序が日時の順序と一致します
+const storedDateLayout = "2006-01-02 15:04:05.999999999"
+
+// storedDate は日時を保存用のUTCの文字列に変換します
+func storedDate(t time.Time) string {
+	return t.UTC().Format(storedDateLayout)
+}
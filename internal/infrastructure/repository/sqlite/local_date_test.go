@@ -0,0 +1,105 @@
+package sqlite
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	sqlite_query "fitness-mcp-server/internal/infrastructure/query/sqlite"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// loadCalendar はテスト用にタイムゾーンのカレンダーを作成します
+func loadCalendar(t *testing.T, name string) shared.Calendar {
+	t.Helper()
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("timezone %s is not available: %v", name, err)
+	}
+	return shared.NewCalendar(location)
+}
+
+// storedDates は保存された日時とローカル日付を返します
+func storedDates(t *testing.T, db *sql.DB, table, id string) (string, string) {
+	t.Helper()
+	var date, localDate string
+	assert.NoError(t, db.QueryRow(`SELECT CAST(date AS TEXT), local_date FROM `+table+` WHERE id = ?`, id).Scan(&date, &localDate))
+	return date, localDate
+}
+
+func TestLocalDate(t *testing.T) {
+	t.Run("正常系:日本時間の日付の境界の前後のセッションをそれぞれのローカル日付で検索できる", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		calendar := loadCalendar(t, "Asia/Tokyo")
+		repo := NewStrengthTrainingRepository(db, calendar)
+		lateNight := newBenchPressTraining(t, time.Date(2025, 1, 15, 23, 30, 0, 0, calendar.Location()), 100)
+		earlyMorning := newBenchPressTraining(t, time.Date(2025, 1, 16, 0, 30, 0, 0, calendar.Location()), 100) // UTCでは前日
+		assert.NoError(t, repo.Save(ctx, lateNight))
+		assert.NoError(t, repo.Save(ctx, earlyMorning))
+		queryService := sqlite_query.NewStrengthQueryService(db, calendar)
+		day, _ := calendar.ParseDate("2025-01-15")
+		nextDay, _ := calendar.ParseDate("2025-01-16")
+
+		// Act
+		sameDay, sameDayErr := queryService.FindByDate(ctx, day)
+		following, followingErr := queryService.FindByDate(ctx, nextDay)
+		inRange, rangeErr := queryService.FindByDateRange(ctx, day, nextDay)
+
+		// Assert
+		date, localDate := storedDates(t, db, "strength_trainings", earlyMorning.ID().String())
+		assert.Equal(t, "2025-01-15 15:30:00", date)
+		assert.Equal(t, "2025-01-16", localDate)
+		assert.NoError(t, rangeErr)
+		assert.Len(t, inRange, 2)
+		if assert.NoError(t, followingErr) && assert.Len(t, following, 1) {
+			assert.Equal(t, earlyMorning.ID(), following[0].ID())
+		}
+		if assert.NoError(t, sameDayErr) && assert.Len(t, sameDay, 1) {
+			assert.Equal(t, lateNight.ID(), sameDay[0].ID())
+			assert.True(t, lateNight.Date().Equal(sameDay[0].Date()))
+			assert.Equal(t, calendar.Location(), sameDay[0].Date().Location())
+		}
+	})
+
+	t.Run("正常系:夏時間に切り替わる日のセッションを0時から24時までその日に含め、週の集計も月曜始まりのローカル日付で行う", func(t *testing.T) {
+		// Arrange: 2025-03-09（日）はニューヨークで夏時間に切り替わり、1日が23時間になる
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		calendar := loadCalendar(t, "America/New_York")
+		repo := NewRunningRepository(db, calendar)
+		distance, _ := running.NewDistance(5)
+		duration, _ := running.NewDuration(30 * time.Minute)
+		var ids []string
+		for _, at := range []time.Time{
+			time.Date(2025, 3, 9, 0, 30, 0, 0, calendar.Location()),  // 切り替え前（EST）
+			time.Date(2025, 3, 9, 23, 30, 0, 0, calendar.Location()), // 切り替え後（EDT、UTCでは翌日）
+		} {
+			session, err := running.NewRunningSession(shared.NewSessionID(), at, distance, duration, running.Easy, "")
+			assert.NoError(t, err)
+			assert.NoError(t, repo.Save(ctx, session))
+			ids = append(ids, session.ID().String())
+		}
+		day, _ := calendar.ParseDate("2025-03-09")
+
+		// Act
+		sessions, err := sqlite_query.NewRunningQueryService(db, calendar).FindByDateRange(ctx, day, day)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, sessions, 2)
+		date, localDate := storedDates(t, db, "running_sessions", ids[1])
+		assert.Equal(t, "2025-03-10 03:30:00", date)
+		assert.Equal(t, "2025-03-09", localDate)
+
+		var weekStart string
+		var totalRuns int
+		assert.NoError(t, db.QueryRow(`SELECT week_start, total_runs FROM running_weekly_stats`).Scan(&weekStart, &totalRuns))
+		assert.Equal(t, "2025-03-03", weekStart)
+		assert.Equal(t, 2, totalRuns)
+	})
+}
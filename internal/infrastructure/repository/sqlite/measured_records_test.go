@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/strength"
+	sqlite_query "fitness-mcp-server/internal/infrastructure/query/sqlite"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// findRecord はエクササイズ名の自己ベストを返します（見つからない場合はnil）
+func findRecord(records []dto.PersonalRecordQueryResult, exerciseName string) *dto.PersonalRecordQueryResult {
+	for i := range records {
+		if records[i].ExerciseName == exerciseName {
+			return &records[i]
+		}
+	}
+	return nil
+}
+
+func TestMeasuredRecords(t *testing.T) {
+	// Arrange
+	db := openMigratedDB(t)
+	ctx := userContext(t, db, "alice")
+	repo := NewStrengthTrainingRepository(db, shared.Calendar{})
+	day := func(d int) time.Time { return time.Date(2025, 6, d, 9, 0, 0, 0, time.UTC) }
+	seconds := func(value int) *strength.Duration {
+		duration, _ := strength.NewDuration(value)
+		return &duration
+	}
+	meters := func(value float64) *strength.Distance {
+		distance, _ := strength.NewDistance(value)
+		return &distance
+	}
+	for _, training := range []*strength.StrengthTraining{
+		newMeasuredTraining(t, day(1), "プランク", 0, seconds(60), nil),
+		newMeasuredTraining(t, day(2), "プランク", 0, seconds(90), nil),
+		newMeasuredTraining(t, day(3), "プランク", 10, seconds(90), nil), // 同じ時間なら重い方を記録とする
+		newMeasuredTraining(t, day(1), "ファーマーズウォーク", 60, nil, meters(40)),
+		newMeasuredTraining(t, day(2), "ファーマーズウォーク", 80, nil, meters(20)),
+		newMeasuredTraining(t, day(3), "ファーマーズウォーク", 70, nil, meters(40)),
+		newBenchPressTraining(t, day(3), 100),
+	} {
+		assert.NoError(t, repo.Save(ctx, training))
+	}
+	queryService := sqlite_query.NewStrengthQueryService(db, shared.Calendar{})
+
+	t.Run("正常系:時間ベースのセットは最長保持時間、距離ベースのセットは最大重量キャリーを記録とする", func(t *testing.T) {
+		// Act
+		records, err := queryService.GetPersonalRecords(ctx, nil, nil)
+
+		// Assert
+		if !assert.NoError(t, err) {
+			return
+		}
+		plank := findRecord(records, "プランク")
+		if assert.NotNil(t, plank) && assert.NotNil(t, plank.LongestHold) {
+			assert.Equal(t, 90.0, plank.LongestHold.Value)
+			assert.Equal(t, "2025-06-03", plank.LongestHold.Date.Format(shared.DateLayout))
+			assert.Equal(t, 10.0, plank.LongestHold.SetDetails.WeightKg)
+			assert.Nil(t, plank.HeaviestCarry)
+		}
+		carry := findRecord(records, "ファーマーズウォーク")
+		if assert.NotNil(t, carry) && assert.NotNil(t, carry.HeaviestCarry) {
+			assert.Equal(t, 80.0, carry.HeaviestCarry.Value)
+			assert.Equal(t, 20.0, *carry.HeaviestCarry.SetDetails.DistanceMeters)
+			assert.Nil(t, carry.LongestHold)
+		}
+		bench := findRecord(records, "ベンチプレス")
+		if assert.NotNil(t, bench) {
+			assert.Nil(t, bench.LongestHold)
+			assert.Nil(t, bench.HeaviestCarry)
+		}
+	})
+
+	t.Run("正常系:キャリーの距離を指定した場合は距離未満のキャリーを最大重量キャリーの対象にしない", func(t *testing.T) {
+		// Arrange
+		name, minimum, tooFar := "ファーマーズウォーク", 30.0, 50.0
+
+		// Act
+		records, err := queryService.GetPersonalRecords(ctx, &name, &minimum)
+		farRecords, farErr := queryService.GetPersonalRecords(ctx, &name, &tooFar)
+
+		// Assert
+		if assert.NoError(t, err) && assert.Len(t, records, 1) && assert.NotNil(t, records[0].HeaviestCarry) {
+			assert.Equal(t, 70.0, records[0].HeaviestCarry.Value)
+			assert.Equal(t, 40.0, *records[0].HeaviestCarry.SetDetails.DistanceMeters)
+			assert.Equal(t, "2025-06-03", records[0].HeaviestCarry.Date.Format(shared.DateLayout))
+		}
+		if assert.NoError(t, farErr) && assert.Len(t, farRecords, 1) {
+			assert.Nil(t, farRecords[0].HeaviestCarry)
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"fitness-mcp-server/internal/application/auth"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/strength"
+	"fitness-mcp-server/internal/interface/repository"
+)
+
+// PersonalRecordRepository はSQLiteを使ったPRイベントログRepository実装
+// 日時はUTCで、カレンダーのタイムゾーンでの日付をローカル日付として併せて保存します
+type PersonalRecordRepository struct {
+	db       *sql.DB
+	calendar shared.Calendar
+}
+
+// NewPersonalRecordRepository は新しいSQLite PersonalRecordRepositoryを作成します
+func NewPersonalRecordRepository(db *sql.DB, calendar shared.Calendar) repository.PersonalRecordRepository {
+	return &PersonalRecordRepository{db: db, calendar: calendar}
+}
+
+// LoadRecordBook はコンテキストのユーザーの保存済みPRイベントから現在の自己ベストを復元します
+func (r *PersonalRecordRepository) LoadRecordBook(ctx context.Context) (*strength.RecordBook, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT exercise_name, record_type, reps, value, date
+		FROM personal_record_events
+		WHERE user_id = ?`, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load personal record events: %w", err)
+	}
+	defer rows.Close()
+
+	book := strength.NewRecordBook()
+	for rows.Next() {
+		var name, recordTypeStr string
+		var reps int
+		var value float64
+		var date time.Time
+		if err := rows.Scan(&name, &recordTypeStr, &reps, &value, &date); err != nil {
+			return nil, fmt.Errorf("failed to scan personal record event: %w", err)
+		}
+
+		exerciseName, err := strength.NewExerciseName(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exercise name: %w", err)
+		}
+		recordType, err := strength.NewRecordType(recordTypeStr)
+		if err != nil {
+			return nil, err
+		}
+		book.Restore(exerciseName, recordType, reps, value, r.calendar.In(date))
+	}
+
+	return book, rows.Err()
+}
+
+// SaveEvents はPRイベントを追記します
+func (r *PersonalRecordRepository) SaveEvents(ctx context.Context, events []strength.PersonalRecordEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.insertEvents(ctx, tx, userID.String(), events); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ReplaceAll はコンテキストのユーザーのPRイベントログを全て置き換えます
+func (r *PersonalRecordRepository) ReplaceAll(ctx context.Context, events []strength.PersonalRecordEvent) error {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM personal_record_events WHERE user_id = ?`, userID.String()); err != nil {
+		return fmt.Errorf("failed to clear personal record events: %w", err)
+	}
+
+	if err := r.insertEvents(ctx, tx, userID.String(), events); err != nil {
+		return err
+	}
+
+	slog.DebugContext(ctx, "rebuilt personal record history", "events", len(events))
+	return tx.Commit()
+}
+
+// CountEvents はコンテキストのユーザーの保存されているPRイベント数を返します
+func (r *PersonalRecordRepository) CountEvents(ctx context.Context) (int, error) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM personal_record_events WHERE user_id = ?`, userID.String()).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count personal record events: %w", err)
+	}
+	return count, nil
+}
+
+// insertEvents はトランザクション内でPRイベントを保存します
+func (r *PersonalRecordRepository) insertEvents(ctx context.Context, tx *sql.Tx, userID string, events []strength.PersonalRecordEvent) error {
+	for _, event := range events {
+		var setWeight, setRPE *float64
+		var setReps *int
+		if set := event.Set(); set != nil {
+			weight := set.Weight().Kg()
+			reps := set.Reps().Count()
+			setWeight, setReps = &weight, &reps
+			if set.RPE() != nil {
+				rpe := set.RPE().Value()
+				setRPE = &rpe
+			}
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO personal_record_events (
+				user_id, exercise_name, record_type, reps, value, previous_value,
+				training_id, date, local_date, set_weight_kg, set_reps, set_rpe
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			userID,
+			event.ExerciseName().String(),
+			event.RecordType().String(),
+			event.Reps(),
+			event.Value(),
+			event.PreviousValue(),
+			event.TrainingID().String(),
+			storedDate(event.Date()),
+			r.calendar.DateOf(event.Date()),
+			setWeight,
+			setReps,
+			setRPE,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save personal record event: %w", err)
+		}
+	}
+	return nil
+}
+
+// コンパイル時のインターフェース実装チェック
+var _ repository.PersonalRecordRepository = (*PersonalRecordRepository)(nil)
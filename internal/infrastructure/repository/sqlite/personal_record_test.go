@@ -0,0 +1,172 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/application/command/usecase"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/strength"
+	sqlite_query "fitness-mcp-server/internal/infrastructure/query/sqlite"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// eventIDs は保存されているPRイベントのIDを保存順に返します
+func eventIDs(t *testing.T, db *sql.DB) []int64 {
+	t.Helper()
+	rows, err := db.Query(`SELECT id FROM personal_record_events ORDER BY id`)
+	if err != nil {
+		t.Fatalf("failed to query events: %v", err)
+	}
+	defer rows.Close()
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		assert.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// newTrainingUsecase はSQLiteのRepository・QueryServiceで筋トレのユースケースを作成します
+func newTrainingUsecase(db *sql.DB) *usecase.StrengthTrainingUsecaseImpl {
+	return usecase.NewStrengthTrainingUsecase(
+		NewStrengthTrainingRepository(db, shared.Calendar{}),
+		NewPersonalRecordRepository(db, shared.Calendar{}),
+		sqlite_query.NewStrengthQueryService(db, shared.Calendar{}),
+	)
+}
+
+// repMaxHistory はベンチプレスの5RMの更新履歴を（日付, 記録値, 更新前の記録値）の文字列で返します
+func repMaxHistory(t *testing.T, ctx context.Context, db *sql.DB) [][3]any {
+	t.Helper()
+	name, recordType := "ベンチプレス", string(strength.RepMaxRecord)
+	history, err := sqlite_query.NewStrengthQueryService(db, shared.Calendar{}).GetPersonalRecordHistory(ctx, &name, &recordType)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	var entries [][3]any
+	for _, event := range history {
+		var previous any
+		if event.PreviousValue != nil {
+			previous = *event.PreviousValue
+		}
+		entries = append(entries, [3]any{event.Date.Format(shared.DateLayout), event.Value, previous})
+	}
+	return entries
+}
+
+func TestPersonalRecordRepository(t *testing.T) {
+	t.Run("正常系:置き換えたPRイベントから現在の自己ベストと最後の記録日を復元できる", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		strengthRepo := NewStrengthTrainingRepository(db, shared.Calendar{})
+		trainings := []*strength.StrengthTraining{
+			newBenchPressTraining(t, time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC), 100),
+			newBenchPressTraining(t, time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC), 110),
+		}
+		assert.NoError(t, strengthRepo.SaveAll(ctx, trainings))
+		recordRepo := NewPersonalRecordRepository(db, shared.Calendar{})
+		assert.NoError(t, recordRepo.SaveEvents(ctx, strength.BuildRecordHistory(trainings[:1])))
+		events := strength.BuildRecordHistory(trainings)
+		expected := strength.NewRecordBook()
+		for _, training := range trainings {
+			expected.Apply(training)
+		}
+
+		// Act
+		replaceErr := recordRepo.ReplaceAll(ctx, events)
+		book, loadErr := recordRepo.LoadRecordBook(ctx)
+
+		// Assert
+		assert.NoError(t, replaceErr)
+		count, err := recordRepo.CountEvents(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, len(events), count)
+		if !assert.NoError(t, loadErr) {
+			return
+		}
+		name, _ := strength.NewExerciseName("ベンチプレス")
+		for _, key := range []struct {
+			recordType strength.RecordType
+			reps       int
+		}{
+			{strength.RepMaxRecord, 5},
+			{strength.EstimatedMaxRecord, 0},
+			{strength.SessionVolumeRecord, 0},
+		} {
+			expectedBest, _ := expected.Best(name, key.recordType, key.reps)
+			best, exists := book.Best(name, key.recordType, key.reps)
+			assert.True(t, exists, key.recordType)
+			assert.Equal(t, expectedBest, best, key.recordType)
+		}
+		assert.True(t, expected.LastDate().Equal(book.LastDate()))
+	})
+}
+
+func TestPersonalRecordDetection(t *testing.T) {
+	t.Run("正常系:最新の日付のセッションはPRイベントを追記する", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		trainings := newTrainingUsecase(db)
+		_, err := trainings.RecordImportedTraining(ctx, newBenchPressTraining(t, time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC), 100), false)
+		assert.NoError(t, err)
+		before := eventIDs(t, db)
+
+		// Act
+		result, err := trainings.RecordImportedTraining(ctx, newBenchPressTraining(t, time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC), 110), false)
+
+		// Assert
+		if !assert.NoError(t, err) {
+			return
+		}
+		after := eventIDs(t, db)
+		assert.Len(t, after, len(before)+len(result.NewRecords))
+		assert.Equal(t, before, after[:len(before)], "既存のイベントは置き換えずに残す")
+		for _, record := range result.NewRecords {
+			assert.NotNil(t, record.PreviousValue, record.Label)
+		}
+		assert.Equal(t, [][3]any{{"2025-06-01", 100.0, nil}, {"2025-06-10", 110.0, 100.0}}, repMaxHistory(t, ctx, db))
+	})
+
+	t.Run("正常系:過去の日付のセッションは履歴全体を再構築し、以降の更新前の記録値も日付順に付け直す", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		trainings := newTrainingUsecase(db)
+		for _, training := range []*strength.StrengthTraining{
+			newBenchPressTraining(t, time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC), 100),
+			newBenchPressTraining(t, time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC), 110),
+		} {
+			_, err := trainings.RecordImportedTraining(ctx, training, false)
+			assert.NoError(t, err)
+		}
+		before := eventIDs(t, db)
+
+		// Act
+		result, err := trainings.RecordImportedTraining(ctx, newBenchPressTraining(t, time.Date(2025, 6, 5, 9, 0, 0, 0, time.UTC), 105), false)
+
+		// Assert
+		if !assert.NoError(t, err) {
+			return
+		}
+		after := eventIDs(t, db)
+		if assert.NotEmpty(t, before) && assert.NotEmpty(t, after) {
+			assert.Greater(t, after[0], before[len(before)-1], "全てのイベントを置き換える")
+		}
+		var repMax []float64
+		for _, record := range result.NewRecords {
+			if record.RecordType == string(strength.RepMaxRecord) {
+				repMax = append(repMax, record.Value)
+				assert.Equal(t, 100.0, *record.PreviousValue)
+			}
+		}
+		assert.Equal(t, []float64{105}, repMax)
+		assert.Equal(t, [][3]any{{"2025-06-01", 100.0, nil}, {"2025-06-05", 105.0, 100.0}, {"2025-06-10", 110.0, 105.0}}, repMaxHistory(t, ctx, db))
+	})
+}
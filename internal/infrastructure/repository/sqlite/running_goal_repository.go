@@ -0,0 +1,128 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"fitness-mcp-server/internal/application/auth"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/repository"
+)
+
+// RunningGoalRepository はSQLiteを使ったランニング目標Repository実装（書き込み専用）
+// イベント日はカレンダーのタイムゾーンでのローカル日付として保存します
+type RunningGoalRepository struct {
+	db       *sql.DB
+	calendar shared.Calendar
+}
+
+// NewRunningGoalRepository は新しいSQLite RunningGoalRepositoryを作成します
+func NewRunningGoalRepository(db *sql.DB, calendar shared.Calendar) repository.RunningGoalRepository {
+	return &RunningGoalRepository{db: db, calendar: calendar}
+}
+
+// Save はランニング目標をコンテキストのユーザーのものとして保存します
+func (r *RunningGoalRepository) Save(ctx context.Context, goal *running.RunningGoal) error {
+	return r.SaveAll(ctx, []*running.RunningGoal{goal})
+}
+
+// SaveAll は複数のランニング目標を1つのトランザクションで保存します
+// いずれかの保存に失敗した場合は全てロールバックします
+func (r *RunningGoalRepository) SaveAll(ctx context.Context, goals []*running.RunningGoal) error {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, goal := range goals {
+		slog.DebugContext(ctx, "saving running goal", "goal_id", goal.ID().String())
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO running_goals (
+				id, user_id, event_type, distance_km, target_seconds, event_date,
+				status, description, created_at, achieved_at
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			goal.ID().String(),
+			userID.String(),
+			goal.EventType().String(),
+			goal.Distance().Km(),
+			int(goal.TargetTime().Seconds()),
+			r.eventDate(goal),
+			goal.Status().String(),
+			goal.Description(),
+			storedDate(goal.CreatedAt()),
+			achievedAt(goal),
+		)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to save running goal", "goal_id", goal.ID().String(), "error", err)
+			return fmt.Errorf("failed to save running goal %s: %w", goal.ID().String(), err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit running goals: %w", err)
+	}
+	return nil
+}
+
+// Update は既存のランニング目標の状態・イベント日・説明を更新します
+// 他のユーザーのランニング目標は存在しないものとして扱います
+func (r *RunningGoalRepository) Update(ctx context.Context, goal *running.RunningGoal) error {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE running_goals
+		SET event_date = ?, status = ?, description = ?, achieved_at = ?
+		WHERE id = ? AND user_id = ?`,
+		r.eventDate(goal),
+		goal.Status().String(),
+		goal.Description(),
+		achievedAt(goal),
+		goal.ID().String(),
+		userID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update running goal: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("running goal not found: %s", goal.ID().String())
+	}
+	return nil
+}
+
+// eventDate はイベント日をローカル日付に変換します（未設定の場合はNULL）
+func (r *RunningGoalRepository) eventDate(goal *running.RunningGoal) *string {
+	if goal.EventDate() == nil {
+		return nil
+	}
+	date := r.calendar.DateOf(*goal.EventDate())
+	return &date
+}
+
+// achievedAt は達成日時を保存用の文字列に変換します（未達成の場合はNULL）
+func achievedAt(goal *running.RunningGoal) *string {
+	if goal.AchievedAt() == nil {
+		return nil
+	}
+	date := storedDate(*goal.AchievedAt())
+	return &date
+}
+
+// コンパイル時のインターフェース実装チェック
+var _ repository.RunningGoalRepository = (*RunningGoalRepository)(nil)
@@ -0,0 +1,116 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	sqlite_query "fitness-mcp-server/internal/infrastructure/query/sqlite"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestGoal はイベント日付きのマラソンの目標を作成します
+func newTestGoal(t *testing.T) *running.RunningGoal {
+	t.Helper()
+	targetTime, _ := running.NewDuration(3*time.Hour + 30*time.Minute)
+	goal, err := running.NewRunningGoal(shared.NewGoalID(), running.Marathon, targetTime, "サブ3.5")
+	if err != nil {
+		t.Fatalf("invalid goal: %v", err)
+	}
+	goal.SetEventDate(time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC))
+	return goal
+}
+
+func TestRunningGoalRepository(t *testing.T) {
+	t.Run("正常系:保存した目標をイベント日・距離・状態と併せて復元する", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		repo := NewRunningGoalRepository(db, shared.Calendar{})
+		goal := newTestGoal(t)
+
+		// Act
+		saveErr := repo.Save(ctx, goal)
+		found, findErr := sqlite_query.NewRunningQueryService(db, shared.Calendar{}).FindGoalByID(ctx, goal.ID())
+
+		// Assert
+		assert.NoError(t, saveErr)
+		if !assert.NoError(t, findErr) || !assert.NotNil(t, found) {
+			return
+		}
+		assert.Equal(t, running.Marathon, found.EventType())
+		assert.Equal(t, 42.195, found.Distance().Km())
+		assert.Equal(t, "3:30:00", found.TargetTime().Clock())
+		if assert.NotNil(t, found.EventDate()) {
+			assert.Equal(t, "2025-11-30", found.EventDate().Format("2006-01-02"))
+		}
+		assert.Equal(t, running.Active, found.Status())
+		assert.Equal(t, "サブ3.5", found.Description())
+		assert.True(t, goal.CreatedAt().Equal(found.CreatedAt()))
+	})
+
+	t.Run("正常系:カスタム距離の目標の距離を復元する", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		repo := NewRunningGoalRepository(db, shared.Calendar{})
+		distance, _ := running.NewDistance(30)
+		targetTime, _ := running.NewDuration(150 * time.Minute)
+		goal, err := running.NewCustomRunningGoal(shared.NewGoalID(), distance, targetTime, "")
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		// Act
+		saveErr := repo.Save(ctx, goal)
+		goals, findErr := sqlite_query.NewRunningQueryService(db, shared.Calendar{}).FindGoals(ctx)
+
+		// Assert
+		assert.NoError(t, saveErr)
+		if !assert.NoError(t, findErr) || !assert.Len(t, goals, 1) {
+			return
+		}
+		assert.Equal(t, running.Custom, goals[0].EventType())
+		assert.Equal(t, 30.0, goals[0].Distance().Km())
+		assert.Nil(t, goals[0].EventDate())
+	})
+
+	t.Run("正常系:達成した目標の状態と達成日時を更新する", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		repo := NewRunningGoalRepository(db, shared.Calendar{})
+		goal := newTestGoal(t)
+		if !assert.NoError(t, repo.Save(ctx, goal)) {
+			return
+		}
+		goal.MarkAsAchieved()
+
+		// Act
+		updateErr := repo.Update(ctx, goal)
+		found, findErr := sqlite_query.NewRunningQueryService(db, shared.Calendar{}).FindGoalByID(ctx, goal.ID())
+
+		// Assert
+		assert.NoError(t, updateErr)
+		if !assert.NoError(t, findErr) || !assert.NotNil(t, found) {
+			return
+		}
+		assert.Equal(t, running.Achieved, found.Status())
+		assert.NotNil(t, found.AchievedAt())
+	})
+
+	t.Run("異常系:保存していない目標の更新はエラー", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		repo := NewRunningGoalRepository(db, shared.Calendar{})
+
+		// Act
+		err := repo.Update(ctx, newTestGoal(t))
+
+		// Assert
+		assert.ErrorContains(t, err, "running goal not found")
+	})
+}
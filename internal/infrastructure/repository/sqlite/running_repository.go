@@ -1,55 +1,114 @@
 package sqlite
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
 
+	"fitness-mcp-server/internal/application/auth"
 	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
 	"fitness-mcp-server/internal/interface/repository"
 )
 
 // RunningRepository はSQLiteを使ったランニングRepository実装（書き込み専用）
+// 日時はUTCで、カレンダーのタイムゾーンでの日付をローカル日付として併せて保存します
 type RunningRepository struct {
-	db *sql.DB
+	db       *sql.DB
+	calendar shared.Calendar
 }
 
 // NewRunningRepository は新しいSQLite RunningRepositoryを作成します
-func NewRunningRepository(db *sql.DB) repository.RunningRepository {
-	return &RunningRepository{db: db}
+func NewRunningRepository(db *sql.DB, calendar shared.Calendar) repository.RunningRepository {
+	return &RunningRepository{db: db, calendar: calendar}
 }
 
-// Save はランニングセッションを保存します
-func (r *RunningRepository) Save(session *running.RunningSession) error {
-	log.Printf("Saving running session: %s", session.ID().String()[:8])
+// Save はランニングセッションをラップと併せて、コンテキストのユーザーのものとして保存します
+func (r *RunningRepository) Save(ctx context.Context, session *running.RunningSession) error {
+	return r.SaveAll(ctx, []*running.RunningSession{session})
+}
+
+// SaveAll は複数のランニングセッションをラップと併せて1つのトランザクションで保存します
+// いずれかの保存に失敗した場合は全てロールバックします
+// ランニングセッションはコンテキストのユーザーのものとして保存します
+func (r *RunningRepository) SaveAll(ctx context.Context, sessions []*running.RunningSession) error {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return err
+	}
 
-	// 心拍数の処理（オプション）
-	var heartRateBPM *int
-	if session.HeartRate() != nil {
-		bpm := session.HeartRate().BPM()
-		heartRateBPM = &bpm
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
+
+	for _, session := range sessions {
+		if err := r.insertSession(ctx, tx, userID.String(), session); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit running sessions: %w", err)
+	}
+	return nil
+}
 
-	_, err := r.db.Exec(`
+// insertSession はランニングセッションとラップをトランザクション内で保存します
+func (r *RunningRepository) insertSession(ctx context.Context, tx *sql.Tx, userID string, session *running.RunningSession) error {
+	slog.DebugContext(ctx, "saving running session", "session_id", session.ID().String())
+
+	_, err := tx.ExecContext(ctx, `
 		INSERT INTO running_sessions (
-			id, date, distance_km, duration_seconds, pace_seconds_per_km, 
+			id, user_id, date, local_date, distance_km, duration_seconds, pace_seconds_per_km, 
 			heart_rate_bpm, run_type, notes
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		session.ID().String(),
-		session.Date(),
+		userID,
+		storedDate(session.Date()),
+		r.calendar.DateOf(session.Date()),
 		session.Distance().Km(),
 		int(session.Duration().Value().Seconds()),
 		session.Pace().SecondsPerKm(),
-		heartRateBPM,
+		heartRateBPM(session.HeartRate()),
 		session.RunType().String(),
 		session.Notes(),
 	)
 	if err != nil {
-		log.Printf("Failed to save running session: %v", err)
-		return fmt.Errorf("failed to save running session: %w", err)
+		slog.ErrorContext(ctx, "failed to save running session", "session_id", session.ID().String(), "error", err)
+		return fmt.Errorf("failed to save running session %s: %w", session.ID().String(), err)
+	}
+
+	for _, lap := range session.Laps() {
+		if err := r.saveLap(ctx, tx, session, lap); err != nil {
+			return err
+		}
 	}
 
-	log.Printf("Successfully saved running session: %s", session.ID().String()[:8])
+	slog.DebugContext(ctx, "saved running session", "session_id", session.ID().String(), "laps", len(session.Laps()))
+	return nil
+}
+
+// saveLap はラップを保存します
+func (r *RunningRepository) saveLap(ctx context.Context, tx *sql.Tx, session *running.RunningSession, lap *running.Lap) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO running_laps (
+			session_id, lap_number, distance_km, duration_seconds,
+			pace_seconds_per_km, heart_rate_bpm, lap_type
+		) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		session.ID().String(),
+		lap.Number(),
+		lap.Distance().Km(),
+		lap.Duration().Seconds(),
+		lap.Pace().SecondsPerKm(),
+		heartRateBPM(lap.HeartRate()),
+		lap.Type().String(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save lap %d: %w", lap.Number(), err)
+	}
 	return nil
 }
 
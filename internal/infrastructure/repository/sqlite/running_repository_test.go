@@ -0,0 +1,81 @@
+package sqlite
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newLappedRun は1kmのラップを含むランニングセッションを作成します
+func newLappedRun(t *testing.T, id shared.SessionID, date time.Time) *running.RunningSession {
+	t.Helper()
+	distance, _ := running.NewDistance(5)
+	duration, _ := running.NewDuration(25 * time.Minute)
+	session, err := running.NewRunningSession(id, date, distance, duration, running.Easy, "")
+	if err != nil {
+		t.Fatalf("invalid session: %v", err)
+	}
+	lapDistance, _ := running.NewDistance(1)
+	lapDuration, _ := running.NewDuration(5 * time.Minute)
+	if _, err := session.AddLap(lapDistance, lapDuration, running.WorkLap); err != nil {
+		t.Fatalf("invalid lap: %v", err)
+	}
+	return session
+}
+
+// countRows はテーブルの行数を返します
+func countRows(t *testing.T, db *sql.DB, table string) int {
+	t.Helper()
+	var count int
+	assert.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM `+table).Scan(&count))
+	return count
+}
+
+func TestRunningRepositorySaveAll(t *testing.T) {
+	date := time.Date(2025, 6, 1, 7, 0, 0, 0, time.UTC)
+
+	t.Run("正常系:複数のランニングセッションをラップと併せて保存する", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		repo := NewRunningRepository(db, shared.Calendar{})
+		sessions := []*running.RunningSession{
+			newLappedRun(t, shared.NewSessionID(), date),
+			newLappedRun(t, shared.NewSessionID(), date.AddDate(0, 0, 1)),
+		}
+
+		// Act
+		err := repo.SaveAll(ctx, sessions)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, countRows(t, db, "running_sessions"))
+		assert.Equal(t, 2, countRows(t, db, "running_laps"))
+	})
+
+	t.Run("異常系:後のセッションの保存に失敗した場合は先に保存したセッション・ラップも残さない", func(t *testing.T) {
+		// Arrange: 3件目は1件目と同じIDのため主キーの制約で保存に失敗する
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		repo := NewRunningRepository(db, shared.Calendar{})
+		first := newLappedRun(t, shared.NewSessionID(), date)
+		sessions := []*running.RunningSession{
+			first,
+			newLappedRun(t, shared.NewSessionID(), date.AddDate(0, 0, 1)),
+			newLappedRun(t, first.ID(), date.AddDate(0, 0, 2)),
+		}
+
+		// Act
+		err := repo.SaveAll(ctx, sessions)
+
+		// Assert
+		assert.ErrorContains(t, err, "failed to save running session "+first.ID().String())
+		assert.Equal(t, 0, countRows(t, db, "running_sessions"))
+		assert.Equal(t, 0, countRows(t, db, "running_laps"))
+	})
+}
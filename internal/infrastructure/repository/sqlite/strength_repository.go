@@ -1,12 +1,11 @@
 package sqlite
 
 import (
+	"context"
 	"database/sql"
-	"embed"
 	"fmt"
-	"log"
-	"time"
 
+	"fitness-mcp-server/internal/application/auth"
 	"fitness-mcp-server/internal/domain/shared"
 	"fitness-mcp-server/internal/domain/strength"
 	"fitness-mcp-server/internal/interface/repository"
@@ -14,140 +13,63 @@ import (
 	_ "modernc.org/sqlite"
 )
 
-//go:embed migrations/*.sql
-var migrationFiles embed.FS
-
 // StrengthRepository はSQLiteを使った筋トレRepository実装（書き込み専用）
+// スキーマはmigrationsパッケージで適用済みであることを前提とします
+// 日時はUTCで、カレンダーのタイムゾーンでの日付をローカル日付として併せて保存します
 type StrengthRepository struct {
-	db *sql.DB
+	db       *sql.DB
+	calendar shared.Calendar
 }
 
 // NewStrengthTrainingRepository は新しいSQLite Repositoryを作成します
-func NewStrengthTrainingRepository(db *sql.DB) (*StrengthRepository, error) {
-	repo := &StrengthRepository{db: db}
-	if err := repo.migrate(); err != nil {
-		return nil, fmt.Errorf("migration failed: %w", err)
-	}
-	return repo, nil
+func NewStrengthTrainingRepository(db *sql.DB, calendar shared.Calendar) *StrengthRepository {
+	return &StrengthRepository{db: db, calendar: calendar}
 }
 
-// NewStrengthRepository はファイルパスからSQLite Repositoryを作成します
-func NewStrengthRepository(dbPath string) (repository.StrengthTrainingRepository, error) {
-	log.Printf("Creating SQLite repository with path: %s", dbPath)
-
-	db, err := sql.Open("sqlite", dbPath)
-	if err != nil {
-		log.Printf("Failed to open SQLite database: %v", err)
-		return nil, fmt.Errorf("failed to open database: %w", err)
-	}
-
-	// 接続テスト
-	if err := db.Ping(); err != nil {
-		log.Printf("Failed to ping SQLite database: %v", err)
-		return nil, fmt.Errorf("failed to ping database: %w", err)
-	}
-
-	// SQLiteの設定
-	db.SetMaxOpenConns(10)           // 複数接続を許可
-	db.SetMaxIdleConns(2)            // アイドル接続数
-	db.SetConnMaxLifetime(time.Hour) // 接続の最大生存時間
-
-	log.Printf("SQLite database opened successfully: %s", dbPath)
-	return NewStrengthTrainingRepository(db)
+// Close はデータベース接続を閉じます
+func (r *StrengthRepository) Close() error {
+	return r.db.Close()
 }
 
-// Initialize はデータベースの初期化（テーブル作成）を行います
-func (r *StrengthRepository) Initialize() error {
-	return r.migrate()
+// Save は筋トレセッションを保存します
+func (r *StrengthRepository) Save(ctx context.Context, training *strength.StrengthTraining) error {
+	return r.SaveAll(ctx, []*strength.StrengthTraining{training})
 }
 
-// migrate はマイグレーションを実行します
-func (r *StrengthRepository) migrate() error {
-	log.Printf("Starting database migration...")
-
-	// マイグレーション状態追跡テーブルを作成
-	_, err := r.db.Exec(`
-		CREATE TABLE IF NOT EXISTS schema_migrations (
-			version TEXT PRIMARY KEY,
-			applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
-		)
-	`)
+// SaveAll は複数の筋トレセッションを1つのトランザクションで保存します
+// いずれかの保存に失敗した場合は全てロールバックします
+// 筋トレセッションはコンテキストのユーザーのものとして保存します
+func (r *StrengthRepository) SaveAll(ctx context.Context, trainings []*strength.StrengthTraining) error {
+	userID, err := auth.UserFromContext(ctx)
 	if err != nil {
-		log.Printf("Failed to create migrations table: %v", err)
-		return fmt.Errorf("failed to create migrations table: %w", err)
+		return err
 	}
 
-	// マイグレーションファイルのリスト（順序重要）
-	migrations := []struct {
-		version  string
-		filename string
-	}{
-		{"001", "migrations/001_initial_schema.sql"},
-		{"002", "migrations/002_remove_resttime_category.sql"},
-		{"003", "migrations/003_add_running_tables.sql"},
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	for _, migration := range migrations {
-		// 既に適用済みかチェック
-		var count int
-		err := r.db.QueryRow(`SELECT COUNT(*) FROM schema_migrations WHERE version = ?`, migration.version).Scan(&count)
-		if err != nil {
-			log.Printf("Failed to check migration status for %s: %v", migration.version, err)
-			return fmt.Errorf("failed to check migration status for %s: %w", migration.version, err)
-		}
-
-		if count > 0 {
-			log.Printf("Migration %s already applied, skipping", migration.version)
-			continue
-		}
-
-		log.Printf("Executing migration: %s", migration.filename)
-
-		migrationSQL, err := migrationFiles.ReadFile(migration.filename)
-		if err != nil {
-			log.Printf("Failed to read migration file %s: %v", migration.filename, err)
-			return fmt.Errorf("failed to read migration file %s: %w", migration.filename, err)
-		}
-
-		_, err = r.db.Exec(string(migrationSQL))
-		if err != nil {
-			log.Printf("Failed to execute migration %s: %v", migration.filename, err)
-			return fmt.Errorf("failed to execute migration %s: %w", migration.filename, err)
-		}
-
-		// マイグレーション完了を記録
-		_, err = r.db.Exec(`INSERT INTO schema_migrations (version) VALUES (?)`, migration.version)
-		if err != nil {
-			log.Printf("Failed to record migration completion for %s: %v", migration.version, err)
-			return fmt.Errorf("failed to record migration completion for %s: %w", migration.version, err)
+	for _, training := range trainings {
+		if err := r.insertTraining(ctx, tx, userID.String(), training); err != nil {
+			return err
 		}
-
-		log.Printf("Successfully executed migration: %s", migration.filename)
 	}
 
-	log.Printf("Database migration completed successfully")
-	return nil
-}
-
-// Close はデータベース接続を閉じます
-func (r *StrengthRepository) Close() error {
-	return r.db.Close()
+	return tx.Commit()
 }
 
-// Save は筋トレセッションを保存します
-func (r *StrengthRepository) Save(training *strength.StrengthTraining) error {
-	tx, err := r.db.Begin()
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer tx.Rollback()
-
+// insertTraining は筋トレセッションとエクササイズ・セットをトランザクション内で保存します
+func (r *StrengthRepository) insertTraining(ctx context.Context, tx *sql.Tx, userID string, training *strength.StrengthTraining) error {
 	// 筋トレセッションを保存
-	_, err = tx.Exec(`
-		INSERT INTO strength_trainings (id, date, notes) 
-		VALUES (?, ?, ?)`,
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO strength_trainings (id, user_id, date, local_date, notes) 
+		VALUES (?, ?, ?, ?, ?)`,
 		training.ID().String(),
-		training.Date(),
+		userID,
+		storedDate(training.Date()),
+		r.calendar.DateOf(training.Date()),
 		training.Notes(),
 	)
 	if err != nil {
@@ -156,58 +78,75 @@ func (r *StrengthRepository) Save(training *strength.StrengthTraining) error {
 
 	// エクササイズを保存
 	for exerciseOrder, exercise := range training.Exercises() {
-		exerciseID, err := r.saveExercise(tx, training.ID(), exercise, exerciseOrder)
+		exerciseID, err := r.saveExercise(ctx, tx, training.ID(), exercise, exerciseOrder)
 		if err != nil {
 			return fmt.Errorf("failed to save exercise: %w", err)
 		}
 
 		// セットを保存
 		for setOrder, set := range exercise.Sets() {
-			if err := r.saveSet(tx, exerciseID, set, setOrder); err != nil {
+			if err := r.saveSet(ctx, tx, exerciseID, set, setOrder); err != nil {
 				return fmt.Errorf("failed to save set: %w", err)
 			}
 		}
 	}
 
-	return tx.Commit()
+	return nil
 }
 
 // Update は既存の筋トレセッションを更新します
-func (r *StrengthRepository) Update(training *strength.StrengthTraining) error {
-	tx, err := r.db.Begin()
+// 他のユーザーの筋トレセッションは存在しないものとして扱います
+func (r *StrengthRepository) Update(ctx context.Context, training *strength.StrengthTraining) error {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
 	// 筋トレセッションを更新
-	_, err = tx.Exec(`
+	result, err := tx.ExecContext(ctx, `
 		UPDATE strength_trainings 
-		SET date = ?, notes = ?, updated_at = CURRENT_TIMESTAMP 
-		WHERE id = ?`,
-		training.Date(),
+		SET date = ?, local_date = ?, notes = ?, updated_at = CURRENT_TIMESTAMP 
+		WHERE id = ? AND user_id = ?`,
+		storedDate(training.Date()),
+		r.calendar.DateOf(training.Date()),
 		training.Notes(),
 		training.ID().String(),
+		userID.String(),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update training: %w", err)
 	}
 
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("training not found: %s", training.ID().String())
+	}
+
 	// 既存のエクササイズとセットを削除
-	_, err = tx.Exec(`DELETE FROM exercises WHERE training_id = ?`, training.ID().String())
+	_, err = tx.ExecContext(ctx, `DELETE FROM exercises WHERE training_id = ?`, training.ID().String())
 	if err != nil {
 		return fmt.Errorf("failed to delete old exercises: %w", err)
 	}
 
 	// 新しいエクササイズとセットを保存
 	for exerciseOrder, exercise := range training.Exercises() {
-		exerciseID, err := r.saveExercise(tx, training.ID(), exercise, exerciseOrder)
+		exerciseID, err := r.saveExercise(ctx, tx, training.ID(), exercise, exerciseOrder)
 		if err != nil {
 			return fmt.Errorf("failed to save exercise: %w", err)
 		}
 
 		for setOrder, set := range exercise.Sets() {
-			if err := r.saveSet(tx, exerciseID, set, setOrder); err != nil {
+			if err := r.saveSet(ctx, tx, exerciseID, set, setOrder); err != nil {
 				return fmt.Errorf("failed to save set: %w", err)
 			}
 		}
@@ -217,8 +156,14 @@ func (r *StrengthRepository) Update(training *strength.StrengthTraining) error {
 }
 
 // Delete は筋トレセッションを削除します
-func (r *StrengthRepository) Delete(id shared.TrainingID) error {
-	result, err := r.db.Exec(`DELETE FROM strength_trainings WHERE id = ?`, id.String())
+// 他のユーザーの筋トレセッションは存在しないものとして扱います
+func (r *StrengthRepository) Delete(ctx context.Context, id shared.TrainingID) error {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM strength_trainings WHERE id = ? AND user_id = ?`, id.String(), userID.String())
 	if err != nil {
 		return fmt.Errorf("failed to delete training: %w", err)
 	}
@@ -238,8 +183,8 @@ func (r *StrengthRepository) Delete(id shared.TrainingID) error {
 // プライベートヘルパーメソッド
 
 // saveExercise はエクササイズを保存し、IDを返します
-func (r *StrengthRepository) saveExercise(tx *sql.Tx, trainingID shared.TrainingID, exercise *strength.Exercise, order int) (int64, error) {
-	result, err := tx.Exec(`
+func (r *StrengthRepository) saveExercise(ctx context.Context, tx *sql.Tx, trainingID shared.TrainingID, exercise *strength.Exercise, order int) (int64, error) {
+	result, err := tx.ExecContext(ctx, `
 		INSERT INTO exercises (training_id, name, exercise_order) 
 		VALUES (?, ?, ?)`,
 		trainingID.String(),
@@ -254,23 +199,92 @@ func (r *StrengthRepository) saveExercise(tx *sql.Tx, trainingID shared.Training
 }
 
 // saveSet はセットを保存します
-func (r *StrengthRepository) saveSet(tx *sql.Tx, exerciseID int64, set strength.Set, order int) error {
-	var rpe *int
+func (r *StrengthRepository) saveSet(ctx context.Context, tx *sql.Tx, exerciseID int64, set strength.Set, order int) error {
+	var rpe *float64
 	if set.RPE() != nil {
-		rpeValue := set.RPE().Rating()
+		rpeValue := set.RPE().Value()
 		rpe = &rpeValue
 	}
 
-	_, err := tx.Exec(`
-		INSERT INTO sets (exercise_id, weight_kg, reps, rpe, set_order) 
-		VALUES (?, ?, ?, ?, ?)`,
+	// 回数・時間・距離はいずれもオプション（少なくとも1つは必須）
+	var reps *int
+	if set.HasReps() {
+		repsValue := set.Reps().Count()
+		reps = &repsValue
+	}
+
+	var durationSeconds *int
+	if set.Duration() != nil {
+		seconds := set.Duration().Seconds()
+		durationSeconds = &seconds
+	}
+
+	var distanceMeters *float64
+	if set.Distance() != nil {
+		meters := set.Distance().Meters()
+		distanceMeters = &meters
+	}
+
+	// テンポ・可動域（オプション）
+	var tempoEccentric, tempoPause, tempoConcentric, tempoTop, tempoExplosive *int
+	if tempo := set.Tempo(); tempo != nil {
+		eccentric, pause, concentric, top := tempo.Eccentric(), tempo.Pause(), tempo.Concentric(), tempo.Top()
+		tempoEccentric, tempoPause, tempoConcentric, tempoTop = &eccentric, &pause, &concentric, &top
+		if explosive := tempo.ExplosivePhases(); explosive != 0 {
+			tempoExplosive = &explosive
+		}
+	}
+
+	var rangeOfMotion *string
+	if set.RangeOfMotion() != nil {
+		rom := set.RangeOfMotion().Value()
+		rangeOfMotion = &rom
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO sets (
+			exercise_id, weight_kg, reps, duration_seconds, distance_m, rpe, set_order,
+			tempo_eccentric, tempo_pause, tempo_concentric, tempo_top, tempo_explosive, range_of_motion
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		exerciseID,
 		set.Weight().Kg(),
-		set.Reps().Count(),
+		reps,
+		durationSeconds,
+		distanceMeters,
 		rpe,
 		order,
+		tempoEccentric,
+		tempoPause,
+		tempoConcentric,
+		tempoTop,
+		tempoExplosive,
+		rangeOfMotion,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	if len(set.Variations()) == 0 {
+		return nil
+	}
+
+	setID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	// バリエーションタグを保存
+	for _, variation := range set.Variations() {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO set_variations (set_id, variation) 
+			VALUES (?, ?)`,
+			setID,
+			variation.Tag(),
+		); err != nil {
+			return fmt.Errorf("failed to save set variation: %w", err)
+		}
+	}
+	return nil
 }
 
 // コンパイル時のインターフェース実装チェック
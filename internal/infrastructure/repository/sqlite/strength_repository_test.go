@@ -0,0 +1,51 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/strength"
+	sqlite_query "fitness-mcp-server/internal/infrastructure/query/sqlite"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrengthTrainingRepositoryTempo(t *testing.T) {
+	t.Run("正常系:Xの局面を含むテンポを保存・復元しても表記が変わらない", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		ctx := userContext(t, db, "alice")
+		repo := NewStrengthTrainingRepository(db, shared.Calendar{})
+		name, _ := strength.NewExerciseName("ベンチプレス")
+		weight, _ := strength.NewWeight(80)
+		reps, _ := strength.NewReps(5)
+		exercise := strength.NewExercise(name)
+		for _, value := range []string{"31X0", "3-1-0-0"} {
+			tempo, err := strength.ParseTempo(value)
+			if !assert.NoError(t, err) {
+				return
+			}
+			exercise.AddSet(strength.NewSet(weight, reps, nil).WithTempo(tempo))
+		}
+		training := strength.NewStrengthTraining(shared.NewTrainingID(), time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC), "")
+		training.AddExercise(exercise)
+
+		// Act
+		saveErr := repo.Save(ctx, training)
+		found, findErr := sqlite_query.NewStrengthQueryService(db, shared.Calendar{}).FindByID(ctx, training.ID())
+
+		// Assert
+		assert.NoError(t, saveErr)
+		if !assert.NoError(t, findErr) || !assert.Len(t, found.Exercises(), 1) {
+			return
+		}
+		var tempos []string
+		for _, set := range found.Exercises()[0].Sets() {
+			if assert.NotNil(t, set.Tempo()) {
+				tempos = append(tempos, set.Tempo().String())
+			}
+		}
+		assert.Equal(t, []string{"3-1-X-0", "3-1-0-0"}, tempos)
+	})
+}
@@ -0,0 +1,150 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/application/query/dto"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/strength"
+	sqlite_query "fitness-mcp-server/internal/infrastructure/query/sqlite"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// searchDates は検索結果のセッションの日付を返します
+func searchDates(result *dto.TrainingSearchQueryResult) []string {
+	dates := make([]string, 0, len(result.Trainings))
+	for _, training := range result.Trainings {
+		dates = append(dates, training.Date().Format(shared.DateLayout))
+	}
+	return dates
+}
+
+// search はクエリを検索条件に変換して検索します
+func search(ctx context.Context, queryService *sqlite_query.StrengthQueryService, query dto.SearchTrainingsQuery) (*dto.TrainingSearchQueryResult, error) {
+	criteria, err := query.SearchCriteria()
+	if err != nil {
+		return nil, err
+	}
+	return queryService.Search(ctx, criteria)
+}
+
+// newMeasuredTraining は時間・距離ベースのセット1セットのみの筋トレセッションを作成します
+func newMeasuredTraining(t *testing.T, date time.Time, exerciseName string, weightKg float64, duration *strength.Duration, distance *strength.Distance) *strength.StrengthTraining {
+	t.Helper()
+	name, _ := strength.NewExerciseName(exerciseName)
+	weight, _ := strength.NewWeight(weightKg)
+	set, err := strength.NewMeasuredSet(weight, nil, duration, distance, nil)
+	if err != nil {
+		t.Fatalf("invalid set: %v", err)
+	}
+	exercise := strength.NewExercise(name)
+	exercise.AddSet(set)
+	training := strength.NewStrengthTraining(shared.NewTrainingID(), date, "")
+	training.AddExercise(exercise)
+	return training
+}
+
+func TestSearch(t *testing.T) {
+	// Arrange: 2025-06-02・2025-06-09は月曜日
+	db := openMigratedDB(t)
+	ctx := userContext(t, db, "alice")
+	repo := NewStrengthTrainingRepository(db, shared.Calendar{})
+	for _, session := range []struct {
+		date     time.Time
+		weightKg float64
+		notes    string
+	}{
+		{time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC), 100, "調子が良い"},
+		{time.Date(2025, 6, 3, 9, 0, 0, 0, time.UTC), 80, ""},
+		{time.Date(2025, 6, 5, 9, 0, 0, 0, time.UTC), 110, "100%の出力"},
+		{time.Date(2025, 6, 9, 9, 0, 0, 0, time.UTC), 60, "100kgは見送り"},
+	} {
+		training := strength.NewStrengthTraining(shared.NewTrainingID(), session.date, session.notes)
+		for _, exercise := range newBenchPressTraining(t, session.date, session.weightKg).Exercises() {
+			training.AddExercise(exercise)
+		}
+		assert.NoError(t, repo.Save(ctx, training))
+	}
+	// 回数のない時間・距離ベースのセットのみのセッション（ボリュームは0）
+	plankDuration, _ := strength.NewDuration(60)
+	carryDistance, _ := strength.NewDistance(40)
+	assert.NoError(t, repo.Save(ctx, newMeasuredTraining(t, time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC), "プランク", 0, &plankDuration, nil)))
+	assert.NoError(t, repo.Save(ctx, newMeasuredTraining(t, time.Date(2025, 6, 11, 9, 0, 0, 0, time.UTC), "ファーマーズウォーク", 40, nil, &carryDistance)))
+	queryService := sqlite_query.NewStrengthQueryService(db, shared.Calendar{})
+	minWeight, exerciseAlias, notes := 90.0, "bench press", "100%"
+
+	t.Run("正常系:別名のエクササイズ・重量・メモ・曜日で絞り込める", func(t *testing.T) {
+		// Act
+		heavy, heavyErr := search(ctx, queryService, dto.SearchTrainingsQuery{ExerciseName: &exerciseAlias, MinWeightKg: &minWeight})
+		noted, notedErr := search(ctx, queryService, dto.SearchTrainingsQuery{NotesContains: &notes})
+		mondays, mondaysErr := search(ctx, queryService, dto.SearchTrainingsQuery{Weekdays: []string{"月"}, Sort: dto.SortDateAsc})
+
+		// Assert
+		if assert.NoError(t, heavyErr) {
+			assert.Equal(t, []string{"2025-06-05", "2025-06-02"}, searchDates(heavy))
+			assert.Empty(t, heavy.NextCursor)
+		}
+		if assert.NoError(t, notedErr) {
+			assert.Equal(t, []string{"2025-06-05"}, searchDates(noted))
+		}
+		if assert.NoError(t, mondaysErr) {
+			assert.Equal(t, []string{"2025-06-02", "2025-06-09"}, searchDates(mondays))
+		}
+	})
+
+	t.Run("正常系:並び順のカーソルで続きのページを重複・欠落なく取得できる", func(t *testing.T) {
+		// Act
+		first, firstErr := search(ctx, queryService, dto.SearchTrainingsQuery{Sort: dto.SortMaxWeightDesc, Limit: 3})
+		if !assert.NoError(t, firstErr) || !assert.NotEmpty(t, first.NextCursor) {
+			return
+		}
+		second, secondErr := search(ctx, queryService, dto.SearchTrainingsQuery{Sort: dto.SortMaxWeightDesc, Limit: 3, Cursor: first.NextCursor})
+
+		// Assert
+		assert.Equal(t, []string{"2025-06-05", "2025-06-02", "2025-06-03"}, searchDates(first))
+		if assert.NoError(t, secondErr) {
+			assert.Equal(t, []string{"2025-06-09", "2025-06-11", "2025-06-10"}, searchDates(second))
+			assert.Empty(t, second.NextCursor)
+		}
+	})
+
+	t.Run("正常系:時間・距離ベースのセットのみのセッションをボリューム0として検索・ページングできる", func(t *testing.T) {
+		// Act
+		all, allErr := search(ctx, queryService, dto.SearchTrainingsQuery{})
+		first, firstErr := search(ctx, queryService, dto.SearchTrainingsQuery{Sort: dto.SortVolumeDesc, Limit: 5})
+		if !assert.NoError(t, firstErr) || !assert.NotEmpty(t, first.NextCursor) {
+			return
+		}
+		second, secondErr := search(ctx, queryService, dto.SearchTrainingsQuery{Sort: dto.SortVolumeDesc, Limit: 5, Cursor: first.NextCursor})
+
+		// Assert
+		if assert.NoError(t, allErr) {
+			assert.Equal(t, []string{"2025-06-11", "2025-06-10", "2025-06-09", "2025-06-05", "2025-06-03", "2025-06-02"}, searchDates(all))
+		}
+		// ボリュームが同じ0のセッションは新しい順に並び、カーソルがボリューム0のセッションを指しても続きを取得できる
+		assert.Equal(t, []string{"2025-06-05", "2025-06-02", "2025-06-03", "2025-06-09", "2025-06-11"}, searchDates(first))
+		if assert.NoError(t, secondErr) {
+			assert.Equal(t, []string{"2025-06-10"}, searchDates(second))
+			assert.Empty(t, second.NextCursor)
+		}
+	})
+
+	t.Run("異常系:別の並び順のカーソル・不正なカーソルはエラーを返す", func(t *testing.T) {
+		// Arrange
+		first, err := search(ctx, queryService, dto.SearchTrainingsQuery{Limit: 1})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		// Act
+		_, sortErr := search(ctx, queryService, dto.SearchTrainingsQuery{Sort: dto.SortVolumeDesc, Limit: 1, Cursor: first.NextCursor})
+		_, invalidErr := search(ctx, queryService, dto.SearchTrainingsQuery{Cursor: "not-a-cursor"})
+
+		// Assert
+		assert.ErrorContains(t, sortErr, "cursor was issued for sort date_desc")
+		assert.ErrorContains(t, invalidErr, "invalid cursor")
+	})
+}
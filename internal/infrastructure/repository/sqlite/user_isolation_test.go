@@ -0,0 +1,246 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/application/auth"
+	"fitness-mcp-server/internal/config"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/domain/strength"
+	"fitness-mcp-server/internal/domain/user"
+	"fitness-mcp-server/internal/infrastructure/database"
+	"fitness-mcp-server/internal/infrastructure/migrations"
+	sqlite_query "fitness-mcp-server/internal/infrastructure/query/sqlite"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// openMigratedDB はマイグレーションを適用した一時的なデータベースを開きます
+func openMigratedDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := database.Open(config.DatabaseConfig{SQLitePath: filepath.Join(t.TempDir(), "fitness.db"), MaxOpenConns: 2})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	runner, err := migrations.NewRunner(db, nil)
+	if err != nil {
+		t.Fatalf("failed to create migration runner: %v", err)
+	}
+	if _, err := runner.Up(); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	return db
+}
+
+// userContext はユーザーを作成し、そのユーザーとしてデータを扱うコンテキストを返します
+func userContext(t *testing.T, db *sql.DB, name string) context.Context {
+	t.Helper()
+	id, err := user.NewID(name)
+	if err != nil {
+		t.Fatalf("invalid user id: %v", err)
+	}
+	if err := NewUserRepository(db).Save(context.Background(), user.NewUser(id, "", time.Now())); err != nil {
+		t.Fatalf("failed to save user: %v", err)
+	}
+	return auth.WithUser(context.Background(), id)
+}
+
+// newBenchPressTraining はベンチプレス1セットの筋トレセッションを作成します
+func newBenchPressTraining(t *testing.T, date time.Time, weightKg float64) *strength.StrengthTraining {
+	t.Helper()
+	name, _ := strength.NewExerciseName("ベンチプレス")
+	weight, _ := strength.NewWeight(weightKg)
+	reps, _ := strength.NewReps(5)
+	exercise := strength.NewExercise(name)
+	exercise.AddSet(strength.NewSet(weight, reps, nil))
+	training := strength.NewStrengthTraining(shared.NewTrainingID(), date, "")
+	training.AddExercise(exercise)
+	return training
+}
+
+func TestUserIsolation(t *testing.T) {
+	date := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	start, end := date.AddDate(0, 0, -1), date.AddDate(0, 0, 1)
+
+	t.Run("異常系:他のユーザーの筋トレセッションは参照・更新・削除できない", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		alice, bob := userContext(t, db, "alice"), userContext(t, db, "bob")
+		repo := NewStrengthTrainingRepository(db, shared.Calendar{})
+		queryService := sqlite_query.NewStrengthQueryService(db, shared.Calendar{})
+		training := newBenchPressTraining(t, date, 100)
+		assert.NoError(t, repo.Save(alice, training))
+
+		// Act
+		_, findErr := queryService.FindByID(bob, training.ID())
+		exists, existsErr := queryService.ExistsById(bob, training.ID())
+		inRange, rangeErr := queryService.FindByDateRange(bob, start, end)
+		all, allErr := queryService.FindAll(bob)
+		updateErr := repo.Update(bob, strength.NewStrengthTraining(training.ID(), date, "上書き"))
+		deleteErr := repo.Delete(bob, training.ID())
+
+		// Assert
+		assert.Error(t, findErr)
+		assert.NoError(t, existsErr)
+		assert.False(t, exists)
+		assert.NoError(t, rangeErr)
+		assert.Empty(t, inRange)
+		assert.NoError(t, allErr)
+		assert.Empty(t, all)
+		assert.ErrorContains(t, updateErr, "training not found")
+		assert.ErrorContains(t, deleteErr, "training not found")
+
+		// 所有者からは変更されずに参照できる
+		found, err := queryService.FindByID(alice, training.ID())
+		if assert.NoError(t, err) {
+			assert.Equal(t, "", found.Notes())
+			assert.Len(t, found.Exercises(), 1)
+		}
+	})
+
+	t.Run("異常系:他のユーザーの自己ベストとPR履歴は参照・削除できない", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		alice, bob := userContext(t, db, "alice"), userContext(t, db, "bob")
+		training := newBenchPressTraining(t, date, 100)
+		assert.NoError(t, NewStrengthTrainingRepository(db, shared.Calendar{}).Save(alice, training))
+		recordRepo := NewPersonalRecordRepository(db, shared.Calendar{})
+		assert.NoError(t, recordRepo.SaveEvents(alice, strength.NewRecordBook().Apply(training)))
+		queryService := sqlite_query.NewStrengthQueryService(db, shared.Calendar{})
+
+		// Act
+		replaceErr := recordRepo.ReplaceAll(bob, nil)
+		bobCount, countErr := recordRepo.CountEvents(bob)
+		bobRecords, recordsErr := queryService.GetPersonalRecords(bob, nil, nil)
+		bobHistory, historyErr := queryService.GetPersonalRecordHistory(bob, nil, nil)
+
+		// Assert
+		assert.NoError(t, replaceErr)
+		assert.NoError(t, countErr)
+		assert.Equal(t, 0, bobCount)
+		assert.NoError(t, recordsErr)
+		assert.Empty(t, bobRecords)
+		assert.NoError(t, historyErr)
+		assert.Empty(t, bobHistory)
+
+		aliceCount, err := recordRepo.CountEvents(alice)
+		assert.NoError(t, err)
+		assert.Greater(t, aliceCount, 0)
+		aliceRecords, err := queryService.GetPersonalRecords(alice, nil, nil)
+		assert.NoError(t, err)
+		if assert.Len(t, aliceRecords, 1) {
+			assert.Equal(t, 100.0, aliceRecords[0].MaxWeight.Value)
+		}
+	})
+
+	t.Run("異常系:他のユーザーのランニングセッションとプロファイルは参照できない", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		alice, bob := userContext(t, db, "alice"), userContext(t, db, "bob")
+		distance, _ := running.NewDistance(10)
+		duration, _ := running.NewDuration(50 * time.Minute)
+		session, err := running.NewRunningSession(shared.NewSessionID(), date, distance, duration, running.Easy, "")
+		assert.NoError(t, err)
+		assert.NoError(t, NewRunningRepository(db, shared.Calendar{}).Save(alice, session))
+		profile := running.NewAthleteProfile()
+		assert.NoError(t, profile.SetVDOT(50))
+		assert.NoError(t, NewAthleteProfileRepository(db).Save(alice, profile))
+		queryService := sqlite_query.NewRunningQueryService(db, shared.Calendar{})
+
+		// Act
+		sessions, sessionsErr := queryService.FindByDateRange(bob, start, end)
+		exists, existsErr := queryService.ExistsByID(bob, session.ID())
+		bobProfile, profileErr := queryService.FindAthleteProfile(bob)
+
+		// Assert
+		assert.NoError(t, sessionsErr)
+		assert.Empty(t, sessions)
+		assert.NoError(t, existsErr)
+		assert.False(t, exists)
+		assert.NoError(t, profileErr)
+		assert.Nil(t, bobProfile)
+
+		aliceSessions, err := queryService.FindByDateRange(alice, start, end)
+		assert.NoError(t, err)
+		assert.Len(t, aliceSessions, 1)
+		aliceProfile, err := queryService.FindAthleteProfile(alice)
+		if assert.NoError(t, err) && assert.NotNil(t, aliceProfile) {
+			assert.Equal(t, 50.0, *aliceProfile.VDOT())
+		}
+	})
+
+	t.Run("異常系:他のユーザーのランニング目標は参照・更新できない", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		alice, bob := userContext(t, db, "alice"), userContext(t, db, "bob")
+		repo := NewRunningGoalRepository(db, shared.Calendar{})
+		targetTime, _ := running.NewDuration(3*time.Hour + 30*time.Minute)
+		goal, err := running.NewRunningGoal(shared.NewGoalID(), running.Marathon, targetTime, "")
+		assert.NoError(t, err)
+		assert.NoError(t, repo.Save(alice, goal))
+		queryService := sqlite_query.NewRunningQueryService(db, shared.Calendar{})
+
+		// Act
+		bobGoals, goalsErr := queryService.FindGoals(bob)
+		bobGoal, goalErr := queryService.FindGoalByID(bob, goal.ID())
+		goal.MarkAsCancelled()
+		updateErr := repo.Update(bob, goal)
+
+		// Assert
+		assert.NoError(t, goalsErr)
+		assert.Empty(t, bobGoals)
+		assert.NoError(t, goalErr)
+		assert.Nil(t, bobGoal)
+		assert.ErrorContains(t, updateErr, "running goal not found")
+
+		// 所有者からは変更されずに参照できる
+		aliceGoal, err := queryService.FindGoalByID(alice, goal.ID())
+		if assert.NoError(t, err) && assert.NotNil(t, aliceGoal) {
+			assert.Equal(t, running.Active, aliceGoal.Status())
+		}
+	})
+
+	t.Run("異常系:他のユーザーの身体測定値は参照できない", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		alice, bob := userContext(t, db, "alice"), userContext(t, db, "bob")
+		metric := newTestMetric(t, date, 68.4)
+		assert.NoError(t, NewBodyMetricRepository(db, shared.Calendar{}).Save(alice, metric))
+		queryService := sqlite_query.NewBodyMetricQueryService(db, shared.Calendar{})
+
+		// Act
+		metrics, metricsErr := queryService.FindByDateRange(bob, start, end)
+		exists, existsErr := queryService.ExistsByID(bob, metric.ID())
+
+		// Assert
+		assert.NoError(t, metricsErr)
+		assert.Empty(t, metrics)
+		assert.NoError(t, existsErr)
+		assert.False(t, exists)
+
+		aliceMetrics, err := queryService.FindByDateRange(alice, start, end)
+		assert.NoError(t, err)
+		assert.Len(t, aliceMetrics, 1)
+	})
+
+	t.Run("異常系:コンテキストにユーザーがない場合は読み書きしない", func(t *testing.T) {
+		// Arrange
+		db := openMigratedDB(t)
+		repo := NewStrengthTrainingRepository(db, shared.Calendar{})
+
+		// Act
+		saveErr := repo.Save(context.Background(), newBenchPressTraining(t, date, 100))
+		_, findErr := sqlite_query.NewStrengthQueryService(db, shared.Calendar{}).FindAll(context.Background())
+
+		// Assert
+		assert.ErrorIs(t, saveErr, auth.ErrUnauthenticated)
+		assert.ErrorIs(t, findErr, auth.ErrUnauthenticated)
+	})
+}
@@ -0,0 +1,114 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"fitness-mcp-server/internal/domain/user"
+	"fitness-mcp-server/internal/interface/repository"
+)
+
+// UserRepository はSQLiteを使ったユーザーRepository実装
+type UserRepository struct {
+	db *sql.DB
+}
+
+// NewUserRepository は新しいSQLite UserRepositoryを作成します
+func NewUserRepository(db *sql.DB) repository.UserRepository {
+	return &UserRepository{db: db}
+}
+
+// Save はユーザーを保存します（既存のユーザーはトークンを更新します）
+func (r *UserRepository) Save(ctx context.Context, u *user.User) error {
+	var tokenHash *string
+	if u.HasToken() {
+		hash := u.TokenHash()
+		tokenHash = &hash
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO users (id, token_hash, created_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			token_hash = excluded.token_hash`,
+		u.ID().String(),
+		tokenHash,
+		u.CreatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save user: %w", err)
+	}
+	return nil
+}
+
+// FindByID はIDでユーザーを検索します（存在しない場合はnil）
+func (r *UserRepository) FindByID(ctx context.Context, id user.ID) (*user.User, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT id, token_hash, created_at
+		FROM users
+		WHERE id = ?`, id.String())
+	return scanUser(row)
+}
+
+// FindByTokenHash はトークンのハッシュでユーザーを検索します（存在しない場合はnil）
+func (r *UserRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*user.User, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT id, token_hash, created_at
+		FROM users
+		WHERE token_hash = ?`, tokenHash)
+	return scanUser(row)
+}
+
+// FindAll は全てのユーザーを作成日時順に返します
+func (r *UserRepository) FindAll(ctx context.Context) ([]*user.User, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, token_hash, created_at
+		FROM users
+		ORDER BY created_at, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*user.User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+
+	return users, rows.Err()
+}
+
+// userScanner は*sql.Rowと*sql.Rowsの共通インターフェース
+type userScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanUser は1行分のユーザーをドメインモデルに変換します（行がない場合はnil）
+func scanUser(row userScanner) (*user.User, error) {
+	var idStr string
+	var tokenHash sql.NullString
+	var createdAt time.Time
+
+	if err := row.Scan(&idStr, &tokenHash, &createdAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to scan user: %w", err)
+	}
+
+	id, err := user.NewID(idStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return user.NewUser(id, tokenHash.String, createdAt), nil
+}
+
+// コンパイル時のインターフェース実装チェック
+var _ repository.UserRepository = (*UserRepository)(nil)
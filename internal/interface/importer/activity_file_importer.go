@@ -0,0 +1,32 @@
+package importer
+
+import (
+	"time"
+
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/strength"
+)
+
+// ImportedActivity はFITファイルから取り込んだアクティビティの解析結果（RunとStrengthのいずれか一方）
+type ImportedActivity struct {
+	Sport    string            // デバイスが記録した種目（running / training 等）
+	Run      *ImportedRun      // ランの場合の解析結果
+	Strength *ImportedStrength // 筋トレの場合の解析結果
+	Warnings []string          // 取り込めなかったラップ・セット等の警告
+}
+
+// ImportedStrength はFITファイルから取り込んだ筋トレの解析結果
+type ImportedStrength struct {
+	Training    *strength.StrengthTraining // セットメッセージから作成したトレーニング
+	ElapsedTime time.Duration              // 開始から終了までの経過時間
+	RestSets    int                        // 取り込まなかった休息セットの数
+}
+
+// ActivityFileImporter はデバイスのアクティビティファイルからランまたは筋トレを作成するインターフェース
+type ActivityFileImporter interface {
+	// ParseFile はローカルのファイルを解析します
+	ParseFile(path string, runType running.RunType, notes string) (*ImportedActivity, error)
+
+	// Parse はファイルの内容を解析します
+	Parse(data []byte, runType running.RunType, notes string) (*ImportedActivity, error)
+}
@@ -0,0 +1,26 @@
+package importer
+
+import (
+	"time"
+
+	"fitness-mcp-server/internal/domain/running"
+)
+
+// ImportedRun はGPX・TCX・FITファイルから取り込んだランの解析結果
+type ImportedRun struct {
+	Format         string                  // ファイル形式（GPX / TCX / FIT）
+	Session        *running.RunningSession // ラップ（GPX・TCXは1kmごとのスプリット、FITはデバイスのラップ）を持つセッション
+	ElapsedTime    time.Duration           // 開始から終了までの経過時間
+	MovingTime     time.Duration           // 停止中を除いた移動時間（セッションの時間）
+	ElevationGainM float64                 // 累積標高（獲得標高、m）
+	PointCount     int                     // トラックポイント数
+}
+
+// RunFileImporter はGPS記録ファイルからランニングセッションを作成するインターフェース
+type RunFileImporter interface {
+	// ParseFile はローカルのファイルを解析します
+	ParseFile(path string, runType running.RunType, notes string) (*ImportedRun, error)
+
+	// Parse はファイルの内容を解析します（形式は内容から判定します）
+	Parse(data []byte, runType running.RunType, notes string) (*ImportedRun, error)
+}
@@ -0,0 +1,49 @@
+package importer
+
+import (
+	"time"
+
+	"fitness-mcp-server/internal/domain/strength"
+)
+
+// StrengthCSVOptions は筋トレCSVの解析オプション
+type StrengthCSVOptions struct {
+	Format     string                          // アプリ（Strong / Hevy / FitNotes、空の場合はヘッダーから判定）
+	WeightUnit string                          // ファイルに重量の単位がない場合の単位（kg / lbs、空の場合はkg）
+	Aliases    *strength.ExerciseAliasResolver // エクササイズ名の対応付け
+	Location   *time.Location                  // 日時にタイムゾーンがない場合のタイムゾーン（nilの場合はUTC）
+}
+
+// SkippedCSVRow は取り込まなかったCSVの行
+type SkippedCSVRow struct {
+	Line   int    // ファイル上の行番号（ヘッダーは1行目）
+	Reason string // 取り込まなかった理由
+}
+
+// SkippedCSVSession は有効なセットがなく取り込まなかったセッション
+type SkippedCSVSession struct {
+	Date    time.Time // セッションの開始日時
+	Workout string    // ワークアウト名
+	Reason  string    // 取り込まなかった理由
+}
+
+// ImportedStrengthCSV は筋トレCSVの解析結果
+type ImportedStrengthCSV struct {
+	Format          string                       // アプリ（Strong / Hevy / FitNotes）
+	WeightUnit      string                       // ファイルの重量の単位（kg / lbs）
+	UnitSource      string                       // 単位の判定方法（ヘッダー / 列 / 指定 / 既定）
+	RowCount        int                          // データ行数
+	Trainings       []*strength.StrengthTraining // 日時・ワークアウト名ごとにまとめたセッション（日時順）
+	SkippedSessions []SkippedCSVSession          // 取り込まなかったセッション
+	SkippedRows     []SkippedCSVRow              // 取り込まなかった行（ウォームアップ・不正な値等）
+	ExerciseMapping map[string]string            // 名前を対応付けたエクササイズ（元の名前→エクササイズ名）
+}
+
+// StrengthCSVImporter は他アプリの筋トレ記録のCSVエクスポートからトレーニングを作成するインターフェース
+type StrengthCSVImporter interface {
+	// ParseFile はローカルのファイルを解析します
+	ParseFile(path string, options StrengthCSVOptions) (*ImportedStrengthCSV, error)
+
+	// Parse はファイルの内容を解析します
+	Parse(data []byte, options StrengthCSVOptions) (*ImportedStrengthCSV, error)
+}
@@ -0,0 +1,49 @@
+package prompt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"fitness-mcp-server/internal/domain/shared"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// dateLayout はプロンプトの引数の日付形式
+const dateLayout = shared.DateLayout
+
+// dateArgument は日付の引数をカレンダーのタイムゾーンの0時で返します（省略した場合は今日）
+func dateArgument(req mcp.GetPromptRequest, name string, calendar shared.Calendar) (time.Time, error) {
+	value := strings.TrimSpace(req.Params.Arguments[name])
+	if value == "" {
+		return calendar.Today(), nil
+	}
+	date, err := calendar.ParseDate(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %q: expected YYYY-MM-DD", name, value)
+	}
+	return date, nil
+}
+
+// intArgument は正の整数の引数を返します（省略した場合はデフォルト値）
+func intArgument(req mcp.GetPromptRequest, name string, defaultValue, maxValue int) (int, error) {
+	value := strings.TrimSpace(req.Params.Arguments[name])
+	if value == "" {
+		return defaultValue, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 || n > maxValue {
+		return 0, fmt.Errorf("invalid %s %q: expected an integer between 1 and %d", name, value, maxValue)
+	}
+	return n, nil
+}
+
+// userMessage は指示と記録のセクションをまとめたユーザーのメッセージを作成します
+func userMessage(description, instructions string, sections ...string) *mcp.GetPromptResult {
+	text := instructions + "\n\n" + strings.Join(sections, "\n\n---\n\n")
+	return mcp.NewGetPromptResult(description, []mcp.PromptMessage{
+		mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(text)),
+	})
+}
@@ -0,0 +1,106 @@
+package prompt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	query_handler "fitness-mcp-server/internal/application/query/handler"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// weeklyReviewInstructions は週次レビューの指示
+const weeklyReviewInstructions = `以下は%s〜%sの1週間の筋トレ・ランニングの記録です。この記録だけを根拠に週次レビューをしてください。
+
+1. 筋トレ: 実施回数・種目・総ボリュームと、更新した自己ベスト
+2. ランニング: 回数・合計距離と、ポイント練習（Tempo・Interval・Race）とイージーランのバランス
+3. 良かった点と、疲労や偏りなど気になる点
+4. 来週に向けた具体的な提案を3つ以内
+
+記録がない項目は推測せず「記録なし」としてください。`
+
+// ReviewPromptHandler は筋トレとランニングをまとめた振り返りのプロンプトを管理します
+type ReviewPromptHandler struct {
+	strengthQuery *query_handler.StrengthQueryHandler
+	runningQuery  *query_handler.RunningQueryHandler
+	calendar      shared.Calendar
+}
+
+// NewReviewPromptHandler は新しいReviewPromptHandlerを作成します
+// 週はユーザーのタイムゾーンのローカル日付で区切ります
+func NewReviewPromptHandler(strengthQuery *query_handler.StrengthQueryHandler, runningQuery *query_handler.RunningQueryHandler, calendar shared.Calendar) *ReviewPromptHandler {
+	return &ReviewPromptHandler{
+		strengthQuery: strengthQuery,
+		runningQuery:  runningQuery,
+		calendar:      calendar,
+	}
+}
+
+// Register は振り返りのプロンプトを登録します
+func (h *ReviewPromptHandler) Register(s *server.MCPServer) error {
+	s.AddPrompt(
+		mcp.NewPrompt(
+			"weekly_review",
+			mcp.WithPromptDescription("1週間（月曜〜日曜）の筋トレ・ランニングの記録と更新した自己ベストを埋め込んだ週次レビュー"),
+			mcp.WithArgument("week",
+				mcp.ArgumentDescription("対象の週に含まれる日付（YYYY-MM-DD）。省略時は今週"),
+			),
+		),
+		h.handleWeeklyReview,
+	)
+	return nil
+}
+
+// handleWeeklyReview は対象の週の記録を埋め込んだ週次レビューのプロンプトを返します
+func (h *ReviewPromptHandler) handleWeeklyReview(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	date, err := dateArgument(req, "week", h.calendar)
+	if err != nil {
+		return nil, err
+	}
+	monday, sunday := h.calendar.WeekRange(date)
+
+	trainings, err := h.strengthQuery.GetTrainingsByDateRange(ctx, query_dto.GetTrainingsByDateRangeQuery{StartDate: monday, EndDate: sunday})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trainings: %w", err)
+	}
+	history, err := h.strengthQuery.GetPRHistory(ctx, query_dto.GetPRHistoryQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR history: %w", err)
+	}
+	// 日曜の終わりから7日分（月曜の0時以降）
+	runs, err := h.runningQuery.GetRecentRuns(ctx, query_dto.GetRecentRunsQuery{Days: 7, ReferenceDate: sunday})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runs: %w", err)
+	}
+
+	return userMessage(
+		fmt.Sprintf("%s〜%sの週次レビュー", monday.Format(dateLayout), sunday.Format(dateLayout)),
+		fmt.Sprintf(weeklyReviewInstructions, monday.Format(dateLayout), sunday.Format(dateLayout)),
+		converter.FormatQueryResponse(trainings),
+		converter.FormatPRHistoryResponse(prHistoryBetween(history, monday, sunday.AddDate(0, 0, 1))),
+		converter.FormatRecentRunsResponse(runs),
+	), nil
+}
+
+// prHistoryBetween はPR履歴を期間（開始日を含み、終了日を含まない）の更新に絞り込みます
+func prHistoryBetween(history *query_dto.GetPRHistoryResponse, start, end time.Time) *query_dto.GetPRHistoryResponse {
+	filtered := &query_dto.GetPRHistoryResponse{Timelines: []query_dto.PRTimeline{}}
+	for _, timeline := range history.Timelines {
+		var events []query_dto.PREvent
+		for _, event := range timeline.Events {
+			if !event.Date.Before(start) && event.Date.Before(end) {
+				events = append(events, event)
+			}
+		}
+		if len(events) > 0 {
+			filtered.Timelines = append(filtered.Timelines, query_dto.PRTimeline{ExerciseName: timeline.ExerciseName, Events: events})
+			filtered.Count += len(events)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,127 @@
+package prompt
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	query_handler "fitness-mcp-server/internal/application/query/handler"
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// briefingRunDays はレースウィークのブリーフィングに埋め込むランニングの期間（日数）
+const briefingRunDays = 14
+
+// raceWeekBriefingInstructions はレースウィークのブリーフィングの指示
+const raceWeekBriefingInstructions = `%s（%s、あと%d日）に向けたレースウィークのブリーフィングをしてください。以下のレースタイム予測・トレーニングゾーン・直近%d日間のランニングの記録だけを根拠にしてください。
+
+1. 現実的な目標タイムと、1kmごとの目標ペース（前半を抑える場合はその配分）
+2. レース当日までの日ごとの調整メニュー（テーパリング）
+3. 直近の記録から見た疲労・仕上がりの評価
+4. 当日の注意点（ウォームアップ・補給・ペースの目安にするゾーン）
+
+予測やゾーンを算出できていない場合は、その旨を伝えたうえで記録から言える範囲で答えてください。`
+
+// RunningPromptHandler はランニングのプロンプトを管理します
+type RunningPromptHandler struct {
+	queryHandler *query_handler.RunningQueryHandler
+	calendar     shared.Calendar
+}
+
+// NewRunningPromptHandler は新しいRunningPromptHandlerを作成します
+// レースの日付はユーザーのタイムゾーンのローカル日付として解釈します
+func NewRunningPromptHandler(queryHandler *query_handler.RunningQueryHandler, calendar shared.Calendar) *RunningPromptHandler {
+	return &RunningPromptHandler{
+		queryHandler: queryHandler,
+		calendar:     calendar,
+	}
+}
+
+// Register はランニングのプロンプトを登録します
+func (h *RunningPromptHandler) Register(s *server.MCPServer) error {
+	s.AddPrompt(
+		mcp.NewPrompt(
+			"race_week_briefing",
+			mcp.WithPromptDescription("レースタイム予測・トレーニングゾーン・直近のランニングを埋め込んだ、レースウィークの調整と当日のペース配分のブリーフィング"),
+			mcp.WithArgument("race_date",
+				mcp.ArgumentDescription("レースの日付（YYYY-MM-DD）"),
+				mcp.RequiredArgument(),
+			),
+			mcp.WithArgument("event",
+				mcp.ArgumentDescription("種目（5K, 10K, Half, Marathon）。省略時は全種目の予測を埋め込みます"),
+			),
+		),
+		h.handleRaceWeekBriefing,
+	)
+	return nil
+}
+
+// handleRaceWeekBriefing はレースに向けた予測・ゾーン・直近の記録を埋め込んだプロンプトを返します
+func (h *RunningPromptHandler) handleRaceWeekBriefing(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	if strings.TrimSpace(req.Params.Arguments["race_date"]) == "" {
+		return nil, fmt.Errorf("race_date is required")
+	}
+	raceDate, err := dateArgument(req, "race_date", h.calendar)
+	if err != nil {
+		return nil, err
+	}
+	// 夏時間の切り替えを挟むと1日が23・25時間になるため、日数は丸める
+	daysLeft := int(math.Round(raceDate.Sub(h.calendar.Today()).Hours() / 24))
+	if daysLeft < 0 {
+		return nil, fmt.Errorf("race_date %s is in the past", raceDate.Format(dateLayout))
+	}
+	event := strings.TrimSpace(req.Params.Arguments["event"])
+	if event != "" {
+		eventType, err := running.NewEventType(event)
+		if err != nil || eventType.Equals(running.Custom) {
+			return nil, fmt.Errorf("invalid event %q: expected 5K, 10K, Half or Marathon", event)
+		}
+	}
+
+	// 予測・ゾーン・直近のランニングは今日までの記録から算出する
+	predictions, err := h.queryHandler.PredictRaceTimes(ctx, query_dto.PredictRaceTimesQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to predict race times: %w", err)
+	}
+	zones, err := h.queryHandler.GetTrainingZones(ctx, query_dto.GetTrainingZonesQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training zones: %w", err)
+	}
+	runs, err := h.queryHandler.GetRecentRuns(ctx, query_dto.GetRecentRunsQuery{Days: briefingRunDays})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runs: %w", err)
+	}
+
+	label := "種目未指定"
+	if event != "" {
+		label = event
+		predictions = predictionsFor(predictions, event)
+	}
+
+	return userMessage(
+		fmt.Sprintf("%s（%s）のレースウィークのブリーフィング", raceDate.Format(dateLayout), label),
+		fmt.Sprintf(raceWeekBriefingInstructions, raceDate.Format(dateLayout), label, daysLeft, briefingRunDays),
+		converter.FormatRacePredictionResponse(predictions),
+		converter.FormatTrainingZonesResponse(zones),
+		converter.FormatRecentRunsResponse(runs),
+	), nil
+}
+
+// predictionsFor はレースタイム予測を指定した種目に絞り込みます
+func predictionsFor(response *query_dto.PredictRaceTimesResponse, event string) *query_dto.PredictRaceTimesResponse {
+	filtered := *response
+	filtered.Predictions = make([]query_dto.EventPrediction, 0, 1)
+	for _, prediction := range response.Predictions {
+		if prediction.EventType == event {
+			filtered.Predictions = append(filtered.Predictions, prediction)
+		}
+	}
+	return &filtered
+}
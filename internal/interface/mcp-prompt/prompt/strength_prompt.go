@@ -0,0 +1,119 @@
+package prompt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	query_handler "fitness-mcp-server/internal/application/query/handler"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// defaultPlanWeeks は次のセッションの計画に埋め込む履歴のデフォルト期間（週）
+	defaultPlanWeeks = 4
+	// maxPlanWeeks は次のセッションの計画に埋め込む履歴の最大期間（週）
+	maxPlanWeeks = 52
+)
+
+// planNextSessionInstructions は次のセッションの計画の指示
+const planNextSessionInstructions = `以下は「%s」の直近%d週間のセットと自己ベストです。この記録をもとに次のセッションのメニューを提案してください。
+
+1. 直近の推移（重量・レップ数・RPE）から読み取れる傾向
+2. 次のセッションのセットごとの重量・レップ数・目標RPE（ウォームアップを含む）
+3. その重量・レップ数にした理由（漸進性過負荷の根拠）
+
+記録がない場合は、初回として控えめなメニューを提案してください。`
+
+// PlateInventory は使用できるバーとプレート（提案する重量を実際に組める重量にするために埋め込む）
+type PlateInventory struct {
+	BarKg    float64   // バーの重量
+	PlatesKg []float64 // プレートの重量（1枚あたり）
+}
+
+// StrengthPromptHandler は筋トレのプロンプトを管理します
+type StrengthPromptHandler struct {
+	queryHandler *query_handler.StrengthQueryHandler
+	plates       PlateInventory
+	calendar     shared.Calendar
+}
+
+// NewStrengthPromptHandler は新しいStrengthPromptHandlerを作成します
+func NewStrengthPromptHandler(queryHandler *query_handler.StrengthQueryHandler, plates PlateInventory, calendar shared.Calendar) *StrengthPromptHandler {
+	return &StrengthPromptHandler{
+		queryHandler: queryHandler,
+		plates:       plates,
+		calendar:     calendar,
+	}
+}
+
+// Register は筋トレのプロンプトを登録します
+func (h *StrengthPromptHandler) Register(s *server.MCPServer) error {
+	s.AddPrompt(
+		mcp.NewPrompt(
+			"plan_next_session",
+			mcp.WithPromptDescription("エクササイズの直近のセットと自己ベストを埋め込んだ、次のセッションのメニュー作成"),
+			mcp.WithArgument("exercise",
+				mcp.ArgumentDescription("エクササイズ名（例: ベンチプレス）"),
+				mcp.RequiredArgument(),
+			),
+			mcp.WithArgument("weeks",
+				mcp.ArgumentDescription(fmt.Sprintf("埋め込む履歴の期間（週）。省略時は%d週", defaultPlanWeeks)),
+			),
+		),
+		h.handlePlanNextSession,
+	)
+	return nil
+}
+
+// handlePlanNextSession はエクササイズの直近の履歴を埋め込んだ計画のプロンプトを返します
+func (h *StrengthPromptHandler) handlePlanNextSession(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	exercise := strings.TrimSpace(req.Params.Arguments["exercise"])
+	if exercise == "" {
+		return nil, fmt.Errorf("exercise is required")
+	}
+	weeks, err := intArgument(req, "weeks", defaultPlanWeeks, maxPlanWeeks)
+	if err != nil {
+		return nil, err
+	}
+
+	end := h.calendar.Today()
+	trainings, err := h.queryHandler.GetTrainingsByDateRange(ctx, query_dto.GetTrainingsByDateRangeQuery{
+		StartDate:    end.AddDate(0, 0, -7*weeks),
+		EndDate:      end,
+		ExerciseName: &exercise,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trainings: %w", err)
+	}
+	records, err := h.queryHandler.GetPersonalRecords(ctx, query_dto.GetPersonalRecordsQuery{ExerciseName: &exercise})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal records: %w", err)
+	}
+
+	return userMessage(
+		fmt.Sprintf("%sの次のセッションの計画", exercise),
+		fmt.Sprintf(planNextSessionInstructions, exercise, weeks),
+		converter.FormatQueryResponse(trainings),
+		converter.FormatPersonalRecordsResponse(records),
+		formatPlateInventory(h.plates),
+	), nil
+}
+
+// formatPlateInventory は使用できるバーとプレートを整形します
+func formatPlateInventory(plates PlateInventory) string {
+	if len(plates.PlatesKg) == 0 {
+		return fmt.Sprintf("🏋️ **使用できる器具**\n\n- バー: %gkg\n- プレート: なし", plates.BarKg)
+	}
+	weights := make([]string, len(plates.PlatesKg))
+	for i, plate := range plates.PlatesKg {
+		weights[i] = fmt.Sprintf("%gkg", plate)
+	}
+	return fmt.Sprintf("🏋️ **使用できる器具**\n\n- バー: %gkg\n- プレート（1枚あたり、左右に同じ重さを付ける）: %s\n\nバーベル種目の重量は、このバーとプレートで組める重量で提案してください。",
+		plates.BarKg, strings.Join(weights, "・"))
+}
@@ -0,0 +1,158 @@
+package resource
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"fitness-mcp-server/internal/application/auth"
+	"fitness-mcp-server/internal/domain/user"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ChangeNotifier は書き込み系のツールが成功した後、変更されたリソースのURIを通知します
+// 通知先は書き込んだユーザーのセッションのみです（他のユーザーのデータの変更は通知しません）
+//
+// mcp-goはresources/subscribeを処理しないため、ユーザーのセッションは自分の全てのリソースを購読しているものとして扱います
+type ChangeNotifier struct {
+	mu       sync.RWMutex
+	server   *server.MCPServer
+	sessions map[string]user.ID // セッションID → ユーザー
+}
+
+// NewChangeNotifier は新しいChangeNotifierを作成します
+func NewChangeNotifier() *ChangeNotifier {
+	return &ChangeNotifier{sessions: make(map[string]user.ID)}
+}
+
+// Hooks はセッションの追跡とツール実行後の通知を行うフックを返します（MCPサーバの作成時に指定します）
+func (n *ChangeNotifier) Hooks() *server.Hooks {
+	hooks := &server.Hooks{}
+	hooks.AddOnRegisterSession(n.registerSession)
+	hooks.AddOnUnregisterSession(n.unregisterSession)
+	hooks.AddAfterCallTool(n.afterCallTool)
+	return hooks
+}
+
+// Attach は通知を送るMCPサーバを設定します
+func (n *ChangeNotifier) Attach(s *server.MCPServer) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.server = s
+}
+
+// registerSession は通知を受け取り続けるセッション（標準入出力・SSE・Streamable HTTPのGET）をユーザーと紐付けます
+func (n *ChangeNotifier) registerSession(ctx context.Context, session server.ClientSession) {
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sessions[session.SessionID()] = userID
+}
+
+// unregisterSession はセッションの紐付けを解除します
+func (n *ChangeNotifier) unregisterSession(ctx context.Context, session server.ClientSession) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.sessions, session.SessionID())
+}
+
+// afterCallTool は成功した書き込み系のツールが変更したリソースを通知します
+func (n *ChangeNotifier) afterCallTool(ctx context.Context, id any, request *mcp.CallToolRequest, result *mcp.CallToolResult) {
+	if result == nil || result.IsError {
+		return
+	}
+	uris := changedURIs(*request)
+	if len(uris) == 0 {
+		return
+	}
+	userID, err := auth.UserFromContext(ctx)
+	if err != nil {
+		return
+	}
+	n.notify(ctx, userID, uris)
+}
+
+// notify はユーザーのセッションにnotifications/resources/updatedを送信します
+// 呼び出し元のセッションが登録されていない場合（Streamable HTTPのPOSTのみの場合）は、そのレスポンスで通知します
+func (n *ChangeNotifier) notify(ctx context.Context, userID user.ID, uris []string) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if n.server == nil {
+		return
+	}
+
+	callerRegistered := false
+	var caller string
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		caller = session.SessionID()
+		_, callerRegistered = n.sessions[caller]
+	}
+
+	for _, uri := range uris {
+		params := map[string]any{"uri": uri}
+		for sessionID, owner := range n.sessions {
+			if !owner.Equals(userID) {
+				continue
+			}
+			if err := n.server.SendNotificationToSpecificClient(sessionID, mcp.MethodNotificationResourceUpdated, params); err != nil {
+				slog.WarnContext(ctx, "failed to notify resource update", "session_id", sessionID, "error", err)
+			}
+		}
+		if caller != "" && !callerRegistered {
+			if err := n.server.SendNotificationToClient(ctx, mcp.MethodNotificationResourceUpdated, params); err != nil {
+				slog.WarnContext(ctx, "failed to notify resource update", "error", err)
+			}
+		}
+	}
+}
+
+// changedURIs は書き込み系のツールの呼び出しで変更されるリソースのURIを返します
+// 取り込み系のツールは対象日が事前にわからないため、日付ごとのリソースは通知しません
+func changedURIs(request mcp.CallToolRequest) []string {
+	switch request.Params.Name {
+	case "record_training":
+		uris := []string{RecordsURI}
+		if date := request.GetString("date", ""); date != "" {
+			uris = append(uris, TrainingsURI(date))
+		}
+		if arguments, ok := request.Params.Arguments.(map[string]any); ok {
+			exercises, _ := arguments["exercises"].([]any)
+			for _, exercise := range exercises {
+				if fields, ok := exercise.(map[string]any); ok {
+					if name, ok := fields["name"].(string); ok && name != "" {
+						uris = append(uris, ExerciseRecordsURI(name))
+					}
+				}
+			}
+		}
+		return uris
+	case "rebuild_pr_history":
+		return []string{RecordsURI}
+	case "record_running", "import_run_file":
+		return []string{RecentRunsURI}
+	case "set_running_goal", "update_running_goal":
+		return []string{GoalsURI}
+	case "import_strength_csv":
+		if request.GetBool("dry_run", false) {
+			return nil
+		}
+		return []string{RecordsURI}
+	case "import_data":
+		if request.GetBool("dry_run", false) {
+			return nil
+		}
+		return []string{RecordsURI, RecentRunsURI, GoalsURI}
+	case "import_fit_file":
+		if request.GetBool("preview", false) {
+			return nil
+		}
+		return []string{RecordsURI, RecentRunsURI}
+	default:
+		return nil
+	}
+}
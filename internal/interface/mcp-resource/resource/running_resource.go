@@ -0,0 +1,66 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	query_handler "fitness-mcp-server/internal/application/query/handler"
+	"fitness-mcp-server/internal/application/query/usecase"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RunningResourceHandler はランニングのリソースを管理します
+type RunningResourceHandler struct {
+	queryHandler *query_handler.RunningQueryHandler
+}
+
+// NewRunningResourceHandler は新しいRunningResourceHandlerを作成します
+func NewRunningResourceHandler(queryHandler *query_handler.RunningQueryHandler) *RunningResourceHandler {
+	return &RunningResourceHandler{
+		queryHandler: queryHandler,
+	}
+}
+
+// Register はランニングのリソースを登録します
+func (h *RunningResourceHandler) Register(s *server.MCPServer) error {
+	s.AddResource(
+		mcp.NewResource(
+			RecentRunsURI,
+			"直近のランニング",
+			mcp.WithResourceDescription(fmt.Sprintf("直近%d日間のランニングセッション（新しい順）と合計距離", usecase.DefaultRecentRunDays)),
+			mcp.WithMIMEType(jsonMIMEType),
+		),
+		h.handleReadRecentRuns,
+	)
+	s.AddResource(
+		mcp.NewResource(
+			GoalsURI,
+			"ランニング目標",
+			mcp.WithResourceDescription("set_running_goalで設定したランニング目標（作成日時順）。目標タイム・目標ペース・イベント日までの日数・状態を含みます"),
+			mcp.WithMIMEType(jsonMIMEType),
+		),
+		h.handleReadGoals,
+	)
+	return nil
+}
+
+// handleReadRecentRuns は直近のランニングセッションを返します
+func (h *RunningResourceHandler) handleReadRecentRuns(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	response, err := h.queryHandler.GetRecentRuns(ctx, query_dto.GetRecentRunsQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent runs: %w", err)
+	}
+	return jsonContents(req.Params.URI, response)
+}
+
+// handleReadGoals はランニング目標を返します
+func (h *RunningResourceHandler) handleReadGoals(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	response, err := h.queryHandler.GetRunningGoals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get running goals: %w", err)
+	}
+	return jsonContents(req.Params.URI, response)
+}
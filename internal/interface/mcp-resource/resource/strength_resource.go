@@ -0,0 +1,98 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	query_handler "fitness-mcp-server/internal/application/query/handler"
+	"fitness-mcp-server/internal/domain/shared"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// StrengthResourceHandler は筋トレセッション・自己ベストのリソースを管理します
+type StrengthResourceHandler struct {
+	queryHandler *query_handler.StrengthQueryHandler
+	calendar     shared.Calendar
+}
+
+// NewStrengthResourceHandler は新しいStrengthResourceHandlerを作成します
+// URIの日付はユーザーのタイムゾーンのローカル日付として解釈します
+func NewStrengthResourceHandler(queryHandler *query_handler.StrengthQueryHandler, calendar shared.Calendar) *StrengthResourceHandler {
+	return &StrengthResourceHandler{
+		queryHandler: queryHandler,
+		calendar:     calendar,
+	}
+}
+
+// Register は筋トレセッション・自己ベストのリソースを登録します
+func (h *StrengthResourceHandler) Register(s *server.MCPServer) error {
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(
+			TrainingsURITemplate,
+			"指定日の筋トレセッション",
+			mcp.WithTemplateDescription("指定日（YYYY-MM-DD）に記録した筋トレセッション（エクササイズ・セット・サマリー）"),
+			mcp.WithTemplateMIMEType(jsonMIMEType),
+		),
+		h.handleReadTrainings,
+	)
+
+	s.AddResource(
+		mcp.NewResource(
+			RecordsURI,
+			"自己ベスト",
+			mcp.WithResourceDescription("全エクササイズの自己ベスト（最大重量・最大レップ数・最大ボリューム・最長保持時間・最大重量キャリー）"),
+			mcp.WithMIMEType(jsonMIMEType),
+		),
+		h.handleReadRecords,
+	)
+
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(
+			RecordsURITemplate,
+			"エクササイズの自己ベスト",
+			mcp.WithTemplateDescription("指定したエクササイズの自己ベスト。エクササイズ名はURLエンコードします（例: fitness://records/%E3%83%99%E3%83%B3%E3%83%81%E3%83%97%E3%83%AC%E3%82%B9）"),
+			mcp.WithTemplateMIMEType(jsonMIMEType),
+		),
+		h.handleReadRecords,
+	)
+	return nil
+}
+
+// handleReadTrainings は指定日の筋トレセッションを返します
+func (h *StrengthResourceHandler) handleReadTrainings(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	dateStr, err := templateArgument(req, "date")
+	if err != nil {
+		return nil, err
+	}
+	date, err := h.calendar.ParseDate(dateStr)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := h.queryHandler.GetTrainingsByDateRange(ctx, query_dto.GetTrainingsByDateRangeQuery{StartDate: date, EndDate: date})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trainings: %w", err)
+	}
+	return jsonContents(req.Params.URI, response)
+}
+
+// handleReadRecords は自己ベストを返します（エクササイズ名の指定がない場合は全エクササイズ）
+func (h *StrengthResourceHandler) handleReadRecords(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	query := query_dto.GetPersonalRecordsQuery{}
+	if req.Params.URI != RecordsURI {
+		exerciseName, err := templateArgument(req, "exercise")
+		if err != nil {
+			return nil, err
+		}
+		query.ExerciseName = &exerciseName
+	}
+
+	response, err := h.queryHandler.GetPersonalRecords(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal records: %w", err)
+	}
+	return jsonContents(req.Params.URI, response)
+}
@@ -0,0 +1,68 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// =============================================================================
+// MCPリソース - トレーニング履歴・自己ベスト・ランニング・目標をURIで参照できるようにする
+// =============================================================================
+
+// リソースのURI
+const (
+	TrainingsURITemplate = "fitness://trainings/{date}"   // 指定日の筋トレセッション（YYYY-MM-DD）
+	RecordsURI           = "fitness://records"            // 全エクササイズの自己ベスト
+	RecordsURITemplate   = "fitness://records/{exercise}" // エクササイズの自己ベスト（名前はURLエンコード）
+	RecentRunsURI        = "fitness://runs/recent"        // 直近のランニング
+	GoalsURI             = "fitness://goals"              // ランニング目標
+	jsonMIMEType         = "application/json"
+	trainingsURIPrefix   = "fitness://trainings/"
+	recordsURIPrefix     = RecordsURI + "/"
+)
+
+// TrainingsURI は指定日の筋トレセッションのURIを返します
+func TrainingsURI(date string) string {
+	return trainingsURIPrefix + date
+}
+
+// ExerciseRecordsURI はエクササイズの自己ベストのURIを返します
+func ExerciseRecordsURI(exerciseName string) string {
+	return recordsURIPrefix + url.PathEscape(exerciseName)
+}
+
+// templateArgument はURIテンプレートから取り出した変数をデコードして返します
+func templateArgument(request mcp.ReadResourceRequest, name string) (string, error) {
+	var raw string
+	switch value := request.Params.Arguments[name].(type) {
+	case string:
+		raw = value
+	case []string:
+		if len(value) > 0 {
+			raw = value[0]
+		}
+	}
+	if raw == "" {
+		return "", fmt.Errorf("missing %s in resource URI: %s", name, request.Params.URI)
+	}
+
+	decoded, err := url.PathUnescape(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s in resource URI: %w", name, err)
+	}
+	return decoded, nil
+}
+
+// jsonContents はレスポンスをJSONのリソース内容に変換します
+func jsonContents(uri string, response any) ([]mcp.ResourceContents, error) {
+	data, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode resource %s: %w", uri, err)
+	}
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{URI: uri, MIMEType: jsonMIMEType, Text: string(data)},
+	}, nil
+}
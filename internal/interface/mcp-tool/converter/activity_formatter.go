@@ -0,0 +1,57 @@
+package converter
+
+import (
+	command_dto "fitness-mcp-server/internal/application/command/dto"
+	"fmt"
+)
+
+// FormatImportFitFileResult はFITファイル取り込み結果を見やすい形式にフォーマットします
+func FormatImportFitFileResult(result *command_dto.ImportFitFileResult) string {
+	text := ""
+	if result.Preview {
+		text += "👀 **プレビュー**（保存していません。preview=falseで取り込みます）\n"
+	}
+	text += fmt.Sprintf("🏷️ 種目: %s\n", result.Sport)
+	for _, warning := range result.Warnings {
+		text += fmt.Sprintf("⚠️ %s\n", warning)
+	}
+	text += "\n"
+
+	if result.Run != nil {
+		return text + FormatImportRunFileResult(result.Run)
+	}
+	if result.Strength != nil {
+		return text + formatImportStrengthResult(result.Strength)
+	}
+	return text
+}
+
+// formatImportStrengthResult はFITファイルから取り込んだ筋トレを見やすい形式にフォーマットします
+func formatImportStrengthResult(result *command_dto.ImportStrengthResult) string {
+	text := "📥 FITファイルを取り込みました\n"
+	text += fmt.Sprintf("⏱️ 経過時間 %s / 休息セット %d件（取り込み対象外）\n", result.ElapsedTime, result.RestSets)
+	text += fmt.Sprintf("🏋️ 総負荷量 %.1fkg\n\n", result.TotalVolume)
+
+	if result.Result.TrainingID != "" {
+		text += fmt.Sprintf("記録完了: TrainingID=%v, メッセージ=%v\n", result.Result.TrainingID, result.Result.Message)
+	} else {
+		text += fmt.Sprintf("プレビュー: メッセージ=%v\n", result.Result.Message)
+	}
+	text += fmt.Sprintf("📅 %s\n", result.Session.Date.Format("2006-01-02"))
+
+	for _, exercise := range result.Session.Exercises {
+		text += fmt.Sprintf("\n**%s** (%dセット)\n", exercise.Name, len(exercise.Sets))
+		for i, set := range exercise.Sets {
+			line := fmt.Sprintf("%.1fkg", set.WeightKg)
+			if set.Reps > 0 {
+				line += fmt.Sprintf(" × %d回", set.Reps)
+			}
+			if set.DurationSeconds != nil {
+				line += fmt.Sprintf(" × %d秒", *set.DurationSeconds)
+			}
+			text += fmt.Sprintf("  %d. %s\n", i+1, line)
+		}
+	}
+
+	return text + FormatNewRecords(result.Result.NewRecords)
+}
@@ -0,0 +1,49 @@
+package converter
+
+import (
+	command_dto "fitness-mcp-server/internal/application/command/dto"
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	"fmt"
+)
+
+// FormatRecordBodyMetricResult は身体測定値の記録結果を見やすい形式にフォーマットします
+func FormatRecordBodyMetricResult(result *command_dto.RecordBodyMetricResult) string {
+	text := fmt.Sprintf("記録完了: MetricID=%v, メッセージ=%v\n", result.MetricID, result.Message)
+	text += fmt.Sprintf("⚖️ %s 体重 %.1fkg", result.Date.Format("2006-01-02"), result.WeightKg)
+	if result.BodyFatPercent != nil {
+		text += fmt.Sprintf("、体脂肪率 %.1f%%", *result.BodyFatPercent)
+	}
+	if result.LeanMassKg != nil {
+		text += fmt.Sprintf("（除脂肪体重 %.1fkg）", *result.LeanMassKg)
+	}
+	return text + "\n"
+}
+
+// FormatBodyMetricsResponse は身体測定値の一覧を見やすい形式にフォーマットします
+func FormatBodyMetricsResponse(response *query_dto.GetBodyMetricsResponse) string {
+	result := fmt.Sprintf("⚖️ **身体測定**\n📅 対象期間: %s\n\n", response.Period)
+	if response.Count == 0 {
+		return result + "❌ この期間に身体測定の記録は見つかりませんでした。"
+	}
+
+	result += fmt.Sprintf("📊 測定回数: %d回", response.Count)
+	if response.LatestWeightKg != nil {
+		result += fmt.Sprintf("、最新の体重: %.1fkg", *response.LatestWeightKg)
+	}
+	if response.WeightChangeKg != nil {
+		result += fmt.Sprintf("（期間内 %+.1fkg）", *response.WeightChangeKg)
+	}
+	result += "\n\n"
+
+	result += "| 日付 | 体重 | 体脂肪率 | メモ |\n"
+	result += "|---|---|---|---|\n"
+	for _, metric := range response.Metrics {
+		bodyFat := "-"
+		if metric.BodyFatPercent != nil {
+			bodyFat = fmt.Sprintf("%.1f%%", *metric.BodyFatPercent)
+		}
+		result += fmt.Sprintf("| %s | %.1fkg | %s | %s |\n",
+			metric.Date.Format("2006-01-02"), metric.WeightKg, bodyFat, metric.Notes)
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package converter
+
+import (
+	command_dto "fitness-mcp-server/internal/application/command/dto"
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	"fmt"
+)
+
+// FormatExportDataResponse はデータエクスポート結果を見やすい形式にフォーマットします
+// ファイルに書き出していない場合はファイルの内容をそのまま含めます
+func FormatExportDataResponse(response *query_dto.ExportDataResponse) string {
+	text := fmt.Sprintf("📤 %s形式でエクスポートしました（スキーマバージョン %d）\n", response.Format, response.SchemaVersion)
+	text += fmt.Sprintf("🏋️ 筋トレ %d件 / 🏃 ラン %d件 / ⚖️ 身体測定 %d件 / 🎯 目標 %d件",
+		response.StrengthTrainings, response.Runs, response.BodyMetrics, response.Goals)
+	if response.AthleteProfile {
+		text += " / 👤 アスリートプロファイル"
+	}
+	text += "\n"
+
+	if len(response.WrittenPaths) > 0 {
+		text += "\n**書き出したファイル**\n"
+		for _, path := range response.WrittenPaths {
+			text += fmt.Sprintf("  %s\n", path)
+		}
+		return text
+	}
+
+	for _, file := range response.Files {
+		text += fmt.Sprintf("\n===== %s =====\n%s", file.Name, string(file.Content))
+	}
+	return text
+}
+
+// FormatImportDataResult はデータ取り込み結果を見やすい形式にフォーマットします
+func FormatImportDataResult(result *command_dto.ImportDataResult) string {
+	text := ""
+	if result.DryRun {
+		text += "👀 **ドライラン**（保存していません。dry_run=falseで取り込みます）\n"
+	}
+	text += fmt.Sprintf("📥 %sにエクスポートしたデータを読み込みました（スキーマバージョン %d）\n",
+		result.ExportedAt.Format("2006-01-02 15:04"), result.SchemaVersion)
+	text += fmt.Sprintf("🏋️ 筋トレ: 作成 %d件 / 記録済み %d件\n", result.StrengthCreated, result.StrengthExisting)
+	text += fmt.Sprintf("🏃 ラン: 作成 %d件 / 記録済み %d件\n", result.RunsCreated, result.RunsExisting)
+	text += fmt.Sprintf("🎯 目標: 作成 %d件 / 記録済み %d件\n", result.GoalsCreated, result.GoalsExisting)
+	text += fmt.Sprintf("⚖️ 身体測定: 作成 %d件 / 記録済み %d件\n", result.BodyMetricsCreated, result.BodyMetricsExisting)
+	if result.ProfileImported {
+		text += "👤 アスリートプロファイルを取り込みます\n"
+	} else if result.ProfileSkipReason != "" {
+		text += fmt.Sprintf("👤 %s\n", result.ProfileSkipReason)
+	}
+	text += result.Message + "\n"
+
+	if result.Records != nil {
+		text += fmt.Sprintf("\n🏆 %s\n", result.Records.Message)
+	}
+	return text
+}
@@ -0,0 +1,65 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// OutputFormat はツールの結果の出力形式
+type OutputFormat string
+
+const (
+	OutputMarkdown OutputFormat = "markdown" // 人が読むためのMarkdown（デフォルト）
+	OutputJSON     OutputFormat = "json"     // プログラムで扱うためのJSON
+	OutputBoth     OutputFormat = "both"     // Markdown・JSONの順に両方
+)
+
+// OutputSchemaVersion はJSON出力のスキーマのバージョン
+// フィールドの削除・名前や型の変更など、互換性のない変更をした場合に上げます（フィールドの追加では上げません）
+const OutputSchemaVersion = 1
+
+// outputSchemaPrefix はJSON出力のスキーマ名の接頭辞（スキーマ名は「接頭辞/ツール名」）
+const outputSchemaPrefix = "fitness-mcp-server"
+
+// StructuredOutput はJSON出力の共通の形式
+type StructuredOutput struct {
+	Schema        string `json:"schema"`         // 例: fitness-mcp-server/get_personal_records
+	SchemaVersion int    `json:"schema_version"` // OutputSchemaVersion
+	Data          any    `json:"data"`           // ツールのレスポンス・結果のDTO
+}
+
+// ParseOutputFormat は出力形式を解析します（空の場合はMarkdown）
+func ParseOutputFormat(value string) (OutputFormat, error) {
+	switch format := OutputFormat(value); format {
+	case "":
+		return OutputMarkdown, nil
+	case OutputMarkdown, OutputJSON, OutputBoth:
+		return format, nil
+	default:
+		return "", fmt.Errorf("output_format must be markdown, json or both: %s", value)
+	}
+}
+
+// IncludesMarkdown はMarkdownを出力するかを返します
+func (f OutputFormat) IncludesMarkdown() bool {
+	return f != OutputJSON
+}
+
+// IncludesJSON はJSONを出力するかを返します
+func (f OutputFormat) IncludesJSON() bool {
+	return f == OutputJSON || f == OutputBoth
+}
+
+// FormatJSON はツールの結果のDTOをスキーマ名・バージョン付きのJSONにフォーマットします
+func FormatJSON(toolName string, data any) (string, error) {
+	output := StructuredOutput{
+		Schema:        outputSchemaPrefix + "/" + toolName,
+		SchemaVersion: OutputSchemaVersion,
+		Data:          data,
+	}
+	bytes, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s result: %w", toolName, err)
+	}
+	return string(bytes), nil
+}
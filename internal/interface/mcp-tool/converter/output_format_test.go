@@ -0,0 +1,233 @@
+package converter
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	command_dto "fitness-mcp-server/internal/application/command/dto"
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// =============================================================================
+// ツールの結果の出力形式のテスト（Markdown・JSONのゴールデンファイル）
+// =============================================================================
+
+var update = flag.Bool("update", false, "update golden files")
+
+// outputCase はツールの結果のDTOとそのMarkdownのレンダリング
+type outputCase struct {
+	tool     string
+	markdown string
+	data     any
+}
+
+// newOutputCases は主要なツールの結果のDTOを作成します
+func newOutputCases() []outputCase {
+	intPtr := func(v int) *int { return &v }
+	floatPtr := func(v float64) *float64 { return &v }
+	stringPtr := func(v string) *string { return &v }
+	day := time.Date(2025, 6, 14, 0, 0, 0, 0, time.UTC)
+
+	trainings := &query_dto.GetTrainingsByDateRangeResponse{
+		Trainings: []*query_dto.TrainingDTO{
+			{
+				ID:   "11111111-1111-4111-8111-111111111111",
+				Date: day,
+				Exercises: []*query_dto.ExerciseDTO{
+					{Name: "ベンチプレス", Sets: []*query_dto.SetDTO{
+						{WeightKg: 95, Reps: 8, RPE: floatPtr(8)},
+						{WeightKg: 95, Reps: 7, RPE: floatPtr(9), Tempo: stringPtr("3-1-1-0"), TimeUnderTensionSeconds: intPtr(35)},
+					}},
+				},
+				Notes:   "胸の日",
+				Summary: &query_dto.SummaryDTO{TotalExercises: 1, TotalSets: 2, TotalVolume: 1425, TimeUnderTensionSeconds: 35},
+			},
+		},
+		Count:  1,
+		Period: "2025-06-14 〜 2025-06-14",
+		Filter: "エクササイズ: ベンチプレス",
+	}
+
+	records := &query_dto.GetPersonalRecordsResponse{
+		Records: []query_dto.PersonalRecord{
+			{
+				ExerciseName:  "ベンチプレス",
+				MaxWeight:     query_dto.PersonalRecordDetail{Value: 95, Date: day, TrainingID: "11111111-1111-4111-8111-111111111111", SetDetails: &query_dto.SetInfo{WeightKg: 95, Reps: 8, RPE: floatPtr(8)}},
+				MaxReps:       query_dto.PersonalRecordDetail{Value: 8, Date: day, TrainingID: "11111111-1111-4111-8111-111111111111"},
+				MaxVolume:     query_dto.PersonalRecordDetail{Value: 1425, Date: day, TrainingID: "11111111-1111-4111-8111-111111111111"},
+				TotalSessions: 1,
+				LastPerformed: day,
+			},
+		},
+		Count: 1,
+	}
+
+	history := &query_dto.GetPRHistoryResponse{
+		Timelines: []query_dto.PRTimeline{
+			{ExerciseName: "ベンチプレス", Events: []query_dto.PREvent{
+				{Date: day.AddDate(0, 0, -7), RecordType: "E1RM", Label: "推定1RM", Value: 115, TrainingID: "00000000-0000-4000-8000-000000000000", SetDetails: &query_dto.SetInfo{WeightKg: 92.5, Reps: 8}},
+				{Date: day, RecordType: "E1RM", Label: "推定1RM", Value: 120.3, PreviousValue: floatPtr(115), TrainingID: "11111111-1111-4111-8111-111111111111", SetDetails: &query_dto.SetInfo{WeightKg: 95, Reps: 8}},
+			}},
+		},
+		Count: 2,
+	}
+
+	predictions := &query_dto.PredictRaceTimesResponse{
+		Period:     "2025-03-16 〜 2025-06-14",
+		BasisCount: 1,
+		Predictions: []query_dto.EventPrediction{
+			{EventType: "10K", DistanceKm: 10, Models: []query_dto.ModelPrediction{
+				{Model: "Riegel", PredictedSeconds: 2520, PredictedTime: "0:42:00", Pace: "4:12/km", Basis: query_dto.BasisSession{
+					SessionID: "22222222-2222-4222-8222-222222222222", Date: day, RunType: "Race", DistanceKm: 5, Duration: "0:20:00", Pace: "4:00/km",
+				}},
+			}},
+		},
+	}
+
+	zones := &query_dto.GetTrainingZonesResponse{
+		PaceSource: "VDOT",
+		VDOT:       50,
+		PaceZones: []query_dto.PaceZoneDTO{
+			{Zone: "Easy", Fastest: "4:55/km", Slowest: "5:30/km", FastestSecondsPerKm: 295, SlowestSecondsPerKm: 330},
+		},
+		HeartRateMethod: "Karvonen",
+		HeartRateZones: []query_dto.HeartRateZoneDTO{
+			{Zone: 1, LowerBPM: 0, UpperBPM: 134},
+			{Zone: 2, LowerBPM: 134, UpperBPM: 148},
+		},
+		Notes: []string{},
+	}
+
+	run := &command_dto.RecordRunningResult{
+		SessionID:     "33333333-3333-4333-8333-333333333333",
+		Date:          day,
+		DistanceKm:    2,
+		Duration:      "0:08:10",
+		Pace:          "4:05/km",
+		RunType:       "Interval",
+		HeartRateZone: intPtr(4),
+		Laps: []command_dto.LapResultDTO{
+			{Number: 1, Type: "Work", DistanceKm: 1, Duration: "0:04:00", Pace: "4:00/km", HeartRateBPM: intPtr(172)},
+			{Number: 2, Type: "Work", DistanceKm: 1, Duration: "0:04:10", Pace: "4:10/km"},
+		},
+		Message: "ランニングを記録しました",
+	}
+
+	training := &command_dto.RecordTrainingResult{
+		TrainingID: "11111111-1111-4111-8111-111111111111",
+		Date:       day,
+		Message:    "トレーニングを記録しました",
+		NewRecords: []command_dto.PersonalRecordEventDTO{
+			{ExerciseName: "ベンチプレス", RecordType: "RepMax", Label: "8RM", Reps: 8, Value: 95, PreviousValue: floatPtr(92.5)},
+			{ExerciseName: "ベンチプレス", RecordType: "E1RM", Label: "推定1RM", Value: 120.3, SetWeightKg: floatPtr(95), SetReps: intPtr(8)},
+		},
+	}
+
+	return []outputCase{
+		{tool: "get_trainings_by_date_range", markdown: FormatQueryResponse(trainings), data: trainings},
+		{tool: "get_personal_records", markdown: FormatPersonalRecordsResponse(records), data: records},
+		{tool: "get_pr_history", markdown: FormatPRHistoryResponse(history), data: history},
+		{tool: "predict_race_times", markdown: FormatRacePredictionResponse(predictions), data: predictions},
+		{tool: "get_training_zones", markdown: FormatTrainingZonesResponse(zones), data: zones},
+		{tool: "record_running", markdown: FormatRecordRunningResult(run), data: run},
+		{tool: "record_training", markdown: FormatNewRecords(training.NewRecords), data: training},
+	}
+}
+
+// assertGolden はゴールデンファイルと内容を比較します（-updateの場合は書き換えます）
+func assertGolden(t *testing.T, name, got string) {
+	t.Helper()
+	golden := filepath.Join("testdata", name+".golden")
+	if *update {
+		assert.NoError(t, os.WriteFile(golden, []byte(got), 0o644))
+	}
+	want, err := os.ReadFile(golden)
+	assert.NoError(t, err)
+	assert.Equal(t, string(want), got, name)
+}
+
+func TestOutput_Golden(t *testing.T) {
+	for _, tc := range newOutputCases() {
+		t.Run("正常系:"+tc.tool, func(t *testing.T) {
+			// Act
+			got, err := FormatJSON(tc.tool, tc.data)
+
+			// Assert
+			assert.NoError(t, err)
+			assertGolden(t, tc.tool+".md", tc.markdown)
+			assertGolden(t, tc.tool+".json", got)
+		})
+	}
+}
+
+func TestFormatJSON(t *testing.T) {
+	t.Run("正常系:スキーマ名・バージョンとDTOを出力する", func(t *testing.T) {
+		// Arrange
+		response := &query_dto.GetPersonalRecordsResponse{Records: []query_dto.PersonalRecord{}, Count: 0}
+
+		// Act
+		text, err := FormatJSON("get_personal_records", response)
+
+		// Assert
+		if !assert.NoError(t, err) {
+			return
+		}
+		var decoded struct {
+			Schema        string                               `json:"schema"`
+			SchemaVersion int                                  `json:"schema_version"`
+			Data          query_dto.GetPersonalRecordsResponse `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal([]byte(text), &decoded))
+		assert.Equal(t, "fitness-mcp-server/get_personal_records", decoded.Schema)
+		assert.Equal(t, OutputSchemaVersion, decoded.SchemaVersion)
+		assert.Equal(t, *response, decoded.Data)
+	})
+
+	t.Run("異常系:JSONに変換できない値はエラーを返す", func(t *testing.T) {
+		// Act
+		_, err := FormatJSON("get_personal_records", map[string]any{"invalid": func() {}})
+
+		// Assert
+		assert.ErrorContains(t, err, "failed to marshal get_personal_records result")
+	})
+}
+
+func TestParseOutputFormat(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        string
+		want         OutputFormat
+		wantMarkdown bool
+		wantJSON     bool
+		wantErr      bool
+	}{
+		{name: "正常系:省略時はmarkdown", value: "", want: OutputMarkdown, wantMarkdown: true},
+		{name: "正常系:markdown", value: "markdown", want: OutputMarkdown, wantMarkdown: true},
+		{name: "正常系:json", value: "json", want: OutputJSON, wantJSON: true},
+		{name: "正常系:both", value: "both", want: OutputBoth, wantMarkdown: true, wantJSON: true},
+		{name: "異常系:未対応の形式", value: "yaml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got, err := ParseOutputFormat(tt.value)
+
+			// Assert
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "output_format must be markdown, json or both")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantMarkdown, got.IncludesMarkdown())
+			assert.Equal(t, tt.wantJSON, got.IncludesJSON())
+		})
+	}
+}
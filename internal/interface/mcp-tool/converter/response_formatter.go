@@ -1,8 +1,10 @@
 package converter
 
 import (
+	command_dto "fitness-mcp-server/internal/application/command/dto"
 	query_dto "fitness-mcp-server/internal/application/query/dto"
 	"fmt"
+	"strings"
 )
 
 // FormatQueryResponse はクエリレスポンスを見やすい形式にフォーマットします
@@ -11,34 +13,74 @@ func FormatQueryResponse(response *query_dto.GetTrainingsByDateRangeResponse) st
 		return fmt.Sprintf("📊 **期間: %s**\n\n❌ この期間にトレーニング記録は見つかりませんでした。", response.Period)
 	}
 
-	result := fmt.Sprintf("📊 **期間: %s**\n\n🏋️ **トレーニング記録: %d件**\n\n", response.Period, response.Count)
+	result := fmt.Sprintf("📊 **期間: %s**\n", response.Period)
+	if response.Filter != "" {
+		result += fmt.Sprintf("🔎 **絞り込み: %s**\n", response.Filter)
+	}
+	result += fmt.Sprintf("\n🏋️ **トレーニング記録: %d件**\n\n", response.Count)
 
 	for i, training := range response.Trainings {
-		result += fmt.Sprintf("**%d. %s (%s)**\n",
-			i+1,
-			training.Date.Format("2006-01-02"),
-			training.Date.Weekday())
+		result += formatTraining(i+1, training, response.Filter != "")
+	}
 
-		if training.Notes != "" {
-			result += fmt.Sprintf("📝 メモ: %s\n", training.Notes)
-		}
+	return result
+}
 
-		result += fmt.Sprintf("📈 概要: %d種目, %dセット, %.1fkg総ボリューム\n",
-			training.Summary.TotalExercises,
-			training.Summary.TotalSets,
-			training.Summary.TotalVolume)
+// FormatSearchTrainingsResponse は筋トレセッション検索のレスポンスを見やすい形式にフォーマットします
+func FormatSearchTrainingsResponse(response *query_dto.SearchTrainingsResponse) string {
+	result := "🔍 **トレーニング検索**\n"
+	if response.Filter != "" {
+		result += fmt.Sprintf("🔎 **絞り込み: %s**\n", response.Filter)
+	}
+	result += fmt.Sprintf("↕️ 並び順: %s\n", response.Sort)
+	if response.Count == 0 {
+		return result + "\n❌ 条件に一致するトレーニング記録は見つかりませんでした。"
+	}
 
-		// エクササイズの概要のみ（詳細は省略）
-		for _, exercise := range training.Exercises {
-			result += fmt.Sprintf("  • %s: %d sets\n",
-				exercise.Name, len(exercise.Sets))
-		}
-		result += "\n"
+	result += fmt.Sprintf("\n🏋️ **トレーニング記録: %d件**\n\n", response.Count)
+	for i, training := range response.Trainings {
+		result += formatTraining(i+1, training, response.Filter != "")
 	}
 
+	if response.NextCursor != "" {
+		result += fmt.Sprintf("➡️ 続きがあります。cursor に `%s` を指定すると次のページを取得します。\n", response.NextCursor)
+	}
 	return result
 }
 
+// formatTraining はトレーニングセッションの概要をフォーマットします（showSetsの場合はセット詳細も表示）
+func formatTraining(number int, training *query_dto.TrainingDTO, showSets bool) string {
+	result := fmt.Sprintf("**%d. %s (%s)**\n",
+		number,
+		training.Date.Format("2006-01-02"),
+		training.Date.Weekday())
+
+	if training.Notes != "" {
+		result += fmt.Sprintf("📝 メモ: %s\n", training.Notes)
+	}
+
+	result += fmt.Sprintf("📈 概要: %d種目, %dセット, %.1fkg総ボリューム",
+		training.Summary.TotalExercises,
+		training.Summary.TotalSets,
+		training.Summary.TotalVolume)
+	if training.Summary.TimeUnderTensionSeconds > 0 {
+		result += fmt.Sprintf(", TUT %d秒", training.Summary.TimeUnderTensionSeconds)
+	}
+	result += "\n"
+
+	// エクササイズの概要（絞り込み時はセット詳細も表示）
+	for _, exercise := range training.Exercises {
+		result += fmt.Sprintf("  • %s: %d sets\n",
+			exercise.Name, len(exercise.Sets))
+		if showSets {
+			for _, set := range exercise.Sets {
+				result += fmt.Sprintf("    - %s\n", formatSet(set))
+			}
+		}
+	}
+	return result + "\n"
+}
+
 // FormatPersonalRecordsResponse は個人記録レスポンスを見やすい形式にフォーマットします
 func FormatPersonalRecordsResponse(response *query_dto.GetPersonalRecordsResponse) string {
 	if response.Count == 0 {
@@ -62,23 +104,46 @@ func FormatPersonalRecordsResponse(response *query_dto.GetPersonalRecordsRespons
 			details := record.MaxWeight.SetDetails
 			rpeText := ""
 			if details.RPE != nil {
-				rpeText = fmt.Sprintf(", RPE: %d", *details.RPE)
+				rpeText = fmt.Sprintf(", RPE: %g", *details.RPE)
 			}
 			result += fmt.Sprintf("   🔍 セット詳細: %.1fkg × %d回%s\n",
 				details.WeightKg, details.Reps, rpeText)
 		}
 
-		// 最大レップ数
-		result += fmt.Sprintf("\n🔥 **最大レップ数**: %.0f回\n", record.MaxReps.Value)
-		result += fmt.Sprintf("   📅 達成日: %s (ID: %s)\n",
-			record.MaxReps.Date.Format("2006-01-02"),
-			record.MaxReps.TrainingID)
+		// 最大レップ数・最大ボリューム（回数ベースのセットがある場合のみ）
+		if record.MaxReps.Value > 0 {
+			result += fmt.Sprintf("\n🔥 **最大レップ数**: %.0f回\n", record.MaxReps.Value)
+			result += fmt.Sprintf("   📅 達成日: %s (ID: %s)\n",
+				record.MaxReps.Date.Format("2006-01-02"),
+				record.MaxReps.TrainingID)
+
+			result += fmt.Sprintf("\n📊 **最大ボリューム**: %.1fkg\n", record.MaxVolume.Value)
+			result += fmt.Sprintf("   📅 達成日: %s (ID: %s)\n",
+				record.MaxVolume.Date.Format("2006-01-02"),
+				record.MaxVolume.TrainingID)
+		}
 
-		// 最大ボリューム
-		result += fmt.Sprintf("\n📊 **最大ボリューム**: %.1fkg\n", record.MaxVolume.Value)
-		result += fmt.Sprintf("   📅 達成日: %s (ID: %s)\n",
-			record.MaxVolume.Date.Format("2006-01-02"),
-			record.MaxVolume.TrainingID)
+		// 最長保持時間（時間ベースのセットがある場合のみ）
+		if record.LongestHold != nil {
+			result += fmt.Sprintf("\n⏱️ **最長保持時間**: %.0f秒", record.LongestHold.Value)
+			if details := record.LongestHold.SetDetails; details != nil && details.WeightKg > 0 {
+				result += fmt.Sprintf(" (%.1fkg)", details.WeightKg)
+			}
+			result += fmt.Sprintf("\n   📅 達成日: %s (ID: %s)\n",
+				record.LongestHold.Date.Format("2006-01-02"),
+				record.LongestHold.TrainingID)
+		}
+
+		// 最大重量キャリー（距離ベースのセットがある場合のみ）
+		if record.HeaviestCarry != nil {
+			result += fmt.Sprintf("\n🚶 **最大重量キャリー**: %.1fkg", record.HeaviestCarry.Value)
+			if details := record.HeaviestCarry.SetDetails; details != nil && details.DistanceMeters != nil {
+				result += fmt.Sprintf(" × %gm", *details.DistanceMeters)
+			}
+			result += fmt.Sprintf("\n   📅 達成日: %s (ID: %s)\n",
+				record.HeaviestCarry.Date.Format("2006-01-02"),
+				record.HeaviestCarry.TrainingID)
+		}
 
 		if i < len(response.Records)-1 {
 			result += "\n---\n\n"
@@ -89,3 +154,95 @@ func FormatPersonalRecordsResponse(response *query_dto.GetPersonalRecordsRespons
 
 	return result
 }
+
+// FormatNewRecords は記録時に更新されたPRを見やすい形式にフォーマットします（更新がない場合は空文字）
+func FormatNewRecords(records []command_dto.PersonalRecordEventDTO) string {
+	if len(records) == 0 {
+		return ""
+	}
+
+	result := fmt.Sprintf("\n\n🎉 **自己ベスト更新 (%d件)**\n", len(records))
+	for _, record := range records {
+		result += fmt.Sprintf("  • %s %s: %s", record.ExerciseName, record.Label, formatRecordValue(record.RecordType, record.Value))
+		if record.SetWeightKg != nil && record.SetReps != nil && record.RecordType != "RepMax" {
+			result += fmt.Sprintf(" (%.1fkg × %d回)", *record.SetWeightKg, *record.SetReps)
+		}
+		if record.PreviousValue != nil {
+			result += fmt.Sprintf(" ← %s", formatRecordValue(record.RecordType, *record.PreviousValue))
+		} else {
+			result += " (初記録)"
+		}
+		result += "\n"
+	}
+
+	return result
+}
+
+// FormatPRHistoryResponse はPR履歴レスポンスを見やすい形式にフォーマットします
+func FormatPRHistoryResponse(response *query_dto.GetPRHistoryResponse) string {
+	if response.Count == 0 {
+		return "📈 **PR履歴**\n\n❌ PRの履歴が見つかりませんでした。"
+	}
+
+	result := fmt.Sprintf("📈 **PR履歴 (%d種目, %d件)**\n\n", len(response.Timelines), response.Count)
+
+	for i, timeline := range response.Timelines {
+		result += fmt.Sprintf("**%d. %s**\n", i+1, timeline.ExerciseName)
+		for _, event := range timeline.Events {
+			result += fmt.Sprintf("  📅 %s %s: %s",
+				event.Date.Format("2006-01-02"),
+				event.Label,
+				formatRecordValue(event.RecordType, event.Value))
+			if details := event.SetDetails; details != nil && event.RecordType != "RepMax" {
+				result += fmt.Sprintf(" (%.1fkg × %d回)", details.WeightKg, details.Reps)
+			}
+			if event.PreviousValue != nil {
+				result += fmt.Sprintf(" [+%s]", formatRecordValue(event.RecordType, event.Value-*event.PreviousValue))
+			} else {
+				result += " [初記録]"
+			}
+			result += "\n"
+		}
+		result += "\n"
+	}
+
+	return result
+}
+
+// formatRecordValue はPRの記録値をフォーマットします
+func formatRecordValue(recordType string, value float64) string {
+	if recordType == "SessionVolume" {
+		return fmt.Sprintf("%.0fkg", value)
+	}
+	return fmt.Sprintf("%.1fkg", value)
+}
+
+// formatSet はセット詳細を1行の文字列にフォーマットします
+func formatSet(set *query_dto.SetDTO) string {
+	result := fmt.Sprintf("%.1fkg", set.WeightKg)
+	if set.Reps > 0 {
+		result += fmt.Sprintf(" × %d回", set.Reps)
+	}
+	if set.DistanceMeters != nil {
+		result += fmt.Sprintf(" × %gm", *set.DistanceMeters)
+	}
+	if set.DurationSeconds != nil {
+		result += fmt.Sprintf(" × %d秒", *set.DurationSeconds)
+	}
+	if set.RPE != nil {
+		result += fmt.Sprintf(" RPE %g", *set.RPE)
+	}
+	if set.Tempo != nil {
+		result += fmt.Sprintf(" @%s", *set.Tempo)
+	}
+	if set.RangeOfMotion != nil {
+		result += fmt.Sprintf(" (%s)", *set.RangeOfMotion)
+	}
+	if len(set.Variations) > 0 {
+		result += fmt.Sprintf(" [%s]", strings.Join(set.Variations, ", "))
+	}
+	if set.TimeUnderTensionSeconds != nil {
+		result += fmt.Sprintf(" TUT %d秒", *set.TimeUnderTensionSeconds)
+	}
+	return result
+}
@@ -0,0 +1,195 @@
+package converter
+
+import (
+	command_dto "fitness-mcp-server/internal/application/command/dto"
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	"fmt"
+)
+
+// FormatRacePredictionResponse はレースタイム予測レスポンスを見やすい形式にフォーマットします
+func FormatRacePredictionResponse(response *query_dto.PredictRaceTimesResponse) string {
+	result := fmt.Sprintf("🏃 **レースタイム予測**\n📅 対象期間: %s\n\n", response.Period)
+	if response.BasisCount == 0 {
+		return result + "❌ 予測の基準になるレース・テンポ走の記録が見つかりませんでした。"
+	}
+
+	result += fmt.Sprintf("📊 基準になり得るセッション: %d件（Race・Tempo）\n\n", response.BasisCount)
+
+	for _, prediction := range response.Predictions {
+		result += fmt.Sprintf("**%s (%.4gkm)**\n", prediction.EventType, prediction.DistanceKm)
+		result += "| モデル | 予測タイム | ペース | 基準セッション |\n"
+		result += "|---|---|---|---|\n"
+		for _, model := range prediction.Models {
+			result += fmt.Sprintf("| %s | %s | %s | %s %s %.2fkm %s |\n",
+				model.Model,
+				model.PredictedTime,
+				model.Pace,
+				model.Basis.Date.Format("2006-01-02"),
+				model.Basis.RunType,
+				model.Basis.DistanceKm,
+				model.Basis.Duration)
+		}
+		result += "\n"
+	}
+
+	result += "※ テンポ走を基準にした予測は全力のレースより控えめ（遅め）になる傾向があります。"
+	return result
+}
+
+// FormatRecordRunningResult はランニング記録結果を見やすい形式にフォーマットします
+func FormatRecordRunningResult(result *command_dto.RecordRunningResult) string {
+	text := fmt.Sprintf("記録完了: SessionID=%v, メッセージ=%v\n", result.SessionID, result.Message)
+	if result.SessionID == "" {
+		text = fmt.Sprintf("プレビュー: メッセージ=%v\n", result.Message)
+	}
+	text += fmt.Sprintf("🏃 %s %s %.2fkm %s（ペース %s）\n",
+		result.Date.Format("2006-01-02"), result.RunType, result.DistanceKm, result.Duration, result.Pace)
+
+	if result.PaceZone != "" {
+		text += fmt.Sprintf("📊 ペースゾーン: %s\n", result.PaceZone)
+	}
+	if result.HeartRateZone != nil {
+		text += fmt.Sprintf("❤️ 心拍ゾーン: %d\n", *result.HeartRateZone)
+	}
+	for _, warning := range result.Warnings {
+		text += fmt.Sprintf("⚠️ %s\n", warning)
+	}
+
+	if len(result.Laps) > 0 {
+		text += "\n**ラップ**\n"
+		text += "| # | 種類 | 距離 | タイム | ペース | 心拍数 |\n"
+		text += "|---|---|---|---|---|---|\n"
+		for _, lap := range result.Laps {
+			heartRate := "-"
+			if lap.HeartRateBPM != nil {
+				heartRate = fmt.Sprintf("%dbpm", *lap.HeartRateBPM)
+			}
+			text += fmt.Sprintf("| %d | %s | %.2fkm | %s | %s | %s |\n",
+				lap.Number, lap.Type, lap.DistanceKm, lap.Duration, lap.Pace, heartRate)
+		}
+	}
+
+	if analysis := result.LapAnalysis; analysis != nil {
+		consistency := "安定"
+		if !analysis.Consistent {
+			consistency = "ばらつきあり"
+		}
+		text += fmt.Sprintf("\n📈 疾走区間 %d本: 平均 %s、最速 Lap %d / 最遅 Lap %d（差 %.0f秒/km）、変動係数 %.1f%%（%s）\n",
+			analysis.WorkLaps, analysis.AveragePace, analysis.FastestLap, analysis.SlowestLap,
+			analysis.SpreadSecondsPerKm, analysis.CVPercent, consistency)
+
+		split := "ポジティブスプリット（後半が遅い）"
+		if analysis.NegativeSplit {
+			split = "ネガティブスプリット（後半が速い）"
+		}
+		text += fmt.Sprintf("↔️ 前半 %s / 後半 %s（%+.0f秒/km）: %s\n",
+			analysis.FirstHalfPace, analysis.SecondHalfPace, analysis.SplitDiffSeconds, split)
+	}
+	return text
+}
+
+// FormatImportRunFileResult はGPX・TCX・FITファイル取り込み結果を見やすい形式にフォーマットします
+func FormatImportRunFileResult(result *command_dto.ImportRunFileResult) string {
+	text := fmt.Sprintf("📥 %sファイルを取り込みました（%dポイント）\n", result.Format, result.PointCount)
+	text += fmt.Sprintf("⏱️ 経過時間 %s / 移動時間 %s\n", result.ElapsedTime, result.MovingTime)
+	text += fmt.Sprintf("⛰️ 獲得標高 %.0fm\n\n", result.ElevationGainM)
+	return text + FormatRecordRunningResult(result.Run)
+}
+
+// FormatAthleteProfileResult はアスリートプロファイル更新結果を見やすい形式にフォーマットします
+func FormatAthleteProfileResult(result *command_dto.UpdateAthleteProfileResult) string {
+	text := fmt.Sprintf("✅ %s\n", result.Message)
+	if result.MaxHeartRate != nil {
+		text += fmt.Sprintf("- 最大心拍数: %dbpm\n", *result.MaxHeartRate)
+	}
+	if result.RestingHeartRate != nil {
+		text += fmt.Sprintf("- 安静時心拍数: %dbpm\n", *result.RestingHeartRate)
+	}
+	if result.ThresholdHeartRate != nil {
+		text += fmt.Sprintf("- LTHR: %dbpm\n", *result.ThresholdHeartRate)
+	}
+	if result.VDOT != nil {
+		text += fmt.Sprintf("- VDOT: %.1f\n", *result.VDOT)
+	}
+	if result.ThresholdPace != "" {
+		text += fmt.Sprintf("- 閾値ペース: %s\n", result.ThresholdPace)
+	}
+	return text
+}
+
+// FormatTrainingZonesResponse はトレーニングゾーンを見やすい形式にフォーマットします
+func FormatTrainingZonesResponse(response *query_dto.GetTrainingZonesResponse) string {
+	result := "🎯 **トレーニングゾーン**\n\n"
+
+	if len(response.PaceZones) > 0 {
+		result += fmt.Sprintf("**ペースゾーン**（VDOT %.1f、算出元: %s）\n", response.VDOT, response.PaceSource)
+		result += "| ゾーン | ペース |\n"
+		result += "|---|---|\n"
+		for _, zone := range response.PaceZones {
+			result += fmt.Sprintf("| %s | %s 〜 %s |\n", zone.Zone, zone.Fastest, zone.Slowest)
+		}
+		result += "\n"
+	}
+
+	if len(response.HeartRateZones) > 0 {
+		result += fmt.Sprintf("**心拍ゾーン**（%s）\n", response.HeartRateMethod)
+		result += "| ゾーン | 心拍数 |\n"
+		result += "|---|---|\n"
+		for _, zone := range response.HeartRateZones {
+			if zone.LowerBPM == 0 {
+				result += fmt.Sprintf("| %d | 〜 %dbpm |\n", zone.Zone, zone.UpperBPM)
+			} else {
+				result += fmt.Sprintf("| %d | %d 〜 %dbpm |\n", zone.Zone, zone.LowerBPM, zone.UpperBPM)
+			}
+		}
+		result += "\n"
+	}
+
+	for _, note := range response.Notes {
+		result += fmt.Sprintf("❌ %s\n", note)
+	}
+	return result
+}
+
+// FormatRecentRunsResponse は直近のランニングセッションを見やすい形式にフォーマットします
+func FormatRecentRunsResponse(response *query_dto.GetRecentRunsResponse) string {
+	result := fmt.Sprintf("🏃 **ランニング**\n📅 対象期間: %s\n\n", response.Period)
+	if response.Count == 0 {
+		return result + "❌ この期間にランニングの記録は見つかりませんでした。"
+	}
+
+	result += fmt.Sprintf("📊 %d回、合計 %.2fkm\n\n", response.Count, response.TotalDistanceKm)
+	result += "| 日付 | 種類 | 距離 | タイム | ペース | 心拍数 | メモ |\n"
+	result += "|---|---|---|---|---|---|---|\n"
+	for _, run := range response.Runs {
+		heartRate := "-"
+		if run.HeartRateBPM != nil {
+			heartRate = fmt.Sprintf("%dbpm", *run.HeartRateBPM)
+		}
+		result += fmt.Sprintf("| %s | %s | %.2fkm | %s | %s | %s | %s |\n",
+			run.Date.Format("2006-01-02"), run.RunType, run.DistanceKm, run.Duration, run.Pace, heartRate, run.Notes)
+	}
+	return result
+}
+
+// FormatRunningGoalResult はランニング目標の設定・更新結果を見やすい形式にフォーマットします
+func FormatRunningGoalResult(result *command_dto.RunningGoalResult) string {
+	text := fmt.Sprintf("✅ %s（GoalID=%s）\n", result.Message, result.GoalID)
+	text += fmt.Sprintf("🎯 %s (%.4gkm) 目標タイム %s（ペース %s）\n",
+		result.EventType, result.DistanceKm, result.TargetTime, result.TargetPace)
+	text += fmt.Sprintf("- 状態: %s\n", result.Status)
+	if result.EventDate != nil {
+		text += fmt.Sprintf("- イベント日: %s", result.EventDate.Format("2006-01-02"))
+		if result.DaysUntilEvent != nil {
+			text += fmt.Sprintf("（あと%d日）", *result.DaysUntilEvent)
+		}
+		text += "\n"
+	}
+	if result.Description != "" {
+		text += fmt.Sprintf("- 説明: %s\n", result.Description)
+	}
+	if result.AchievedAt != nil {
+		text += fmt.Sprintf("- 達成日: %s\n", result.AchievedAt.Format("2006-01-02"))
+	}
+	return text
+}
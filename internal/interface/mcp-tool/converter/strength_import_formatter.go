@@ -0,0 +1,82 @@
+package converter
+
+import (
+	command_dto "fitness-mcp-server/internal/application/command/dto"
+	"fmt"
+	"sort"
+)
+
+// FormatImportStrengthCSVResult は筋トレCSV取り込み結果を見やすい形式にフォーマットします
+func FormatImportStrengthCSVResult(result *command_dto.ImportStrengthCSVResult) string {
+	text := ""
+	if result.DryRun {
+		text += "👀 **ドライラン**（保存していません。dry_run=falseで取り込みます）\n"
+	}
+	text += fmt.Sprintf("📥 %s のCSVを解析しました（%d行、重量の単位: %s［%s］）\n", result.Format, result.Rows, result.WeightUnit, result.UnitSource)
+	text += fmt.Sprintf("✅ 作成 %d件 / 🔁 重複 %d件 / ⏭️ スキップ %d件\n", len(result.Created), len(result.Duplicates), len(result.Skipped))
+	text += result.Message + "\n"
+
+	if len(result.Created) > 0 {
+		if result.DryRun {
+			text += "\n**作成するセッション**\n"
+		} else {
+			text += "\n**作成したセッション**\n"
+		}
+		for _, session := range result.Created {
+			text += "  " + formatImportedSession(session)
+			if session.TrainingID != "" {
+				text += fmt.Sprintf(" (ID: %s)", session.TrainingID)
+			}
+			text += "\n"
+		}
+	}
+
+	if len(result.Duplicates) > 0 {
+		text += "\n**重複（記録済みのため取り込みません）**\n"
+		for _, session := range result.Duplicates {
+			text += fmt.Sprintf("  %s (既存ID: %s)\n", formatImportedSession(session), session.DuplicateOf)
+		}
+	}
+
+	if len(result.Skipped) > 0 {
+		text += "\n**スキップしたセッション**\n"
+		for _, session := range result.Skipped {
+			text += fmt.Sprintf("  %s %s: %s\n", session.Date.Format("2006-01-02 15:04"), session.Workout, session.Reason)
+		}
+	}
+
+	if len(result.SkippedRows) > 0 {
+		text += fmt.Sprintf("\n**取り込まなかった行**（%d行）\n", len(result.SkippedRows))
+		for _, row := range result.SkippedRows {
+			text += fmt.Sprintf("  %d行目: %s\n", row.Line, row.Reason)
+		}
+	}
+
+	if len(result.ExerciseMapping) > 0 {
+		names := make([]string, 0, len(result.ExerciseMapping))
+		for name := range result.ExerciseMapping {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		text += "\n**エクササイズ名の対応付け**\n"
+		for _, name := range names {
+			text += fmt.Sprintf("  %s → %s\n", name, result.ExerciseMapping[name])
+		}
+	}
+
+	if result.Records != nil {
+		text += fmt.Sprintf("\n🏆 %s\n", result.Records.Message)
+	}
+
+	return text
+}
+
+// formatImportedSession は取り込むセッションの概要を1行にフォーマットします
+func formatImportedSession(session command_dto.ImportedSessionDTO) string {
+	line := session.Date.Format("2006-01-02 15:04")
+	if session.Notes != "" {
+		line += " " + session.Notes
+	}
+	return line + fmt.Sprintf(" - %d種目 %dセット 総負荷量%.1fkg", session.Exercises, session.Sets, session.TotalVolume)
+}
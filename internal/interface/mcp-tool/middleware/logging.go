@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"fitness-mcp-server/internal/logging"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxLoggedArgumentsBytes はログに出力する引数のJSONの最大バイト数（ファイルの内容などを丸ごと出力しないため）
+const maxLoggedArgumentsBytes = 512
+
+// Logging はツールの呼び出しと結果を実行時間とともにログに出力します
+// ツール名とリクエストIDをコンテキストに設定するため、内側のハンドラのログにも同じ属性が付与されます
+func Logging() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ctx = logging.WithAttrs(ctx, slog.String("tool", req.Params.Name), slog.String("request_id", newRequestID()))
+			slog.InfoContext(ctx, "tool request", "arguments", loggedArguments(req))
+
+			start := time.Now()
+			result, err := next(ctx, req)
+			duration := time.Since(start)
+
+			switch {
+			case err != nil:
+				slog.ErrorContext(ctx, "tool response", "duration", duration, "status", "failed", "error", err.Error())
+			case result != nil && result.IsError:
+				slog.WarnContext(ctx, "tool response", "duration", duration, "status", "error", "message", resultText(result))
+			default:
+				slog.InfoContext(ctx, "tool response", "duration", duration, "status", "ok", "contents", contentCount(result))
+			}
+			return result, err
+		}
+	}
+}
+
+// newRequestID はログで呼び出しを追跡するためのリクエストIDを生成します
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// loggedArguments はログに出力する引数のJSONを返します（長い場合は切り詰めます）
+func loggedArguments(req mcp.CallToolRequest) string {
+	data, err := json.Marshal(req.Params.Arguments)
+	if err != nil {
+		return "<unprintable>"
+	}
+	if len(data) > maxLoggedArgumentsBytes {
+		return string(data[:maxLoggedArgumentsBytes]) + "...(truncated)"
+	}
+	return string(data)
+}
+
+// resultText はエラーの結果の最初のテキストを返します
+func resultText(result *mcp.CallToolResult) string {
+	for _, content := range result.Content {
+		if text, ok := content.(mcp.TextContent); ok {
+			return text.Text
+		}
+	}
+	return ""
+}
+
+// contentCount は結果の内容の数を返します
+func contentCount(result *mcp.CallToolResult) int {
+	if result == nil {
+		return 0
+	}
+	return len(result.Content)
+}
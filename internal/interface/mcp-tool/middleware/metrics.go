@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ToolMetrics はツールごとの呼び出し回数・エラー回数・合計実行時間
+type ToolMetrics struct {
+	Tool          string        `json:"tool"`
+	Calls         int64         `json:"calls"`
+	Errors        int64         `json:"errors"` // エラーの結果・エラーを返した回数
+	TotalDuration time.Duration `json:"total_duration"`
+}
+
+// Metrics はツールごとの呼び出しを集計します
+type Metrics struct {
+	mu    sync.Mutex
+	tools map[string]*ToolMetrics
+}
+
+// NewMetrics は新しいMetricsを作成します
+func NewMetrics() *Metrics {
+	return &Metrics{tools: make(map[string]*ToolMetrics)}
+}
+
+// Middleware はツールの呼び出しを集計するミドルウェアを返します
+func (m *Metrics) Middleware() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, req)
+			m.record(req.Params.Name, time.Since(start), err != nil || (result != nil && result.IsError))
+			return result, err
+		}
+	}
+}
+
+// record は1回の呼び出しを集計します
+func (m *Metrics) record(tool string, duration time.Duration, failed bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metrics, ok := m.tools[tool]
+	if !ok {
+		metrics = &ToolMetrics{Tool: tool}
+		m.tools[tool] = metrics
+	}
+	metrics.Calls++
+	metrics.TotalDuration += duration
+	if failed {
+		metrics.Errors++
+	}
+}
+
+// Snapshot はツール名順の集計結果のコピーを返します
+func (m *Metrics) Snapshot() []ToolMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := make([]ToolMetrics, 0, len(m.tools))
+	for _, metrics := range m.tools {
+		snapshot = append(snapshot, *metrics)
+	}
+	sort.Slice(snapshot, func(i, j int) bool { return snapshot[i].Tool < snapshot[j].Tool })
+	return snapshot
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// =============================================================================
+// ツールのミドルウェア - タイムアウト・パニックの回復・ログ・メトリクス
+// =============================================================================
+
+// Chain は複数のミドルウェアを1つにまとめます
+// 先に指定したミドルウェアほど外側（先に呼ばれ、最後に結果を受け取る）になります
+//
+// Timeoutはハンドラを別のgoroutineで実行するため、Recoverは必ずTimeoutより内側に指定してください
+func Chain(middlewares ...server.ToolHandlerMiddleware) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			next = middlewares[i](next)
+		}
+		return next
+	}
+}
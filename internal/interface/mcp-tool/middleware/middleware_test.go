@@ -0,0 +1,251 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"fitness-mcp-server/internal/logging"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+)
+
+// newRequest は引数付きのツールの呼び出しを作成します
+func newRequest(name string, arguments map[string]any) mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Name = name
+	req.Params.Arguments = arguments
+	return req
+}
+
+// okHandler は常に成功するハンドラ
+func okHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return mcp.NewToolResultText("ok"), nil
+}
+
+// captureLog はテスト中のslogの既定のロガーの出力（text形式）を返すバッファを設定します
+func captureLog(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	logger := slog.Default()
+	slog.SetDefault(slog.New(logging.NewHandler(&buf, "text", slog.LevelDebug)))
+	t.Cleanup(func() {
+		slog.SetDefault(logger)
+	})
+	return &buf
+}
+
+func TestChain(t *testing.T) {
+	t.Run("正常系:先に指定したミドルウェアほど外側で実行する", func(t *testing.T) {
+		// Arrange
+		var calls []string
+		trace := func(name string) server.ToolHandlerMiddleware {
+			return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+				return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+					calls = append(calls, name+":before")
+					result, err := next(ctx, req)
+					calls = append(calls, name+":after")
+					return result, err
+				}
+			}
+		}
+		handler := Chain(trace("outer"), trace("inner"))(okHandler)
+
+		// Act
+		_, err := handler(context.Background(), newRequest("get_personal_records", nil))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"outer:before", "inner:before", "inner:after", "outer:after"}, calls)
+	})
+}
+
+func TestTimeout(t *testing.T) {
+	slowHandler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+			return mcp.NewToolResultText("done"), nil
+		}
+	}
+
+	t.Run("正常系:時間内に終わった場合はハンドラの結果を返す", func(t *testing.T) {
+		// Act
+		result, err := Timeout(time.Second)(okHandler)(context.Background(), newRequest("record_training", nil))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("異常系:時間内に終わらない場合はタイムアウトのエラーを返す", func(t *testing.T) {
+		// Act
+		result, err := Timeout(10*time.Millisecond)(slowHandler)(context.Background(), newRequest("record_training", nil))
+
+		// Assert
+		if assert.NoError(t, err) && assert.True(t, result.IsError) {
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "タイムアウトしました（10ms）")
+		}
+	})
+
+	t.Run("異常系:呼び出し元のコンテキストがキャンセルされた場合はそのエラーを返す", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// Act
+		_, err := Timeout(time.Second)(slowHandler)(ctx, newRequest("record_training", nil))
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("正常系:0以下の場合は制限しない", func(t *testing.T) {
+		// Arrange
+		var hasDeadline bool
+		handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			_, hasDeadline = ctx.Deadline()
+			return okHandler(ctx, req)
+		}
+
+		// Act
+		_, err := Timeout(0)(handler)(context.Background(), newRequest("record_training", nil))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.False(t, hasDeadline)
+	})
+}
+
+func TestRecover(t *testing.T) {
+	t.Run("異常系:パニックをエラーの結果に変換する", func(t *testing.T) {
+		// Arrange
+		logs := captureLog(t)
+		panicHandler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			panic("nil map")
+		}
+
+		// Act: Timeoutの内側で回復するため、goroutine内のパニックでもプロセスは終了しない
+		result, err := Chain(Timeout(time.Second), Recover())(panicHandler)(context.Background(), newRequest("record_training", nil))
+
+		// Assert
+		if assert.NoError(t, err) && assert.True(t, result.IsError) {
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "内部エラーが発生しました: nil map")
+		}
+		assert.Contains(t, logs.String(), `msg="tool panic" tool=record_training panic="nil map"`)
+	})
+}
+
+func TestLogging(t *testing.T) {
+	t.Run("正常系:引数と結果・実行時間を出力する", func(t *testing.T) {
+		// Arrange
+		logs := captureLog(t)
+
+		// Act
+		_, err := Logging()(okHandler)(context.Background(), newRequest("get_personal_records", map[string]any{"exercise_name": "ベンチプレス"}))
+
+		// Assert
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+		if assert.Len(t, lines, 2) {
+			assert.Contains(t, lines[0], `level=INFO msg="tool request" arguments="{\"exercise_name\":\"ベンチプレス\"}" tool=get_personal_records request_id=`)
+			assert.Contains(t, lines[1], `msg="tool response" duration=`)
+			assert.Contains(t, lines[1], "status=ok contents=1 tool=get_personal_records request_id=")
+		}
+	})
+
+	t.Run("正常系:内側のハンドラのログにツール名とリクエストIDを付与する", func(t *testing.T) {
+		// Arrange
+		logs := captureLog(t)
+		handler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			slog.DebugContext(ctx, "saving training")
+			return okHandler(ctx, req)
+		}
+
+		// Act
+		_, err := Logging()(handler)(context.Background(), newRequest("record_training", nil))
+
+		// Assert
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+		if assert.Len(t, lines, 3) {
+			requestID := lines[0][strings.Index(lines[0], "request_id="):]
+			assert.Contains(t, lines[1], `msg="saving training" tool=record_training `+requestID)
+			assert.True(t, strings.HasSuffix(lines[2], requestID))
+		}
+	})
+
+	t.Run("正常系:長い引数は切り詰めて出力する", func(t *testing.T) {
+		// Arrange
+		logs := captureLog(t)
+		content := strings.Repeat("a", 2*maxLoggedArgumentsBytes)
+
+		// Act
+		_, err := Logging()(okHandler)(context.Background(), newRequest("import_data", map[string]any{"content": content}))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, logs.String(), "...(truncated)")
+		assert.NotContains(t, logs.String(), content)
+	})
+
+	t.Run("異常系:エラーの結果とエラーを区別して出力する", func(t *testing.T) {
+		// Arrange
+		logs := captureLog(t)
+		errorResult := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultError("データが不正です"), nil
+		}
+		failure := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return nil, errors.New("connection closed")
+		}
+
+		// Act
+		_, _ = Logging()(errorResult)(context.Background(), newRequest("record_training", nil))
+		_, _ = Logging()(failure)(context.Background(), newRequest("record_training", nil))
+
+		// Assert
+		assert.Contains(t, logs.String(), `level=WARN msg="tool response"`)
+		assert.Contains(t, logs.String(), `status=error message=データが不正です`)
+		assert.Contains(t, logs.String(), `level=ERROR msg="tool response"`)
+		assert.Contains(t, logs.String(), `status=failed error="connection closed"`)
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	t.Run("正常系:ツールごとに呼び出し回数とエラー回数を集計する", func(t *testing.T) {
+		// Arrange
+		metrics := NewMetrics()
+		errorResult := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultError("データが不正です"), nil
+		}
+		failure := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return nil, errors.New("connection closed")
+		}
+
+		// Act
+		for i := 0; i < 3; i++ {
+			_, _ = metrics.Middleware()(okHandler)(context.Background(), newRequest("get_personal_records", nil))
+		}
+		_, _ = metrics.Middleware()(okHandler)(context.Background(), newRequest("record_training", nil))
+		_, _ = metrics.Middleware()(errorResult)(context.Background(), newRequest("record_training", nil))
+		_, _ = metrics.Middleware()(failure)(context.Background(), newRequest("record_training", nil))
+		snapshot := metrics.Snapshot()
+
+		// Assert
+		if assert.Len(t, snapshot, 2) {
+			assert.Equal(t, "get_personal_records", snapshot[0].Tool)
+			assert.Equal(t, int64(3), snapshot[0].Calls)
+			assert.Equal(t, int64(0), snapshot[0].Errors)
+			assert.Equal(t, "record_training", snapshot[1].Tool)
+			assert.Equal(t, int64(3), snapshot[1].Calls)
+			assert.Equal(t, int64(2), snapshot[1].Errors)
+		}
+	})
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Recover はツールのパニックを回復し、エラーの結果として返します（スタックトレースはログに出力します）
+func Recover() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (result *mcp.CallToolResult, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					slog.ErrorContext(ctx, "tool panic", "tool", req.Params.Name, "panic", fmt.Sprint(r), "stack", string(debug.Stack()))
+					result = mcp.NewToolResultError(fmt.Sprintf("内部エラーが発生しました: %v", r))
+					err = nil
+				}
+			}()
+			return next(ctx, req)
+		}
+	}
+}
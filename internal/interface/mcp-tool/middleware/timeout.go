@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Timeout はツールの実行時間を制限します（0以下の場合は制限しません）
+// 時間内に終わらない場合はコンテキストをキャンセルし、タイムアウトのエラーを返します
+func Timeout(timeout time.Duration) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		if timeout <= 0 {
+			return next
+		}
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			type outcome struct {
+				result *mcp.CallToolResult
+				err    error
+			}
+			done := make(chan outcome, 1)
+			go func() {
+				result, err := next(timeoutCtx, req)
+				done <- outcome{result: result, err: err}
+			}()
+
+			select {
+			case o := <-done:
+				return o.result, o.err
+			case <-timeoutCtx.Done():
+				if ctx.Err() != nil {
+					// クライアントの切断・サーバの終了によるキャンセル
+					return nil, ctx.Err()
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("リクエストがタイムアウトしました（%s）", timeout)), nil
+			}
+		}
+	}
+}
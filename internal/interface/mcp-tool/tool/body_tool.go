@@ -0,0 +1,136 @@
+package tool
+
+import (
+	"context"
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/application/command/handler"
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	query_handler "fitness-mcp-server/internal/application/query/handler"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// BodyToolHandler は身体測定値の記録・取得ツールを管理します
+type BodyToolHandler struct {
+	commandHandler *handler.BodyMetricCommandHandler
+	queryHandler   *query_handler.BodyMetricQueryHandler
+	calendar       shared.Calendar
+}
+
+// NewBodyToolHandler は新しいBodyToolHandlerを作成します
+// 日付はユーザーのタイムゾーンのローカル日付として解釈します
+func NewBodyToolHandler(commandHandler *handler.BodyMetricCommandHandler, queryHandler *query_handler.BodyMetricQueryHandler, calendar shared.Calendar) *BodyToolHandler {
+	return &BodyToolHandler{
+		commandHandler: commandHandler,
+		queryHandler:   queryHandler,
+		calendar:       calendar,
+	}
+}
+
+// Register は身体測定値の記録・取得ツールを登録します
+func (h *BodyToolHandler) Register(s *server.MCPServer) error {
+	recordTool := mcp.NewTool(
+		"record_body_metrics",
+		mcp.WithDescription(`体重・体脂肪率を記録するツール。体脂肪率を指定すると除脂肪体重も計算して表示します。
+
+【使用例】
+- 体重のみ: {"date": "2025-06-16", "weight_kg": 68.4}
+- 体脂肪率も記録: {"date": "2025-06-16", "weight_kg": 68.4, "body_fat_percent": 15.2, "notes": "起床時"}`),
+		mcp.WithString("date",
+			mcp.Required(),
+			mcp.Description("測定日付。YYYY-MM-DD形式で指定してください。例: 2025-06-16"),
+		),
+		mcp.WithNumber("weight_kg",
+			mcp.Required(),
+			mcp.Description("体重（kg、20〜300）"),
+		),
+		mcp.WithNumber("body_fat_percent",
+			mcp.Description("体脂肪率（%、2〜70、省略可）"),
+		),
+		mcp.WithString("notes",
+			mcp.Description("メモや備考（省略可）"),
+		),
+		withOutputFormat(),
+	)
+	s.AddTool(recordTool, h.handleRecordBodyMetrics)
+
+	getTool := mcp.NewTool(
+		"get_body_metrics",
+		mcp.WithDescription(`直近の体重・体脂肪率の記録を測定日時順に取得するツール。期間内の最新の体重と、最初の測定からの増減も表示します。`),
+		mcp.WithNumber("days",
+			mcp.Description("今日から遡る日数（省略時は90日）"),
+		),
+		withOutputFormat(),
+	)
+	s.AddTool(getTool, h.handleGetBodyMetrics)
+
+	return nil
+}
+
+// handleRecordBodyMetrics は身体測定値の記録処理を行います
+func (h *BodyToolHandler) handleRecordBodyMetrics(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	paramsMap, ok := req.Params.Arguments.(map[string]interface{})
+	if !ok {
+		return mcp.NewToolResultError("パラメータが不正です"), nil
+	}
+
+	dateStr, err := req.RequireString("date")
+	if err != nil {
+		return mcp.NewToolResultError("dateパラメータが必要です: " + err.Error()), nil
+	}
+	date, err := h.calendar.ParseDate(dateStr)
+	if err != nil {
+		return mcp.NewToolResultError("日付の形式が不正です（YYYY-MM-DD形式で入力してください）: " + err.Error()), nil
+	}
+
+	weightKg, err := req.RequireFloat("weight_kg")
+	if err != nil {
+		return mcp.NewToolResultError("weight_kgパラメータが必要です: " + err.Error()), nil
+	}
+
+	cmd := dto.RecordBodyMetricCommand{
+		Date:           date,
+		WeightKg:       weightKg,
+		BodyFatPercent: optionalFloat(paramsMap, "body_fat_percent"),
+		Notes:          req.GetString("notes", ""),
+	}
+
+	if err := cmd.Validate(); err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	result, err := h.commandHandler.RecordBodyMetric(ctx, cmd)
+	if err != nil {
+		return mcp.NewToolResultError("記録に失敗しました: " + err.Error()), nil
+	}
+
+	return newToolResult(output, req, converter.FormatRecordBodyMetricResult(result), result), nil
+}
+
+// handleGetBodyMetrics は身体測定値の取得処理を行います
+func (h *BodyToolHandler) handleGetBodyMetrics(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	query := query_dto.GetBodyMetricsQuery{
+		Days:          req.GetInt("days", 0),
+		ReferenceDate: h.calendar.Today(),
+	}
+
+	response, err := h.queryHandler.GetBodyMetrics(ctx, query)
+	if err != nil {
+		return mcp.NewToolResultError("取得に失敗しました: " + err.Error()), nil
+	}
+
+	return newToolResult(output, req, converter.FormatBodyMetricsResponse(response), response), nil
+}
@@ -0,0 +1,154 @@
+package tool
+
+import (
+	"context"
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/application/command/handler"
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	query_handler "fitness-mcp-server/internal/application/query/handler"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DataToolHandler はデータのエクスポート・取り込みツールを管理します
+type DataToolHandler struct {
+	queryHandler   *query_handler.DataExportQueryHandler
+	commandHandler *handler.DataImportCommandHandler
+	calendar       shared.Calendar
+}
+
+// NewDataToolHandler は新しいDataToolHandlerを作成します
+// 期間の日付はユーザーのタイムゾーンのローカル日付として解釈します
+func NewDataToolHandler(queryHandler *query_handler.DataExportQueryHandler, commandHandler *handler.DataImportCommandHandler, calendar shared.Calendar) *DataToolHandler {
+	return &DataToolHandler{
+		queryHandler:   queryHandler,
+		commandHandler: commandHandler,
+		calendar:       calendar,
+	}
+}
+
+// Register はデータのエクスポート・取り込みツールを登録します
+func (h *DataToolHandler) Register(s *server.MCPServer) error {
+	exportTool := mcp.NewTool(
+		"export_data",
+		mcp.WithDescription(`筋トレ（セッション・セット）・ラン（ラップ）・アスリートプロファイルをエクスポートするツール。
+【形式】
+- json: バージョン付きの正規のJSON（import_dataで取り込めます。別の環境への移行に使用）
+- csv: エンティティごとのCSV（strength_trainings / strength_sets / runs / run_laps / athlete_profile）
+- markdown: 日ごとのトレーニングログ
+期間（start_date・end_date）を省略した場合は全期間をエクスポートします。
+output_pathを指定するとファイルに書き出します（csvはディレクトリに複数のファイルを書き出します）。
+指定しない場合はファイルの内容をそのまま返します。
+※目標・身体測定値はまだ保存していないため、エクスポートの対象外です。`),
+		mcp.WithString("format",
+			mcp.Required(),
+			mcp.Description("エクスポート形式"),
+			mcp.Enum("json", "csv", "markdown"),
+		),
+		mcp.WithString("start_date",
+			mcp.Description("開始日 (YYYY-MM-DD形式、end_dateと同時に指定)"),
+		),
+		mcp.WithString("end_date",
+			mcp.Description("終了日 (YYYY-MM-DD形式、start_dateと同時に指定)"),
+		),
+		mcp.WithString("output_path",
+			mcp.Description("書き出し先のパス（csvの場合はディレクトリ）"),
+		),
+		withOutputFormat(),
+	)
+	s.AddTool(exportTool, h.handleExportData)
+
+	importTool := mcp.NewTool(
+		"import_data",
+		mcp.WithDescription(`export_data（format=json）でエクスポートしたデータを取り込むツール。
+セッションはエクスポート元のIDのまま保存し、同じIDのセッションが記録済みの場合は取り込みません（同じファイルを何度取り込んでも重複しません）。
+アスリートプロファイルは未登録の場合のみ取り込みます。
+1件でも不正なデータがあれば何も保存しません。取り込み後にPR履歴を再構築します。
+dry_run=trueの場合は保存せずに取り込み件数のみ表示します。
+file_path（ローカルのファイルパス）またはcontent（JSONの内容）のどちらか一方を指定してください。`),
+		mcp.WithString("file_path",
+			mcp.Description("エクスポートしたJSONファイルのローカルパス"),
+		),
+		mcp.WithString("content",
+			mcp.Description("エクスポートしたJSONの内容"),
+		),
+		mcp.WithBoolean("dry_run",
+			mcp.Description("trueの場合は保存せずに取り込み結果のみ表示（デフォルト: false）"),
+		),
+		withOutputFormat(),
+	)
+	s.AddTool(importTool, h.handleImportData)
+
+	return nil
+}
+
+// handleExportData はデータエクスポート処理を行います
+func (h *DataToolHandler) handleExportData(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	format, err := req.RequireString("format")
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: format パラメータが必要です"), nil
+	}
+
+	query := query_dto.ExportDataQuery{
+		Format:     format,
+		OutputPath: req.GetString("output_path", ""),
+	}
+	if startDateStr := req.GetString("start_date", ""); startDateStr != "" {
+		startDate, err := h.calendar.ParseDate(startDateStr)
+		if err != nil {
+			return mcp.NewToolResultError("データが不正です: start_date の形式が不正です: " + err.Error()), nil
+		}
+		query.StartDate = &startDate
+	}
+	if endDateStr := req.GetString("end_date", ""); endDateStr != "" {
+		endDate, err := h.calendar.ParseDate(endDateStr)
+		if err != nil {
+			return mcp.NewToolResultError("データが不正です: end_date の形式が不正です: " + err.Error()), nil
+		}
+		query.EndDate = &endDate
+	}
+
+	if err := query.Validate(); err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	response, err := h.queryHandler.ExportData(ctx, query)
+	if err != nil {
+		return mcp.NewToolResultError("エクスポートに失敗しました: " + err.Error()), nil
+	}
+
+	return newToolResult(output, req, converter.FormatExportDataResponse(response), response), nil
+}
+
+// handleImportData はデータ取り込み処理を行います
+func (h *DataToolHandler) handleImportData(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	cmd := dto.ImportDataCommand{
+		FilePath: req.GetString("file_path", ""),
+		Content:  []byte(req.GetString("content", "")),
+		DryRun:   req.GetBool("dry_run", false),
+	}
+
+	if err := cmd.Validate(); err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	result, err := h.commandHandler.ImportData(ctx, cmd)
+	if err != nil {
+		return mcp.NewToolResultError("取り込みに失敗しました: " + err.Error()), nil
+	}
+
+	return newToolResult(output, req, converter.FormatImportDataResult(result), result), nil
+}
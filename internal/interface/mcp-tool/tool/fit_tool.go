@@ -0,0 +1,95 @@
+package tool
+
+import (
+	"context"
+	"encoding/base64"
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/application/command/handler"
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// FitToolHandler はFITファイル取り込みツールを管理します
+type FitToolHandler struct {
+	commandHandler *handler.ActivityImportCommandHandler
+}
+
+// NewFitToolHandler は新しいFitToolHandlerを作成します
+func NewFitToolHandler(commandHandler *handler.ActivityImportCommandHandler) *FitToolHandler {
+	return &FitToolHandler{
+		commandHandler: commandHandler,
+	}
+}
+
+// Register はFITファイル取り込みツールを登録します
+func (h *FitToolHandler) Register(s *server.MCPServer) error {
+	tool := mcp.NewTool(
+		"import_fit_file",
+		mcp.WithDescription(`Garmin等のデバイスが出力したFITファイルからランまたは筋トレを取り込むツール。
+ファイルに記録された種目（session）で判定します。
+
+【ラン】セッションの距離・タイマー時間（一時停止を除く）・平均心拍数・獲得標高と、デバイスのラップを取り込みます。
+ウォームアップ・クールダウン・休息のラップは休息区間（Rest）として、インターバルの分析から除外します。
+【筋トレ】setメッセージの重量・回数・エクササイズカテゴリを取り込みます。同じカテゴリのセットは1種目にまとめ、
+休息セットは除外します。回数がないセット（プランク等）は時間ベースのセットとして取り込みます。
+
+preview=trueの場合は保存せずに解析結果（強度判定・更新されるPRを含む）のみ表示します。
+file_path（ローカルのファイルパス）またはcontent_base64（ファイルの内容をbase64エンコードしたもの）のどちらか一方を指定してください。`),
+		mcp.WithString("file_path",
+			mcp.Description("FITファイルのローカルパス"),
+		),
+		mcp.WithString("content_base64",
+			mcp.Description("FITファイルの内容（base64エンコード）"),
+		),
+		mcp.WithBoolean("preview",
+			mcp.Description("trueの場合は保存せずに解析結果のみ表示（デフォルト: false）"),
+		),
+		mcp.WithString("run_type",
+			mcp.Description("ランの場合のランニングタイプ（省略時はEasy）"),
+			mcp.Enum("Easy", "Tempo", "Interval", "Long", "Race"),
+		),
+		mcp.WithString("notes",
+			mcp.Description("メモ（省略時はファイル名）"),
+		),
+		withOutputFormat(),
+	)
+
+	s.AddTool(tool, h.handleImportFitFile)
+	return nil
+}
+
+// handleImportFitFile はFITファイル取り込み処理を行います
+func (h *FitToolHandler) handleImportFitFile(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	cmd := dto.ImportFitFileCommand{
+		FilePath: req.GetString("file_path", ""),
+		RunType:  req.GetString("run_type", ""),
+		Notes:    req.GetString("notes", ""),
+		Preview:  req.GetBool("preview", false),
+	}
+
+	if encoded := req.GetString("content_base64", ""); encoded != "" {
+		content, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return mcp.NewToolResultError("content_base64のデコードに失敗しました: " + err.Error()), nil
+		}
+		cmd.Content = content
+	}
+
+	if err := cmd.Validate(); err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	result, err := h.commandHandler.ImportFitFile(ctx, cmd)
+	if err != nil {
+		return mcp.NewToolResultError("取り込みに失敗しました: " + err.Error()), nil
+	}
+
+	return newToolResult(output, req, converter.FormatImportFitFileResult(result), result), nil
+}
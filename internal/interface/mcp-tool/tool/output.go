@@ -0,0 +1,36 @@
+package tool
+
+import (
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// withOutputFormat は全てのツールに共通のoutput_formatパラメータを定義します
+func withOutputFormat() mcp.ToolOption {
+	return mcp.WithString("output_format",
+		mcp.Description("出力形式（省略可、デフォルト: markdown）。json: スキーマ名・バージョン付きのJSON（schema・schema_version・data）、both: MarkdownとJSONの両方"),
+		mcp.Enum(string(converter.OutputMarkdown), string(converter.OutputJSON), string(converter.OutputBoth)),
+	)
+}
+
+// outputFormat はリクエストの出力形式を返します
+func outputFormat(req mcp.CallToolRequest) (converter.OutputFormat, error) {
+	return converter.ParseOutputFormat(req.GetString("output_format", ""))
+}
+
+// newToolResult は出力形式に応じてMarkdown・JSONのテキストを持つ結果を作成します
+func newToolResult(format converter.OutputFormat, req mcp.CallToolRequest, markdown string, data any) *mcp.CallToolResult {
+	result := &mcp.CallToolResult{}
+	if format.IncludesMarkdown() {
+		result.Content = append(result.Content, mcp.NewTextContent(markdown))
+	}
+	if format.IncludesJSON() {
+		text, err := converter.FormatJSON(req.Params.Name, data)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error())
+		}
+		result.Content = append(result.Content, mcp.NewTextContent(text))
+	}
+	return result
+}
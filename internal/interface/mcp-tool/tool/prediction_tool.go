@@ -0,0 +1,78 @@
+package tool
+
+import (
+	"context"
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	query_handler "fitness-mcp-server/internal/application/query/handler"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// PredictionToolHandler はレースタイム予測ツールを管理します
+type PredictionToolHandler struct {
+	queryHandler *query_handler.RunningQueryHandler
+	calendar     shared.Calendar
+}
+
+// NewPredictionToolHandler は新しいPredictionToolHandlerを作成します
+// 日付はユーザーのタイムゾーンのローカル日付として解釈します
+func NewPredictionToolHandler(queryHandler *query_handler.RunningQueryHandler, calendar shared.Calendar) *PredictionToolHandler {
+	return &PredictionToolHandler{
+		queryHandler: queryHandler,
+		calendar:     calendar,
+	}
+}
+
+// Register はレースタイム予測ツールを登録します
+func (h *PredictionToolHandler) Register(s *server.MCPServer) error {
+	tool := mcp.NewTool(
+		"predict_race_times",
+		mcp.WithDescription(`最近のレース・テンポ走の記録から5K・10K・ハーフ・フルマラソンの予測タイムを計算するツール。
+Riegel式・Jack DanielsのVDOT・Cameronモデルの3つの予測を並べて表示し、それぞれの予測の基準にしたセッションも示します。
+
+【使用例】
+- 最近の10Kレースからハーフマラソンのタイムを予測したい場合
+- 直近60日のテンポ走から現在の走力を確認したい場合`),
+		mcp.WithNumber("days",
+			mcp.Description("予測の基準にするセッションの対象期間（日数、省略時は90日、最大365日）"),
+		),
+		mcp.WithString("reference_date",
+			mcp.Description("対象期間の終了日（YYYY-MM-DD形式、省略時は今日）"),
+		),
+		withOutputFormat(),
+	)
+
+	s.AddTool(tool, h.handlePredictRaceTimes)
+	return nil
+}
+
+// handlePredictRaceTimes はレースタイム予測処理を行います
+func (h *PredictionToolHandler) handlePredictRaceTimes(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	query := query_dto.PredictRaceTimesQuery{
+		Days: req.GetInt("days", 0),
+	}
+
+	if dateStr := req.GetString("reference_date", ""); dateStr != "" {
+		date, err := h.calendar.ParseDate(dateStr)
+		if err != nil {
+			return mcp.NewToolResultError("日付の形式が不正です（YYYY-MM-DD形式で入力してください）: " + err.Error()), nil
+		}
+		query.ReferenceDate = date
+	}
+
+	response, err := h.queryHandler.PredictRaceTimes(ctx, query)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("予測に失敗しました: %v", err)), nil
+	}
+
+	return newToolResult(output, req, converter.FormatRacePredictionResponse(response), response), nil
+}
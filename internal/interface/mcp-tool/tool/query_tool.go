@@ -2,11 +2,12 @@ package tool
 
 import (
 	"context"
+	"fmt"
+
 	query_dto "fitness-mcp-server/internal/application/query/dto"
 	query_handler "fitness-mcp-server/internal/application/query/handler"
+	"fitness-mcp-server/internal/domain/shared"
 	"fitness-mcp-server/internal/interface/mcp-tool/converter"
-	"fmt"
-	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -15,12 +16,15 @@ import (
 // QueryToolHandler は期間指定クエリツールを管理します
 type QueryToolHandler struct {
 	queryHandler *query_handler.StrengthQueryHandler
+	calendar     shared.Calendar
 }
 
 // NewQueryToolHandler は新しいQueryToolHandlerを作成します
-func NewQueryToolHandler(queryHandler *query_handler.StrengthQueryHandler) *QueryToolHandler {
+// 日付はユーザーのタイムゾーンのローカル日付として解釈します
+func NewQueryToolHandler(queryHandler *query_handler.StrengthQueryHandler, calendar shared.Calendar) *QueryToolHandler {
 	return &QueryToolHandler{
 		queryHandler: queryHandler,
+		calendar:     calendar,
 	}
 }
 
@@ -37,6 +41,20 @@ func (h *QueryToolHandler) Register(s *server.MCPServer) error {
 			mcp.Required(),
 			mcp.Description("検索終了日（YYYY-MM-DD形式）"),
 		),
+		mcp.WithString("exercise_name",
+			mcp.Description("エクササイズ名で絞り込み（省略可）。例: ベンチプレス"),
+		),
+		mcp.WithString("variation",
+			mcp.Description("バリエーションタグで絞り込み（省略可）。例: paused（テンポのボトム停止も含む）, pin"),
+		),
+		mcp.WithString("range_of_motion",
+			mcp.Description("可動域で絞り込み（省略可）。Full または Partial"),
+			mcp.Enum("Full", "Partial"),
+		),
+		mcp.WithBoolean("tempo_only",
+			mcp.Description("テンポが記録されたセットのみを取得（省略可）"),
+		),
+		withOutputFormat(),
 	)
 
 	toolHandler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -44,70 +62,180 @@ func (h *QueryToolHandler) Register(s *server.MCPServer) error {
 	}
 
 	s.AddTool(tool, toolHandler)
+
+	searchTool := mcp.NewTool(
+		"search_trainings",
+		mcp.WithDescription(`条件に一致するセットを含むトレーニングセッションを検索する。
+セットの条件（エクササイズ・重量・回数・RPE）は、返すセッションの絞り込み（同じセットで全て満たすセットを含むもの）と
+max_weight_desc・volume_descの並び順の計算（一致したセットの最大重量・合計ボリューム）に使います。
+セッションは一致しないセットも含めて全てのセットを返します。
+結果は1ページずつ返し、続きがある場合は next_cursor を cursor に指定して次のページを取得します。`),
+		mcp.WithString("exercise_name",
+			mcp.Description("エクササイズ名または別名で絞り込み（省略可）。例: ベンチプレス, bench press"),
+		),
+		mcp.WithNumber("min_weight_kg",
+			mcp.Description("最小重量（kg、省略可）"),
+		),
+		mcp.WithNumber("max_weight_kg",
+			mcp.Description("最大重量（kg、省略可）"),
+		),
+		mcp.WithNumber("min_reps",
+			mcp.Description("最小回数（省略可）"),
+		),
+		mcp.WithNumber("max_reps",
+			mcp.Description("最大回数（省略可）"),
+		),
+		mcp.WithNumber("min_rpe",
+			mcp.Description("最小RPE（省略可。RPEが記録されていないセットは一致しません）"),
+		),
+		mcp.WithNumber("max_rpe",
+			mcp.Description("最大RPE（省略可。RPEが記録されていないセットは一致しません）"),
+		),
+		mcp.WithString("notes_contains",
+			mcp.Description("セッションのメモに含まれる文字列（省略可）"),
+		),
+		mcp.WithArray("weekdays",
+			mcp.Description("実施した曜日（省略可）。例: [\"Mon\", \"Thu\"] または [\"月\", \"木\"]"),
+			mcp.Items(map[string]any{"type": "string"}),
+		),
+		mcp.WithString("start_date",
+			mcp.Description("検索開始日（YYYY-MM-DD形式、省略可）"),
+		),
+		mcp.WithString("end_date",
+			mcp.Description("検索終了日（YYYY-MM-DD形式、省略可）"),
+		),
+		mcp.WithString("sort",
+			mcp.Description("並び順（省略時は date_desc）。max_weight_desc・volume_desc は一致したセットで計算します"),
+			mcp.Enum(query_dto.SortDateDesc, query_dto.SortDateAsc, query_dto.SortMaxWeightDesc, query_dto.SortVolumeDesc),
+		),
+		mcp.WithString("cursor",
+			mcp.Description("前のページの next_cursor（省略時は先頭のページ）。並び順は前のページと同じにしてください"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description(fmt.Sprintf("1ページの件数（省略時は%d、最大%d）", query_dto.DefaultSearchLimit, query_dto.MaxSearchLimit)),
+		),
+		withOutputFormat(),
+	)
+	s.AddTool(searchTool, h.handleSearchTrainings)
 	return nil
 }
 
 // handleGetTrainingsByDateRange は期間指定トレーニング取得処理を行います
 func (h *QueryToolHandler) handleGetTrainingsByDateRange(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// タイムアウト設定（30秒）
-	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-	defer cancel()
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
 
-	// Goroutineで処理を実行
-	resultCh := make(chan *mcp.CallToolResult, 1)
-	errorCh := make(chan error, 1)
+	// パラメータの取得
+	startDateStr, err := req.RequireString("start_date")
+	if err != nil {
+		return mcp.NewToolResultError("start_date パラメータが必要です: " + err.Error()), nil
+	}
 
-	go func() {
-		// パラメータの取得
-		startDateStr, err := req.RequireString("start_date")
-		if err != nil {
-			errorCh <- fmt.Errorf("start_date パラメータが必要です: %w", err)
-			return
-		}
+	endDateStr, err := req.RequireString("end_date")
+	if err != nil {
+		return mcp.NewToolResultError("end_date パラメータが必要です: " + err.Error()), nil
+	}
 
-		endDateStr, err := req.RequireString("end_date")
-		if err != nil {
-			errorCh <- fmt.Errorf("end_date パラメータが必要です: %w", err)
-			return
-		}
+	// 日付のパース
+	startDate, err := h.calendar.ParseDate(startDateStr)
+	if err != nil {
+		return mcp.NewToolResultError("start_date の形式が不正です: " + err.Error()), nil
+	}
 
-		// 日付のパース
-		startDate, err := time.Parse("2006-01-02", startDateStr)
-		if err != nil {
-			errorCh <- fmt.Errorf("start_date の形式が不正です: %w", err)
-			return
-		}
+	endDate, err := h.calendar.ParseDate(endDateStr)
+	if err != nil {
+		return mcp.NewToolResultError("end_date の形式が不正です: " + err.Error()), nil
+	}
 
-		endDate, err := time.Parse("2006-01-02", endDateStr)
-		if err != nil {
-			errorCh <- fmt.Errorf("end_date の形式が不正です: %w", err)
-			return
-		}
+	// クエリの実行
+	query := query_dto.GetTrainingsByDateRangeQuery{
+		StartDate: startDate,
+		EndDate:   endDate,
+		TempoOnly: req.GetBool("tempo_only", false),
+	}
+
+	// 絞り込み条件（オプション）
+	if exerciseName := req.GetString("exercise_name", ""); exerciseName != "" {
+		query.ExerciseName = &exerciseName
+	}
+	if variation := req.GetString("variation", ""); variation != "" {
+		query.Variation = &variation
+	}
+	if rangeOfMotion := req.GetString("range_of_motion", ""); rangeOfMotion != "" {
+		query.RangeOfMotion = &rangeOfMotion
+	}
+
+	response, err := h.queryHandler.GetTrainingsByDateRange(ctx, query)
+	if err != nil {
+		return mcp.NewToolResultError("トレーニング取得に失敗しました: " + err.Error()), nil
+	}
 
-		// クエリの実行
-		query := query_dto.GetTrainingsByDateRangeQuery{
-			StartDate: startDate,
-			EndDate:   endDate,
+	// レスポンスの整形
+	return newToolResult(output, req, converter.FormatQueryResponse(response), response), nil
+}
+
+// handleSearchTrainings はトレーニングセッションの検索処理を行います
+func (h *QueryToolHandler) handleSearchTrainings(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	paramsMap := req.GetArguments()
+	query := query_dto.SearchTrainingsQuery{
+		MinWeightKg: optionalFloat(paramsMap, "min_weight_kg"),
+		MaxWeightKg: optionalFloat(paramsMap, "max_weight_kg"),
+		MinReps:     optionalInt(paramsMap, "min_reps"),
+		MaxReps:     optionalInt(paramsMap, "max_reps"),
+		MinRPE:      optionalFloat(paramsMap, "min_rpe"),
+		MaxRPE:      optionalFloat(paramsMap, "max_rpe"),
+		Sort:        req.GetString("sort", ""),
+		Cursor:      req.GetString("cursor", ""),
+		Limit:       req.GetInt("limit", 0),
+	}
+	if exerciseName := req.GetString("exercise_name", ""); exerciseName != "" {
+		query.ExerciseName = &exerciseName
+	}
+	if notes := req.GetString("notes_contains", ""); notes != "" {
+		query.NotesContains = &notes
+	}
+	if weekdaysData, exists := paramsMap["weekdays"]; exists {
+		weekdaysSlice, ok := weekdaysData.([]interface{})
+		if !ok {
+			return mcp.NewToolResultError("weekdays は文字列の配列である必要があります"), nil
+		}
+		for _, weekdayData := range weekdaysSlice {
+			weekday, ok := weekdayData.(string)
+			if !ok {
+				return mcp.NewToolResultError("weekdays は文字列の配列である必要があります"), nil
+			}
+			query.Weekdays = append(query.Weekdays, weekday)
 		}
+	}
 
-		response, err := h.queryHandler.GetTrainingsByDateRange(query)
+	// 日付のパース（オプション）
+	if startDateStr := req.GetString("start_date", ""); startDateStr != "" {
+		startDate, err := h.calendar.ParseDate(startDateStr)
 		if err != nil {
-			errorCh <- fmt.Errorf("トレーニング取得に失敗しました: %w", err)
-			return
+			return mcp.NewToolResultError("start_date の形式が不正です: " + err.Error()), nil
 		}
+		query.StartDate = &startDate
+	}
+	if endDateStr := req.GetString("end_date", ""); endDateStr != "" {
+		endDate, err := h.calendar.ParseDate(endDateStr)
+		if err != nil {
+			return mcp.NewToolResultError("end_date の形式が不正です: " + err.Error()), nil
+		}
+		query.EndDate = &endDate
+	}
 
-		// レスポンスの整形
-		result := converter.FormatQueryResponse(response)
-		resultCh <- mcp.NewToolResultText(result)
-	}()
-
-	// タイムアウトまたは結果を待機
-	select {
-	case <-timeoutCtx.Done():
-		return mcp.NewToolResultError("リクエストがタイムアウトしました（30秒）"), nil
-	case err := <-errorCh:
-		return mcp.NewToolResultError(err.Error()), nil
-	case result := <-resultCh:
-		return result, nil
+	response, err := h.queryHandler.SearchTrainings(ctx, query)
+	if err != nil {
+		return mcp.NewToolResultError("トレーニングの検索に失敗しました: " + err.Error()), nil
 	}
+
+	// レスポンスの整形
+	return newToolResult(output, req, converter.FormatSearchTrainingsResponse(response), response), nil
 }
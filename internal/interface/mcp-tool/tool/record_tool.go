@@ -6,7 +6,6 @@ import (
 	query_handler "fitness-mcp-server/internal/application/query/handler"
 	"fitness-mcp-server/internal/interface/mcp-tool/converter"
 	"fmt"
-	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -28,10 +27,14 @@ func NewRecordToolHandler(queryHandler *query_handler.StrengthQueryHandler) *Rec
 func (h *RecordToolHandler) Register(s *server.MCPServer) error {
 	tool := mcp.NewTool(
 		"get_personal_records",
-		mcp.WithDescription("個人記録（最大重量、最大レップ数、最大ボリューム等）を取得する"),
+		mcp.WithDescription("個人記録（最大重量、最大レップ数、最大ボリューム、最長保持時間、最大重量キャリー等）を取得する"),
 		mcp.WithString("exercise_name",
 			mcp.Description("特定のエクササイズ名（省略可）。指定すると該当エクササイズの記録のみを取得します。"),
 		),
+		mcp.WithNumber("carry_distance_m",
+			mcp.Description("最大重量キャリーの対象とする最低距離（m、省略可）。例: 40を指定すると40m以上のキャリーのみを対象にします。"),
+		),
+		withOutputFormat(),
 	)
 
 	toolHandler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -39,53 +42,68 @@ func (h *RecordToolHandler) Register(s *server.MCPServer) error {
 	}
 
 	s.AddTool(tool, toolHandler)
+
+	// PR履歴ツール
+	historyTool := mcp.NewTool(
+		"get_pr_history",
+		mcp.WithDescription("エクササイズごとのPR（自己ベスト）更新履歴を時系列で取得する。いつ、どのセットで1RM〜10RM・推定1RM・セッションボリュームの記録を更新したかを確認できます。"),
+		mcp.WithString("exercise_name",
+			mcp.Description("特定のエクササイズ名（省略可）。指定すると該当エクササイズの履歴のみを取得します。"),
+		),
+		mcp.WithString("record_type",
+			mcp.Description("記録の種類（省略可）。RepMax: nRM、E1RM: 推定1RM、SessionVolume: セッションボリューム"),
+			mcp.Enum("RepMax", "E1RM", "SessionVolume"),
+		),
+		withOutputFormat(),
+	)
+	s.AddTool(historyTool, h.handleGetPRHistory)
 	return nil
 }
 
+// handleGetPRHistory はPR履歴取得処理を行います
+func (h *RecordToolHandler) handleGetPRHistory(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	query := query_dto.GetPRHistoryQuery{}
+	if name := req.GetString("exercise_name", ""); name != "" {
+		query.ExerciseName = &name
+	}
+	if recordType := req.GetString("record_type", ""); recordType != "" {
+		query.RecordType = &recordType
+	}
+
+	response, err := h.queryHandler.GetPRHistory(ctx, query)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("PR履歴の取得に失敗しました: %v", err)), nil
+	}
+
+	return newToolResult(output, req, converter.FormatPRHistoryResponse(response), response), nil
+}
+
 // handleGetPersonalRecords は個人記録取得処理を行います
 func (h *RecordToolHandler) handleGetPersonalRecords(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// タイムアウト設定（30秒）
-	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-	defer cancel()
-
-	// Goroutineで処理を実行
-	resultCh := make(chan *mcp.CallToolResult, 1)
-	errorCh := make(chan error, 1)
-
-	go func() {
-		// パラメータの取得（オプション）
-		var exerciseName *string
-		if paramsMap, ok := req.Params.Arguments.(map[string]interface{}); ok {
-			if name, exists := paramsMap["exercise_name"]; exists {
-				if nameStr, ok := name.(string); ok && nameStr != "" {
-					exerciseName = &nameStr
-				}
-			}
-		}
-
-		// クエリの実行
-		query := query_dto.GetPersonalRecordsQuery{
-			ExerciseName: exerciseName,
-		}
-
-		response, err := h.queryHandler.GetPersonalRecords(query)
-		if err != nil {
-			errorCh <- fmt.Errorf("個人記録取得に失敗しました: %w", err)
-			return
-		}
-
-		// レスポンスの整形
-		result := converter.FormatPersonalRecordsResponse(response)
-		resultCh <- mcp.NewToolResultText(result)
-	}()
-
-	// タイムアウトまたは結果を待機
-	select {
-	case <-timeoutCtx.Done():
-		return mcp.NewToolResultError("リクエストがタイムアウトしました（30秒）"), nil
-	case err := <-errorCh:
-		return mcp.NewToolResultError(err.Error()), nil
-	case result := <-resultCh:
-		return result, nil
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	// パラメータの取得（オプション）
+	query := query_dto.GetPersonalRecordsQuery{}
+	if name := req.GetString("exercise_name", ""); name != "" {
+		query.ExerciseName = &name
+	}
+	if distance := req.GetFloat("carry_distance_m", 0); distance > 0 {
+		query.CarryDistanceMeters = &distance
 	}
+
+	response, err := h.queryHandler.GetPersonalRecords(ctx, query)
+	if err != nil {
+		return mcp.NewToolResultError("個人記録取得に失敗しました: " + err.Error()), nil
+	}
+
+	// レスポンスの整形
+	return newToolResult(output, req, converter.FormatPersonalRecordsResponse(response), response), nil
 }
@@ -0,0 +1,529 @@
+package tool
+
+import (
+	"context"
+	"encoding/base64"
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/application/command/handler"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RunningToolHandler はランニング記録ツールを管理します
+type RunningToolHandler struct {
+	commandHandler *handler.RunningCommandHandler
+	calendar       shared.Calendar
+}
+
+// NewRunningToolHandler は新しいRunningToolHandlerを作成します
+// 日付はユーザーのタイムゾーンのローカル日付として解釈します
+func NewRunningToolHandler(commandHandler *handler.RunningCommandHandler, calendar shared.Calendar) *RunningToolHandler {
+	return &RunningToolHandler{
+		commandHandler: commandHandler,
+		calendar:       calendar,
+	}
+}
+
+// Register はランニング記録ツールを登録します
+func (h *RunningToolHandler) Register(s *server.MCPServer) error {
+	recordTool := mcp.NewTool(
+		"record_running",
+		mcp.WithDescription(`ランニングセッションを記録するツール。距離と時間からペースを計算して保存します。
+記録時にトレーニングゾーン（ペースゾーン・心拍ゾーン）で強度を判定し、
+例えば「Easy」のはずのランが閾値ペースで行われていた場合は警告を表示します。
+ラップ（スプリット）を記録すると、各ラップのペース、インターバルのペースの安定度、ネガティブスプリットかどうかを表示します。
+
+【使用例】
+- 5kmを25分30秒でイージーラン: {"date": "2025-06-16", "distance_km": 5, "duration": "25:30", "run_type": "Easy"}
+- ハーフマラソンのレース: {"date": "2025-06-22", "distance_km": 21.0975, "duration": "1:35:12", "run_type": "Race"}
+- 800m×2本（レスト400mジョグ）: {"date": "2025-06-24", "run_type": "Interval", "laps": [
+    {"distance_m": 800, "duration": "2:55"}, {"distance_m": 400, "duration": "2:30", "type": "Rest"}, {"distance_m": 800, "duration": "2:53"}]}`),
+		mcp.WithString("date",
+			mcp.Required(),
+			mcp.Description("ランニング実施日付。YYYY-MM-DD形式で指定してください。例: 2025-06-16"),
+		),
+		mcp.WithNumber("distance_km",
+			mcp.Description("走行距離（km単位、lapsを指定した場合は省略可でラップの合計）"),
+		),
+		mcp.WithString("duration",
+			mcp.Description(`走行時間。"MM:SS"または"H:MM:SS"形式、もしくは分数（例: 25.5 = 25分30秒）で指定してください（lapsを指定した場合は省略可でラップの合計）`),
+		),
+		mcp.WithString("run_type",
+			mcp.Required(),
+			mcp.Description("ランニングタイプ（Easy: イージー、Tempo: テンポ・閾値走、Interval: インターバル、Long: ロング、Race: レース・タイムトライアル）"),
+			mcp.Enum("Easy", "Tempo", "Interval", "Long", "Race"),
+		),
+		mcp.WithNumber("heart_rate_bpm",
+			mcp.Description("平均心拍数（bpm、省略可）"),
+		),
+		mcp.WithString("notes",
+			mcp.Description("メモや備考（省略可）"),
+		),
+		mcp.WithArray("laps",
+			mcp.Description(`ラップ（スプリット）のリスト（記録順、省略可）。
+
+【lapオブジェクト】
+{
+  "distance_km": ラップ距離（km）または "distance_m": ラップ距離（m）,
+  "duration": ラップタイム（"M:SS"形式、または分数）,
+  "heart_rate_bpm": 平均心拍数（省略可）,
+  "type": "Work"（疾走区間、省略時）または "Rest"（リカバリー・レスト）
+}`),
+		),
+		withOutputFormat(),
+	)
+	s.AddTool(recordTool, h.handleRecordRunning)
+
+	importTool := mcp.NewTool(
+		"import_run_file",
+		mcp.WithDescription(`GPS時計などが出力したGPX・TCXファイルからランニングセッションを取り込むツール。
+距離（ハーバサイン距離、位置のないTCXは記録された距離）、移動時間（停止中を除く）、獲得標高、1kmごとのスプリット、
+平均心拍数（記録されている場合）を計算して保存します。セッションの時間とペースには移動時間を使用します。
+
+file_path（ローカルのファイルパス）またはcontent_base64（ファイルの内容をbase64エンコードしたもの）のどちらか一方を指定してください。`),
+		mcp.WithString("file_path",
+			mcp.Description("GPX・TCXファイルのローカルパス"),
+		),
+		mcp.WithString("content_base64",
+			mcp.Description("GPX・TCXファイルの内容（base64エンコード）"),
+		),
+		mcp.WithString("run_type",
+			mcp.Description("ランニングタイプ（省略時はEasy）"),
+			mcp.Enum("Easy", "Tempo", "Interval", "Long", "Race"),
+		),
+		mcp.WithString("notes",
+			mcp.Description("メモ（省略時はファイル内のトラック名）"),
+		),
+		withOutputFormat(),
+	)
+	s.AddTool(importTool, h.handleImportRunFile)
+
+	profileTool := mcp.NewTool(
+		"set_athlete_profile",
+		mcp.WithDescription(`トレーニングゾーンの算出に使うアスリートの生理学的指標を登録・更新するツール。
+指定した項目のみ更新し、省略した項目は現在の値を維持します。
+
+【心拍ゾーン】最大心拍数と安静時心拍数があればKarvonen法、なければLTHRから算出します
+【ペースゾーン】VDOT、閾値ペースの順に使用し、どちらもなければ直近のレース・テンポ走から推定します`),
+		mcp.WithNumber("max_hr",
+			mcp.Description("最大心拍数（bpm）"),
+		),
+		mcp.WithNumber("resting_hr",
+			mcp.Description("安静時心拍数（bpm）"),
+		),
+		mcp.WithNumber("lthr",
+			mcp.Description("乳酸閾値心拍数（LTHR、bpm）"),
+		),
+		mcp.WithNumber("vdot",
+			mcp.Description("VDOT（20〜90）"),
+		),
+		mcp.WithString("threshold_pace",
+			mcp.Description(`閾値ペース（1kmあたり、"M:SS"形式 例: "4:15"）`),
+		),
+		withOutputFormat(),
+	)
+	s.AddTool(profileTool, h.handleSetAthleteProfile)
+
+	setGoalTool := mcp.NewTool(
+		"set_running_goal",
+		mcp.WithDescription(`ランニングの目標（種目・目標タイム・イベント日）を設定するツール。
+目標タイムと距離から目標ペースを計算して保存します。設定した目標は fitness://goals リソースで参照できます。
+
+【使用例】
+- サブ4マラソン: {"event_type": "Marathon", "target_time": "3:59:59", "event_date": "2025-11-30"}
+- 30kmのカスタム目標: {"event_type": "Custom", "distance_km": 30, "target_time": "2:30:00"}`),
+		mcp.WithString("event_type",
+			mcp.Required(),
+			mcp.Description("種目（5K・10K・Half・Marathon、その他の距離はCustom）"),
+			mcp.Enum("5K", "10K", "Half", "Marathon", "Custom"),
+		),
+		mcp.WithNumber("distance_km",
+			mcp.Description("目標の距離（km、event_typeがCustomの場合のみ指定）"),
+		),
+		mcp.WithString("target_time",
+			mcp.Required(),
+			mcp.Description(`目標タイム（"MM:SS"または"H:MM:SS"形式）`),
+		),
+		mcp.WithString("event_date",
+			mcp.Description("イベント日（YYYY-MM-DD形式、省略可）"),
+		),
+		mcp.WithString("description",
+			mcp.Description("目標の説明（省略可）"),
+		),
+		withOutputFormat(),
+	)
+	s.AddTool(setGoalTool, h.handleSetRunningGoal)
+
+	updateGoalTool := mcp.NewTool(
+		"update_running_goal",
+		mcp.WithDescription(`設定済みのランニング目標の状態・イベント日・説明を更新するツール。
+指定した項目のみ更新し、省略した項目は現在の値を維持します。再開（Active）できるのは一時停止中（Paused）の目標のみです。`),
+		mcp.WithString("goal_id",
+			mcp.Required(),
+			mcp.Description("更新する目標のID（fitness://goals リソースで確認できます）"),
+		),
+		mcp.WithString("status",
+			mcp.Description("目標の状態（Active: 進行中、Achieved: 達成、Paused: 一時停止、Cancelled: 中止）"),
+			mcp.Enum("Active", "Achieved", "Paused", "Cancelled"),
+		),
+		mcp.WithString("event_date",
+			mcp.Description("イベント日（YYYY-MM-DD形式）"),
+		),
+		mcp.WithString("description",
+			mcp.Description("目標の説明"),
+		),
+		withOutputFormat(),
+	)
+	s.AddTool(updateGoalTool, h.handleUpdateRunningGoal)
+
+	return nil
+}
+
+// handleRecordRunning はランニング記録処理を行います
+func (h *RunningToolHandler) handleRecordRunning(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	paramsMap, ok := req.Params.Arguments.(map[string]interface{})
+	if !ok {
+		return mcp.NewToolResultError("パラメータが不正です"), nil
+	}
+
+	dateStr, err := req.RequireString("date")
+	if err != nil {
+		return mcp.NewToolResultError("dateパラメータが必要です: " + err.Error()), nil
+	}
+	date, err := h.calendar.ParseDate(dateStr)
+	if err != nil {
+		return mcp.NewToolResultError("日付の形式が不正です（YYYY-MM-DD形式で入力してください）: " + err.Error()), nil
+	}
+
+	laps, err := parseLaps(paramsMap)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// 距離・時間はラップを指定した場合は省略可（ラップの合計）
+	distanceKm := req.GetFloat("distance_km", 0)
+	if distanceKm == 0 && len(laps) == 0 {
+		return mcp.NewToolResultError("distance_kmパラメータが必要です"), nil
+	}
+
+	var durationSeconds float64
+	if durationData, exists := paramsMap["duration"]; exists || len(laps) == 0 {
+		durationSeconds, err = parseRunDuration(durationData)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
+	runType, err := req.RequireString("run_type")
+	if err != nil {
+		return mcp.NewToolResultError("run_typeパラメータが必要です: " + err.Error()), nil
+	}
+
+	cmd := dto.RecordRunningCommand{
+		Date:            date,
+		DistanceKm:      distanceKm,
+		DurationSeconds: durationSeconds,
+		RunType:         runType,
+		HeartRateBPM:    optionalInt(paramsMap, "heart_rate_bpm"),
+		Notes:           req.GetString("notes", ""),
+		Laps:            laps,
+	}
+
+	if err := cmd.Validate(); err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	result, err := h.commandHandler.RecordRunning(ctx, cmd)
+	if err != nil {
+		return mcp.NewToolResultError("記録に失敗しました: " + err.Error()), nil
+	}
+
+	return newToolResult(output, req, converter.FormatRecordRunningResult(result), result), nil
+}
+
+// handleImportRunFile はGPX・TCXファイル取り込み処理を行います
+func (h *RunningToolHandler) handleImportRunFile(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	cmd := dto.ImportRunFileCommand{
+		FilePath: req.GetString("file_path", ""),
+		RunType:  req.GetString("run_type", ""),
+		Notes:    req.GetString("notes", ""),
+	}
+
+	if encoded := req.GetString("content_base64", ""); encoded != "" {
+		content, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return mcp.NewToolResultError("content_base64のデコードに失敗しました: " + err.Error()), nil
+		}
+		cmd.Content = content
+	}
+
+	if err := cmd.Validate(); err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	result, err := h.commandHandler.ImportRunFile(ctx, cmd)
+	if err != nil {
+		return mcp.NewToolResultError("取り込みに失敗しました: " + err.Error()), nil
+	}
+
+	return newToolResult(output, req, converter.FormatImportRunFileResult(result), result), nil
+}
+
+// handleSetAthleteProfile はアスリートプロファイル更新処理を行います
+func (h *RunningToolHandler) handleSetAthleteProfile(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	paramsMap, ok := req.Params.Arguments.(map[string]interface{})
+	if !ok {
+		return mcp.NewToolResultError("パラメータが不正です"), nil
+	}
+
+	cmd := dto.UpdateAthleteProfileCommand{
+		MaxHeartRate:       optionalInt(paramsMap, "max_hr"),
+		RestingHeartRate:   optionalInt(paramsMap, "resting_hr"),
+		ThresholdHeartRate: optionalInt(paramsMap, "lthr"),
+		VDOT:               optionalFloat(paramsMap, "vdot"),
+	}
+
+	if paceStr := req.GetString("threshold_pace", ""); paceStr != "" {
+		seconds, err := parseClock(paceStr)
+		if err != nil {
+			return mcp.NewToolResultError(`threshold_paceの形式が不正です（"M:SS"形式で入力してください）: ` + err.Error()), nil
+		}
+		cmd.ThresholdPaceSecondsPerKm = &seconds
+	}
+
+	if err := cmd.Validate(); err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	result, err := h.commandHandler.UpdateAthleteProfile(ctx, cmd)
+	if err != nil {
+		return mcp.NewToolResultError("プロファイルの更新に失敗しました: " + err.Error()), nil
+	}
+
+	return newToolResult(output, req, converter.FormatAthleteProfileResult(result), result), nil
+}
+
+// handleSetRunningGoal はランニング目標設定処理を行います
+func (h *RunningToolHandler) handleSetRunningGoal(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	eventType, err := req.RequireString("event_type")
+	if err != nil {
+		return mcp.NewToolResultError("event_typeパラメータが必要です: " + err.Error()), nil
+	}
+
+	targetTimeStr, err := req.RequireString("target_time")
+	if err != nil {
+		return mcp.NewToolResultError("target_timeパラメータが必要です: " + err.Error()), nil
+	}
+	targetTime, err := parseClock(targetTimeStr)
+	if err != nil {
+		return mcp.NewToolResultError(`target_timeの形式が不正です（"MM:SS"・"H:MM:SS"形式で入力してください）: ` + err.Error()), nil
+	}
+
+	cmd := dto.SetRunningGoalCommand{
+		EventType:         eventType,
+		DistanceKm:        req.GetFloat("distance_km", 0),
+		TargetTimeSeconds: targetTime,
+		Description:       req.GetString("description", ""),
+	}
+
+	if dateStr := req.GetString("event_date", ""); dateStr != "" {
+		eventDate, err := h.calendar.ParseDate(dateStr)
+		if err != nil {
+			return mcp.NewToolResultError("event_dateの形式が不正です（YYYY-MM-DD形式で入力してください）: " + err.Error()), nil
+		}
+		cmd.EventDate = &eventDate
+	}
+
+	if err := cmd.Validate(); err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	result, err := h.commandHandler.SetRunningGoal(ctx, cmd)
+	if err != nil {
+		return mcp.NewToolResultError("目標の設定に失敗しました: " + err.Error()), nil
+	}
+
+	return newToolResult(output, req, converter.FormatRunningGoalResult(result), result), nil
+}
+
+// handleUpdateRunningGoal はランニング目標更新処理を行います
+func (h *RunningToolHandler) handleUpdateRunningGoal(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	paramsMap, ok := req.Params.Arguments.(map[string]interface{})
+	if !ok {
+		return mcp.NewToolResultError("パラメータが不正です"), nil
+	}
+
+	goalID, err := req.RequireString("goal_id")
+	if err != nil {
+		return mcp.NewToolResultError("goal_idパラメータが必要です: " + err.Error()), nil
+	}
+
+	cmd := dto.UpdateRunningGoalCommand{
+		GoalID:      goalID,
+		Status:      optionalString(paramsMap, "status"),
+		Description: optionalString(paramsMap, "description"),
+	}
+
+	if dateStr := req.GetString("event_date", ""); dateStr != "" {
+		eventDate, err := h.calendar.ParseDate(dateStr)
+		if err != nil {
+			return mcp.NewToolResultError("event_dateの形式が不正です（YYYY-MM-DD形式で入力してください）: " + err.Error()), nil
+		}
+		cmd.EventDate = &eventDate
+	}
+
+	if err := cmd.Validate(); err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	result, err := h.commandHandler.UpdateRunningGoal(ctx, cmd)
+	if err != nil {
+		return mcp.NewToolResultError("目標の更新に失敗しました: " + err.Error()), nil
+	}
+
+	return newToolResult(output, req, converter.FormatRunningGoalResult(result), result), nil
+}
+
+// parseLaps はリクエストからラップ情報を解析します
+func parseLaps(paramsMap map[string]interface{}) ([]dto.LapDTO, error) {
+	lapsData, exists := paramsMap["laps"]
+	if !exists {
+		return nil, nil
+	}
+
+	lapsSlice, ok := lapsData.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("lapsは配列である必要があります")
+	}
+
+	laps := make([]dto.LapDTO, 0, len(lapsSlice))
+	for i, lapData := range lapsSlice {
+		lapMap, ok := lapData.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("lap[%d]の要素が不正です", i)
+		}
+
+		var distanceKm float64
+		if km, ok := lapMap["distance_km"].(float64); ok {
+			distanceKm = km
+		} else if meters, ok := lapMap["distance_m"].(float64); ok {
+			distanceKm = meters / 1000
+		} else {
+			return nil, fmt.Errorf("lap[%d]: distance_kmまたはdistance_mが必要です", i)
+		}
+
+		durationSeconds, err := parseRunDuration(lapMap["duration"])
+		if err != nil {
+			return nil, fmt.Errorf("lap[%d]: %w", i, err)
+		}
+
+		lapType, _ := lapMap["type"].(string)
+		laps = append(laps, dto.LapDTO{
+			DistanceKm:      distanceKm,
+			DurationSeconds: durationSeconds,
+			HeartRateBPM:    optionalInt(lapMap, "heart_rate_bpm"),
+			Type:            lapType,
+		})
+	}
+	return laps, nil
+}
+
+// parseRunDuration は走行時間（"MM:SS"・"H:MM:SS"形式の文字列、または分数）を秒数に変換します
+func parseRunDuration(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v * 60, nil
+	case string:
+		if minutes, err := strconv.ParseFloat(v, 64); err == nil {
+			return minutes * 60, nil
+		}
+		seconds, err := parseClock(v)
+		if err != nil {
+			return 0, fmt.Errorf(`durationの形式が不正です（"MM:SS"・"H:MM:SS"形式、または分数で入力してください）: %w`, err)
+		}
+		return seconds, nil
+	default:
+		return 0, fmt.Errorf("durationパラメータが必要です")
+	}
+}
+
+// parseClock は"M:SS"・"H:MM:SS"形式の時刻表記を秒数に変換します
+func parseClock(value string) (float64, error) {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid time format: %s", value)
+	}
+
+	total := 0.0
+	for i, part := range parts {
+		number, err := strconv.ParseFloat(part, 64)
+		if err != nil || number < 0 {
+			return 0, fmt.Errorf("invalid time format: %s", value)
+		}
+		// 先頭以外の分・秒は60未満
+		if i > 0 && number >= 60 {
+			return 0, fmt.Errorf("invalid time format: %s", value)
+		}
+		total = total*60 + number
+	}
+	return total, nil
+}
+
+// optionalInt はパラメータマップから整数のオプション値を取得します
+func optionalInt(paramsMap map[string]interface{}, key string) *int {
+	value, ok := paramsMap[key].(float64)
+	if !ok {
+		return nil
+	}
+	intValue := int(value)
+	return &intValue
+}
+
+// optionalFloat はパラメータマップから数値のオプション値を取得します
+func optionalFloat(paramsMap map[string]interface{}, key string) *float64 {
+	value, ok := paramsMap[key].(float64)
+	if !ok {
+		return nil
+	}
+	return &value
+}
+
+// optionalString はパラメータマップから文字列のオプション値を取得します
+func optionalString(paramsMap map[string]interface{}, key string) *string {
+	value, ok := paramsMap[key].(string)
+	if !ok {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,123 @@
+package tool
+
+import (
+	"context"
+	"fitness-mcp-server/internal/application/command/dto"
+	"fitness-mcp-server/internal/application/command/handler"
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// StrengthCSVToolHandler は他アプリの筋トレCSV取り込みツールを管理します
+type StrengthCSVToolHandler struct {
+	commandHandler *handler.StrengthImportCommandHandler
+}
+
+// NewStrengthCSVToolHandler は新しいStrengthCSVToolHandlerを作成します
+func NewStrengthCSVToolHandler(commandHandler *handler.StrengthImportCommandHandler) *StrengthCSVToolHandler {
+	return &StrengthCSVToolHandler{
+		commandHandler: commandHandler,
+	}
+}
+
+// Register は筋トレCSV取り込みツールを登録します
+func (h *StrengthCSVToolHandler) Register(s *server.MCPServer) error {
+	tool := mcp.NewTool(
+		"import_strength_csv",
+		mcp.WithDescription(`Strong・Hevy・FitNotesのCSVエクスポートから筋トレ履歴を取り込むツール。
+アプリはヘッダーから判定し、行を日時・ワークアウト名ごとのセッションにまとめます（FitNotesは日付ごと）。
+
+【単位】重量の単位はヘッダー（Hevyのweight_lbs、FitNotesのWeight (lbs)等）やWeight Unit列から判定し、kgに換算します。
+ファイルから判定できない場合はweight_unitを使用します（省略時はサーバの設定の単位、既定はkg）。
+【エクササイズ名】"Bench Press (Barbell)"等のBIG3の英語名はベンチプレス・スクワット・デッドリフトに対応付けます。
+それ以外はexercise_mapping（アプリの名前→このアプリの名前）で対応付け、指定がなければアプリの名前のまま取り込みます。
+【取り込まない行】ウォームアップセット・休憩タイマーの行・回数/時間/距離のない行・範囲外の値の行。
+【重複】同じ日に同じ内容（エクササイズ・セット）を記録済みのセッションは取り込みません。
+
+全てのセッションを1つのトランザクションで保存し、取り込み後にPR履歴を再構築します。
+dry_run=trueの場合は保存せずに作成・重複・スキップするセッションを表示します。まずドライランで確認してください。
+file_path（ローカルのファイルパス）またはcontent（CSVの内容）のどちらか一方を指定してください。`),
+		mcp.WithString("file_path",
+			mcp.Description("CSVファイルのローカルパス"),
+		),
+		mcp.WithString("content",
+			mcp.Description("CSVファイルの内容"),
+		),
+		mcp.WithString("format",
+			mcp.Description("エクスポート元のアプリ（省略時はヘッダーから判定）"),
+			mcp.Enum("Strong", "Hevy", "FitNotes"),
+		),
+		mcp.WithString("weight_unit",
+			mcp.Description("ファイルから単位を判定できない場合の重量の単位（省略時はサーバの設定の単位、既定はkg）"),
+			mcp.Enum("kg", "lbs"),
+		),
+		mcp.WithObject("exercise_mapping",
+			mcp.Description(`エクササイズ名の対応表（例: {"Incline Bench Press (Dumbbell)": "インクラインダンベルプレス"}）`),
+			mcp.AdditionalProperties(map[string]any{"type": "string"}),
+		),
+		mcp.WithBoolean("dry_run",
+			mcp.Description("trueの場合は保存せずに取り込み結果のみ表示（デフォルト: false）"),
+		),
+		withOutputFormat(),
+	)
+
+	s.AddTool(tool, h.handleImportStrengthCSV)
+	return nil
+}
+
+// handleImportStrengthCSV は筋トレCSV取り込み処理を行います
+func (h *StrengthCSVToolHandler) handleImportStrengthCSV(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	cmd := dto.ImportStrengthCSVCommand{
+		FilePath:   req.GetString("file_path", ""),
+		Content:    []byte(req.GetString("content", "")),
+		Format:     req.GetString("format", ""),
+		WeightUnit: req.GetString("weight_unit", ""),
+		DryRun:     req.GetBool("dry_run", false),
+	}
+
+	mapping, err := parseExerciseMapping(req.GetArguments()["exercise_mapping"])
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+	cmd.ExerciseMapping = mapping
+
+	if err := cmd.Validate(); err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	result, err := h.commandHandler.ImportStrengthCSV(ctx, cmd)
+	if err != nil {
+		return mcp.NewToolResultError("取り込みに失敗しました: " + err.Error()), nil
+	}
+
+	return newToolResult(output, req, converter.FormatImportStrengthCSVResult(result), result), nil
+}
+
+// parseExerciseMapping はエクササイズ名の対応表を解析します
+func parseExerciseMapping(value any) (map[string]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	raw, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("exercise_mapping must be an object")
+	}
+
+	mapping := make(map[string]string, len(raw))
+	for alias, name := range raw {
+		nameStr, ok := name.(string)
+		if !ok {
+			return nil, fmt.Errorf("exercise_mapping value for %q must be a string", alias)
+		}
+		mapping[alias] = nameStr
+	}
+	return mapping, nil
+}
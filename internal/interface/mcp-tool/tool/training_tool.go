@@ -4,8 +4,9 @@ import (
 	"context"
 	"fitness-mcp-server/internal/application/command/dto"
 	"fitness-mcp-server/internal/application/command/handler"
+	"fitness-mcp-server/internal/domain/shared"
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
 	"fmt"
-	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -14,12 +15,15 @@ import (
 // TrainingToolHandler はトレーニング記録ツールを管理します
 type TrainingToolHandler struct {
 	commandHandler *handler.StrengthCommandHandler
+	calendar       shared.Calendar
 }
 
 // NewTrainingToolHandler は新しいTrainingToolHandlerを作成します
-func NewTrainingToolHandler(commandHandler *handler.StrengthCommandHandler) *TrainingToolHandler {
+// 日付はユーザーのタイムゾーンのローカル日付として解釈します
+func NewTrainingToolHandler(commandHandler *handler.StrengthCommandHandler, calendar shared.Calendar) *TrainingToolHandler {
 	return &TrainingToolHandler{
 		commandHandler: commandHandler,
+		calendar:       calendar,
 	}
 }
 
@@ -28,6 +32,8 @@ func (h *TrainingToolHandler) Register(s *server.MCPServer) error {
 	tool := mcp.NewTool(
 		"record_training",
 		mcp.WithDescription(`筋トレセッションの記録を管理するツール。実施したエクササイズ、セット数、重量、回数を記録できます。
+回数の代わりに実施時間（プランク等）や移動距離（キャリー、スレッド等）も記録できます。
+記録時に自己ベスト（1RM〜10RM、推定1RM、セッションボリューム）の更新を検出し、結果に表示します。
 
 【使用例】
 - ベンチプレス 80kg×10回を3セット実施した場合
@@ -49,21 +55,41 @@ func (h *TrainingToolHandler) Register(s *server.MCPServer) error {
 
 【setオブジェクト】
 {
-  "weight_kg": 使用重量（kg、数値）,
+  "weight_kg": 使用重量（kg、数値。時間・距離ベースのセットでは省略可）,
   "reps": 実施回数（回、整数）,
-  "rpe": RPE値（1-10、省略可）
+  "duration_seconds": 実施時間（秒、整数、省略可）,
+  "distance_m": 移動距離（m、数値、省略可）,
+  "rpe": RPE値（1-10、0.5刻み可 例: 8.5、省略可）,
+  "rir": RIR値（余力レップ数 0-9、RPE = 10 - RIR に換算、rpeと同時指定不可、省略可）,
+  "tempo": テンポ（"エキセントリック-ボトム停止-コンセントリック-トップ停止"の秒数、例: "3-1-1-0"、Xは爆発的な局面（0秒として扱い、表示もXのまま）、省略可）,
+  "range_of_motion": 可動域（"Full" または "Partial"、省略可）,
+  "variations": バリエーションタグの配列（例: ["paused"], ["pin"]、省略可）
 }
+reps・duration_seconds・distance_m のいずれか1つ以上を指定してください。
+テンポが記録されたセットは筋緊張時間（TUT）が計算されます。
+
+【テンポ・可動域の例】
+- 3-1-1-0 ポーズベンチ: {"weight_kg": 80, "reps": 5, "tempo": "3-1-1-0", "variations": ["paused"]}
+- ピンプレス: {"weight_kg": 90, "reps": 3, "range_of_motion": "Partial", "variations": ["pin"]}
+
+【時間・距離ベースのセット例】
+- プランク 60秒: {"weight_kg": 0, "duration_seconds": 60}
+- ファーマーズキャリー 32kg×40m: {"weight_kg": 32, "distance_m": 40}
+- スレッドプッシュ 100kg×20mを15秒: {"weight_kg": 100, "distance_m": 20, "duration_seconds": 15}
 
 【RPEについて】
 RPE（Rate of Perceived Exertion）は主観的運動強度です。
 - 1-3: 非常に楽
 - 4-6: 楽〜やや楽  
 - 7-8: きつい
-- 9-10: 非常にきつい〜限界`),
+- 9-10: 非常にきつい〜限界
+8.5のような0.5刻みの値も記録できます。
+「あと2回できた」のようにRIR（Reps in Reserve）で記録する場合は "rir": 2 と指定するとRPE 8として保存されます。`),
 		),
 		mcp.WithString("notes",
 			mcp.Description("セッション全体のメモや備考（省略可）。例: 調子良い、フォーム意識、疲労感あり等"),
 		),
+		withOutputFormat(),
 	)
 
 	toolHandler := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -71,11 +97,39 @@ RPE（Rate of Perceived Exertion）は主観的運動強度です。
 	}
 
 	s.AddTool(tool, toolHandler)
+
+	// PR履歴再構築ツール
+	rebuildTool := mcp.NewTool(
+		"rebuild_pr_history",
+		mcp.WithDescription("全てのトレーニング履歴からPR（自己ベスト）の更新履歴を再構築する。過去の記録を修正・削除した後などに使用します。"),
+		withOutputFormat(),
+	)
+	s.AddTool(rebuildTool, h.handleRebuildPRHistory)
 	return nil
 }
 
+// handleRebuildPRHistory はPR履歴の再構築処理を行います
+func (h *TrainingToolHandler) handleRebuildPRHistory(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	result, err := h.commandHandler.RebuildPersonalRecords(ctx)
+	if err != nil {
+		return mcp.NewToolResultError("PR履歴の再構築に失敗しました: " + err.Error()), nil
+	}
+
+	return newToolResult(output, req, result.Message, result), nil
+}
+
 // handleRecordTraining はトレーニング記録処理を行います
 func (h *TrainingToolHandler) handleRecordTraining(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
 	// パラメータマップの取得
 	paramsMap, ok := req.Params.Arguments.(map[string]interface{})
 	if !ok {
@@ -88,7 +142,7 @@ func (h *TrainingToolHandler) handleRecordTraining(ctx context.Context, req mcp.
 		return mcp.NewToolResultError("dateパラメータが必要です: " + err.Error()), nil
 	}
 
-	date, err := time.Parse("2006-01-02", dateStr)
+	date, err := h.calendar.ParseDate(dateStr)
 	if err != nil {
 		return mcp.NewToolResultError("日付の形式が不正です（YYYY-MM-DD形式で入力してください）: " + err.Error()), nil
 	}
@@ -119,15 +173,15 @@ func (h *TrainingToolHandler) handleRecordTraining(ctx context.Context, req mcp.
 		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
 	}
 
-	result, err := h.commandHandler.RecordTraining(cmd)
+	result, err := h.commandHandler.RecordTraining(ctx, cmd)
 	if err != nil {
 		return mcp.NewToolResultError("記録に失敗しました: " + err.Error()), nil
 	}
 
-	// 結果をテキストで返す
-	return mcp.NewToolResultText(
-		fmt.Sprintf("記録完了: TrainingID=%v, メッセージ=%v", result.TrainingID, result.Message),
-	), nil
+	// 結果を出力形式に応じて返す（PRを更新した場合は併せて表示）
+	markdown := fmt.Sprintf("記録完了: TrainingID=%v, メッセージ=%v", result.TrainingID, result.Message) +
+		converter.FormatNewRecords(result.NewRecords)
+	return newToolResult(output, req, markdown, result), nil
 }
 
 // parseExercises はリクエストからエクササイズ情報を解析します
@@ -189,34 +243,88 @@ func (h *TrainingToolHandler) parseSets(exerciseMap map[string]interface{}) ([]d
 			return nil, fmt.Errorf("set要素が不正です")
 		}
 
-		// 重量、回数の取得
+		// 実施時間・移動距離（オプション）
+		var durationSeconds *int
+		if durationFloat, ok := setMap["duration_seconds"].(float64); ok {
+			durationInt := int(durationFloat)
+			durationSeconds = &durationInt
+		}
+
+		var distanceMeters *float64
+		if distanceFloat, ok := setMap["distance_m"].(float64); ok {
+			distanceMeters = &distanceFloat
+		}
+
+		hasTimeOrDistance := durationSeconds != nil || distanceMeters != nil
+
+		// 重量、回数の取得（時間・距離ベースのセットでは省略可）
 		weightKg, ok := setMap["weight_kg"].(float64)
-		if !ok {
+		if !ok && !hasTimeOrDistance {
 			return nil, fmt.Errorf("weight_kgが必要です")
 		}
 
 		repsFloat, ok := setMap["reps"].(float64)
-		if !ok {
-			return nil, fmt.Errorf("repsが必要です")
+		if !ok && !hasTimeOrDistance {
+			return nil, fmt.Errorf("repsが必要です（時間・距離ベースのセットの場合はduration_secondsまたはdistance_mを指定してください）")
 		}
 		reps := int(repsFloat)
 
-		// RPE（オプション）
-		var rpe *int
+		// RPE・RIR（オプション）
+		var rpe *float64
 		if rpeData, exists := setMap["rpe"]; exists {
 			if rpeFloat, ok := rpeData.(float64); ok {
-				rpeInt := int(rpeFloat)
-				if rpeInt < 1 || rpeInt > 10 {
+				if rpeFloat < 1 || rpeFloat > 10 {
 					return nil, fmt.Errorf("RPEは1-10の範囲で指定してください（1:非常に楽 〜 10:限界）")
 				}
-				rpe = &rpeInt
+				rpe = &rpeFloat
+			}
+		}
+
+		var rir *float64
+		if rirData, exists := setMap["rir"]; exists {
+			if rirFloat, ok := rirData.(float64); ok {
+				if rpe != nil {
+					return nil, fmt.Errorf("rpeとrirはどちらか一方のみ指定してください")
+				}
+				if rirFloat < 0 || rirFloat > 9 {
+					return nil, fmt.Errorf("RIRは0-9の範囲で指定してください（0:限界 〜 あと何回できたか）")
+				}
+				rir = &rirFloat
+			}
+		}
+
+		// テンポ・可動域・バリエーション（オプション）
+		var tempo *string
+		if tempoStr, ok := setMap["tempo"].(string); ok && tempoStr != "" {
+			tempo = &tempoStr
+		}
+
+		var rangeOfMotion *string
+		if romStr, ok := setMap["range_of_motion"].(string); ok && romStr != "" {
+			rangeOfMotion = &romStr
+		}
+
+		var variations []string
+		if variationsData, ok := setMap["variations"].([]interface{}); ok {
+			for _, variationData := range variationsData {
+				variation, ok := variationData.(string)
+				if !ok {
+					return nil, fmt.Errorf("variationsは文字列の配列である必要があります")
+				}
+				variations = append(variations, variation)
 			}
 		}
 
 		sets = append(sets, dto.SetDTO{
-			WeightKg: weightKg,
-			Reps:     reps,
-			RPE:      rpe,
+			WeightKg:        weightKg,
+			Reps:            reps,
+			DurationSeconds: durationSeconds,
+			DistanceMeters:  distanceMeters,
+			RPE:             rpe,
+			RIR:             rir,
+			Tempo:           tempo,
+			RangeOfMotion:   rangeOfMotion,
+			Variations:      variations,
 		})
 	}
 
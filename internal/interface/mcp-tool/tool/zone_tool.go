@@ -0,0 +1,90 @@
+package tool
+
+import (
+	"context"
+	query_dto "fitness-mcp-server/internal/application/query/dto"
+	query_handler "fitness-mcp-server/internal/application/query/handler"
+	"fitness-mcp-server/internal/interface/mcp-tool/converter"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ZoneToolHandler はトレーニングゾーン取得ツールを管理します
+type ZoneToolHandler struct {
+	queryHandler *query_handler.RunningQueryHandler
+}
+
+// NewZoneToolHandler は新しいZoneToolHandlerを作成します
+func NewZoneToolHandler(queryHandler *query_handler.RunningQueryHandler) *ZoneToolHandler {
+	return &ZoneToolHandler{
+		queryHandler: queryHandler,
+	}
+}
+
+// Register はトレーニングゾーン取得ツールを登録します
+func (h *ZoneToolHandler) Register(s *server.MCPServer) error {
+	tool := mcp.NewTool(
+		"get_training_zones",
+		mcp.WithDescription(`ペースゾーン（Easy・Marathon・Threshold・Interval・Repetition）と心拍ゾーン1〜5を計算するツール。
+set_athlete_profileで登録したプロファイルを使用します。パラメータを指定するとプロファイルより優先されます。
+
+【ペースゾーン】VDOT → 閾値ペース → 直近90日のレース・テンポ走から推定したVDOT の順に使用
+【心拍ゾーン】最大心拍数と安静時心拍数（Karvonen法）→ LTHR の順に使用`),
+		mcp.WithNumber("vdot",
+			mcp.Description("VDOT（20〜90、省略時はプロファイルの値）"),
+		),
+		mcp.WithString("threshold_pace",
+			mcp.Description(`閾値ペース（1kmあたり、"M:SS"形式 例: "4:15"、省略時はプロファイルの値）`),
+		),
+		mcp.WithNumber("max_hr",
+			mcp.Description("最大心拍数（bpm、省略時はプロファイルの値）"),
+		),
+		mcp.WithNumber("resting_hr",
+			mcp.Description("安静時心拍数（bpm、省略時はプロファイルの値）"),
+		),
+		mcp.WithNumber("lthr",
+			mcp.Description("乳酸閾値心拍数（bpm、省略時はプロファイルの値）"),
+		),
+		withOutputFormat(),
+	)
+
+	s.AddTool(tool, h.handleGetTrainingZones)
+	return nil
+}
+
+// handleGetTrainingZones はトレーニングゾーン取得処理を行います
+func (h *ZoneToolHandler) handleGetTrainingZones(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	output, err := outputFormat(req)
+	if err != nil {
+		return mcp.NewToolResultError("データが不正です: " + err.Error()), nil
+	}
+
+	paramsMap, ok := req.Params.Arguments.(map[string]interface{})
+	if !ok {
+		paramsMap = map[string]interface{}{}
+	}
+
+	query := query_dto.GetTrainingZonesQuery{
+		VDOT:               optionalFloat(paramsMap, "vdot"),
+		MaxHeartRate:       optionalInt(paramsMap, "max_hr"),
+		RestingHeartRate:   optionalInt(paramsMap, "resting_hr"),
+		ThresholdHeartRate: optionalInt(paramsMap, "lthr"),
+	}
+
+	if paceStr := req.GetString("threshold_pace", ""); paceStr != "" {
+		seconds, err := parseClock(paceStr)
+		if err != nil {
+			return mcp.NewToolResultError(`threshold_paceの形式が不正です（"M:SS"形式で入力してください）: ` + err.Error()), nil
+		}
+		query.ThresholdPaceSecondsPerKm = &seconds
+	}
+
+	response, err := h.queryHandler.GetTrainingZones(ctx, query)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("トレーニングゾーンの取得に失敗しました: %v", err)), nil
+	}
+
+	return newToolResult(output, req, converter.FormatTrainingZonesResponse(response), response), nil
+}
@@ -0,0 +1,18 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"fitness-mcp-server/internal/domain/body"
+	"fitness-mcp-server/internal/domain/shared"
+)
+
+// BodyMetricQueryService は身体測定値の読み取り専用サービスインターフェース
+type BodyMetricQueryService interface {
+	// FindByDateRange は指定した期間の身体測定値を測定日時順に検索します
+	FindByDateRange(ctx context.Context, start, end time.Time) ([]*body.Metric, error)
+
+	// ExistsByID はIDの身体測定値が存在するかチェックします
+	ExistsByID(ctx context.Context, id shared.BodyMetricID) (bool, error)
+}
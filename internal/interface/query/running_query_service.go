@@ -0,0 +1,27 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"fitness-mcp-server/internal/domain/running"
+	"fitness-mcp-server/internal/domain/shared"
+)
+
+// RunningQueryService はランニングデータの読み取り専用サービスインターフェース
+type RunningQueryService interface {
+	// FindByDateRange は指定した期間のランニングセッションを検索します
+	FindByDateRange(ctx context.Context, start, end time.Time) ([]*running.RunningSession, error)
+
+	// FindAthleteProfile はアスリートプロファイルを取得します（未登録の場合はnil）
+	FindAthleteProfile(ctx context.Context) (*running.AthleteProfile, error)
+
+	// ExistsByID はIDのランニングセッションが存在するかチェックします
+	ExistsByID(ctx context.Context, id shared.SessionID) (bool, error)
+
+	// FindGoals はランニング目標を作成日時順に取得します
+	FindGoals(ctx context.Context) ([]*running.RunningGoal, error)
+
+	// FindGoalByID はIDでランニング目標を取得します（存在しない場合はnil）
+	FindGoalByID(ctx context.Context, id shared.GoalID) (*running.RunningGoal, error)
+}
@@ -1,6 +1,7 @@
 package query
 
 import (
+	"context"
 	"time"
 
 	"fitness-mcp-server/internal/application/query/dto"
@@ -11,20 +12,26 @@ import (
 // StrengthQueryService は筋トレデータの読み取り専用サービスインターフェース
 type StrengthQueryService interface {
 	// FindByID はIDで筋トレセッションを検索します
-	FindByID(id shared.TrainingID) (*strength.StrengthTraining, error)
+	FindByID(ctx context.Context, id shared.TrainingID) (*strength.StrengthTraining, error)
 
 	// FindByDateRange は指定した期間の筋トレセッションを検索します
-	FindByDateRange(start, end time.Time) ([]*strength.StrengthTraining, error)
+	FindByDateRange(ctx context.Context, start, end time.Time) ([]*strength.StrengthTraining, error)
 
 	// FindByDate は指定した日の筋トレセッションを検索します
-	FindByDate(date time.Time) ([]*strength.StrengthTraining, error)
+	FindByDate(ctx context.Context, date time.Time) ([]*strength.StrengthTraining, error)
 
 	// FindAll は全ての筋トレセッションを検索します
-	FindAll() ([]*strength.StrengthTraining, error)
+	FindAll(ctx context.Context) ([]*strength.StrengthTraining, error)
+
+	// Search は条件に一致するセットを含む筋トレセッションを並び順に1ページ分検索します
+	Search(ctx context.Context, criteria dto.TrainingSearchCriteria) (*dto.TrainingSearchQueryResult, error)
 
 	// GetPersonalRecords は個人記録を取得します
-	GetPersonalRecords(exerciseName *string) ([]dto.PersonalRecordQueryResult, error)
+	GetPersonalRecords(ctx context.Context, exerciseName *string, carryDistanceMeters *float64) ([]dto.PersonalRecordQueryResult, error)
+
+	// GetPersonalRecordHistory はPR更新イベントを日付順に取得します
+	GetPersonalRecordHistory(ctx context.Context, exerciseName *string, recordType *string) ([]dto.PersonalRecordEventQueryResult, error)
 
 	// ExistsById はIDの筋トレセッションが存在するかチェックします
-	ExistsById(id shared.TrainingID) (bool, error)
+	ExistsById(ctx context.Context, id shared.TrainingID) (bool, error)
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/domain/body"
+)
+
+// BodyMetricRepository は身体測定値の永続化を担当するインターフェース（書き込み専用）
+type BodyMetricRepository interface {
+	// Save は身体測定値を保存します
+	Save(ctx context.Context, metric *body.Metric) error
+	// SaveAll は複数の身体測定値を1つのトランザクションで保存します
+	SaveAll(ctx context.Context, metrics []*body.Metric) error
+}
@@ -1,11 +1,31 @@
 package repository
 
 import (
+	"context"
+
 	"fitness-mcp-server/internal/domain/running"
 )
 
 // RunningRepository はランニングデータの永続化を担当するインターフェース（書き込み専用）
 type RunningRepository interface {
 	// Save はランニングセッションを保存します
-	Save(session *running.RunningSession) error
+	Save(ctx context.Context, session *running.RunningSession) error
+	// SaveAll は複数のランニングセッションを1つのトランザクションで保存します
+	SaveAll(ctx context.Context, sessions []*running.RunningSession) error
+}
+
+// AthleteProfileRepository はアスリートプロファイルの永続化を担当するインターフェース（書き込み専用）
+type AthleteProfileRepository interface {
+	// Save はアスリートプロファイルを保存します（既存のプロファイルは上書きします）
+	Save(ctx context.Context, profile *running.AthleteProfile) error
+}
+
+// RunningGoalRepository はランニング目標の永続化を担当するインターフェース（書き込み専用）
+type RunningGoalRepository interface {
+	// Save はランニング目標を保存します
+	Save(ctx context.Context, goal *running.RunningGoal) error
+	// SaveAll は複数のランニング目標を1つのトランザクションで保存します
+	SaveAll(ctx context.Context, goals []*running.RunningGoal) error
+	// Update は既存のランニング目標の状態・イベント日・説明を更新します
+	Update(ctx context.Context, goal *running.RunningGoal) error
 }
@@ -1,21 +1,38 @@
 package repository
 
 import (
+	"context"
+
 	"fitness-mcp-server/internal/domain/shared"
 	"fitness-mcp-server/internal/domain/strength"
 )
 
 // StrengthTrainingRepository は筋トレデータの永続化を担当するインターフェース（書き込み専用）
 type StrengthTrainingRepository interface {
-	// Initialize はデータベースの初期化を行います
-	Initialize() error
-
 	// Save は筋トレセッションを保存します
-	Save(training *strength.StrengthTraining) error
+	Save(ctx context.Context, training *strength.StrengthTraining) error
+
+	// SaveAll は複数の筋トレセッションを1つのトランザクションで保存します
+	SaveAll(ctx context.Context, trainings []*strength.StrengthTraining) error
 
 	// Update は既存の筋トレセッションを更新します
-	Update(training *strength.StrengthTraining) error
+	Update(ctx context.Context, training *strength.StrengthTraining) error
 
 	// Delete は筋トレセッションを削除します
-	Delete(id shared.TrainingID) error
+	Delete(ctx context.Context, id shared.TrainingID) error
+}
+
+// PersonalRecordRepository はPRイベントログの永続化を担当するインターフェース
+type PersonalRecordRepository interface {
+	// LoadRecordBook は現在の自己ベストを復元したRecordBookを返します
+	LoadRecordBook(ctx context.Context) (*strength.RecordBook, error)
+
+	// SaveEvents はPRイベントを追記します
+	SaveEvents(ctx context.Context, events []strength.PersonalRecordEvent) error
+
+	// ReplaceAll はPRイベントログを全て置き換えます（履歴からの再構築用）
+	ReplaceAll(ctx context.Context, events []strength.PersonalRecordEvent) error
+
+	// CountEvents は保存されているPRイベント数を返します
+	CountEvents(ctx context.Context) (int, error)
 }
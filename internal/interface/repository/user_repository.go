@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"fitness-mcp-server/internal/domain/user"
+)
+
+// UserRepository はユーザーの永続化を担当するインターフェース
+// ユーザーの管理は認証より前に行うため、他のリポジトリと異なりコンテキストのユーザーでは絞り込みません
+type UserRepository interface {
+	// Save はユーザーを保存します（既存のユーザーはトークンを更新します）
+	Save(ctx context.Context, u *user.User) error
+
+	// FindByID はIDでユーザーを検索します（存在しない場合はnil）
+	FindByID(ctx context.Context, id user.ID) (*user.User, error)
+
+	// FindByTokenHash はトークンのハッシュでユーザーを検索します（存在しない場合はnil）
+	FindByTokenHash(ctx context.Context, tokenHash string) (*user.User, error)
+
+	// FindAll は全てのユーザーを作成日時順に返します
+	FindAll(ctx context.Context) ([]*user.User, error)
+}
@@ -0,0 +1,61 @@
+package transport
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"fitness-mcp-server/internal/application/auth"
+)
+
+// APIKeyHeader はAuthorizationヘッダを設定できないクライアント向けのトークンのヘッダ
+const APIKeyHeader = "X-API-Key"
+
+// authenticate はリクエストのトークンからユーザーを特定し、コンテキストに設定してから次のハンドラを呼び出します
+// トークンが不要な設定の場合は、全てのリクエストを既定のユーザーとして扱います
+func authenticate(authenticator *auth.Authenticator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !authenticator.RequiresToken() {
+			next.ServeHTTP(w, r.WithContext(auth.WithUser(r.Context(), authenticator.DefaultUser())))
+			return
+		}
+
+		token := tokenFromRequest(r)
+		if token == "" {
+			unauthorized(w, "missing token")
+			return
+		}
+
+		userID, err := authenticator.Authenticate(r.Context(), token)
+		if errors.Is(err, auth.ErrInvalidToken) {
+			unauthorized(w, "invalid token")
+			return
+		}
+		if err != nil {
+			slog.ErrorContext(r.Context(), "failed to authenticate request", "error", err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(auth.WithUser(r.Context(), userID)))
+	})
+}
+
+// tokenFromRequest はAuthorization: Bearer またはX-API-Keyヘッダのトークンを返します
+func tokenFromRequest(r *http.Request) string {
+	if header := r.Header.Get("Authorization"); header != "" {
+		scheme, token, found := strings.Cut(header, " ")
+		if found && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token)
+		}
+		return ""
+	}
+	return strings.TrimSpace(r.Header.Get(APIKeyHeader))
+}
+
+// unauthorized は401を返します
+func unauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="fitness-mcp-server"`)
+	http.Error(w, message, http.StatusUnauthorized)
+}
@@ -0,0 +1,119 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"fitness-mcp-server/internal/application/auth"
+	"fitness-mcp-server/internal/config"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// =============================================================================
+// MCPサーバのトランスポート - 標準入出力・SSE・Streamable HTTPでの待ち受けと終了処理
+// =============================================================================
+
+// StreamableHTTPPath はStreamable HTTPのエンドポイント
+const StreamableHTTPPath = "/mcp"
+
+// Serve は設定したトランスポートでMCPサーバを実行します
+// ctxがキャンセルされると新しいリクエストの受け付けを止め、処理中のリクエストの完了を待ってから戻ります
+// 標準入出力は既定のユーザー、HTTP系はリクエストのトークンのユーザーとしてツールを実行します
+func Serve(ctx context.Context, mcpServer *server.MCPServer, cfg config.TransportConfig, authenticator *auth.Authenticator) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	if cfg.Type == config.TransportStdio {
+		slog.InfoContext(ctx, "serving MCP over stdio", "user", authenticator.DefaultUser().String())
+		err := server.NewStdioServer(mcpServer).Listen(auth.WithUser(ctx, authenticator.DefaultUser()), os.Stdin, os.Stdout)
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		return err
+	}
+
+	listener, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", cfg.Addr, err)
+	}
+	return ServeListener(ctx, mcpServer, cfg, authenticator, listener)
+}
+
+// ServeListener はHTTP系のトランスポートでMCPサーバを実行します（listenerは終了時に閉じます）
+func ServeListener(ctx context.Context, mcpServer *server.MCPServer, cfg config.TransportConfig, authenticator *auth.Authenticator, listener net.Listener) error {
+	httpServer := &http.Server{ReadHeaderTimeout: 10 * time.Second}
+	shutdown, err := mountHandler(httpServer, mcpServer, cfg)
+	if err != nil {
+		listener.Close()
+		return err
+	}
+	httpServer.Handler = authenticate(authenticator, httpServer.Handler)
+
+	scheme := "http"
+	if cfg.UsesTLS() {
+		scheme = "https"
+	}
+	slog.InfoContext(ctx, "serving MCP", "transport", cfg.Type, "url", fmt.Sprintf("%s://%s", scheme, listener.Addr()), "token_required", authenticator.RequiresToken())
+
+	errCh := make(chan error, 1)
+	go func() {
+		if cfg.UsesTLS() {
+			errCh <- httpServer.ServeTLS(listener, cfg.TLSCertFile, cfg.TLSKeyFile)
+			return
+		}
+		errCh <- httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("server error: %w", err)
+	case <-ctx.Done():
+	}
+
+	slog.Info("shutting down MCP server", "timeout_seconds", cfg.ShutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.ShutdownTimeout)*time.Second)
+	defer cancel()
+
+	if err := shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down server: %w", err)
+	}
+	if err := <-errCh; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("server error: %w", err)
+	}
+	slog.Info("MCP server stopped")
+	return nil
+}
+
+// mountHandler はトランスポートのハンドラをHTTPサーバに設定し、終了処理の関数を返します
+// 終了処理はSSEのストリームを閉じてからHTTPサーバを停止します（接続中のクライアントがいても終了できるように）
+func mountHandler(httpServer *http.Server, mcpServer *server.MCPServer, cfg config.TransportConfig) (func(context.Context) error, error) {
+	switch cfg.Type {
+	case config.TransportSSE:
+		options := []server.SSEOption{server.WithHTTPServer(httpServer)}
+		if cfg.BaseURL != "" {
+			options = append(options, server.WithBaseURL(cfg.BaseURL))
+		}
+		sse := server.NewSSEServer(mcpServer, options...)
+		httpServer.Handler = sse
+		return sse.Shutdown, nil
+	case config.TransportStreamableHTTP:
+		streamable := server.NewStreamableHTTPServer(mcpServer,
+			server.WithEndpointPath(StreamableHTTPPath),
+			server.WithStreamableHTTPServer(httpServer),
+		)
+		mux := http.NewServeMux()
+		mux.Handle(StreamableHTTPPath, streamable)
+		httpServer.Handler = mux
+		return streamable.Shutdown, nil
+	default:
+		return nil, fmt.Errorf("transport %q does not serve HTTP", cfg.Type)
+	}
+}
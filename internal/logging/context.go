@@ -0,0 +1,46 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+// attrsKey はコンテキストにリクエストの属性を格納するキー
+type attrsKey struct{}
+
+// WithAttrs はログに付与するリクエストの属性（ツール名・リクエストIDなど）をコンテキストに追加します
+func WithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	existing, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+	merged := make([]slog.Attr, 0, len(existing)+len(attrs))
+	merged = append(merged, existing...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, attrsKey{}, merged)
+}
+
+// contextHandler はコンテキストのリクエストの属性を付与するハンドラ
+type contextHandler struct {
+	slog.Handler
+}
+
+// newContextHandler はハンドラをコンテキストの属性を付与するハンドラで包みます
+func newContextHandler(handler slog.Handler) slog.Handler {
+	return contextHandler{Handler: handler}
+}
+
+// Handle はコンテキストの属性を付与してログを出力します
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if attrs, ok := ctx.Value(attrsKey{}).([]slog.Attr); ok {
+		record.AddAttrs(attrs...)
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs は属性を追加したハンドラを返します
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup はグループを追加したハンドラを返します
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{Handler: h.Handler.WithGroup(name)}
+}
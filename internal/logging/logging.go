@@ -0,0 +1,63 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"fitness-mcp-server/internal/config"
+)
+
+// =============================================================================
+// 構造化ログ - log/slogのハンドラの設定
+// =============================================================================
+
+// Setup は設定に従ってslogの既定のロガーを設定します（logパッケージの出力もこのロガーに送られます）
+// ログは常に標準エラー出力に書き込みます（標準入出力のJSON-RPCのストリームを壊さないため）
+// LogFileを指定した場合はサイズでローテーションするファイルにも書き込み、終了時に閉じるCloserを返します
+func Setup(cfg config.ServerConfig) (io.Closer, error) {
+	var writer io.Writer = os.Stderr
+	var closer io.Closer = nopCloser{}
+	if cfg.LogFile != "" {
+		file, err := OpenRotatingFile(cfg.LogFile, int64(cfg.LogMaxSizeMB)*1024*1024, cfg.LogMaxBackups)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open log file: %w", err)
+		}
+		writer = io.MultiWriter(os.Stderr, file)
+		closer = file
+	}
+
+	slog.SetDefault(slog.New(NewHandler(writer, cfg.LogFormat, ParseLevel(cfg.LogLevel))))
+	return closer, nil
+}
+
+// NewHandler は形式（text・json）とレベルを指定したハンドラを作成します
+// コンテキストに設定したリクエストの属性（WithAttrs）を各ログに付与します
+func NewHandler(w io.Writer, format string, level slog.Leveler) slog.Handler {
+	options := &slog.HandlerOptions{Level: level}
+	if format == config.LogFormatJSON {
+		return newContextHandler(slog.NewJSONHandler(w, options))
+	}
+	return newContextHandler(slog.NewTextHandler(w, options))
+}
+
+// ParseLevel はログレベルの文字列を解析します（不明な値はinfo）
+func ParseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// nopCloser はファイルに出力しない場合のCloser
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
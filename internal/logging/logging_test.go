@@ -0,0 +1,144 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  slog.Level
+	}{
+		{name: "正常系:debug", input: "debug", want: slog.LevelDebug},
+		{name: "正常系:大文字でも解析する", input: "WARN", want: slog.LevelWarn},
+		{name: "正常系:warning", input: "warning", want: slog.LevelWarn},
+		{name: "正常系:error", input: "error", want: slog.LevelError},
+		{name: "正常系:未指定はinfo", input: "", want: slog.LevelInfo},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := ParseLevel(tt.input)
+
+			// Assert
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewHandler(t *testing.T) {
+	t.Run("正常系:JSON形式でコンテキストの属性を付与する", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+		logger := slog.New(NewHandler(&buf, "json", slog.LevelInfo))
+		ctx := WithAttrs(context.Background(), slog.String("tool", "record_training"))
+		ctx = WithAttrs(ctx, slog.String("request_id", "abc123"))
+
+		// Act
+		logger.InfoContext(ctx, "tool request", "arguments", "{}")
+
+		// Assert
+		var entry map[string]any
+		if assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry)) {
+			assert.Equal(t, "tool request", entry["msg"])
+			assert.Equal(t, "record_training", entry["tool"])
+			assert.Equal(t, "abc123", entry["request_id"])
+			assert.Equal(t, "{}", entry["arguments"])
+		}
+	})
+
+	t.Run("正常系:レベル未満のログは出力しない", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+		logger := slog.New(NewHandler(&buf, "text", slog.LevelWarn))
+
+		// Act
+		logger.Info("saved")
+		logger.Warn("failed")
+
+		// Assert
+		assert.NotContains(t, buf.String(), "msg=saved")
+		assert.Contains(t, buf.String(), "level=WARN msg=failed")
+	})
+
+	t.Run("正常系:WithやWithGroupの後もコンテキストの属性を付与する", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+		logger := slog.New(NewHandler(&buf, "text", slog.LevelInfo)).With("component", "notifier")
+		ctx := WithAttrs(context.Background(), slog.String("request_id", "abc123"))
+
+		// Act
+		logger.InfoContext(ctx, "notified")
+
+		// Assert
+		assert.Contains(t, buf.String(), "msg=notified component=notifier request_id=abc123")
+	})
+}
+
+func TestRotatingFile(t *testing.T) {
+	t.Run("正常系:上限を超えるとローテーションし、世代数を超えた分を削除する", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "server.log")
+		file, err := OpenRotatingFile(path, 10, 2)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer file.Close()
+
+		// Act
+		for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+			_, err := file.Write([]byte(line))
+			assert.NoError(t, err)
+		}
+
+		// Assert
+		assert.Equal(t, "fourth\n", readFile(t, path))
+		assert.Equal(t, "third\n", readFile(t, path+".1"))
+		assert.Equal(t, "second\n", readFile(t, path+".2"))
+		assert.NoFileExists(t, path+".3")
+	})
+
+	t.Run("正常系:既存のファイルに追記し、そのサイズを上限の判定に含める", func(t *testing.T) {
+		// Arrange
+		path := filepath.Join(t.TempDir(), "server.log")
+		assert.NoError(t, os.WriteFile(path, []byte("existing\n"), 0o644))
+		file, err := OpenRotatingFile(path, 12, 1)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer file.Close()
+
+		// Act
+		_, err = file.Write([]byte("next\n"))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "next\n", readFile(t, path))
+		assert.Equal(t, "existing\n", readFile(t, path+".1"))
+	})
+
+	t.Run("異常系:上限が0以下の場合はエラー", func(t *testing.T) {
+		// Act
+		_, err := OpenRotatingFile(filepath.Join(t.TempDir(), "server.log"), 0, 1)
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+// readFile はファイルの内容を返します
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	return string(data)
+}
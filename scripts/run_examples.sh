@@ -9,7 +9,7 @@ cd "$(dirname "$0")/.."
 
 # バイナリをビルド
 echo "📦 バイナリをビルド中..."
-go build -o mcp ./cmd/mcp
+go build -o mcp ./cmd/mcp && go build -o fitness ./cmd/fitness
 
 if [ $? -ne 0 ]; then
     echo "❌ ビルドに失敗しました"
@@ -20,7 +20,6 @@ echo "✅ ビルド完了"
 
 # 実行権限を付与
 chmod +x scripts/cli_examples.sh
-chmod +x scripts/fitness_cli.py
 
 echo ""
 echo "🎯 利用可能なコマンド例:"
@@ -30,21 +29,21 @@ echo "1. シェルスクリプトでサンプル実行:"
 echo "   ./scripts/cli_examples.sh"
 echo ""
 
-echo "2. Python CLIツール:"
-echo "   # インタラクティブな記録"
-echo "   python3 scripts/fitness_cli.py record"
+echo "2. fitness CLI:"
+echo "   # 筋トレの記録"
+echo '   ./fitness log strength "ベンチプレス:80x5x3@8"'
 echo ""
-echo "   # 今月の履歴表示"
-echo "   python3 scripts/fitness_cli.py history --start $(date +%Y-%m-01) --end $(date +%Y-%m-%d)"
+echo "   # ランニングの記録"
+echo "   ./fitness log run -distance 10 -duration 50:00"
+echo ""
+echo "   # 直近30日のランニング"
+echo "   ./fitness runs"
 echo ""
 echo "   # 個人記録表示"
-echo "   python3 scripts/fitness_cli.py records"
+echo "   ./fitness prs"
 echo ""
 echo "   # 特定エクササイズの記録"
-echo "   python3 scripts/fitness_cli.py records --exercise ベンチプレス"
-echo ""
-echo "   # クイック記録"
-echo "   python3 scripts/fitness_cli.py quick"
+echo "   ./fitness prs -exercise ベンチプレス -json"
 echo ""
 
 echo "3. 直接JSON-RPC実行:"